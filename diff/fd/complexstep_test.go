@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// squireTrapp is the test function of
+//  Squire, W. and Trapp, G.: Using complex variables to estimate derivatives
+//  of real functions. SIAM Review 40(1), 110–112 (1998)
+// and its derivative.
+func squireTrapp(x complex128) complex128 {
+	s, c := cmplx.Sin(x), cmplx.Cos(x)
+	return cmplx.Exp(x) / cmplx.Sqrt(s*s*s+c*c*c)
+}
+
+func squireTrappDeriv(x float64) float64 {
+	s, c := math.Sincos(x)
+	d := s*s*s + c*c*c
+	return math.Exp(x) * (1/math.Sqrt(d) - 1.5*(s*s*c-c*c*s)/math.Pow(d, 1.5))
+}
+
+func TestComplexStepDerivative(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		f     func(complex128) complex128
+		deriv func(float64) float64
+		x     []float64
+	}{
+		{
+			name:  "SquireTrapp",
+			f:     squireTrapp,
+			deriv: squireTrappDeriv,
+			x:     []float64{-0.5, 0, 0.5, 1, 1.5},
+		},
+		{
+			name:  "Exp",
+			f:     cmplx.Exp,
+			deriv: math.Exp,
+			x:     []float64{-10, 0, 10},
+		},
+		{
+			name:  "Rational",
+			f:     func(x complex128) complex128 { return x * x * x / (1 + x*x) },
+			deriv: func(x float64) float64 { return x * x * (3 + x*x) / ((1 + x*x) * (1 + x*x)) },
+			x:     []float64{-3, 0.1, 2},
+		},
+	} {
+		for _, x := range test.x {
+			got := ComplexStepDerivative(test.f, x, 0)
+			want := test.deriv(x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-15, 1e-14) {
+				t.Errorf("%s: unexpected derivative at %v: got %v, want %v", test.name, x, got, want)
+			}
+		}
+	}
+
+	// The central difference loses about a third of the digits.
+	x := 1.5
+	want := squireTrappDeriv(x)
+	central := Derivative(func(x float64) float64 { return real(squireTrapp(complex(x, 0))) }, x, &Settings{Formula: Central})
+	complexStep := ComplexStepDerivative(squireTrapp, x, 0)
+	if math.Abs(complexStep-want) >= math.Abs(central-want) {
+		t.Errorf("complex step not more accurate than central difference: got error %v, central error %v", math.Abs(complexStep-want), math.Abs(central-want))
+	}
+}
+
+func TestComplexStepGradientJacobian(t *testing.T) {
+	t.Parallel()
+	x := []float64{0.3, -1.2, 2}
+
+	f := func(x []complex128) complex128 {
+		return x[0]*cmplx.Sin(x[1]) + cmplx.Exp(x[2]*x[0]) + x[1]*x[1]
+	}
+	want := []float64{
+		math.Sin(x[1]) + x[2]*math.Exp(x[2]*x[0]),
+		x[0]*math.Cos(x[1]) + 2*x[1],
+		x[0] * math.Exp(x[2]*x[0]),
+	}
+	got := ComplexStepGradient(nil, f, x, 0)
+	if !floats.EqualApprox(got, want, 1e-15) {
+		t.Errorf("unexpected gradient: got %v, want %v", got, want)
+	}
+
+	vf := func(y, x []complex128) {
+		y[0] = x[0] + 1
+		y[1] = 5 * x[2]
+		y[2] = 4*x[1]*x[1] - 2*x[2]
+		y[3] = x[2] * cmplx.Sin(x[0])
+	}
+	jac := mat.NewDense(4, 3, nil)
+	ComplexStepJacobian(jac, vf, x, 0)
+	wantJac := mat.NewDense(4, 3, nil)
+	vecFunc43Jac(wantJac, x)
+	if !mat.EqualApprox(jac, wantJac, 1e-15) {
+		t.Errorf("unexpected Jacobian:\ngot  %v\nwant %v", mat.Formatted(jac), mat.Formatted(wantJac))
+	}
+}
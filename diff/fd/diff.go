@@ -45,6 +45,11 @@ type Settings struct {
 	OriginValue float64 // Value at the origin (only used if OriginKnown is true).
 
 	Concurrent bool // Should the function calls be executed concurrently.
+
+	// Sparsity is the sparsity pattern of the Hessian. It is only used
+	// by Hessian, which estimates only the elements in the pattern if
+	// Sparsity is not nil.
+	Sparsity SparsityPattern
 }
 
 // Forward represents a first-order accurate forward approximation
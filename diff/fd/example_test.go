@@ -7,6 +7,7 @@ package fd_test
 import (
 	"fmt"
 	"math"
+	"math/cmplx"
 
 	"github.com/jingcheng-WU/gonum/diff/fd"
 	"github.com/jingcheng-WU/gonum/mat"
@@ -66,3 +67,24 @@ func ExampleJacobian() {
 	//     ⎢       0        16        -2⎥
 	//     ⎣ 1.62091         0  0.841471⎦
 }
+
+func ExampleDerivativeRidders() {
+	f := func(x float64) float64 {
+		return math.Exp(x) / math.Sqrt(math.Pow(math.Sin(x), 3)+math.Pow(math.Cos(x), 3))
+	}
+	// Estimate the derivative of f at 1.5 and the error of the estimate.
+	d, err := fd.DerivativeRidders(f, 1.5, nil)
+	fmt.Printf("f'(1.5) ≈ %.10f (error estimate < 1e-10: %t)\n", d, err < 1e-10)
+
+	// The complex-step approximation is accurate to machine precision for
+	// functions that can be evaluated with complex arguments.
+	g := func(x complex128) complex128 {
+		s, c := cmplx.Sin(x), cmplx.Cos(x)
+		return cmplx.Exp(x) / cmplx.Sqrt(s*s*s+c*c*c)
+	}
+	fmt.Printf("f'(1.5) = %.10f\n", fd.ComplexStepDerivative(g, 1.5, 0))
+
+	// Output:
+	// f'(1.5) ≈ 4.0534278939 (error estimate < 1e-10: true)
+	// f'(1.5) = 4.0534278939
+}
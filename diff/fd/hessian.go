@@ -18,9 +18,23 @@ import (
 // options are specified by settings. If settings is nil, the Hessian will be
 // estimated using the Forward formula and a default step size.
 //
+// If the sparsity pattern of the Hessian is given in settings, only the
+// elements in the pattern and the diagonal are estimated, and all other
+// elements of dst are set to zero. Only one of the elements (i, j) and (j, i)
+// of the pattern is needed. The number of evaluations of f is then
+// proportional to the number of elements in the pattern instead of n^2.
+// Unlike in HessianFromGrad, the variables are not grouped by a coloring:
+// each difference of function values estimates a single second derivative
+// along a pair of directions, so at least one difference is needed for each
+// element of the Hessian, and grouping the variables would only save the
+// evaluations at the points displaced along a single direction.
+//
 // If the dst matrix is empty it will be resized to the correct dimensions,
 // otherwise the dimensions of dst must match the length of x or Hessian will panic.
 // Hessian will panic if the derivative order of the formula is not 1.
+//
+// The Hessian of a function written in terms of num/jet numbers can be
+// computed exactly with a single evaluation by jet.Hessian.
 func Hessian(dst *mat.SymDense, f func(x []float64) float64, x []float64, settings *Settings) {
 	n := len(x)
 	if dst.IsEmpty() {
@@ -35,6 +49,7 @@ func Hessian(dst *mat.SymDense, f func(x []float64) float64, x []float64, settin
 	step := math.Sqrt(formula.Step) // Use the sqrt because taking derivatives of derivatives.
 	var originValue float64
 	var originKnown, concurrent bool
+	var sparsity SparsityPattern
 
 	// Use user settings if provided.
 	if settings != nil {
@@ -55,25 +70,55 @@ func Hessian(dst *mat.SymDense, f func(x []float64) float64, x []float64, settin
 		originKnown = settings.OriginKnown
 		originValue = settings.OriginValue
 		concurrent = settings.Concurrent
+		sparsity = settings.Sparsity
 	}
 
-	evals := n * (n + 1) / 2 * len(formula.Stencil) * len(formula.Stencil)
+	elems := hessianElements(n, sparsity)
+	evals := len(elems) * len(formula.Stencil) * len(formula.Stencil)
 	for _, pt := range formula.Stencil {
 		if pt.Loc == 0 {
-			evals -= n * (n + 1) / 2
+			evals -= len(elems)
 			break
 		}
 	}
 
 	nWorkers := computeWorkers(concurrent, evals)
 	if nWorkers == 1 {
-		hessianSerial(dst, f, x, formula.Stencil, step, originKnown, originValue)
+		hessianSerial(dst, f, x, elems, formula.Stencil, step, originKnown, originValue)
 		return
 	}
-	hessianConcurrent(dst, nWorkers, evals, f, x, formula.Stencil, step, originKnown, originValue)
+	hessianConcurrent(dst, nWorkers, evals, f, x, elems, formula.Stencil, step, originKnown, originValue)
+}
+
+// hessianElements returns the indices (i, j) with i <= j of the elements of
+// the n×n Hessian that are estimated, which are all elements if p is nil and
+// the diagonal and the elements of the symmetric pattern p otherwise.
+func hessianElements(n int, p SparsityPattern) [][2]int {
+	var elems [][2]int
+	if p == nil {
+		elems = make([][2]int, 0, n*(n+1)/2)
+		for i := 0; i < n; i++ {
+			for j := i; j < n; j++ {
+				elems = append(elems, [2]int{i, j})
+			}
+		}
+		return elems
+	}
+	if len(p) != n {
+		panic("hessian: mismatched sparsity pattern rows")
+	}
+	for i, a := range symmetricAdjacency(p) {
+		elems = append(elems, [2]int{i, i})
+		for _, j := range a {
+			if i < j {
+				elems = append(elems, [2]int{i, j})
+			}
+		}
+	}
+	return elems
 }
 
-func hessianSerial(dst *mat.SymDense, f func(x []float64) float64, x []float64, stencil []Point, step float64, originKnown bool, originValue float64) {
+func hessianSerial(dst *mat.SymDense, f func(x []float64) float64, x []float64, elems [][2]int, stencil []Point, step float64, originKnown bool, originValue float64) {
 	n := len(x)
 	xCopy := make([]float64, n)
 	fo := func() float64 {
@@ -83,35 +128,33 @@ func hessianSerial(dst *mat.SymDense, f func(x []float64) float64, x []float64,
 	}
 	is2 := 1 / (step * step)
 	origin := getOrigin(originKnown, originValue, fo, stencil)
-	for i := 0; i < n; i++ {
-		for j := i; j < n; j++ {
-			var hess float64
-			for _, pti := range stencil {
-				for _, ptj := range stencil {
-					var v float64
-					if pti.Loc == 0 && ptj.Loc == 0 {
-						v = origin
-					} else {
-						// Copying the data anew has two benefits. First, it
-						// avoids floating point issues where adding and then
-						// subtracting the step don't return to the exact same
-						// location. Secondly, it protects against the function
-						// modifying the input data.
-						copy(xCopy, x)
-						xCopy[i] += pti.Loc * step
-						xCopy[j] += ptj.Loc * step
-						v = f(xCopy)
-					}
-					hess += v * pti.Coeff * ptj.Coeff * is2
+	for _, e := range elems {
+		i, j := e[0], e[1]
+		var hess float64
+		for _, pti := range stencil {
+			for _, ptj := range stencil {
+				var v float64
+				if pti.Loc == 0 && ptj.Loc == 0 {
+					v = origin
+				} else {
+					// Copying the data anew has two benefits. First, it
+					// avoids floating point issues where adding and then
+					// subtracting the step don't return to the exact same
+					// location. Secondly, it protects against the function
+					// modifying the input data.
+					copy(xCopy, x)
+					xCopy[i] += pti.Loc * step
+					xCopy[j] += ptj.Loc * step
+					v = f(xCopy)
 				}
+				hess += v * pti.Coeff * ptj.Coeff * is2
 			}
-			dst.SetSym(i, j, hess)
 		}
+		dst.SetSym(i, j, hess)
 	}
 }
 
-func hessianConcurrent(dst *mat.SymDense, nWorkers, evals int, f func(x []float64) float64, x []float64, stencil []Point, step float64, originKnown bool, originValue float64) {
-	n := dst.Symmetric()
+func hessianConcurrent(dst *mat.SymDense, nWorkers, evals int, f func(x []float64) float64, x []float64, elems [][2]int, stencil []Point, step float64, originKnown bool, originValue float64) {
 	type run struct {
 		i, j       int
 		iIdx, jIdx int
@@ -159,13 +202,11 @@ func hessianConcurrent(dst *mat.SymDense, nWorkers, evals int, f func(x []float6
 
 	// Launch the distributor, which sends all of runs.
 	go func(send chan<- run) {
-		for i := 0; i < n; i++ {
-			for j := i; j < n; j++ {
-				for iIdx := range stencil {
-					for jIdx := range stencil {
-						send <- run{
-							i: i, j: j, iIdx: iIdx, jIdx: jIdx,
-						}
+		for _, e := range elems {
+			for iIdx := range stencil {
+				for jIdx := range stencil {
+					send <- run{
+						i: e[0], j: e[1], iIdx: iIdx, jIdx: jIdx,
 					}
 				}
 			}
@@ -184,3 +225,46 @@ func hessianConcurrent(dst *mat.SymDense, nWorkers, evals int, f func(x []float6
 		dst.SetSym(r.i, r.j, v)
 	}
 }
+
+// HessianFromGrad approximates the Hessian matrix of a multivariate function
+// at the location x as the symmetric part of the Jacobian of its gradient
+// grad, and stores the result in dst. Finite difference formula and other
+// options are specified by settings as for Jacobian, where OriginValue is the
+// gradient at x. If settings is nil, the Hessian will be estimated using the
+// Forward formula and a default step size.
+//
+// If the sparsity pattern of the Hessian is given in settings, the
+// variables are grouped by StarColoring and the Hessian is recovered
+// directly from the gradient differences along the groups, so that the
+// number of evaluations of grad is proportional to the number of colors
+// instead of n. Only one of the elements (i, j) and (j, i) of the pattern is
+// needed, and the diagonal is always estimated.
+//
+// If the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise the dimensions of dst must match the length of x or
+// HessianFromGrad will panic. HessianFromGrad will panic if the derivative
+// order of the formula is not 1.
+func HessianFromGrad(dst *mat.SymDense, grad func(g, x []float64), x []float64, settings *JacobianSettings) {
+	n := len(x)
+	if n == 0 {
+		panic("hessian: x has zero length")
+	}
+	if dst.IsEmpty() {
+		*dst = *(dst.GrowSym(n).(*mat.SymDense))
+	} else if dst.Symmetric() != n {
+		panic("hessian: dst size mismatch")
+	}
+
+	formula, step, originValue, concurrent := jacobianOptions(settings, n)
+	if settings != nil && settings.Sparsity != nil {
+		hessianSparse(dst, grad, x, settings.Sparsity, originValue, formula, step, concurrent)
+		return
+	}
+	jac := mat.NewDense(n, n, nil)
+	jacobian(jac, grad, x, originValue, formula, step, concurrent)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			dst.SetSym(i, j, (jac.At(i, j)+jac.At(j, i))/2)
+		}
+	}
+}
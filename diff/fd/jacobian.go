@@ -16,6 +16,13 @@ type JacobianSettings struct {
 	OriginValue []float64
 	Step        float64
 	Concurrent  bool
+
+	// Sparsity is the sparsity pattern of the Jacobian. If Sparsity is
+	// not nil, the columns of the Jacobian are grouped by ColumnColoring
+	// and the Jacobian is estimated with one set of function evaluations
+	// per group instead of per column. Elements that are not in the
+	// pattern are set to zero.
+	Sparsity SparsityPattern
 }
 
 // Jacobian approximates the Jacobian matrix of a vector-valued function f at
@@ -36,6 +43,11 @@ type JacobianSettings struct {
 //      [     .          .  .     ]
 //      [ ∂f_m/∂x_1 ... ∂f_m/∂x_n ]
 //
+// If the sparsity pattern of J is given in settings, the columns of J that
+// have no non-zero elements in a common row are perturbed together, in the
+// method of Curtis, Powell and Reid, so that the number of evaluations of f is
+// proportional to the number of colors found by ColumnColoring instead of n.
+//
 // dst must be non-nil, the number of its columns must equal the length of x, and
 // the derivative order of the formula must be 1, otherwise Jacobian will panic.
 func Jacobian(dst *mat.Dense, f func(y, x []float64), x []float64, settings *JacobianSettings) {
@@ -48,11 +60,20 @@ func Jacobian(dst *mat.Dense, f func(y, x []float64), x []float64, settings *Jac
 		panic("jacobian: mismatched matrix size")
 	}
 
+	formula, step, originValue, concurrent := jacobianOptions(settings, m)
+	if settings != nil && settings.Sparsity != nil {
+		jacobianSparse(dst, f, x, settings.Sparsity, originValue, formula, step, concurrent)
+		return
+	}
+	jacobian(dst, f, x, originValue, formula, step, concurrent)
+}
+
+// jacobianOptions returns the options in settings for a Jacobian with m rows,
+// or the default options if settings is nil.
+func jacobianOptions(settings *JacobianSettings, m int) (formula Formula, step float64, originValue []float64, concurrent bool) {
 	// Default settings.
-	formula := Forward
-	step := formula.Step
-	var originValue []float64
-	var concurrent bool
+	formula = Forward
+	step = formula.Step
 
 	// Use user settings if provided.
 	if settings != nil {
@@ -73,7 +94,13 @@ func Jacobian(dst *mat.Dense, f func(y, x []float64), x []float64, settings *Jac
 		}
 		concurrent = settings.Concurrent
 	}
+	return formula, step, originValue, concurrent
+}
 
+// jacobian approximates the Jacobian of f at x, evaluating f serially or
+// concurrently.
+func jacobian(dst *mat.Dense, f func(y, x []float64), x, originValue []float64, formula Formula, step float64, concurrent bool) {
+	n := len(x)
 	evals := n * len(formula.Stencil)
 	for _, pt := range formula.Stencil {
 		if pt.Loc == 0 {
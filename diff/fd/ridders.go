@@ -0,0 +1,227 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// RiddersSettings are the settings of the derivative estimates with
+// Ridders' extrapolation.
+type RiddersSettings struct {
+	// Step is the initial step of the central differences. It should be
+	// of the size over which the function changes substantially, and need
+	// not be small. If Step is zero, a default of 0.1 is used.
+	Step float64
+
+	// Shrink is the factor by which the step is reduced in each
+	// iteration. If Shrink is zero, a default of 1.4 is used, otherwise
+	// it must be greater than 1.
+	Shrink float64
+
+	// MaxIter is the maximum number of steps. If MaxIter is zero, a
+	// default of 10 is used.
+	MaxIter int
+}
+
+// riddersSafe is the factor by which the error may grow before the
+// extrapolation is stopped.
+const riddersSafe = 2
+
+func riddersOptions(settings *RiddersSettings) (step, shrink float64, maxIter int) {
+	step = 0.1
+	shrink = 1.4
+	maxIter = 10
+	if settings != nil {
+		if settings.Step < 0 {
+			panic(negativeStep)
+		}
+		if settings.Step != 0 {
+			step = settings.Step
+		}
+		if settings.Shrink != 0 {
+			if !(settings.Shrink > 1) {
+				panic("fd: shrink factor not greater than 1")
+			}
+			shrink = settings.Shrink
+		}
+		if settings.MaxIter < 0 {
+			panic("fd: negative MaxIter")
+		}
+		if settings.MaxIter != 0 {
+			maxIter = settings.MaxIter
+		}
+	}
+	return step, shrink, maxIter
+}
+
+// ridders estimates the first derivatives of m functions by Ridders'
+// polynomial extrapolation of their central differences to a zero step, and
+// stores the estimates and their estimated errors into deriv and errs. diff
+// stores the central differences of the functions with step h into dst.
+//
+// The method is described in
+//  Ridders, C. J. F.: Accurate computation of F'(x) and F'(x)F''(x).
+//  Advances in Engineering Software 4(2), 75–76 (1982)
+// and section 5.7 of Numerical Recipes.
+func ridders(deriv, errs []float64, diff func(dst []float64, h float64), settings *RiddersSettings) {
+	h, shrink, maxIter := riddersOptions(settings)
+	shrink2 := shrink * shrink
+	m := len(deriv)
+
+	// prev and cur are the previous and the current rows of the
+	// extrapolation tableau of each function.
+	prev := make([][]float64, m)
+	cur := make([][]float64, m)
+	for k := range prev {
+		prev[k] = make([]float64, maxIter)
+		cur[k] = make([]float64, maxIter)
+	}
+	done := make([]bool, m)
+
+	d := make([]float64, m)
+	diff(d, h)
+	for k, v := range d {
+		prev[k][0] = v
+		deriv[k] = v
+		errs[k] = math.Inf(1)
+	}
+	for i := 1; i < maxIter; i++ {
+		h /= shrink
+		diff(d, h)
+		var remaining bool
+		for k, v := range d {
+			if done[k] {
+				continue
+			}
+			c, p := cur[k], prev[k]
+			c[0] = v
+			fac := shrink2
+			for j := 1; j <= i; j++ {
+				// Extrapolate to higher orders, and keep the estimate
+				// with the smallest error.
+				c[j] = (c[j-1]*fac - p[j-1]) / (fac - 1)
+				fac *= shrink2
+				e := math.Max(math.Abs(c[j]-c[j-1]), math.Abs(c[j]-p[j-1]))
+				if e <= errs[k] {
+					errs[k] = e
+					deriv[k] = c[j]
+				}
+			}
+			// Stop when the highest order estimate becomes worse than
+			// the best estimate by a significant factor.
+			if math.Abs(c[i]-p[i-1]) >= riddersSafe*errs[k] {
+				done[k] = true
+			} else {
+				remaining = true
+			}
+			prev[k], cur[k] = c, p
+		}
+		if !remaining {
+			break
+		}
+	}
+}
+
+// DerivativeRidders estimates the first derivative of the function f at x
+// and the error of the estimate, using Ridders' polynomial extrapolation of
+// central differences with decreasing steps. The extrapolation adapts the
+// effective step, so that the estimate is typically accurate to close to
+// machine precision for smooth functions. If settings is nil, the default
+// settings are used.
+func DerivativeRidders(f func(float64) float64, x float64, settings *RiddersSettings) (deriv, err float64) {
+	var d, e [1]float64
+	ridders(d[:], e[:], func(dst []float64, h float64) {
+		dst[0] = (f(x+h) - f(x-h)) / (2 * h)
+	}, settings)
+	return d[0], e[0]
+}
+
+// GradientRidders estimates the gradient of the multivariate function f at
+// the location x using Ridders' extrapolation for each element, see
+// DerivativeRidders. If dst is not nil, the result will be stored in-place
+// into dst and returned, otherwise a new slice will be allocated first. If
+// errs is not nil, the estimated errors of the elements of the gradient are
+// stored into errs. If settings is nil, the default settings are used.
+//
+// GradientRidders panics if the lengths of dst, or errs if it is not nil,
+// and x are not equal.
+func GradientRidders(dst, errs []float64, f func([]float64) float64, x []float64, settings *RiddersSettings) []float64 {
+	n := len(x)
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n || (errs != nil && len(errs) != n) {
+		panic("fd: slice length mismatch")
+	}
+	xcopy := make([]float64, n)
+	var e [1]float64
+	for j := range x {
+		ridders(dst[j:j+1], e[:], func(d []float64, h float64) {
+			// Copying the data anew protects against the function
+			// modifying the input data.
+			copy(xcopy, x)
+			xcopy[j] += h
+			fp := f(xcopy)
+			copy(xcopy, x)
+			xcopy[j] -= h
+			fm := f(xcopy)
+			d[0] = (fp - fm) / (2 * h)
+		}, settings)
+		if errs != nil {
+			errs[j] = e[0]
+		}
+	}
+	return dst
+}
+
+// JacobianRidders estimates the Jacobian matrix of the vector-valued
+// function f at the location x using Ridders' extrapolation for each
+// element, see DerivativeRidders, and stores the result in-place into dst.
+// If errs is not nil, the estimated errors of the elements of the Jacobian
+// are stored into errs. If settings is nil, the default settings are used.
+//
+// dst must be non-nil and the number of its columns must equal the length of
+// x, and errs must be nil or have the dimensions of dst, otherwise
+// JacobianRidders will panic.
+func JacobianRidders(dst, errs *mat.Dense, f func(y, x []float64), x []float64, settings *RiddersSettings) {
+	n := len(x)
+	if n == 0 {
+		panic("jacobian: x has zero length")
+	}
+	m, c := dst.Dims()
+	if c != n {
+		panic("jacobian: mismatched matrix size")
+	}
+	if errs != nil {
+		if r, c := errs.Dims(); r != m || c != n {
+			panic("jacobian: mismatched error matrix size")
+		}
+	}
+	xcopy := make([]float64, n)
+	yp := make([]float64, m)
+	ym := make([]float64, m)
+	col := make([]float64, m)
+	e := make([]float64, m)
+	for j := range x {
+		ridders(col, e, func(d []float64, h float64) {
+			copy(xcopy, x)
+			xcopy[j] += h
+			f(yp, xcopy)
+			copy(xcopy, x)
+			xcopy[j] -= h
+			f(ym, xcopy)
+			for i := range d {
+				d[i] = (yp[i] - ym[i]) / (2 * h)
+			}
+		}, settings)
+		dst.SetCol(j, col)
+		if errs != nil {
+			errs.SetCol(j, e)
+		}
+	}
+}
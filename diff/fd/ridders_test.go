@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func TestDerivativeRidders(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		f, deriv func(float64) float64
+		x        float64
+		settings *RiddersSettings
+	}{
+		{name: "Exp", f: math.Exp, deriv: math.Exp, x: 1},
+		{name: "Sin", f: math.Sin, deriv: math.Cos, x: 0.7},
+		{
+			name: "SquireTrapp",
+			f: func(x float64) float64 {
+				return real(squireTrapp(complex(x, 0)))
+			},
+			deriv: squireTrappDeriv,
+			x:     1.5,
+		},
+		{
+			name:     "Log",
+			f:        math.Log,
+			deriv:    func(x float64) float64 { return 1 / x },
+			x:        0.5,
+			settings: &RiddersSettings{Step: 0.2, Shrink: 2, MaxIter: 20},
+		},
+		{
+			name:  "Polynomial",
+			f:     func(x float64) float64 { return x*x*x - 2*x },
+			deriv: func(x float64) float64 { return 3*x*x - 2 },
+			x:     2,
+		},
+	} {
+		got, err := DerivativeRidders(test.f, test.x, test.settings)
+		want := test.deriv(test.x)
+		diff := math.Abs(got - want)
+		if diff > 1e-11*math.Max(1, math.Abs(want)) {
+			t.Errorf("%s: unexpected derivative: got %v, want %v", test.name, got, want)
+		}
+		if err > 1e-10 {
+			t.Errorf("%s: unexpectedly large error estimate: %v", test.name, err)
+		}
+		if diff > 10*err+1e-14*math.Abs(want) {
+			t.Errorf("%s: error %v not bounded by estimate %v", test.name, diff, err)
+		}
+
+		// Ridders' extrapolation is more accurate than the central
+		// difference with its default step.
+		central := Derivative(test.f, test.x, &Settings{Formula: Central})
+		if diff > math.Abs(central-want) && diff > 1e-15 {
+			t.Errorf("%s: Ridders' error %v larger than central difference error %v", test.name, diff, math.Abs(central-want))
+		}
+	}
+}
+
+func TestGradientRidders(t *testing.T) {
+	t.Parallel()
+	x := []float64{0.3, -1.2, 2}
+	f := func(x []float64) float64 {
+		v := x[0]*math.Sin(x[1]) + math.Exp(x[2]*x[0]) + x[1]*x[1]
+		x[0] = math.NaN() // Modifications of x must not affect the result.
+		return v
+	}
+	want := []float64{
+		math.Sin(x[1]) + x[2]*math.Exp(x[2]*x[0]),
+		x[0]*math.Cos(x[1]) + 2*x[1],
+		x[0] * math.Exp(x[2]*x[0]),
+	}
+	errs := make([]float64, len(x))
+	got := GradientRidders(nil, errs, f, x, nil)
+	for i := range got {
+		diff := math.Abs(got[i] - want[i])
+		if diff > 1e-11 || errs[i] > 1e-10 || diff > 10*errs[i]+1e-15 {
+			t.Errorf("unexpected gradient element %d: got %v±%v, want %v", i, got[i], errs[i], want[i])
+		}
+	}
+}
+
+func TestJacobianRidders(t *testing.T) {
+	t.Parallel()
+	x := []float64{0.3, -1.2, 2}
+	got := mat.NewDense(4, 3, nil)
+	errs := mat.NewDense(4, 3, nil)
+	JacobianRidders(got, errs, vecFunc43, x, nil)
+	want := mat.NewDense(4, 3, nil)
+	vecFunc43Jac(want, x)
+	if !mat.EqualApprox(got, want, 1e-11) {
+		t.Errorf("unexpected Jacobian:\ngot  %v\nwant %v", mat.Formatted(got), mat.Formatted(want))
+	}
+	var diff mat.Dense
+	diff.Sub(got, want)
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 3; j++ {
+			if math.Abs(diff.At(i, j)) > 10*errs.At(i, j)+1e-14*math.Max(1, math.Abs(want.At(i, j))) {
+				t.Errorf("error of element (%d, %d) not bounded by estimate %v", i, j, errs.At(i, j))
+			}
+		}
+	}
+
+	// A nil error matrix is allowed.
+	JacobianRidders(got, nil, vecFunc43, x, nil)
+	if !mat.EqualApprox(got, want, 1e-11) {
+		t.Errorf("unexpected Jacobian without errors:\ngot  %v\nwant %v", mat.Formatted(got), mat.Formatted(want))
+	}
+}
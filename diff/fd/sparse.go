@@ -0,0 +1,277 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"sort"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// SparsityPattern is the sparsity pattern of a matrix. Element i holds the
+// column indices of the elements in row i that may be non-zero. All other
+// elements of the matrix are known to be zero.
+type SparsityPattern [][]int
+
+// check panics if the pattern does not describe a matrix with m rows and
+// n columns.
+func (p SparsityPattern) check(m, n int, prefix string) {
+	if len(p) != m {
+		panic(prefix + ": mismatched sparsity pattern rows")
+	}
+	for _, row := range p {
+		for _, j := range row {
+			if j < 0 || n <= j {
+				panic(prefix + ": sparsity pattern column out of range")
+			}
+		}
+	}
+}
+
+// ColumnColoring returns a coloring of the columns of an m×n matrix with
+// the sparsity pattern p, such that no two columns with non-zero elements in
+// a common row have the same color, and the number of colors used. Colors
+// are numbered from zero. Columns of the same color are structurally
+// orthogonal and their derivatives can be estimated together.
+//
+// ColumnColoring uses a greedy coloring of the column intersection graph in
+// order of decreasing degree. ColumnColoring panics if a column index in p
+// is not in [0, n).
+func ColumnColoring(p SparsityPattern, n int) (colors []int, k int) {
+	p.check(len(p), n, "fd")
+
+	// Columns are adjacent in the column intersection graph if they
+	// share a row.
+	rows := make([][]int, n)
+	for i, row := range p {
+		for _, j := range row {
+			rows[j] = append(rows[j], i)
+		}
+	}
+	adj := make([][]int, n)
+	seen := make([]int, n)
+	for j := range seen {
+		seen[j] = -1
+	}
+	for j, rj := range rows {
+		seen[j] = j
+		for _, i := range rj {
+			for _, l := range p[i] {
+				if seen[l] != j {
+					seen[l] = j
+					adj[j] = append(adj[j], l)
+				}
+			}
+		}
+	}
+	return greedyColoring(adj, func(v int, colors []int, forbid func(c int)) {
+		for _, w := range adj[v] {
+			if colors[w] >= 0 {
+				forbid(colors[w])
+			}
+		}
+	})
+}
+
+// StarColoring returns a star coloring of the adjacency graph of the
+// symmetric n×n matrix with the sparsity pattern p, and the number of colors
+// used. Colors are numbered from zero. In the adjacency graph, i and j are
+// adjacent if element (i, j) or (j, i) is in the pattern and i ≠ j.
+//
+// A star coloring is a coloring in which adjacent vertices have different
+// colors and every path on four vertices uses at least three colors. The
+// elements of a symmetric matrix can be recovered directly from its
+// products with the indicator vectors of the colors, which is used by
+// HessianFromGrad.
+//
+// StarColoring uses the greedy algorithm 4.1 of
+//  Gebremedhin, A. H., Manne, F. and Pothen, A.: What color is your
+//  Jacobian? Graph coloring for computing derivatives. SIAM Review 47(4),
+//  629–705 (2005)
+// in order of decreasing degree. StarColoring panics if a column index in p
+// is not in [0, len(p)).
+func StarColoring(p SparsityPattern) (colors []int, k int) {
+	return starColoring(symmetricAdjacency(p))
+}
+
+// starColoring returns a star coloring of the graph with the adjacency
+// lists adj.
+func starColoring(adj [][]int) (colors []int, k int) {
+	return greedyColoring(adj, func(v int, colors []int, forbid func(c int)) {
+		for _, w := range adj[v] {
+			cw := colors[w]
+			if cw >= 0 {
+				forbid(cw)
+			}
+			for _, x := range adj[w] {
+				cx := colors[x]
+				if x == v || cx < 0 {
+					continue
+				}
+				if cw < 0 {
+					// v and x would be the ends of a path v-w-x
+					// that may become bicolored with the color
+					// of w.
+					forbid(cx)
+					continue
+				}
+				// Forbid the color of x if giving it to v would
+				// make the path v-w-x-y bicolored.
+				for _, y := range adj[x] {
+					if y != w && colors[y] == cw {
+						forbid(cx)
+						break
+					}
+				}
+			}
+		}
+	})
+}
+
+// symmetricAdjacency returns the adjacency lists of the adjacency graph of
+// the symmetric square matrix with the sparsity pattern p.
+func symmetricAdjacency(p SparsityPattern) [][]int {
+	n := len(p)
+	p.check(n, n, "fd")
+	adj := make([][]int, n)
+	for i, row := range p {
+		for _, j := range row {
+			if i != j {
+				adj[i] = append(adj[i], j)
+				adj[j] = append(adj[j], i)
+			}
+		}
+	}
+	for i, a := range adj {
+		sort.Ints(a)
+		// Remove duplicate entries.
+		var l int
+		for k, j := range a {
+			if k == 0 || j != a[l-1] {
+				a[l] = j
+				l++
+			}
+		}
+		adj[i] = a[:l]
+	}
+	return adj
+}
+
+// greedyColoring colors the vertices of the graph with the adjacency lists
+// adj in order of decreasing degree. Each vertex v is given the smallest
+// color that is not forbidden by forbidden, which calls forbid for each
+// color that v may not have given the colors of the vertices colored so
+// far. Uncolored vertices have color -1.
+func greedyColoring(adj [][]int, forbidden func(v int, colors []int, forbid func(c int))) (colors []int, k int) {
+	n := len(adj)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return len(adj[order[a]]) > len(adj[order[b]])
+	})
+
+	colors = make([]int, n)
+	for i := range colors {
+		colors[i] = -1
+	}
+	// mark[c] is v+1 when color c is forbidden for vertex v.
+	mark := make([]int, n+1)
+	for _, v := range order {
+		forbidden(v, colors, func(c int) { mark[c] = v + 1 })
+		var c int
+		for mark[c] == v+1 {
+			c++
+		}
+		colors[v] = c
+		if c >= k {
+			k = c + 1
+		}
+	}
+	return colors, k
+}
+
+// compressedJacobian returns an estimate of the m×k matrix J*S, where J is
+// the Jacobian of f at x and column c of the n×k matrix S is the indicator
+// vector of the columns of color c.
+func compressedJacobian(m int, f func(y, x []float64), x []float64, colors []int, k int, originValue []float64, formula Formula, step float64, concurrent bool) *mat.Dense {
+	g := func(y, t []float64) {
+		xt := make([]float64, len(x))
+		for j, c := range colors {
+			xt[j] = x[j] + t[c]
+		}
+		f(y, xt)
+	}
+	b := mat.NewDense(m, k, nil)
+	jacobian(b, g, make([]float64, k), originValue, formula, step, concurrent)
+	return b
+}
+
+func jacobianSparse(dst *mat.Dense, f func(y, x []float64), x []float64, p SparsityPattern, originValue []float64, formula Formula, step float64, concurrent bool) {
+	m, n := dst.Dims()
+	p.check(m, n, "jacobian")
+	colors, k := ColumnColoring(p, n)
+	if k == 0 {
+		dst.Zero()
+		return
+	}
+	b := compressedJacobian(m, f, x, colors, k, originValue, formula, step, concurrent)
+	dst.Zero()
+	for i, row := range p {
+		for _, j := range row {
+			dst.Set(i, j, b.At(i, colors[j]))
+		}
+	}
+}
+
+func hessianSparse(dst *mat.SymDense, grad func(g, x []float64), x []float64, p SparsityPattern, originValue []float64, formula Formula, step float64, concurrent bool) {
+	n := len(x)
+	adj := symmetricAdjacency(p)
+	colors, k := starColoring(adj)
+	b := compressedJacobian(n, grad, x, colors, k, originValue, formula, step, concurrent)
+
+	// Element (i, j) of the Hessian is the element (i, color of j) of b
+	// if j is the only neighbor of i with its color. In a star coloring
+	// this holds for (i, j) or (j, i).
+	unique := make([][]bool, n)
+	count := make([]int, k)
+	for i, a := range adj {
+		for _, j := range a {
+			count[colors[j]]++
+		}
+		unique[i] = make([]bool, len(a))
+		for l, j := range a {
+			unique[i][l] = count[colors[j]] == 1
+		}
+		for _, j := range a {
+			count[colors[j]] = 0
+		}
+	}
+
+	dst.Zero()
+	for i, a := range adj {
+		dst.SetSym(i, i, b.At(i, colors[i]))
+		for l, j := range a {
+			if j < i {
+				continue
+			}
+			var sum float64
+			var m int
+			if unique[i][l] {
+				sum += b.At(i, colors[j])
+				m++
+			}
+			if unique[j][sort.SearchInts(adj[j], i)] {
+				sum += b.At(j, colors[i])
+				m++
+			}
+			if m == 0 {
+				panic("fd: invalid star coloring")
+			}
+			dst.SetSym(i, j, sum/float64(m))
+		}
+	}
+}
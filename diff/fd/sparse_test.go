@@ -0,0 +1,375 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package fd
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// broydenTridiagonal is the Broyden tridiagonal function, which has a
+// tridiagonal Jacobian.
+func broydenTridiagonal(y, x []float64) {
+	n := len(x)
+	for i := range y {
+		y[i] = (3-2*x[i])*x[i] + 1
+		if i > 0 {
+			y[i] -= x[i-1]
+		}
+		if i < n-1 {
+			y[i] -= 2 * x[i+1]
+		}
+	}
+}
+
+func tridiagonalPattern(n int) SparsityPattern {
+	p := make(SparsityPattern, n)
+	for i := range p {
+		for j := i - 1; j <= i+1; j++ {
+			if 0 <= j && j < n {
+				p[i] = append(p[i], j)
+			}
+		}
+	}
+	return p
+}
+
+func randomPattern(rnd *rand.Rand, m, n int, density float64) SparsityPattern {
+	p := make(SparsityPattern, m)
+	for i := range p {
+		for j := 0; j < n; j++ {
+			if rnd.Float64() < density {
+				p[i] = append(p[i], j)
+			}
+		}
+	}
+	return p
+}
+
+func TestJacobianSparse(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+
+	// A separable function with a random sparsity pattern.
+	const m, n = 30, 20
+	pattern := randomPattern(rnd, m, n, 0.15)
+	a := mat.NewDense(m, n, nil)
+	for i, row := range pattern {
+		for _, j := range row {
+			a.Set(i, j, rnd.NormFloat64())
+		}
+	}
+	separable := func(y, x []float64) {
+		for i, row := range pattern {
+			y[i] = 0
+			for _, j := range row {
+				y[i] += math.Sin(a.At(i, j) * x[j])
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		name    string
+		m, n    int
+		f       func(y, x []float64)
+		pattern SparsityPattern
+		colors  int
+	}{
+		{name: "Tridiagonal", m: 50, n: 50, f: broydenTridiagonal, pattern: tridiagonalPattern(50), colors: 3},
+		{name: "Random", m: m, n: n, f: separable, pattern: pattern},
+	} {
+		x := randomSlice(rnd, test.n, 1)
+		_, k := ColumnColoring(test.pattern, test.n)
+		if test.colors != 0 && k != test.colors {
+			t.Errorf("%s: unexpected number of colors: got %d, want %d", test.name, k, test.colors)
+		}
+		for _, formula := range []Formula{Forward, Central} {
+			for _, concurrent := range []bool{false, true} {
+				want := mat.NewDense(test.m, test.n, nil)
+				Jacobian(want, test.f, x, &JacobianSettings{Formula: formula})
+
+				var evals int64
+				f := func(y, x []float64) {
+					atomic.AddInt64(&evals, 1)
+					test.f(y, x)
+				}
+				got := mat.NewDense(test.m, test.n, nil)
+				fillNaNDense(got)
+				Jacobian(got, f, x, &JacobianSettings{
+					Formula:    formula,
+					Concurrent: concurrent,
+					Sparsity:   test.pattern,
+				})
+				// Only one column of each row is perturbed at a time,
+				// so the estimates are identical to the dense ones up
+				// to the order of the summation of the formula terms.
+				if !mat.EqualApprox(got, want, 1e-12) {
+					t.Errorf("%s: sparse Jacobian does not match dense Jacobian, concurrent=%t", test.name, concurrent)
+				}
+				wantEvals := k * len(formula.Stencil)
+				if usesOrigin(formula.Stencil) {
+					wantEvals -= k - 1
+				}
+				if int(evals) != wantEvals {
+					t.Errorf("%s: unexpected number of evaluations: got %d, want %d", test.name, evals, wantEvals)
+				}
+			}
+		}
+	}
+}
+
+func TestColumnColoring(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		m, n := 1+rnd.Intn(30), 1+rnd.Intn(30)
+		p := randomPattern(rnd, m, n, 0.3*rnd.Float64())
+		colors, k := ColumnColoring(p, n)
+		if len(colors) != n {
+			t.Fatalf("unexpected number of colored columns: got %d, want %d", len(colors), n)
+		}
+		for _, c := range colors {
+			if c < 0 || k <= c {
+				t.Fatalf("color %d out of range [0, %d)", c, k)
+			}
+		}
+		for i, row := range p {
+			seen := make(map[int]int)
+			for _, j := range row {
+				if l, ok := seen[colors[j]]; ok {
+					t.Errorf("columns %d and %d share row %d and color %d", l, j, i, colors[j])
+				}
+				seen[colors[j]] = j
+			}
+		}
+	}
+}
+
+func TestStarColoring(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 50; trial++ {
+		n := 1 + rnd.Intn(25)
+		p := randomPattern(rnd, n, n, 0.3*rnd.Float64())
+		colors, k := StarColoring(p)
+		for _, c := range colors {
+			if c < 0 || k <= c {
+				t.Fatalf("color %d out of range [0, %d)", c, k)
+			}
+		}
+		adj := symmetricAdjacency(p)
+		for v1, a := range adj {
+			for _, v2 := range a {
+				if colors[v1] == colors[v2] {
+					t.Errorf("adjacent vertices %d and %d have color %d", v1, v2, colors[v1])
+				}
+				for _, v3 := range adj[v2] {
+					if v3 == v1 {
+						continue
+					}
+					for _, v4 := range adj[v3] {
+						if v4 == v2 || v4 == v1 {
+							continue
+						}
+						if colors[v1] == colors[v3] && colors[v2] == colors[v4] {
+							t.Errorf("path %d-%d-%d-%d is bicolored", v1, v2, v3, v4)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	// The arrowhead pattern needs only two colors.
+	const n = 10
+	arrow := make(SparsityPattern, n)
+	for j := 0; j < n; j++ {
+		arrow[0] = append(arrow[0], j)
+	}
+	if _, k := StarColoring(arrow); k != 2 {
+		t.Errorf("unexpected number of colors for arrowhead pattern: got %d, want 2", k)
+	}
+}
+
+func TestHessianFromGrad(t *testing.T) {
+	t.Parallel()
+	const n = 12
+	const a = 10
+
+	// The chained Rosenbrock function has a tridiagonal Hessian.
+	chainGrad := func(g, x []float64) {
+		for i := range g {
+			g[i] = 0
+		}
+		for i := 0; i < n-1; i++ {
+			d := x[i+1] - x[i]*x[i]
+			g[i] += -4*a*x[i]*d - 2*(1-x[i])
+			g[i+1] += 2 * a * d
+		}
+	}
+	chainHess := func(h *mat.SymDense, x []float64) {
+		for i := 0; i < n-1; i++ {
+			h.SetSym(i, i, h.At(i, i)-4*a*x[i+1]+12*a*x[i]*x[i]+2)
+			h.SetSym(i, i+1, -4*a*x[i])
+			h.SetSym(i+1, i+1, h.At(i+1, i+1)+2*a)
+		}
+	}
+	// Only the upper triangle of the pattern is given.
+	chainPattern := make(SparsityPattern, n)
+	for i := 0; i < n-1; i++ {
+		chainPattern[i] = []int{i + 1}
+	}
+
+	// f(x) = x_0 * \sum_{i>0} x_i^2 + \sum_i sin(x_i) has an arrowhead
+	// Hessian.
+	arrowGrad := func(g, x []float64) {
+		g[0] = math.Cos(x[0])
+		for i := 1; i < n; i++ {
+			g[0] += x[i] * x[i]
+			g[i] = 2*x[0]*x[i] + math.Cos(x[i])
+		}
+	}
+	arrowHess := func(h *mat.SymDense, x []float64) {
+		h.SetSym(0, 0, -math.Sin(x[0]))
+		for i := 1; i < n; i++ {
+			h.SetSym(0, i, 2*x[i])
+			h.SetSym(i, i, 2*x[0]-math.Sin(x[i]))
+		}
+	}
+	arrowPattern := make(SparsityPattern, n)
+	for i := 1; i < n; i++ {
+		arrowPattern[i] = []int{0}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name    string
+		grad    func(g, x []float64)
+		hess    func(h *mat.SymDense, x []float64)
+		pattern SparsityPattern
+		colors  int
+	}{
+		{name: "Chain", grad: chainGrad, hess: chainHess, pattern: chainPattern, colors: 3},
+		{name: "Arrow", grad: arrowGrad, hess: arrowHess, pattern: arrowPattern, colors: 2},
+	} {
+		x := randomSlice(rnd, n, 1)
+		want := mat.NewSymDense(n, nil)
+		test.hess(want, x)
+
+		var dense mat.SymDense
+		HessianFromGrad(&dense, test.grad, x, &JacobianSettings{Formula: Central})
+		if !mat.EqualApprox(&dense, want, 1e-6) {
+			t.Errorf("%s: unexpected dense Hessian:\ngot  %v\nwant %v", test.name, mat.Formatted(&dense), mat.Formatted(want))
+		}
+
+		for _, concurrent := range []bool{false, true} {
+			var evals int64
+			grad := func(g, x []float64) {
+				atomic.AddInt64(&evals, 1)
+				test.grad(g, x)
+			}
+			var sparse mat.SymDense
+			HessianFromGrad(&sparse, grad, x, &JacobianSettings{
+				Formula:    Central,
+				Concurrent: concurrent,
+				Sparsity:   test.pattern,
+			})
+			if !mat.EqualApprox(&sparse, want, 1e-6) {
+				t.Errorf("%s: unexpected sparse Hessian, concurrent=%t:\ngot  %v\nwant %v", test.name, concurrent, mat.Formatted(&sparse), mat.Formatted(want))
+			}
+			if want := 2 * test.colors; int(evals) != want {
+				t.Errorf("%s: unexpected number of evaluations: got %d, want %d", test.name, evals, want)
+			}
+		}
+	}
+}
+
+func TestHessianSparse(t *testing.T) {
+	t.Parallel()
+	const n = 12
+	const a = 10
+
+	// The chained Rosenbrock function has a tridiagonal Hessian.
+	chain := func(x []float64) float64 {
+		var f float64
+		for i := 0; i < n-1; i++ {
+			d := x[i+1] - x[i]*x[i]
+			f += a*d*d + (1-x[i])*(1-x[i])
+		}
+		return f
+	}
+	// Only the upper triangle of the pattern is given.
+	chainPattern := make(SparsityPattern, n)
+	for i := 0; i < n-1; i++ {
+		chainPattern[i] = []int{i + 1}
+	}
+
+	// f(x) = x_0 * \sum_{i>0} x_i^2 + \sum_i sin(x_i) has an arrowhead
+	// Hessian.
+	arrow := func(x []float64) float64 {
+		f := math.Sin(x[0])
+		for i := 1; i < n; i++ {
+			f += x[0]*x[i]*x[i] + math.Sin(x[i])
+		}
+		return f
+	}
+	arrowPattern := make(SparsityPattern, n)
+	for i := 1; i < n; i++ {
+		arrowPattern[i] = []int{0}
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name    string
+		f       func(x []float64) float64
+		pattern SparsityPattern
+		elems   int
+	}{
+		{name: "Chain", f: chain, pattern: chainPattern, elems: 2*n - 1},
+		{name: "Arrow", f: arrow, pattern: arrowPattern, elems: 2*n - 1},
+	} {
+		x := randomSlice(rnd, n, 1)
+		var dense mat.SymDense
+		Hessian(&dense, test.f, x, &Settings{Formula: Central})
+		// The elements outside the pattern are zero.
+		want := mat.NewSymDense(n, nil)
+		for i := 0; i < n; i++ {
+			want.SetSym(i, i, dense.At(i, i))
+			for _, j := range test.pattern[i] {
+				want.SetSym(i, j, dense.At(i, j))
+			}
+		}
+		if !mat.EqualApprox(&dense, want, 1e-6) {
+			t.Errorf("%s: dense Hessian does not match pattern:\n%v", test.name, mat.Formatted(&dense))
+		}
+
+		for _, concurrent := range []bool{false, true} {
+			var evals int64
+			f := func(x []float64) float64 {
+				atomic.AddInt64(&evals, 1)
+				return test.f(x)
+			}
+			var sparse mat.SymDense
+			Hessian(&sparse, f, x, &Settings{
+				Formula:    Central,
+				Concurrent: concurrent,
+				Sparsity:   test.pattern,
+			})
+			if !mat.Equal(&sparse, want) {
+				t.Errorf("%s: unexpected sparse Hessian, concurrent=%t:\ngot  %v\nwant %v", test.name, concurrent, mat.Formatted(&sparse), mat.Formatted(want))
+			}
+			// The central formula has no point at the origin, so
+			// each element takes four evaluations.
+			if want := 4 * test.elems; int(evals) != want {
+				t.Errorf("%s: unexpected number of evaluations: got %d, want %d", test.name, evals, want)
+			}
+		}
+	}
+}
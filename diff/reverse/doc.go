@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package reverse provides reverse-mode automatic differentiation of scalar
+// functions of scalar and matrix-valued computations.
+//
+// Operations on the Scalar and Matrix variables of a Tape are recorded on
+// the Tape, and Backward computes the derivatives of a Scalar with respect to
+// all recorded variables in a single pass through the recorded operations in
+// reverse order. The cost of the gradient of a function of n variables is
+// thus a small multiple of the cost of evaluating the function, independent
+// of n, unlike forward-mode differentiation with the num/dual package which
+// needs n evaluations.
+package reverse // import "github.com/jingcheng-WU/gonum/diff/reverse"
@@ -0,0 +1,80 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jingcheng-WU/gonum/diff/reverse"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize"
+)
+
+func ExampleGradient() {
+	// The extended Rosenbrock function.
+	f := func(t *reverse.Tape, x []reverse.Scalar) reverse.Scalar {
+		sum := t.Const(0)
+		for i := 0; i < len(x)-1; i++ {
+			a := x[i].Scale(-1).AddConst(1)
+			b := x[i+1].Sub(x[i].Mul(x[i]))
+			sum = sum.Add(a.Mul(a)).Add(b.Mul(b).Scale(100))
+		}
+		return sum
+	}
+
+	// The gradient is computed in a single backward pass through the
+	// recorded operations, independent of the dimension of the problem.
+	p := optimize.Problem{
+		Func: func(x []float64) float64 {
+			v, err := reverse.Value(f, x)
+			if err != nil {
+				panic(err)
+			}
+			return v
+		},
+		Grad: func(grad, x []float64) {
+			_, err := reverse.Gradient(grad, f, x)
+			if err != nil {
+				panic(err)
+			}
+		},
+	}
+	x := make([]float64, 10)
+	result, err := optimize.Minimize(p, x, nil, &optimize.LBFGS{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("minimum at %.4f\n", result.X)
+
+	// Output:
+	// minimum at [1.0000 1.0000 1.0000 1.0000 1.0000 1.0000 1.0000 1.0000 1.0000 1.0000]
+}
+
+func ExampleCholesky() {
+	// The gradient of the log-determinant of a symmetric positive definite
+	// matrix A computed from its Cholesky factor L,
+	//  log|A| = 2 * Σ log(L_ii),
+	// is the inverse of A.
+	var t reverse.Tape
+	a := t.MatrixVar(mat.NewDense(2, 2, []float64{
+		4, 2,
+		2, 3,
+	}))
+	l := reverse.Cholesky(a)
+	logDet := reverse.Log(l.At(0, 0)).Add(reverse.Log(l.At(1, 1))).Scale(2)
+	err := t.Backward(logDet)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("log|A| = %.4f\n", logDet.Value())
+	fmt.Printf("gradient:\n%.4f\n", mat.Formatted(a.Grad()))
+
+	// Output:
+	// log|A| = 2.0794
+	// gradient:
+	// ⎡ 0.3750  -0.2500⎤
+	// ⎣-0.2500   0.5000⎦
+}
@@ -0,0 +1,286 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import (
+	"errors"
+	"math"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// ErrNotPositiveDefinite signifies that the Cholesky factorization of a
+// matrix failed because the matrix is not positive definite.
+var ErrNotPositiveDefinite = errors.New("reverse: matrix not positive definite")
+
+// nanDense returns an r×c matrix with all elements NaN.
+func nanDense(r, c int) *mat.Dense {
+	m := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			m.Set(i, j, math.NaN())
+		}
+	}
+	return m
+}
+
+// Add returns the sum of the receiver and b.
+func (m Matrix) Add(b Matrix) Matrix {
+	t := m.t
+	t.check(b.t)
+	var c mat.Dense
+	c.Add(m.Value(), b.Value())
+	return t.pushMatrix(&c, func(adj *mat.Dense) {
+		t.addAdj(m.i, 1, adj)
+		t.addAdj(b.i, 1, adj)
+	})
+}
+
+// Sub returns the difference of the receiver and b.
+func (m Matrix) Sub(b Matrix) Matrix {
+	t := m.t
+	t.check(b.t)
+	var c mat.Dense
+	c.Sub(m.Value(), b.Value())
+	return t.pushMatrix(&c, func(adj *mat.Dense) {
+		t.addAdj(m.i, 1, adj)
+		t.addAdj(b.i, -1, adj)
+	})
+}
+
+// Mul returns the matrix product of the receiver and b.
+func (m Matrix) Mul(b Matrix) Matrix {
+	t := m.t
+	t.check(b.t)
+	av, bv := m.Value(), b.Value()
+	var c mat.Dense
+	c.Mul(av, bv)
+	return t.pushMatrix(&c, func(adj *mat.Dense) {
+		var tmp mat.Dense
+		tmp.Mul(adj, bv.T())
+		t.addAdj(m.i, 1, &tmp)
+		tmp.Reset()
+		tmp.Mul(av.T(), adj)
+		t.addAdj(b.i, 1, &tmp)
+	})
+}
+
+// MulElem returns the element-wise product of the receiver and b.
+func (m Matrix) MulElem(b Matrix) Matrix {
+	t := m.t
+	t.check(b.t)
+	av, bv := m.Value(), b.Value()
+	var c mat.Dense
+	c.MulElem(av, bv)
+	return t.pushMatrix(&c, func(adj *mat.Dense) {
+		var tmp mat.Dense
+		tmp.MulElem(adj, bv)
+		t.addAdj(m.i, 1, &tmp)
+		tmp.MulElem(adj, av)
+		t.addAdj(b.i, 1, &tmp)
+	})
+}
+
+// Scale returns the receiver scaled by s.
+func (m Matrix) Scale(s Scalar) Matrix {
+	t := m.t
+	t.check(s.t)
+	av, f := m.Value(), s.Value()
+	var c mat.Dense
+	c.Scale(f, av)
+	return t.pushMatrix(&c, func(adj *mat.Dense) {
+		t.addAdj(m.i, f, adj)
+		t.nodes[s.i].adj += dot(av, adj)
+	})
+}
+
+// T returns the transpose of the receiver.
+func (m Matrix) T() Matrix {
+	t := m.t
+	c := mat.DenseCopyOf(m.Value().T())
+	return t.pushMatrix(c, func(adj *mat.Dense) {
+		t.addAdj(m.i, 1, adj.T())
+	})
+}
+
+// At returns the element of the receiver in row i and column j.
+func (m Matrix) At(i, j int) Scalar {
+	t := m.t
+	return t.push(m.Value().At(i, j), func(adj float64) {
+		n := &t.nodes[m.i]
+		if n.adjM == nil {
+			r, c := n.m.Dims()
+			n.adjM = mat.NewDense(r, c, nil)
+		}
+		n.adjM.Set(i, j, n.adjM.At(i, j)+adj)
+	})
+}
+
+// Sum returns the sum of the elements of the receiver.
+func (m Matrix) Sum() Scalar {
+	t := m.t
+	return t.push(mat.Sum(m.Value()), func(adj float64) {
+		n := &t.nodes[m.i]
+		r, c := n.m.Dims()
+		var ones mat.Dense
+		ones.ReuseAs(r, c)
+		ones.Apply(func(_, _ int, _ float64) float64 { return adj }, &ones)
+		t.addAdj(m.i, 1, &ones)
+	})
+}
+
+// Trace returns the trace of the receiver, which must be square.
+func (m Matrix) Trace() Scalar {
+	t := m.t
+	return t.push(mat.Trace(m.Value()), func(adj float64) {
+		n := &t.nodes[m.i]
+		r, _ := n.m.Dims()
+		t.addAdj(m.i, adj, eye(r))
+	})
+}
+
+// Dot returns the sum of the element-wise products of a and b.
+func Dot(a, b Matrix) Scalar {
+	t := a.t
+	t.check(b.t)
+	av, bv := a.Value(), b.Value()
+	return t.push(dot(av, bv), func(adj float64) {
+		t.addAdj(a.i, adj, bv)
+		t.addAdj(b.i, adj, av)
+	})
+}
+
+// Solve returns the solution X of the linear system A * X = B. If A is
+// singular, an error is recorded on the Tape.
+func Solve(a, b Matrix) Matrix {
+	t := a.t
+	t.check(b.t)
+	var lu mat.LU
+	lu.Factorize(a.Value())
+	var x mat.Dense
+	err := lu.SolveTo(&x, false, b.Value())
+	if t.record(err) {
+		_, c := b.Dims()
+		r, _ := a.Dims()
+		return t.pushMatrix(nanDense(r, c), nil)
+	}
+	return t.pushMatrix(&x, func(adj *mat.Dense) {
+		// With G = A^-T * adj, the adjoints are
+		//  adj_B += G,
+		//  adj_A -= G * X^T.
+		var g mat.Dense
+		lu.SolveTo(&g, true, adj)
+		t.addAdj(b.i, 1, &g)
+		var tmp mat.Dense
+		tmp.Mul(&g, x.T())
+		t.addAdj(a.i, -1, &tmp)
+	})
+}
+
+// Inverse returns the inverse of A. If A is singular, an error is recorded
+// on the Tape.
+func Inverse(a Matrix) Matrix {
+	t := a.t
+	var inv mat.Dense
+	err := inv.Inverse(a.Value())
+	if t.record(err) {
+		r, c := a.Dims()
+		return t.pushMatrix(nanDense(r, c), nil)
+	}
+	return t.pushMatrix(&inv, func(adj *mat.Dense) {
+		// adj_A -= A^-T * adj * A^-T.
+		var tmp, g mat.Dense
+		tmp.Mul(inv.T(), adj)
+		g.Mul(&tmp, inv.T())
+		t.addAdj(a.i, -1, &g)
+	})
+}
+
+// LogDet returns the log of the absolute value of the determinant of A.
+// If A is singular, an error is recorded on the Tape.
+func LogDet(a Matrix) Scalar {
+	t := a.t
+	var lu mat.LU
+	lu.Factorize(a.Value())
+	logDet, _ := lu.LogDet()
+	r, _ := a.Dims()
+	var invT mat.Dense
+	err := lu.SolveTo(&invT, true, eye(r))
+	if t.record(err) {
+		return t.push(math.NaN(), nil)
+	}
+	return t.push(logDet, func(adj float64) {
+		// adj_A += adj * A^-T.
+		t.addAdj(a.i, adj, &invT)
+	})
+}
+
+// Cholesky returns the lower triangular factor L of the Cholesky
+// factorization of the symmetric part of A,
+//  (A + A^T)/2 = L * L^T.
+// If the symmetric part of A is not positive definite, ErrNotPositiveDefinite
+// is recorded on the Tape.
+func Cholesky(a Matrix) Matrix {
+	t := a.t
+	av := a.Value()
+	n, c := av.Dims()
+	if n != c {
+		panic(mat.ErrSquare)
+	}
+	sym := mat.NewSymDense(n, nil)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			sym.SetSym(i, j, (av.At(i, j)+av.At(j, i))/2)
+		}
+	}
+	var chol mat.Cholesky
+	if !chol.Factorize(sym) {
+		t.record(ErrNotPositiveDefinite)
+		return t.pushMatrix(nanDense(n, n), nil)
+	}
+	var tri mat.TriDense
+	chol.LTo(&tri)
+	l := mat.DenseCopyOf(&tri)
+	return t.pushMatrix(l, func(adj *mat.Dense) {
+		// The adjoint of the symmetric part of A is
+		//  S = L^-T * Φ(L^T * adj) * L^-1,
+		// where Φ takes the lower triangle and halves the diagonal, as
+		// described in
+		//  Murray, I.: Differentiation of the Cholesky decomposition.
+		//  arXiv:1602.07527 (2016)
+		// and the adjoint of A is the symmetric part of S.
+		var p mat.Dense
+		p.Mul(tri.T(), adj)
+		for i := 0; i < n; i++ {
+			p.Set(i, i, p.At(i, i)/2)
+			for j := i + 1; j < n; j++ {
+				p.Set(i, j, 0)
+			}
+		}
+		var y mat.Dense
+		y.Solve(tri.T(), &p)
+		var s mat.Dense
+		s.Solve(tri.T(), y.T())
+		var sym mat.Dense
+		sym.Add(&s, s.T())
+		t.addAdj(a.i, 0.5, &sym)
+	})
+}
+
+// dot returns the sum of the element-wise products of a and b.
+func dot(a, b *mat.Dense) float64 {
+	var tmp mat.Dense
+	tmp.MulElem(a, b)
+	return mat.Sum(&tmp)
+}
+
+// eye returns the n×n identity matrix.
+func eye(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
@@ -0,0 +1,234 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/diff/fd"
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// square returns the n×n matrix with the first n*n elements of x.
+func square(t *Tape, n int, x []Scalar) Matrix {
+	return t.NewMatrix(n, n, x[:n*n])
+}
+
+// spd returns the positive definite matrix M*M^T + I, where M is the n×n
+// matrix with the first n*n elements of x.
+func spd(t *Tape, n int, x []Scalar) Matrix {
+	m := square(t, n, x)
+	one := t.Const(1)
+	ones := make([]Scalar, n*n)
+	for i := range ones {
+		ones[i] = t.Const(0)
+	}
+	for i := 0; i < n; i++ {
+		ones[i*n+i] = one
+	}
+	return m.Mul(m.T()).Add(t.NewMatrix(n, n, ones))
+}
+
+func TestGradient(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    Func
+		dim  int
+	}{
+		{
+			name: "Scalar",
+			f: func(t *Tape, x []Scalar) Scalar {
+				a, b, c := x[0], x[1], x[2]
+				terms := []Scalar{
+					a.Mul(b).Div(c.AddConst(2)),
+					Abs(a.Sub(b)).Neg(),
+					Pow(a, b).Add(PowReal(c, 2.5)),
+					Sqrt(Inv(b)),
+					Log(Exp(a.Mul(c))).Scale(3),
+					Sin(a).Mul(Cos(b)).Add(Tan(c)),
+					Atan(a).Mul(Sinh(b)).Sub(Cosh(c)),
+					Tanh(a.Add(b)),
+				}
+				return Sum(terms)
+			},
+			dim: 3,
+		},
+		{
+			name: "MatrixArithmetic",
+			f: func(t *Tape, x []Scalar) Scalar {
+				a := t.NewMatrix(2, 3, x[:6])
+				b := t.NewMatrix(3, 2, x[6:12])
+				c := a.Mul(b).Scale(x[12])
+				d := c.T().Sub(c).MulElem(c)
+				return d.Sum().Add(c.Trace()).Add(Dot(a, b.T())).Add(c.At(1, 0))
+			},
+			dim: 13,
+		},
+		{
+			name: "Solve",
+			f: func(t *Tape, x []Scalar) Scalar {
+				a := spd(t, 3, x)
+				b := t.NewMatrix(3, 2, x[9:15])
+				y := Solve(a, b)
+				return Dot(y, b)
+			},
+			dim: 15,
+		},
+		{
+			name: "Inverse",
+			f: func(t *Tape, x []Scalar) Scalar {
+				a := square(t, 3, x)
+				b := t.NewMatrix(3, 3, x[9:18])
+				return Inverse(a).Mul(b).Trace()
+			},
+			dim: 18,
+		},
+		{
+			name: "LogDet",
+			f: func(t *Tape, x []Scalar) Scalar {
+				return LogDet(square(t, 4, x))
+			},
+			dim: 16,
+		},
+		{
+			name: "Cholesky",
+			f: func(t *Tape, x []Scalar) Scalar {
+				a := spd(t, 3, x)
+				l := Cholesky(a)
+				// The upper triangle of w is multiplied by the zeros of l.
+				w := t.NewMatrix(3, 3, x[9:18])
+				logDet := Log(l.At(0, 0)).Add(Log(l.At(1, 1))).Add(Log(l.At(2, 2))).Scale(2)
+				return Dot(l, w).Add(logDet)
+			},
+			dim: 18,
+		},
+		{
+			name: "GaussianLogLikelihood",
+			f: func(t *Tape, x []Scalar) Scalar {
+				// The negative log-likelihood of y under a normal
+				// distribution with covariance K, up to a constant,
+				//  (y^T K^-1 y + log|K|)/2.
+				k := spd(t, 3, x)
+				y := t.NewMatrix(3, 1, x[9:12])
+				l := Cholesky(k)
+				z := Solve(l, y)
+				return Dot(z, z).Add(LogDet(k)).Scale(0.5)
+			},
+			dim: 12,
+		},
+	} {
+		rnd := rand.New(rand.NewSource(1))
+		x := make([]float64, test.dim)
+		for i := range x {
+			x[i] = 0.2 + 0.6*rnd.Float64()
+		}
+		grad := make([]float64, len(x))
+		v, err := Gradient(grad, test.f, x)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		plain := func(x []float64) float64 {
+			v, err := Value(test.f, x)
+			if err != nil {
+				panic(err)
+			}
+			return v
+		}
+		if want := plain(x); v != want {
+			t.Errorf("%s: value mismatch: got %v, want %v", test.name, v, want)
+		}
+		want := fd.Gradient(nil, plain, x, &fd.Settings{Formula: fd.Central})
+		if !floats.EqualApprox(grad, want, 1e-6) {
+			t.Errorf("%s: gradient does not match finite differences:\ngot  %v\nwant %v", test.name, grad, want)
+		}
+	}
+}
+
+func TestMatrixVar(t *testing.T) {
+	t.Parallel()
+	a := mat.NewDense(3, 3, []float64{
+		4, 1, 0.5,
+		2, 5, 1,
+		0, 1, 3,
+	})
+	var tape Tape
+	m := tape.MatrixVar(a)
+	err := tape.Backward(LogDet(m))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The derivative of log|det A| is A^-T.
+	var want mat.Dense
+	err = want.Inverse(a.T())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !mat.EqualApprox(m.Grad(), &want, 1e-14) {
+		t.Errorf("unexpected gradient:\ngot  %v\nwant %v", mat.Formatted(m.Grad()), mat.Formatted(&want))
+	}
+
+	// The tape can be reused after Reset.
+	tape.Reset()
+	x := tape.Var(2)
+	err = tape.Backward(x.Mul(x))
+	if err != nil || x.Grad() != 4 {
+		t.Errorf("unexpected result after Reset: got %v, %v, want 4", x.Grad(), err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    Func
+		err  error
+	}{
+		{
+			name: "Cholesky",
+			f: func(t *Tape, x []Scalar) Scalar {
+				return Cholesky(square(t, 2, x)).Sum()
+			},
+			err: ErrNotPositiveDefinite,
+		},
+		{
+			name: "Solve",
+			f: func(t *Tape, x []Scalar) Scalar {
+				return Solve(square(t, 2, x), square(t, 2, x)).Sum()
+			},
+		},
+		{
+			name: "Inverse",
+			f: func(t *Tape, x []Scalar) Scalar {
+				return Inverse(square(t, 2, x)).Sum()
+			},
+		},
+		{
+			name: "LogDet",
+			f: func(t *Tape, x []Scalar) Scalar {
+				return LogDet(square(t, 2, x))
+			},
+		},
+	} {
+		// The matrix is singular and indefinite.
+		x := []float64{1, 2, 2, 4}
+		grad := make([]float64, len(x))
+		v, err := Gradient(grad, test.f, x)
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+		}
+		if test.err != nil && err != test.err {
+			t.Errorf("%s: unexpected error: got %v, want %v", test.name, err, test.err)
+		}
+		if !math.IsNaN(v) {
+			t.Errorf("%s: unexpected value: got %v, want NaN", test.name, v)
+		}
+	}
+}
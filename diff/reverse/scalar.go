@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import "math"
+
+// Add returns the sum of the receiver and y.
+func (s Scalar) Add(y Scalar) Scalar {
+	t := s.t
+	t.check(y.t)
+	return t.push(s.Value()+y.Value(), func(adj float64) {
+		t.nodes[s.i].adj += adj
+		t.nodes[y.i].adj += adj
+	})
+}
+
+// Sub returns the difference of the receiver and y.
+func (s Scalar) Sub(y Scalar) Scalar {
+	t := s.t
+	t.check(y.t)
+	return t.push(s.Value()-y.Value(), func(adj float64) {
+		t.nodes[s.i].adj += adj
+		t.nodes[y.i].adj -= adj
+	})
+}
+
+// Mul returns the product of the receiver and y.
+func (s Scalar) Mul(y Scalar) Scalar {
+	t := s.t
+	t.check(y.t)
+	a, b := s.Value(), y.Value()
+	return t.push(a*b, func(adj float64) {
+		t.nodes[s.i].adj += adj * b
+		t.nodes[y.i].adj += adj * a
+	})
+}
+
+// Div returns the quotient of the receiver and y.
+func (s Scalar) Div(y Scalar) Scalar {
+	t := s.t
+	t.check(y.t)
+	b := y.Value()
+	q := s.Value() / b
+	return t.push(q, func(adj float64) {
+		t.nodes[s.i].adj += adj / b
+		t.nodes[y.i].adj -= adj * q / b
+	})
+}
+
+// Neg returns the negation of the receiver.
+func (s Scalar) Neg() Scalar {
+	return s.Scale(-1)
+}
+
+// Scale returns the receiver scaled by f.
+func (s Scalar) Scale(f float64) Scalar {
+	t := s.t
+	return t.push(f*s.Value(), func(adj float64) {
+		t.nodes[s.i].adj += adj * f
+	})
+}
+
+// AddConst returns the sum of the receiver and c.
+func (s Scalar) AddConst(c float64) Scalar {
+	t := s.t
+	return t.push(s.Value()+c, func(adj float64) {
+		t.nodes[s.i].adj += adj
+	})
+}
+
+// unary records the value v of a function of x with derivative d.
+func unary(x Scalar, v, d float64) Scalar {
+	t := x.t
+	return t.push(v, func(adj float64) {
+		t.nodes[x.i].adj += adj * d
+	})
+}
+
+// Inv returns the reciprocal of x.
+func Inv(x Scalar) Scalar {
+	v := 1 / x.Value()
+	return unary(x, v, -v*v)
+}
+
+// Abs returns the absolute value of x. The derivative of Abs at zero is
+// zero.
+func Abs(x Scalar) Scalar {
+	v := x.Value()
+	var d float64
+	switch {
+	case v > 0:
+		d = 1
+	case v < 0:
+		d = -1
+	}
+	return unary(x, math.Abs(v), d)
+}
+
+// Sqrt returns the square root of x.
+func Sqrt(x Scalar) Scalar {
+	v := math.Sqrt(x.Value())
+	return unary(x, v, 0.5/v)
+}
+
+// Exp returns e**x, the base-e exponential of x.
+func Exp(x Scalar) Scalar {
+	v := math.Exp(x.Value())
+	return unary(x, v, v)
+}
+
+// Log returns the natural logarithm of x.
+func Log(x Scalar) Scalar {
+	return unary(x, math.Log(x.Value()), 1/x.Value())
+}
+
+// PowReal returns x**p, the base-x exponential of the real power p.
+func PowReal(x Scalar, p float64) Scalar {
+	v := x.Value()
+	return unary(x, math.Pow(v, p), p*math.Pow(v, p-1))
+}
+
+// Pow returns x**p, the base-x exponential of p.
+func Pow(x, p Scalar) Scalar {
+	t := x.t
+	t.check(p.t)
+	a, b := x.Value(), p.Value()
+	v := math.Pow(a, b)
+	return t.push(v, func(adj float64) {
+		t.nodes[x.i].adj += adj * b * math.Pow(a, b-1)
+		if v != 0 {
+			t.nodes[p.i].adj += adj * v * math.Log(a)
+		}
+	})
+}
+
+// Sin returns the sine of x.
+func Sin(x Scalar) Scalar {
+	return unary(x, math.Sin(x.Value()), math.Cos(x.Value()))
+}
+
+// Cos returns the cosine of x.
+func Cos(x Scalar) Scalar {
+	return unary(x, math.Cos(x.Value()), -math.Sin(x.Value()))
+}
+
+// Tan returns the tangent of x.
+func Tan(x Scalar) Scalar {
+	v := math.Tan(x.Value())
+	return unary(x, v, 1+v*v)
+}
+
+// Atan returns the inverse tangent of x.
+func Atan(x Scalar) Scalar {
+	v := x.Value()
+	return unary(x, math.Atan(v), 1/(1+v*v))
+}
+
+// Sinh returns the hyperbolic sine of x.
+func Sinh(x Scalar) Scalar {
+	return unary(x, math.Sinh(x.Value()), math.Cosh(x.Value()))
+}
+
+// Cosh returns the hyperbolic cosine of x.
+func Cosh(x Scalar) Scalar {
+	return unary(x, math.Cosh(x.Value()), math.Sinh(x.Value()))
+}
+
+// Tanh returns the hyperbolic tangent of x.
+func Tanh(x Scalar) Scalar {
+	v := math.Tanh(x.Value())
+	return unary(x, v, 1-v*v)
+}
+
+// Sum returns the sum of the variables in x, which must not be empty.
+func Sum(x []Scalar) Scalar {
+	t := x[0].t
+	var v float64
+	idx := make([]int, len(x))
+	for k, s := range x {
+		t.check(s.t)
+		v += s.Value()
+		idx[k] = s.i
+	}
+	return t.push(v, func(adj float64) {
+		for _, i := range idx {
+			t.nodes[i].adj += adj
+		}
+	})
+}
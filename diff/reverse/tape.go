@@ -0,0 +1,258 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package reverse
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+const (
+	badLength = "reverse: slice length mismatch"
+	badTape   = "reverse: variables from different tapes"
+)
+
+// Tape records operations on variables for reverse-mode differentiation.
+// The zero value is an empty Tape ready to use.
+//
+// Operations that fail, for example the Cholesky factorization of a matrix
+// that is not positive definite, record an error on the Tape that is
+// returned by Err and Backward, and produce NaN values.
+type Tape struct {
+	nodes []node
+	err   error
+}
+
+// node is a recorded variable. Scalar nodes have a nil matrix value.
+type node struct {
+	v    float64
+	m    *mat.Dense
+	adj  float64
+	adjM *mat.Dense
+
+	// back propagates the adjoint of the node to the adjoints of the
+	// variables it was computed from.
+	back  func(adj float64)
+	backM func(adj *mat.Dense)
+}
+
+// Scalar is a scalar variable recorded on a Tape.
+type Scalar struct {
+	t *Tape
+	i int
+}
+
+// Matrix is a matrix-valued variable recorded on a Tape.
+type Matrix struct {
+	t *Tape
+	i int
+}
+
+// Reset removes all recorded variables and the recorded error from the
+// Tape. Variables recorded before Reset must not be used after it.
+func (t *Tape) Reset() {
+	for i := range t.nodes {
+		t.nodes[i] = node{}
+	}
+	t.nodes = t.nodes[:0]
+	t.err = nil
+}
+
+// Err returns the first error recorded on the Tape.
+func (t *Tape) Err() error {
+	return t.err
+}
+
+// Var records an independent scalar variable with value v.
+func (t *Tape) Var(v float64) Scalar {
+	return t.push(v, nil)
+}
+
+// Vars records independent scalar variables with the values in x.
+func (t *Tape) Vars(x []float64) []Scalar {
+	s := make([]Scalar, len(x))
+	for i, v := range x {
+		s[i] = t.Var(v)
+	}
+	return s
+}
+
+// Const records a constant with value v. Constants are recorded like
+// independent variables, and their derivatives can be ignored.
+func (t *Tape) Const(v float64) Scalar {
+	return t.push(v, nil)
+}
+
+// MatrixVar records an independent matrix variable with the value of m.
+func (t *Tape) MatrixVar(m mat.Matrix) Matrix {
+	return t.pushMatrix(mat.DenseCopyOf(m), nil)
+}
+
+// NewMatrix records an r×c matrix variable with the scalar elements in data
+// in row-major order. NewMatrix panics if the length of data is not r*c.
+func (t *Tape) NewMatrix(r, c int, data []Scalar) Matrix {
+	if len(data) != r*c {
+		panic(badLength)
+	}
+	m := mat.NewDense(r, c, nil)
+	for k, s := range data {
+		t.check(s.t)
+		m.Set(k/c, k%c, s.Value())
+	}
+	elems := make([]int, len(data))
+	for k, s := range data {
+		elems[k] = s.i
+	}
+	return t.pushMatrix(m, func(adj *mat.Dense) {
+		for k, i := range elems {
+			t.nodes[i].adj += adj.At(k/c, k%c)
+		}
+	})
+}
+
+// Backward computes the derivatives of f with respect to all variables
+// recorded on the Tape before f, which are then returned by the Grad
+// methods of the variables. Backward returns the error recorded on the
+// Tape, if any.
+func (t *Tape) Backward(f Scalar) error {
+	t.check(f.t)
+	for i := range t.nodes {
+		n := &t.nodes[i]
+		n.adj = 0
+		if n.adjM != nil {
+			n.adjM.Zero()
+		}
+	}
+	t.nodes[f.i].adj = 1
+	for i := f.i; i >= 0; i-- {
+		n := &t.nodes[i]
+		switch {
+		case n.back != nil:
+			if n.adj != 0 {
+				n.back(n.adj)
+			}
+		case n.backM != nil:
+			if n.adjM != nil {
+				n.backM(n.adjM)
+			}
+		}
+	}
+	return t.err
+}
+
+// Value returns the value of the variable.
+func (s Scalar) Value() float64 {
+	return s.t.nodes[s.i].v
+}
+
+// Grad returns the derivative of the function passed to the last call of
+// Backward with respect to the variable.
+func (s Scalar) Grad() float64 {
+	return s.t.nodes[s.i].adj
+}
+
+// Tape returns the Tape on which the variable is recorded.
+func (s Scalar) Tape() *Tape {
+	return s.t
+}
+
+// Value returns the value of the variable. The returned matrix must not be
+// modified.
+func (m Matrix) Value() *mat.Dense {
+	return m.t.nodes[m.i].m
+}
+
+// Grad returns the derivative of the function passed to the last call of
+// Backward with respect to the variable. The returned matrix must not be
+// modified.
+func (m Matrix) Grad() *mat.Dense {
+	n := &m.t.nodes[m.i]
+	if n.adjM == nil {
+		r, c := n.m.Dims()
+		n.adjM = mat.NewDense(r, c, nil)
+	}
+	return n.adjM
+}
+
+// Dims returns the dimensions of the variable.
+func (m Matrix) Dims() (r, c int) {
+	return m.t.nodes[m.i].m.Dims()
+}
+
+// push records a scalar variable with value v.
+func (t *Tape) push(v float64, back func(adj float64)) Scalar {
+	t.nodes = append(t.nodes, node{v: v, back: back})
+	return Scalar{t: t, i: len(t.nodes) - 1}
+}
+
+// pushMatrix records a matrix variable with value m.
+func (t *Tape) pushMatrix(m *mat.Dense, back func(adj *mat.Dense)) Matrix {
+	t.nodes = append(t.nodes, node{m: m, backM: back})
+	return Matrix{t: t, i: len(t.nodes) - 1}
+}
+
+// addAdj adds alpha*a to the adjoint of the matrix variable i.
+func (t *Tape) addAdj(i int, alpha float64, a mat.Matrix) {
+	n := &t.nodes[i]
+	if n.adjM == nil {
+		r, c := n.m.Dims()
+		n.adjM = mat.NewDense(r, c, nil)
+	}
+	var tmp mat.Dense
+	tmp.Scale(alpha, a)
+	n.adjM.Add(n.adjM, &tmp)
+}
+
+// check panics if a variable from the tape u is used on t.
+func (t *Tape) check(u *Tape) {
+	if u != t {
+		panic(badTape)
+	}
+}
+
+// record records err on the Tape unless it is a finite condition number
+// warning.
+func (t *Tape) record(err error) bool {
+	if err == nil {
+		return false
+	}
+	if c, ok := err.(mat.Condition); ok && !math.IsInf(float64(c), 1) {
+		return false
+	}
+	if t.err == nil {
+		t.err = err
+	}
+	return true
+}
+
+// Func is a scalar function of the variables in x, which are recorded on t.
+type Func func(t *Tape, x []Scalar) Scalar
+
+// Value returns the value of f at x and the error recorded on the Tape
+// during the evaluation.
+func Value(f Func, x []float64) (float64, error) {
+	var t Tape
+	v := f(&t, t.Vars(x))
+	return v.Value(), t.err
+}
+
+// Gradient computes the gradient of f at x with a single backward pass and
+// stores it into dst. It returns the value of f at x and the error recorded
+// on the Tape during the evaluation. Gradient panics if the lengths of dst
+// and x differ.
+func Gradient(dst []float64, f Func, x []float64) (float64, error) {
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	var t Tape
+	vars := t.Vars(x)
+	v := f(&t, vars)
+	err := t.Backward(v)
+	for i, s := range vars {
+		dst[i] = s.Grad()
+	}
+	return v.Value(), err
+}
@@ -0,0 +1,342 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"container/heap"
+	"errors"
+	"math"
+)
+
+// ErrMaxEvals is returned by Adaptive when the maximum number of
+// evaluations of the integrand is reached before the tolerance is met.
+var ErrMaxEvals = errors.New("cubature: maximum number of evaluations reached")
+
+// Norm specifies how the errors of the components of a vector-valued
+// integrand are combined to test convergence.
+type Norm int
+
+const (
+	// Individual requires each component to meet the tolerance.
+	Individual Norm = iota
+	// L1 requires the 1-norm of the errors to meet the tolerance
+	// relative to the 1-norm of the integral.
+	L1
+	// L2 requires the 2-norm of the errors to meet the tolerance
+	// relative to the 2-norm of the integral.
+	L2
+	// LInf requires the maximum of the errors to meet the tolerance
+	// relative to the maximum norm of the integral.
+	LInf
+)
+
+// AdaptiveSettings are the settings of Adaptive.
+type AdaptiveSettings struct {
+	// AbsTol and RelTol are the requested absolute and relative
+	// tolerances. Adaptive attempts to find an estimate of each
+	// component of the integral I with
+	//  |I - estimate| ≤ max(AbsTol, RelTol * |I|),
+	// or of the norm of the errors as specified by Norm. If both are
+	// zero, they default to 1.49e-8, the square root of the machine
+	// epsilon.
+	AbsTol, RelTol float64
+
+	// Norm specifies how the errors of the components are combined.
+	Norm Norm
+
+	// MaxEvals is the maximum number of evaluations of the integrand.
+	// If MaxEvals is zero, a default of 1e6 is used.
+	MaxEvals int
+
+	// Concurrent is the maximum number of simultaneous evaluations of the
+	// integrand. If Concurrent <= 0, the integrand is evaluated serially.
+	Concurrent int
+}
+
+// Result is the result of an integration.
+type Result struct {
+	// Value is the estimate of the integral.
+	Value []float64
+	// Error is the estimate of the absolute error of the components of
+	// Value.
+	Error []float64
+	// Evals is the number of evaluations of the integrand.
+	Evals int
+	// Regions is the number of subregions used by Adaptive.
+	Regions int
+}
+
+// Adaptive approximates the integral of the function f with values of length
+// fdim over the hyperrectangle with the finite corners min and max to the
+// tolerance in settings, using the global adaptive subdivision of the
+// HCubature algorithm. The subregions with the largest estimated errors are
+// bisected along the dimension in which the integrand varies most until the
+// sum of the errors meets the tolerance.
+//
+// The integral over each subregion is estimated by the Genz–Malik rule of
+// degree 7 with an embedded rule of degree 5, using 2^d + 2d^2 + 2d + 1
+// evaluations for d dimensions, or by the 15-point Gauss–Kronrod rule in one
+// dimension. The Genz–Malik rule is practical for up to about 10 to 15
+// dimensions.
+//
+// If settings is nil, the default settings are used. The result contains
+// the best estimate of the integral even if an error is returned. If the
+// tolerance cannot be met within the maximum number of evaluations,
+// ErrMaxEvals is returned.
+//
+// The integrand is evaluated in batches for the points of the rule on all
+// subregions bisected in an iteration. If settings.Concurrent > 0, f may be
+// evaluated with at most Concurrent simultaneous evaluations, and the result
+// does not depend on the concurrency.
+//
+// Adaptive panics if min and max have different or zero lengths, if a bound
+// is infinite or min[i] > max[i], if fdim is not positive, or if AbsTol is
+// not positive and RelTol is not greater than zero.
+func Adaptive(f Integrand, fdim int, min, max []float64, settings *AdaptiveSettings) (Result, error) {
+	dim := len(min)
+	if len(max) != dim {
+		panic("cubature: bound length mismatch")
+	}
+	if dim == 0 {
+		panic("cubature: zero dimension")
+	}
+	if fdim <= 0 {
+		panic("cubature: non-positive integrand dimension")
+	}
+	for i, a := range min {
+		b := max[i]
+		if math.IsInf(a, 0) || math.IsInf(b, 0) {
+			panic("cubature: infinite bound")
+		}
+		if a > b {
+			panic("cubature: min > max")
+		}
+	}
+	q := adaptive{
+		absTol:   1.49e-8,
+		relTol:   1.49e-8,
+		maxEvals: 1000000,
+	}
+	if settings != nil {
+		if settings.AbsTol != 0 || settings.RelTol != 0 {
+			q.absTol = settings.AbsTol
+			q.relTol = settings.RelTol
+		}
+		if settings.MaxEvals < 0 {
+			panic("cubature: negative maximum number of evaluations")
+		}
+		if settings.MaxEvals != 0 {
+			q.maxEvals = settings.MaxEvals
+		}
+		q.norm = settings.Norm
+		q.eval.concurrent = settings.Concurrent
+	}
+	if q.absTol <= 0 && q.relTol <= 0 {
+		panic("cubature: tolerance too small")
+	}
+	if q.norm < Individual || LInf < q.norm {
+		panic("cubature: unknown norm")
+	}
+	q.eval.f = f
+	if dim == 1 {
+		q.rule = newKronrod1D()
+	} else {
+		q.rule = newGenzMalik(dim)
+	}
+
+	r := newRegion(dim, fdim)
+	for i, a := range min {
+		r.center[i] = (a + max[i]) / 2
+		r.half[i] = (max[i] - a) / 2
+	}
+	return q.integrate(r)
+}
+
+// region is a subregion of the integration domain with the estimates of
+// the rule on it.
+type region struct {
+	center, half []float64
+	value, err   []float64
+	// errMax is the largest error of the components.
+	errMax float64
+	// split is the dimension along which the region is bisected.
+	split int
+}
+
+func newRegion(dim, fdim int) *region {
+	return &region{
+		center: make([]float64, dim),
+		half:   make([]float64, dim),
+		value:  make([]float64, fdim),
+		err:    make([]float64, fdim),
+	}
+}
+
+func (r *region) volume() float64 {
+	v := 1.0
+	for _, h := range r.half {
+		v *= 2 * h
+	}
+	return v
+}
+
+func (r *region) updateErrMax() {
+	r.errMax = 0
+	for _, e := range r.err {
+		r.errMax = math.Max(r.errMax, e)
+	}
+}
+
+// bisect splits r into two halves along the split dimension, reusing r
+// for the lower half.
+func (r *region) bisect() (lower, upper *region) {
+	upper = newRegion(len(r.center), len(r.value))
+	copy(upper.center, r.center)
+	copy(upper.half, r.half)
+	i := r.split
+	h := r.half[i] / 2
+	r.half[i] = h
+	upper.half[i] = h
+	r.center[i] -= h
+	upper.center[i] += h
+	return r, upper
+}
+
+// regionHeap is a max-heap of regions ordered by their largest errors.
+type regionHeap []*region
+
+func (h regionHeap) Len() int            { return len(h) }
+func (h regionHeap) Less(i, j int) bool  { return h[i].errMax > h[j].errMax }
+func (h regionHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *regionHeap) Push(x interface{}) { *h = append(*h, x.(*region)) }
+func (h *regionHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// adaptive holds the state of the HCubature algorithm.
+type adaptive struct {
+	eval     evaluator
+	rule     embeddedRule
+	absTol   float64
+	relTol   float64
+	norm     Norm
+	maxEvals int
+
+	regions regionHeap
+	// x and fx are the points and the values of the integrand for the
+	// regions evaluated in an iteration.
+	x, fx [][]float64
+}
+
+// integrate returns the integral over the region r.
+func (q *adaptive) integrate(r *region) (Result, error) {
+	q.evaluate([]*region{r})
+	q.regions = regionHeap{r}
+	res := q.result()
+	remaining := make([]float64, len(res.Error))
+
+	for !q.converged(res.Value, res.Error) {
+		if q.eval.evals+2*q.rule.points() > q.maxEvals {
+			return res, ErrMaxEvals
+		}
+
+		// Bisect the regions with the largest errors until the
+		// errors of the remaining regions would meet the tolerance.
+		copy(remaining, res.Error)
+		var batch []*region
+		for len(q.regions) > 0 && q.eval.evals+(len(batch)+2)*q.rule.points() <= q.maxEvals {
+			r := heap.Pop(&q.regions).(*region)
+			for j, e := range r.err {
+				remaining[j] -= e
+			}
+			lower, upper := r.bisect()
+			batch = append(batch, lower, upper)
+			if q.converged(res.Value, remaining) {
+				break
+			}
+		}
+
+		q.evaluate(batch)
+		for _, r := range batch {
+			heap.Push(&q.regions, r)
+		}
+		// Sum over all regions rather than updating the sums to avoid
+		// the cancellation of large errors of regions that were split.
+		res = q.result()
+	}
+	return res, nil
+}
+
+// evaluate evaluates the rule on the regions.
+func (q *adaptive) evaluate(regions []*region) {
+	m := q.rule.points()
+	n := m * len(regions)
+	for len(q.x) < n {
+		q.x = append(q.x, make([]float64, len(regions[0].center)))
+		q.fx = append(q.fx, make([]float64, len(regions[0].value)))
+	}
+	for i, r := range regions {
+		q.rule.locations(q.x[i*m:(i+1)*m], r)
+	}
+	q.eval.evaluate(q.fx[:n], q.x[:n])
+	for i, r := range regions {
+		q.rule.estimate(r, q.fx[i*m:(i+1)*m])
+	}
+}
+
+// converged returns whether the errors meet the tolerance for the values.
+func (q *adaptive) converged(value, err []float64) bool {
+	switch q.norm {
+	default:
+		panic("cubature: unknown norm")
+	case Individual:
+		for j, e := range err {
+			if e > math.Max(q.absTol, q.relTol*math.Abs(value[j])) {
+				return false
+			}
+		}
+		return true
+	case L1, L2, LInf:
+		e := vecNorm(err, q.norm)
+		return e <= math.Max(q.absTol, q.relTol*vecNorm(value, q.norm))
+	}
+}
+
+func vecNorm(x []float64, norm Norm) float64 {
+	var s float64
+	for _, v := range x {
+		switch norm {
+		case L1:
+			s += math.Abs(v)
+		case L2:
+			s = math.Hypot(s, v)
+		case LInf:
+			s = math.Max(s, math.Abs(v))
+		}
+	}
+	return s
+}
+
+// result returns the result from the sums over all regions.
+func (q *adaptive) result() Result {
+	fdim := len(q.regions[0].value)
+	res := Result{
+		Value:   make([]float64, fdim),
+		Error:   make([]float64, fdim),
+		Evals:   q.eval.evals,
+		Regions: len(q.regions),
+	}
+	for _, r := range q.regions {
+		for j, v := range r.value {
+			res.Value[j] += v
+			res.Error[j] += r.err[j]
+		}
+	}
+	return res
+}
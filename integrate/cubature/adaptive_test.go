@@ -0,0 +1,265 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+)
+
+func TestGenzMalikExactness(t *testing.T) {
+	t.Parallel()
+	// The rule must be exact for monomials of degree 7 and the embedded
+	// rule for monomials of degree 5.
+	for dim := 2; dim <= 6; dim++ {
+		g := newGenzMalik(dim)
+		r := newRegion(dim, 1)
+		min := make([]float64, dim)
+		max := make([]float64, dim)
+		for i := range min {
+			min[i] = -0.5 + 0.1*float64(i)
+			max[i] = 1 + 0.3*float64(i)
+			r.center[i] = (min[i] + max[i]) / 2
+			r.half[i] = (max[i] - min[i]) / 2
+		}
+		x := make([][]float64, g.points())
+		fx := make([][]float64, g.points())
+		for i := range x {
+			x[i] = make([]float64, dim)
+			fx[i] = make([]float64, 1)
+		}
+		g.locations(x, r)
+
+		// Test the monomials x_0^p x_1^q x_(dim-1)^s.
+		for p := 0; p <= 7; p++ {
+			for q := 0; p+q <= 7; q++ {
+				for s := 0; p+q+s <= 7; s++ {
+					exp := make([]float64, dim)
+					exp[0] = float64(p)
+					exp[1] += float64(q)
+					exp[dim-1] += float64(s)
+					want := 1.0
+					for i, e := range exp {
+						want *= (math.Pow(max[i], e+1) - math.Pow(min[i], e+1)) / (e + 1)
+					}
+					for i, v := range x {
+						fx[i][0] = 1
+						for k, e := range exp {
+							fx[i][0] *= math.Pow(v[k], e)
+						}
+					}
+					g.estimate(r, fx)
+					if !scalar.EqualWithinAbsOrRel(r.value[0], want, 1e-13, 1e-13) {
+						t.Errorf("dim=%d: unexpected integral of monomial %v: got %v, want %v", dim, exp, r.value[0], want)
+					}
+					if p+q+s <= 5 && r.err[0] > 1e-13*math.Max(1, math.Abs(want)) {
+						t.Errorf("dim=%d: unexpected error for monomial %v: %v", dim, exp, r.err[0])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestAdaptive(t *testing.T) {
+	t.Parallel()
+	erf1 := math.Sqrt(math.Pi) / 2 * math.Erf(1)
+	for _, test := range []struct {
+		name     string
+		f        Integrand
+		fdim     int
+		min, max []float64
+		want     []float64
+		tol      float64
+	}{
+		{
+			name: "sqrt",
+			f:    func(dst, x []float64) { dst[0] = math.Sqrt(x[0]) },
+			fdim: 1,
+			min:  []float64{0},
+			max:  []float64{1},
+			want: []float64{2.0 / 3},
+			tol:  1e-10,
+		},
+		{
+			name: "gaussian",
+			f: func(dst, x []float64) {
+				dst[0] = math.Exp(-floats.Dot(x, x))
+			},
+			fdim: 1,
+			min:  []float64{0, 0, 0},
+			max:  []float64{1, 1, 1},
+			want: []float64{erf1 * erf1 * erf1},
+			tol:  1e-10,
+		},
+		{
+			name: "cos product",
+			f: func(dst, x []float64) {
+				dst[0] = 1
+				for _, v := range x {
+					dst[0] *= math.Cos(v)
+				}
+			},
+			fdim: 1,
+			min:  []float64{0, 0, 0, 0, 0},
+			max:  []float64{1, 1, 1, 1, 1},
+			want: []float64{math.Pow(math.Sin(1), 5)},
+			tol:  1e-6,
+		},
+		{
+			name: "vector",
+			f: func(dst, x []float64) {
+				dst[0] = x[0] * x[1]
+				dst[1] = x[0]*x[0] + x[1]*x[1]
+				dst[2] = math.Sin(x[0] + x[1])
+			},
+			fdim: 3,
+			min:  []float64{0, 0},
+			max:  []float64{1, 2},
+			want: []float64{1, 2.0/3 + 8.0/3, math.Sin(1) + math.Sin(2) - math.Sin(3)},
+			tol:  1e-10,
+		},
+		{
+			name: "corner peak",
+			f: func(dst, x []float64) {
+				dst[0] = 1 / math.Pow(1+x[0]+x[1], 3)
+			},
+			fdim: 1,
+			min:  []float64{0, 0},
+			max:  []float64{1, 1},
+			want: []float64{1.0 / 6},
+			tol:  1e-10,
+		},
+		{
+			name: "degenerate",
+			f:    func(dst, x []float64) { dst[0] = 1 },
+			fdim: 1,
+			min:  []float64{0, 1},
+			max:  []float64{1, 1},
+			want: []float64{0},
+			tol:  0,
+		},
+	} {
+		var want Result
+		for _, concurrent := range []int{0, 3} {
+			settings := &AdaptiveSettings{AbsTol: test.tol / 10, RelTol: test.tol / 10, Concurrent: concurrent}
+			res, err := Adaptive(test.f, test.fdim, test.min, test.max, settings)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", test.name, err)
+			}
+			for j, v := range res.Value {
+				if math.Abs(v-test.want[j]) > test.tol*math.Max(1, math.Abs(test.want[j])) {
+					t.Errorf("%s: unexpected value of component %d: got %v, want %v", test.name, j, v, test.want[j])
+				}
+				if res.Error[j] > test.tol/10*math.Max(1, math.Abs(v)) {
+					t.Errorf("%s: error estimate %v does not meet tolerance", test.name, res.Error[j])
+				}
+			}
+			if concurrent == 0 {
+				want = res
+				continue
+			}
+			if !floats.Equal(res.Value, want.Value) || !floats.Equal(res.Error, want.Error) ||
+				res.Evals != want.Evals || res.Regions != want.Regions {
+				t.Errorf("%s: result depends on concurrency", test.name)
+			}
+		}
+	}
+}
+
+func TestAdaptiveNorm(t *testing.T) {
+	t.Parallel()
+	f := func(dst, x []float64) {
+		dst[0] = math.Exp(x[0] * x[1])
+		dst[1] = 1e-6 * math.Cos(10*x[0]*x[1])
+	}
+	// With Individual, the small component must meet the relative
+	// tolerance, which needs more evaluations than with the other norms.
+	var evals [4]int
+	for _, norm := range []Norm{Individual, L1, L2, LInf} {
+		res, err := Adaptive(f, 2, []float64{0, 0}, []float64{2, 2}, &AdaptiveSettings{RelTol: 1e-8, Norm: norm})
+		if err != nil {
+			t.Fatalf("unexpected error for norm %d: %v", norm, err)
+		}
+		evals[norm] = res.Evals
+		var e, v float64
+		switch norm {
+		case Individual:
+			for j := range res.Value {
+				if res.Error[j] > 1e-8*math.Abs(res.Value[j]) {
+					t.Errorf("component %d does not meet tolerance", j)
+				}
+			}
+			continue
+		case L1:
+			e, v = floats.Norm(res.Error, 1), floats.Norm(res.Value, 1)
+		case L2:
+			e, v = floats.Norm(res.Error, 2), floats.Norm(res.Value, 2)
+		case LInf:
+			e, v = floats.Norm(res.Error, math.Inf(1)), floats.Norm(res.Value, math.Inf(1))
+		}
+		if e > 1e-8*v {
+			t.Errorf("norm %d of error does not meet tolerance", norm)
+		}
+	}
+	if evals[Individual] <= evals[L2] {
+		t.Errorf("unexpected number of evaluations: Individual %d, L2 %d", evals[Individual], evals[L2])
+	}
+}
+
+func TestAdaptiveMaxEvals(t *testing.T) {
+	t.Parallel()
+	f := func(dst, x []float64) {
+		dst[0] = math.Pow(x[0]*x[1], -0.45)
+	}
+	const maxEvals = 5000
+	res, err := Adaptive(f, 1, []float64{0, 0}, []float64{1, 1}, &AdaptiveSettings{RelTol: 1e-12, MaxEvals: maxEvals})
+	if err != ErrMaxEvals {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrMaxEvals)
+	}
+	if res.Evals > maxEvals {
+		t.Errorf("too many evaluations: %d", res.Evals)
+	}
+	want := 1 / (0.55 * 0.55)
+	if math.Abs(res.Value[0]-want) > 0.1 {
+		t.Errorf("unexpected value: got %v, want about %v", res.Value[0], want)
+	}
+}
+
+func TestAdaptivePanics(t *testing.T) {
+	t.Parallel()
+	f := func(dst, x []float64) { dst[0] = 1 }
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name     string
+		fdim     int
+		min, max []float64
+		settings *AdaptiveSettings
+	}{
+		{name: "length mismatch", fdim: 1, min: []float64{0}, max: []float64{1, 1}},
+		{name: "zero dimension", fdim: 1, min: []float64{}, max: []float64{}},
+		{name: "fdim", fdim: 0, min: []float64{0}, max: []float64{1}},
+		{name: "infinite", fdim: 1, min: []float64{0}, max: []float64{inf}},
+		{name: "min > max", fdim: 1, min: []float64{0, 1}, max: []float64{1, 0}},
+		{name: "tolerance", fdim: 1, min: []float64{0}, max: []float64{1}, settings: &AdaptiveSettings{AbsTol: -1}},
+		{name: "norm", fdim: 1, min: []float64{0}, max: []float64{1}, settings: &AdaptiveSettings{Norm: LInf + 1}},
+		{name: "max evals", fdim: 1, min: []float64{0}, max: []float64{1}, settings: &AdaptiveSettings{MaxEvals: -1}},
+	} {
+		if !panics(func() { Adaptive(f, test.fdim, test.min, test.max, test.settings) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
+
+func panics(f func()) (ok bool) {
+	defer func() {
+		ok = recover() != nil
+	}()
+	f()
+	return false
+}
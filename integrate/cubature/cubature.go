@@ -0,0 +1,105 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"sync"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Integrand is a vector-valued function of several variables. It stores
+// the value of the function at x in dst, and must not modify x.
+type Integrand func(dst, x []float64)
+
+// Rule is a fixed cubature rule.
+type Rule interface {
+	// Locations returns the locations of the rule for integration over
+	// the hyperrectangle with the corners min and max as the rows of x,
+	// and the corresponding weights.
+	Locations(min, max []float64) (x *mat.Dense, weight []float64)
+}
+
+// Fixed approximates the integral of the function f with values of length
+// fdim over the hyperrectangle with the corners min and max using the
+// rule. That is, Fixed estimates
+//  int_min^max f(x) dx ≈ \sum_i w_i f(x_i)
+// where the properties of the integral must match the assumptions of the
+// rule.
+//
+// If concurrent <= 0, f is evaluated serially, while if concurrent > 0, f
+// may be evaluated with at most concurrent simultaneous evaluations.
+//
+// Fixed panics if min and max have different lengths or if fdim is not
+// positive.
+func Fixed(f Integrand, fdim int, min, max []float64, rule Rule, concurrent int) []float64 {
+	if len(min) != len(max) {
+		panic("cubature: bound length mismatch")
+	}
+	if fdim <= 0 {
+		panic("cubature: non-positive integrand dimension")
+	}
+	x, weight := rule.Locations(min, max)
+	n, _ := x.Dims()
+	points := make([][]float64, n)
+	for i := range points {
+		points[i] = x.RawRowView(i)
+	}
+	fx := make([][]float64, n)
+	for i := range fx {
+		fx[i] = make([]float64, fdim)
+	}
+	e := evaluator{f: f, concurrent: concurrent}
+	e.evaluate(fx, points)
+
+	integral := make([]float64, fdim)
+	for i, v := range fx {
+		for j, fj := range v {
+			integral[j] += weight[i] * fj
+		}
+	}
+	return integral
+}
+
+// evaluator evaluates an integrand at batches of points.
+type evaluator struct {
+	f          Integrand
+	concurrent int
+	evals      int
+}
+
+// evaluate stores the values of the integrand at the points in x into fx.
+func (e *evaluator) evaluate(fx, x [][]float64) {
+	e.evals += len(x)
+	concurrent := e.concurrent
+	if concurrent > len(x) {
+		concurrent = len(x)
+	}
+	if concurrent <= 0 {
+		for i, v := range x {
+			e.f(fx[i], v)
+		}
+		return
+	}
+
+	tasks := make(chan int)
+	go func() {
+		for i := range x {
+			tasks <- i
+		}
+		close(tasks)
+	}()
+	var wg sync.WaitGroup
+	wg.Add(concurrent)
+	for i := 0; i < concurrent; i++ {
+		go func() {
+			defer wg.Done()
+			for k := range tasks {
+				e.f(fx[k], x[k])
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cubature provides numerical evaluation of definite integrals of
+// functions of several variables over hyperrectangles
+//  int_min[0]^max[0] ... int_min[d-1]^max[d-1] f(x) dx .
+//
+// Adaptive integrates vector-valued functions to a requested tolerance by
+// global adaptive subdivision with the Genz–Malik rule. Fixed evaluates a
+// fixed cubature Rule, such as a Smolyak sparse grid built from the
+// one-dimensional rules of package quad. QMC estimates integrals and their
+// errors by randomized quasi-Monte Carlo with importance sampling from the
+// distributions of package distmv.
+package cubature // import "github.com/jingcheng-WU/gonum/integrate/cubature"
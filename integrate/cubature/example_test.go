@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/integrate/cubature"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/stat/distmv"
+)
+
+func ExampleAdaptive() {
+	// Compute the normalizing constant and the posterior means of a
+	// two-parameter model with an unnormalized posterior density on the
+	// unit square, as in the calculation of a marginal likelihood.
+	post := func(x []float64) float64 {
+		a, b := x[0], x[1]
+		return math.Pow(a, 3) * math.Pow(1-a, 2) * math.Exp(-8*(b-a)*(b-a))
+	}
+	f := func(dst, x []float64) {
+		p := post(x)
+		dst[0] = p
+		dst[1] = x[0] * p
+		dst[2] = x[1] * p
+	}
+	res, err := cubature.Adaptive(f, 3, []float64{0, 0}, []float64{1, 1}, &cubature.AdaptiveSettings{
+		RelTol: 1e-8,
+		Norm:   cubature.L2,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	z := res.Value[0]
+	fmt.Printf("Z = %.6f\n", z)
+	fmt.Printf("E[a] = %.6f, E[b] = %.6f\n", res.Value[1]/z, res.Value[2]/z)
+
+	// Output:
+	// Z = 0.009285
+	// E[a] = 0.564286, E[b] = 0.545533
+}
+
+func ExampleSmolyak() {
+	// Integrate a smooth function over the unit hypercube in 6 dimensions
+	// with a sparse grid of Gauss–Legendre rules. The tensor product rule
+	// of the same accuracy would need 6^6 = 46656 locations.
+	const dim = 6
+	min := make([]float64, dim)
+	max := make([]float64, dim)
+	for i := range max {
+		max[i] = 1
+	}
+	f := func(dst, x []float64) {
+		var s float64
+		for _, v := range x {
+			s += v
+		}
+		dst[0] = math.Cos(s)
+	}
+	rule := cubature.Smolyak{Level: 5}
+	x, _ := rule.Locations(min, max)
+	n, _ := x.Dims()
+	got := cubature.Fixed(f, 1, min, max, rule, 0)
+	// The integral is the real part of ((e^i - 1) / i)^6.
+	want := real(cpow((complex(math.Cos(1), math.Sin(1))-1)/1i, dim))
+	fmt.Printf("%d locations, integral = %.8f, want %.8f\n", n, got[0], want)
+
+	// Output:
+	// 4541 locations, integral = -0.76937637, want -0.76937641
+}
+
+func ExampleQMC() {
+	// Compute the expectation of exp(x_0 + x_1) under a correlated normal
+	// distribution p, for which the integrand is the function times the
+	// density of p.
+	sigma := mat.NewSymDense(2, []float64{1, 0.5, 0.5, 1})
+	p, ok := distmv.NewNormal([]float64{0, 0}, sigma, nil)
+	if !ok {
+		log.Fatal("bad covariance")
+	}
+	f := func(dst, x []float64) {
+		dst[0] = math.Exp(x[0]+x[1]) * math.Exp(p.LogProb(x))
+	}
+	// The sum of the variables has variance 3.
+	want := math.Exp(1.5)
+
+	// Sampling from p itself gives a large variance since the function
+	// grows rapidly in the tails of p. Shifting the proposal towards
+	// the region where the integrand is large reduces the error.
+	shifted, ok := distmv.NewNormal([]float64{1, 1}, sigma, nil)
+	if !ok {
+		log.Fatal("bad covariance")
+	}
+	for _, q := range []struct {
+		name string
+		dist *distmv.Normal
+	}{
+		{"p", p},
+		{"shifted", shifted},
+	} {
+		res := cubature.QMC(f, 1, q.dist, cubature.QMCSettings{
+			Points: 4096,
+			Src:    rand.NewSource(1),
+		})
+		fmt.Printf("%-7s proposal: E = %.5f ± %.5f, want %.5f\n", q.name, res.Value[0], res.Error[0], want)
+	}
+
+	// Output:
+	// p       proposal: E = 4.47185 ± 0.02271, want 4.48169
+	// shifted proposal: E = 4.48147 ± 0.00029, want 4.48169
+}
+
+func cpow(z complex128, n int) complex128 {
+	p := complex(1, 0)
+	for i := 0; i < n; i++ {
+		p *= z
+	}
+	return p
+}
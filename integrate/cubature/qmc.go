@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/stat/distmv"
+	"github.com/jingcheng-WU/gonum/stat/samplemv"
+)
+
+// Density is a multivariate probability distribution that can be sampled
+// by inversion of the unit hypercube and that has a computable density.
+// The distmv.Normal and distmv.Uniform types implement Density.
+type Density interface {
+	distmv.Quantiler
+	distmv.LogProber
+
+	// Dim returns the dimension of the distribution.
+	Dim() int
+}
+
+// QMCSettings are the settings of QMC.
+type QMCSettings struct {
+	// Points is the number of points in each replicate. The Sobol'
+	// points are best balanced when Points is a power of two. If Points
+	// is zero, a default of 1024 is used.
+	Points int
+
+	// Replicates is the number of independently scrambled replicates
+	// used to estimate the error. If Replicates is zero, a default of
+	// 16 is used.
+	Replicates int
+
+	// Src is the source of randomness for the scrambling. If Src is nil,
+	// the rand package is used.
+	Src rand.Source
+
+	// Concurrent is the maximum number of simultaneous evaluations of the
+	// integrand. If Concurrent <= 0, the integrand is evaluated serially.
+	Concurrent int
+}
+
+// QMC approximates the integral of the function f with values of length
+// fdim over the support of the proposal density q by randomized quasi-Monte
+// Carlo integration with importance sampling. That is, QMC estimates
+//  int f(x) dx = E_q[f(x) / q(x)]
+// by averaging f(x_i)/q(x_i) over the points x_i of an Owen-scrambled
+// Sobol' sequence mapped through the quantile function of q.
+//
+// The estimate is repeated for settings.Replicates independent scramblings
+// of the sequence. The returned Value is the mean of the replicates, and
+// Error is the standard error of that mean, which is an unbiased estimate
+// of the root mean square error of Value. For a smooth integrand the error
+// of each replicate decreases faster than the Monte Carlo rate of
+// 1/sqrt(Points). Points at which q is zero contribute nothing to the
+// estimate.
+//
+// To integrate over a hyperrectangle, q may be a distmv.Uniform over it,
+// and to compute the expectation of g(x) under a density p with the proposal
+// q, f should return g(x) p(x). A proposal close in shape to |f| reduces
+// the variance of the estimate.
+//
+// QMC panics if fdim is not positive, if settings.Points or
+// settings.Replicates is negative, if settings.Replicates is one, or if
+// the dimension of q exceeds samplemv.SobolMaxDim.
+func QMC(f Integrand, fdim int, q Density, settings QMCSettings) Result {
+	if fdim <= 0 {
+		panic("cubature: non-positive integrand dimension")
+	}
+	n := settings.Points
+	if n < 0 {
+		panic("cubature: negative number of points")
+	}
+	if n == 0 {
+		n = 1024
+	}
+	reps := settings.Replicates
+	if reps < 0 || reps == 1 {
+		panic("cubature: invalid number of replicates")
+	}
+	if reps == 0 {
+		reps = 16
+	}
+
+	dim := q.Dim()
+	batch := mat.NewDense(n, dim, nil)
+	sampler := samplemv.Sobol{Kind: samplemv.SobolOwen, Q: q, Src: settings.Src}
+	points := make([][]float64, n)
+	fx := make([][]float64, n)
+	for i := range fx {
+		fx[i] = make([]float64, fdim)
+	}
+	e := evaluator{f: f, concurrent: settings.Concurrent}
+
+	// Accumulate the mean and variance of the replicates with Welford's
+	// algorithm.
+	mean := make([]float64, fdim)
+	m2 := make([]float64, fdim)
+	est := make([]float64, fdim)
+	for r := 0; r < reps; r++ {
+		sampler.Sample(batch)
+		for i := range points {
+			points[i] = batch.RawRowView(i)
+		}
+		e.evaluate(fx, points)
+
+		for j := range est {
+			est[j] = 0
+		}
+		for i, x := range points {
+			logq := q.LogProb(x)
+			if math.IsInf(logq, -1) {
+				continue
+			}
+			w := math.Exp(-logq)
+			for j, v := range fx[i] {
+				est[j] += w * v
+			}
+		}
+		for j, v := range est {
+			v /= float64(n)
+			d := v - mean[j]
+			mean[j] += d / float64(r+1)
+			m2[j] += d * (v - mean[j])
+		}
+	}
+
+	stderr := make([]float64, fdim)
+	for j, v := range m2 {
+		stderr[j] = math.Sqrt(v / float64(reps-1) / float64(reps))
+	}
+	return Result{
+		Value: mean,
+		Error: stderr,
+		Evals: e.evals,
+	}
+}
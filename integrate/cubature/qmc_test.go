@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/spatial/r1"
+	"github.com/jingcheng-WU/gonum/stat/distmv"
+)
+
+func TestQMCUniform(t *testing.T) {
+	t.Parallel()
+	// Integrate prod_i exp(x_i) and sum_i x_i^2 over a box.
+	bnds := []r1.Interval{{Min: 0, Max: 1}, {Min: -1, Max: 2}, {Min: 0.5, Max: 1}, {Min: -2, Max: 0}, {Min: 0, Max: 3}}
+	f := func(dst, x []float64) {
+		var sum float64
+		for _, v := range x {
+			sum += v * v
+		}
+		dst[0] = math.Exp(floats.Sum(x))
+		dst[1] = sum
+	}
+	want := []float64{1, 0}
+	vol := 1.0
+	for _, b := range bnds {
+		want[0] *= math.Exp(b.Max) - math.Exp(b.Min)
+		vol *= b.Max - b.Min
+	}
+	for _, b := range bnds {
+		want[1] += (b.Max*b.Max*b.Max - b.Min*b.Min*b.Min) / 3 / (b.Max - b.Min)
+	}
+	want[1] *= vol
+
+	for _, concurrent := range []int{0, 4} {
+		res := QMC(f, 2, distmv.NewUniform(bnds, nil), QMCSettings{
+			Points:     4096,
+			Src:        rand.NewSource(1),
+			Concurrent: concurrent,
+		})
+		if res.Evals != 16*4096 {
+			t.Errorf("unexpected number of evaluations: got %d, want %d", res.Evals, 16*4096)
+		}
+		for j := range want {
+			err := math.Abs(res.Value[j] - want[j])
+			if err > 5*res.Error[j] {
+				t.Errorf("concurrent=%d, component %d: error %v not within estimate %v", concurrent, j, err, res.Error[j])
+			}
+			if res.Error[j] > 1e-3*math.Abs(want[j]) {
+				t.Errorf("concurrent=%d, component %d: error estimate too large: %v", concurrent, j, res.Error[j])
+			}
+		}
+	}
+}
+
+func TestQMCDeterministic(t *testing.T) {
+	t.Parallel()
+	f := func(dst, x []float64) {
+		dst[0] = math.Sin(x[0] + 2*x[1])
+	}
+	q := distmv.NewUnitUniform(2, nil)
+	a := QMC(f, 1, q, QMCSettings{Points: 256, Replicates: 4, Src: rand.NewSource(3)})
+	b := QMC(f, 1, q, QMCSettings{Points: 256, Replicates: 4, Src: rand.NewSource(3), Concurrent: 3})
+	if a.Value[0] != b.Value[0] || a.Error[0] != b.Error[0] {
+		t.Errorf("results differ with concurrency: %v, %v", a, b)
+	}
+}
+
+func TestQMCConvergence(t *testing.T) {
+	t.Parallel()
+	// For a smooth integrand the error of scrambled Sobol' points decreases
+	// faster than the Monte Carlo rate, so a 16-fold increase in the number
+	// of points must reduce the error estimate by more than a factor of 4.
+	f := func(dst, x []float64) {
+		dst[0] = 1
+		for _, v := range x {
+			dst[0] *= 1 + (v-0.5)/2
+		}
+	}
+	q := distmv.NewUnitUniform(4, nil)
+	small := QMC(f, 1, q, QMCSettings{Points: 256, Replicates: 32, Src: rand.NewSource(1)})
+	large := QMC(f, 1, q, QMCSettings{Points: 4096, Replicates: 32, Src: rand.NewSource(1)})
+	if ratio := small.Error[0] / large.Error[0]; ratio < 8 {
+		t.Errorf("error not reduced at QMC rate: ratio %v", ratio)
+	}
+	if err := math.Abs(large.Value[0] - 1); err > 5*large.Error[0] {
+		t.Errorf("error %v not within estimate %v", err, large.Error[0])
+	}
+}
+
+func TestQMCImportance(t *testing.T) {
+	t.Parallel()
+	// Compute E[x_0^2 + x_0 x_1] under a correlated normal p using a wider
+	// normal proposal centered elsewhere.
+	p, ok := distmv.NewNormal([]float64{1, -1}, mat.NewSymDense(2, []float64{2, 0.5, 0.5, 1}), nil)
+	if !ok {
+		t.Fatal("bad covariance")
+	}
+	q, ok := distmv.NewNormal([]float64{0.5, -0.5}, mat.NewSymDense(2, []float64{4, 0, 0, 3}), nil)
+	if !ok {
+		t.Fatal("bad covariance")
+	}
+	f := func(dst, x []float64) {
+		px := math.Exp(p.LogProb(x))
+		dst[0] = px
+		dst[1] = (x[0]*x[0] + x[0]*x[1]) * px
+	}
+	res := QMC(f, 2, q, QMCSettings{Points: 4096, Src: rand.NewSource(1)})
+	// E[x_0^2] = 2 + 1 and E[x_0 x_1] = 0.5 - 1.
+	want := []float64{1, 2.5}
+	for j := range want {
+		err := math.Abs(res.Value[j] - want[j])
+		if err > 5*res.Error[j] || err > 1e-3 {
+			t.Errorf("component %d: got %v, want %v, error estimate %v", j, res.Value[j], want[j], res.Error[j])
+		}
+	}
+}
+
+func TestQMCPanics(t *testing.T) {
+	t.Parallel()
+	f := func(dst, x []float64) { dst[0] = 1 }
+	q := distmv.NewUnitUniform(2, nil)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"zero fdim", func() { QMC(f, 0, q, QMCSettings{}) }},
+		{"negative points", func() { QMC(f, 1, q, QMCSettings{Points: -1}) }},
+		{"single replicate", func() { QMC(f, 1, q, QMCSettings{Replicates: 1}) }},
+		{"too many dimensions", func() { QMC(f, 1, distmv.NewUnitUniform(100, nil), QMCSettings{}) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/integrate/quad"
+)
+
+// embeddedRule is a cubature rule with an embedded rule of lower degree for
+// estimating the error on a region.
+type embeddedRule interface {
+	// points returns the number of points of the rule.
+	points() int
+
+	// locations stores the points of the rule on the region r into x.
+	locations(x [][]float64, r *region)
+
+	// estimate sets the value and the error of the region r from the
+	// values fx of the integrand at the points returned by locations, and
+	// chooses the dimension along which the region is split.
+	estimate(r *region, fx [][]float64)
+}
+
+// genzMalik is the degree 7 rule with an embedded degree 5 rule for
+// dimensions of at least two, from
+//  A. C. Genz and A. A. Malik, "An adaptive algorithm for numerical
+//  integration over an n-dimensional rectangular region", J. Comput.
+//  Appl. Math. 6:295-302, 1980.
+// The weights and the choice of the split dimension follow the HCubature
+// implementation of S. G. Johnson's cubature package.
+type genzMalik struct {
+	dim int
+
+	w1, w2, w3, w4, w5 float64
+	e1, e2, e3, e4     float64
+}
+
+const (
+	gmLambda2 = 0.3585685828003180919906451539079374954541 // sqrt(9/70)
+	gmLambda4 = 0.9486832980505137995996680633298155601160 // sqrt(9/10)
+	gmLambda5 = 0.6882472016116852977216287342936235251269 // sqrt(9/19)
+)
+
+func newGenzMalik(dim int) *genzMalik {
+	n := float64(dim)
+	return &genzMalik{
+		dim: dim,
+		w1:  (12824 - 9120*n + 400*n*n) / 19683,
+		w2:  980.0 / 6561,
+		w3:  (1820 - 400*n) / 19683,
+		w4:  200.0 / 19683,
+		w5:  6859.0 / 19683 / math.Exp2(n),
+		e1:  (729 - 950*n + 50*n*n) / 729,
+		e2:  245.0 / 486,
+		e3:  (265 - 100*n) / 1458,
+		e4:  25.0 / 729,
+	}
+}
+
+func (g *genzMalik) points() int {
+	n := g.dim
+	return 1 + 4*n + 2*n*(n-1) + 1<<uint(n)
+}
+
+// locations stores the center, the points at ±λ2 and ±λ4 along each axis,
+// the points at ±λ4 along each pair of axes and the corners at ±λ5, in that
+// order, relative to the half widths of the region.
+func (g *genzMalik) locations(x [][]float64, r *region) {
+	n := g.dim
+	k := 0
+	next := func() []float64 {
+		p := x[k]
+		copy(p, r.center)
+		k++
+		return p
+	}
+	next()
+	for i := 0; i < n; i++ {
+		for _, l := range []float64{-gmLambda2, gmLambda2, -gmLambda4, gmLambda4} {
+			next()[i] += l * r.half[i]
+		}
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			for _, s := range [][2]float64{{-1, -1}, {-1, 1}, {1, -1}, {1, 1}} {
+				p := next()
+				p[i] += s[0] * gmLambda4 * r.half[i]
+				p[j] += s[1] * gmLambda4 * r.half[j]
+			}
+		}
+	}
+	for c := 0; c < 1<<uint(n); c++ {
+		p := next()
+		for i := 0; i < n; i++ {
+			if c&(1<<uint(i)) != 0 {
+				p[i] += gmLambda5 * r.half[i]
+			} else {
+				p[i] -= gmLambda5 * r.half[i]
+			}
+		}
+	}
+}
+
+func (g *genzMalik) estimate(r *region, fx [][]float64) {
+	n := g.dim
+	vol := r.volume()
+	const ratio = gmLambda2 * gmLambda2 / (gmLambda4 * gmLambda4)
+	diff := make([]float64, n)
+	for j := range r.value {
+		f0 := fx[0][j]
+		var sum2, sum3, sum4, sum5 float64
+		k := 1
+		for i := 0; i < n; i++ {
+			s2 := fx[k][j] + fx[k+1][j]
+			s3 := fx[k+2][j] + fx[k+3][j]
+			k += 4
+			sum2 += s2
+			sum3 += s3
+			diff[i] += math.Abs(s2 - 2*f0 - ratio*(s3-2*f0))
+		}
+		for ; k < 1+4*n+2*n*(n-1); k++ {
+			sum4 += fx[k][j]
+		}
+		for ; k < len(fx); k++ {
+			sum5 += fx[k][j]
+		}
+		v := vol * (g.w1*f0 + g.w2*sum2 + g.w3*sum3 + g.w4*sum4 + g.w5*sum5)
+		v5 := vol * (g.e1*f0 + g.e2*sum2 + g.e3*sum3 + g.e4*sum4)
+		r.value[j] = v
+		r.err[j] = math.Abs(v - v5)
+	}
+	r.updateErrMax()
+
+	// Split along the dimension with the largest fourth difference, or
+	// the widest of those with similar differences.
+	split := floats.MaxIdx(diff)
+	maxDiff := diff[split]
+	for i, d := range diff {
+		if d > maxDiff*(1-1e-10) && r.half[i] > r.half[split] {
+			split = i
+		}
+	}
+	r.split = split
+}
+
+// kronrod1D is the 15-point Gauss–Kronrod rule with the embedded 7-point
+// Gauss rule for one dimension.
+type kronrod1D struct {
+	x, wk, wg []float64
+}
+
+func newKronrod1D() *kronrod1D {
+	const m = 7
+	r := &kronrod1D{
+		x:  make([]float64, 2*m+1),
+		wk: make([]float64, 2*m+1),
+		wg: make([]float64, 2*m+1),
+	}
+	quad.Kronrod{}.FixedLocations(r.x, r.wk, -1, 1)
+	xg := make([]float64, m)
+	wg := make([]float64, m)
+	quad.Legendre{}.FixedLocations(xg, wg, -1, 1)
+	// The Gauss locations are the Kronrod locations with odd indices,
+	// and Legendre returns them in decreasing order.
+	for i, w := range wg {
+		r.wg[2*(m-1-i)+1] = w
+	}
+	return r
+}
+
+func (k *kronrod1D) points() int {
+	return len(k.x)
+}
+
+func (k *kronrod1D) locations(x [][]float64, r *region) {
+	for i, v := range k.x {
+		x[i][0] = r.center[0] + v*r.half[0]
+	}
+}
+
+func (k *kronrod1D) estimate(r *region, fx [][]float64) {
+	for j := range r.value {
+		var vk, vg float64
+		for i, f := range fx {
+			vk += k.wk[i] * f[j]
+			vg += k.wg[i] * f[j]
+		}
+		r.value[j] = vk * r.half[0]
+		r.err[j] = math.Abs(vk-vg) * r.half[0]
+	}
+	r.updateErrMax()
+	r.split = 0
+}
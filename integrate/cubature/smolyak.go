@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/integrate/quad"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/stat/combin"
+)
+
+// Smolyak is a sparse grid cubature rule constructed from one-dimensional
+// rules by the combination technique of Smolyak. For smooth integrands in
+// several dimensions, it needs far fewer locations than the tensor product
+// of the one-dimensional rules of the same accuracy.
+//
+// The rule of level l in d dimensions is the combination
+//  \sum_i (-1)^(l+d-|i|) binomial(d-1, l+d-|i|) Q_(i_1) ⊗ ... ⊗ Q_(i_d)
+// over the multi-indices i with i_k ≥ 1 and l+1 ≤ |i| ≤ l+d, where Q_j is
+// the one-dimensional rule of level j and |i| = i_1 + ... + i_d. If the
+// rule of level j is exact for polynomials of degree 2j-1, such as the
+// j-point Gauss rule, the rule of level l is exact for polynomials of total
+// degree 2l+1.
+//
+// Locations that coincide in the tensor products, as for nested
+// one-dimensional rules, are merged.
+type Smolyak struct {
+	// Level is the level of the rule. Level zero is the tensor product
+	// of the one-dimensional rules of level one.
+	Level int
+
+	// Rule is the one-dimensional rule, which is called with the bounds
+	// of each dimension, so weighted rules such as quad.Hermite may be
+	// used if the bounds match their assumptions. If Rule is nil,
+	// quad.Legendre is used.
+	Rule quad.FixedLocationer
+
+	// Points returns the number of locations of the one-dimensional rule
+	// of level j ≥ 1. If Points is nil, the rule of level j has j
+	// locations.
+	Points func(level int) int
+}
+
+// Locations returns the locations and weights of the rule for integration
+// over the hyperrectangle with the corners min and max. Locations panics if
+// the level is negative or if min and max have different or zero lengths.
+func (s Smolyak) Locations(min, max []float64) (x *mat.Dense, weight []float64) {
+	d := len(min)
+	if len(max) != d {
+		panic("cubature: bound length mismatch")
+	}
+	if d == 0 {
+		panic("cubature: zero dimension")
+	}
+	if s.Level < 0 {
+		panic("cubature: negative level")
+	}
+	rule := s.Rule
+	if rule == nil {
+		rule = quad.Legendre{}
+	}
+	points := s.Points
+	if points == nil {
+		points = func(level int) int { return level }
+	}
+
+	// rules[k][j-1] holds the rule of level j for dimension k.
+	l := s.Level
+	type rule1D struct {
+		x, w []float64
+	}
+	rules := make([][]rule1D, d)
+	for k := range rules {
+		rules[k] = make([]rule1D, l+1)
+		for j := range rules[k] {
+			n := points(j + 1)
+			if n <= 0 {
+				panic("cubature: non-positive number of points")
+			}
+			r := rule1D{x: make([]float64, n), w: make([]float64, n)}
+			rule.FixedLocations(r.x, r.w, min[k], max[k])
+			rules[k][j] = r
+		}
+	}
+
+	var locs [][]float64
+	index := make(map[string]int)
+	key := make([]byte, 8*d)
+	add := func(p []float64, w float64) {
+		for k, v := range p {
+			b := math.Float64bits(v)
+			for i := 0; i < 8; i++ {
+				key[8*k+i] = byte(b >> (8 * uint(i)))
+			}
+		}
+		if i, ok := index[string(key)]; ok {
+			weight[i] += w
+			return
+		}
+		index[string(key)] = len(locs)
+		locs = append(locs, append([]float64(nil), p...))
+		weight = append(weight, w)
+	}
+
+	// Iterate over the multi-indices with 1 ≤ i_k and |i| ≤ l+d.
+	levels := make([]int, d)
+	for k := range levels {
+		levels[k] = 1
+	}
+	p := make([]float64, d)
+	pos := make([]int, d)
+	for {
+		sum := 0
+		for _, j := range levels {
+			sum += j
+		}
+		if sum >= l+1 {
+			c := float64(combin.Binomial(d-1, l+d-sum))
+			if (l+d-sum)%2 == 1 {
+				c = -c
+			}
+			// Add the tensor product of the rules of the levels.
+			for k := range pos {
+				pos[k] = 0
+			}
+			for {
+				w := c
+				for k, i := range pos {
+					r := rules[k][levels[k]-1]
+					p[k] = r.x[i]
+					w *= r.w[i]
+				}
+				add(p, w)
+				if !next(pos, func(k int) int { return len(rules[k][levels[k]-1].x) }) {
+					break
+				}
+			}
+		}
+		if !nextLevels(levels, l+d) {
+			break
+		}
+	}
+
+	x = mat.NewDense(len(locs), d, nil)
+	for i, v := range locs {
+		x.SetRow(i, v)
+	}
+	return x, weight
+}
+
+// next advances the multi-index pos with bounds n(k) in lexicographic order,
+// returning false when all indices have been visited.
+func next(pos []int, n func(k int) int) bool {
+	for k := len(pos) - 1; k >= 0; k-- {
+		pos[k]++
+		if pos[k] < n(k) {
+			return true
+		}
+		pos[k] = 0
+	}
+	return false
+}
+
+// nextLevels advances the multi-index of levels to the next with all levels
+// at least one and a sum of at most max, returning false when all have been
+// visited.
+func nextLevels(levels []int, max int) bool {
+	sum := 0
+	for _, j := range levels {
+		sum += j
+	}
+	for k := len(levels) - 1; k >= 0; k-- {
+		if sum < max {
+			levels[k]++
+			return true
+		}
+		sum -= levels[k] - 1
+		levels[k] = 1
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cubature
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/integrate/quad"
+)
+
+func TestSmolyakExactness(t *testing.T) {
+	t.Parallel()
+	for dim := 1; dim <= 4; dim++ {
+		min := make([]float64, dim)
+		max := make([]float64, dim)
+		for i := range min {
+			min[i] = -1 + 0.2*float64(i)
+			max[i] = 2 - 0.3*float64(i)
+		}
+		for level := 0; level <= 4; level++ {
+			x, w := Smolyak{Level: level}.Locations(min, max)
+			n, _ := x.Dims()
+			if n != len(w) {
+				t.Fatalf("dim=%d level=%d: mismatched locations and weights", dim, level)
+			}
+			// Test the monomials of total degree 2*level+1 in the
+			// first and last variables.
+			deg := 2*level + 1
+			for p := 0; p <= deg; p++ {
+				q := deg - p
+				if dim == 1 {
+					q = 0
+				}
+				exp := make([]float64, dim)
+				exp[0] = float64(p)
+				exp[dim-1] += float64(q)
+				want := 1.0
+				for i, e := range exp {
+					want *= (math.Pow(max[i], e+1) - math.Pow(min[i], e+1)) / (e + 1)
+				}
+				var got float64
+				for i, wi := range w {
+					v := wi
+					for k, e := range exp {
+						v *= math.Pow(x.At(i, k), e)
+					}
+					got += v
+				}
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+					t.Errorf("dim=%d level=%d: unexpected integral of monomial %v: got %v, want %v", dim, level, exp, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSmolyakLocations(t *testing.T) {
+	t.Parallel()
+	// The level one rule in two dimensions combines the one-point rule
+	// and the two-point rules in each dimension.
+	x, w := Smolyak{Level: 1}.Locations([]float64{-1, -1}, []float64{1, 1})
+	if n, _ := x.Dims(); n != 5 {
+		t.Errorf("unexpected number of locations: got %d, want 5", n)
+	}
+	if !floats.EqualApprox(w, []float64{-4, 2, 2, 2, 2}, 1e-14) {
+		t.Errorf("unexpected weights: %v", w)
+	}
+
+	// Coinciding locations are merged.
+	center := func(x, w []float64, min, max float64) {
+		for i := range x {
+			x[i] = (min + max) / 2
+			w[i] = (max - min) / float64(len(x))
+		}
+	}
+	x, w = Smolyak{Level: 3, Rule: fixedLocationer(center)}.Locations([]float64{0, 0, 0}, []float64{1, 2, 3})
+	if n, _ := x.Dims(); n != 1 {
+		t.Errorf("unexpected number of merged locations: got %d, want 1", n)
+	}
+	if math.Abs(w[0]-6) > 1e-14 {
+		t.Errorf("unexpected merged weight: got %v, want 6", w[0])
+	}
+}
+
+type fixedLocationer func(x, w []float64, min, max float64)
+
+func (f fixedLocationer) FixedLocations(x, w []float64, min, max float64) { f(x, w, min, max) }
+
+func TestSmolyakFixed(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name     string
+		f        Integrand
+		fdim     int
+		min, max []float64
+		rule     Smolyak
+		want     []float64
+		tol      float64
+	}{
+		{
+			name: "exp",
+			f: func(dst, x []float64) {
+				dst[0] = math.Exp(floats.Sum(x))
+				dst[1] = x[0] * x[1] * x[2] * x[3]
+			},
+			fdim: 2,
+			min:  []float64{0, 0, 0, 0},
+			max:  []float64{1, 1, 1, 1},
+			rule: Smolyak{Level: 6},
+			want: []float64{math.Pow(math.E-1, 4), 1.0 / 16},
+			tol:  1e-10,
+		},
+		{
+			// The expectation of x^2 y^2 + cos(x + y) under
+			// the weight exp(-x^2 - y^2).
+			name: "hermite",
+			f: func(dst, x []float64) {
+				dst[0] = x[0]*x[0]*x[1]*x[1] + math.Cos(x[0]+x[1])
+			},
+			fdim: 1,
+			min:  []float64{-inf, -inf},
+			max:  []float64{inf, inf},
+			rule: Smolyak{Level: 10, Rule: quad.Hermite{}},
+			want: []float64{math.Pi/4 + math.Pi*math.Exp(-0.5)},
+			tol:  1e-12,
+		},
+	} {
+		for _, concurrent := range []int{0, 3} {
+			got := Fixed(test.f, test.fdim, test.min, test.max, test.rule, concurrent)
+			if !floats.EqualApprox(got, test.want, test.tol) {
+				t.Errorf("%s: unexpected result: got %v, want %v", test.name, got, test.want)
+			}
+		}
+	}
+}
+
+func TestSmolyakPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		rule     Smolyak
+		min, max []float64
+	}{
+		{name: "length mismatch", min: []float64{0}, max: []float64{1, 1}},
+		{name: "zero dimension", min: []float64{}, max: []float64{}},
+		{name: "negative level", rule: Smolyak{Level: -1}, min: []float64{0}, max: []float64{1}},
+		{name: "points", rule: Smolyak{Points: func(int) int { return 0 }}, min: []float64{0}, max: []float64{1}},
+	} {
+		if !panics(func() { test.rule.Locations(test.min, test.max) }) {
+			t.Errorf("%s: expected panic", test.name)
+		}
+	}
+}
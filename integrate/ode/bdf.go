@@ -0,0 +1,388 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+)
+
+// bdfMaxOrder is the maximum order of BDF.
+const bdfMaxOrder = 5
+
+// BDF is the variable order backward differentiation formula method of
+// orders 1 to 5 in quasi-constant step size form, with the state held as
+// backward differences. It is efficient for stiff problems and
+// differential-algebraic equations, in particular for large systems, since
+// it needs one solution of a nonlinear system per step and reuses the
+// Jacobian and its factorization over many steps. The dense output
+// interpolates the solution with the polynomial of the formula.
+//
+// If NDF is true, the numerical differentiation formulas of Klopfenstein
+// and Shampine are used instead, which are more efficient at orders 1 to 4
+// with the same stability, as in MATLAB's ode15s.
+//
+// The method is described in
+//  Shampine, L. F., Reichelt, M. W.: The MATLAB ODE Suite. SIAM J. Sci.
+//  Comput. 18(1), 1-22 (1997)
+type BDF struct {
+	// MaxOrder is the maximum order of the formulas. If MaxOrder is
+	// zero, it defaults to 5. MaxOrder must not be greater than 5.
+	MaxOrder int
+
+	// NDF specifies whether the numerical differentiation formulas are
+	// used.
+	NDF bool
+
+	f     Func
+	s     *Settings
+	stats *Stats
+
+	jac       *jacobian
+	mass      massMatrix
+	lu        luSolver
+	validLU   bool
+	newtonTol float64
+
+	maxOrder    int
+	alpha       [bdfMaxOrder + 2]float64
+	gamma       [bdfMaxOrder + 2]float64
+	errConst    [bdfMaxOrder + 2]float64
+	order       int
+	equalSteps  int
+	t, h        float64
+	d           [bdfMaxOrder + 3][]float64
+	ynew, ypred []float64
+	psi, dsum   []float64
+	fy, tmp     []float64
+	work, work2 []float64
+	scale       []float64
+}
+
+// Init initializes the method. It is called by Solve.
+func (m *BDF) Init(p *Problem, s *Settings, stats *Stats) {
+	if m.MaxOrder < 0 || m.MaxOrder > bdfMaxOrder {
+		panic("ode: invalid BDF order")
+	}
+	m.maxOrder = m.MaxOrder
+	if m.maxOrder == 0 {
+		m.maxOrder = bdfMaxOrder
+	}
+	n := len(p.Y0)
+	m.f = p.Func
+	m.s = s
+	m.stats = stats
+	m.jac = newJacobian(p, stats)
+	m.mass = newMassMatrix(p)
+	m.validLU = false
+	m.newtonTol = math.Max(10*machEps/s.RelTol, math.Min(0.03, math.Sqrt(s.RelTol)))
+
+	var kappa [bdfMaxOrder + 2]float64
+	if m.NDF {
+		kappa = [bdfMaxOrder + 2]float64{0, -0.1850, -1.0 / 9, -0.0823, -0.0415, 0, 0}
+	}
+	for k := 1; k < len(m.gamma); k++ {
+		m.gamma[k] = m.gamma[k-1] + 1/float64(k)
+	}
+	for k := range m.alpha {
+		m.alpha[k] = (1 - kappa[k]) * m.gamma[k]
+		m.errConst[k] = kappa[k]*m.gamma[k] + 1/float64(k+1)
+	}
+
+	m.t = p.T0
+	for i := range m.d {
+		m.d[i] = make([]float64, n)
+	}
+	m.ynew = make([]float64, n)
+	m.ypred = make([]float64, n)
+	m.psi = make([]float64, n)
+	m.dsum = make([]float64, n)
+	m.fy = make([]float64, n)
+	m.tmp = make([]float64, n)
+	m.work = make([]float64, n)
+	m.work2 = make([]float64, n)
+	m.scale = make([]float64, n)
+
+	m.f(m.fy, m.t, p.Y0)
+	stats.FuncEvals++
+	m.h = initialStep(m.f, m.t, p.Y0, m.fy, sign(p.T0, p.T1), 1, s, stats)
+	copy(m.d[0], p.Y0)
+	floats.ScaleTo(m.d[1], m.h, m.fy)
+	m.order = 1
+	m.equalSteps = 0
+	m.jac.eval(m.t, p.Y0, m.fy)
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (m *BDF) Step(tEnd float64) (Interpolant, error) {
+	if math.Abs(m.h) > m.s.MaxStep {
+		m.changeStep(math.Copysign(m.s.MaxStep, m.h) / m.h)
+	}
+	currentJac := false
+
+	var (
+		errNorm float64
+		iters   int
+		tnew, h float64
+	)
+	for {
+		h = m.h
+		if (m.t+h-tEnd)*h >= 0 {
+			m.changeStep((tEnd - m.t) / h)
+			h = m.h
+			tnew = tEnd
+		} else {
+			if tooSmall(m.t, h) {
+				return nil, ErrStepSize
+			}
+			tnew = m.t + h
+		}
+		order := m.order
+
+		// Predict the solution and form the constant part of the
+		// corrector equation.
+		for k := range m.ypred {
+			m.ypred[k] = 0
+			m.psi[k] = 0
+		}
+		for i := 0; i <= order; i++ {
+			floats.Add(m.ypred, m.d[i])
+		}
+		for i := 1; i <= order; i++ {
+			floats.AddScaled(m.psi, m.gamma[i]/m.alpha[order], m.d[i])
+		}
+		for k, v := range m.ypred {
+			m.scale[k] = m.s.AbsTol + m.s.RelTol*math.Abs(v)
+		}
+		c := h / m.alpha[order]
+
+		converged := false
+		for {
+			if !m.validLU && !m.factorize(c) {
+				break
+			}
+			converged, iters = m.solveCorrector(tnew, c)
+			if converged || currentJac {
+				break
+			}
+			m.f(m.tmp, tnew, m.ypred)
+			m.stats.FuncEvals++
+			m.jac.eval(tnew, m.ypred, m.tmp)
+			currentJac = true
+			m.validLU = false
+		}
+		if !converged {
+			m.stats.Rejected++
+			m.changeStep(0.5)
+			if tooSmall(m.t, m.h) {
+				return nil, ErrStepSize
+			}
+			continue
+		}
+
+		for k, v := range m.ynew {
+			m.scale[k] = m.s.AbsTol + m.s.RelTol*math.Abs(v)
+		}
+		errNorm = m.errConst[order] * rmsNorm(m.dsum, m.scale)
+		if errNorm <= 1 {
+			break
+		}
+		m.stats.Rejected++
+		safety := 0.9 * float64(2*bdfNewtonMaxIter+1) / float64(2*bdfNewtonMaxIter+iters)
+		m.changeStep(math.Max(minFac, safety*math.Pow(errNorm, -1/float64(order+1))))
+		if tooSmall(m.t, m.h) {
+			return nil, ErrStepSize
+		}
+	}
+
+	// Accept the step and update the differences, using
+	//  ∇^(j+1) y_n = ∇^j y_n - ∇^j y_(n-1),
+	// where dsum = ∇^(k+1) y_n.
+	order := m.order
+	m.stats.Steps++
+	m.equalSteps++
+	d := &m.d
+	floats.SubTo(d[order+2], m.dsum, d[order+1])
+	copy(d[order+1], m.dsum)
+	for i := order; i >= 0; i-- {
+		floats.Add(d[i], d[i+1])
+	}
+
+	dense := &bdfDense{t0: m.t, t1: tnew, h: h, order: order}
+	for i := 0; i <= order; i++ {
+		dense.d = append(dense.d, append([]float64(nil), d[i]...))
+	}
+	m.t = tnew
+	if tnew == tEnd {
+		return dense, nil
+	}
+
+	if m.equalSteps < order+1 {
+		return dense, nil
+	}
+
+	// Choose the order and the step size for the next step from the
+	// error estimates of the neighboring orders.
+	errM, errP := math.Inf(1), math.Inf(1)
+	if order > 1 {
+		errM = m.errConst[order-1] * rmsNorm(d[order], m.scale)
+	}
+	if order < m.maxOrder {
+		errP = m.errConst[order+1] * rmsNorm(d[order+2], m.scale)
+	}
+	best := 0
+	fac := math.Inf(-1)
+	for i, e := range [3]float64{errM, errNorm, errP} {
+		f := math.Pow(e, -1/float64(order+i))
+		if f > fac {
+			fac = f
+			best = i
+		}
+	}
+	m.order += best - 1
+	safety := 0.9 * float64(2*bdfNewtonMaxIter+1) / float64(2*bdfNewtonMaxIter+iters)
+	m.changeStep(math.Min(maxFac, safety*fac))
+	return dense, nil
+}
+
+// changeStep multiplies the step size by fac and updates the differences
+// for the new step size.
+func (m *BDF) changeStep(fac float64) {
+	m.h *= fac
+	m.equalSteps = 0
+	m.validLU = false
+	if fac == 1 {
+		return
+	}
+	order := m.order
+	r := bdfR(order, fac)
+	u := bdfR(order, 1)
+	var ru [bdfMaxOrder + 1][bdfMaxOrder + 1]float64
+	for i := 0; i <= order; i++ {
+		for j := 0; j <= order; j++ {
+			for k := 0; k <= order; k++ {
+				ru[i][j] += r[i][k] * u[k][j]
+			}
+		}
+	}
+	n := len(m.ynew)
+	var d [bdfMaxOrder + 1][]float64
+	for i := 0; i <= order; i++ {
+		d[i] = make([]float64, n)
+		for k := 0; k <= order; k++ {
+			floats.AddScaled(d[i], ru[k][i], m.d[k])
+		}
+	}
+	for i := 0; i <= order; i++ {
+		copy(m.d[i], d[i])
+	}
+}
+
+// bdfR returns the matrix that transforms the backward differences of order
+// up to order for a change of the step size by fac.
+func bdfR(order int, fac float64) [bdfMaxOrder + 1][bdfMaxOrder + 1]float64 {
+	var r [bdfMaxOrder + 1][bdfMaxOrder + 1]float64
+	for j := 0; j <= order; j++ {
+		r[0][j] = 1
+	}
+	for i := 1; i <= order; i++ {
+		for j := 1; j <= order; j++ {
+			r[i][j] = r[i-1][j] * (float64(i-1) - fac*float64(j)) / float64(i)
+		}
+	}
+	return r
+}
+
+// rmsNorm returns the root mean square norm of x scaled by scale.
+func rmsNorm(x, scale []float64) float64 {
+	var sum float64
+	for i, v := range x {
+		v /= scale[i]
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(len(x)))
+}
+
+// bdfDense is the dense output of a step of BDF.
+type bdfDense struct {
+	t0, t1 float64
+	h      float64
+	order  int
+	d      [][]float64
+}
+
+func (b *bdfDense) Interval() (t0, t1 float64) {
+	return b.t0, b.t1
+}
+
+func (b *bdfDense) Eval(dst []float64, t float64) {
+	if len(dst) != len(b.d[0]) {
+		panic("ode: destination length mismatch")
+	}
+	copy(dst, b.d[0])
+	p := 1.0
+	for j := 0; j < b.order; j++ {
+		p *= (t - (b.t1 - b.h*float64(j))) / (b.h * float64(j+1))
+		floats.AddScaled(dst, p, b.d[j+1])
+	}
+}
+
+// bdfNewtonMaxIter is the maximum number of Newton iterations of BDF.
+const bdfNewtonMaxIter = 4
+
+// factorize factorizes the iteration matrix M - c J.
+func (m *BDF) factorize(c float64) bool {
+	kl, ku, banded := m.jac.bandwidth()
+	m.validLU = m.lu.factorize(len(m.ynew), kl, ku, banded, func(i, k int) float64 {
+		return m.mass.at(i, k) - c*m.jac.at(i, k)
+	}, m.stats)
+	return m.validLU
+}
+
+// solveCorrector solves the corrector equation
+//  M (y - ypred + psi) = c f(t, y)
+// by simplified Newton iterations, storing the solution in m.ynew and its
+// difference to the prediction in m.dsum, and returns whether the
+// iterations converged and their number.
+func (m *BDF) solveCorrector(t, c float64) (converged bool, iters int) {
+	copy(m.ynew, m.ypred)
+	for k := range m.dsum {
+		m.dsum[k] = 0
+	}
+	var normOld float64
+	for iters = 1; iters <= bdfNewtonMaxIter; iters++ {
+		m.f(m.tmp, t, m.ynew)
+		m.stats.FuncEvals++
+		for _, v := range m.tmp {
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return false, iters
+			}
+		}
+		// dy = (M - cJ)^-1 (c f - M (psi + dsum)).
+		res := m.tmp
+		floats.AddTo(m.work, m.psi, m.dsum)
+		m.mass.mulTo(m.work2, m.work)
+		for k, v := range res {
+			res[k] = c*v - m.work2[k]
+		}
+		m.lu.solve(res, res, m.stats)
+		norm := rmsNorm(res, m.scale)
+		var rate float64
+		if iters > 1 {
+			rate = norm / normOld
+			if rate >= 1 || math.Pow(rate, float64(bdfNewtonMaxIter-iters+1))/(1-rate)*norm > m.newtonTol {
+				return false, iters
+			}
+		}
+		floats.Add(m.ynew, res)
+		floats.Add(m.dsum, res)
+		if norm == 0 || iters > 1 && rate/(1-rate)*norm < m.newtonTol {
+			return true, iters
+		}
+		normOld = norm
+	}
+	return false, bdfNewtonMaxIter
+}
@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ode provides numerical solution of initial value problems for
+// systems of ordinary differential equations
+//  dy/dt = f(t, y),  y(t0) = y0.
+//
+// Solve integrates a Problem with a Method, choosing the step sizes
+// adaptively to keep the estimated local error within the tolerances given
+// in Settings. The returned Solution holds the states at the steps and
+// dense output that approximates the solution at any time in the
+// integration interval. Events locate zero crossings of functions of the
+// solution, and may stop the integration.
+//
+// The explicit Runge–Kutta methods DormandPrince and Tsitouras are
+// efficient for non-stiff problems. The implicit methods BDF, Radau and
+// Rosenbrock are suited to stiff problems, for which explicit methods need
+// very small steps to remain stable. They solve linear systems with the
+// Jacobian of the right-hand side, which may be dense or banded, and support
+// a mass matrix, including singular mass matrices of differential-algebraic
+// equations of index 1.
+package ode // import "github.com/jingcheng-WU/gonum/integrate/ode"
@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jingcheng-WU/gonum/optimize/univariate"
+)
+
+// Event is a zero crossing of a function of the solution.
+type Event struct {
+	// Func is the event function. An event occurs when Func changes
+	// sign. Func must not modify y.
+	Func func(t float64, y []float64) float64
+
+	// Direction restricts the crossings that are events. If Direction
+	// is positive, only crossings from negative to positive values are
+	// events, if it is negative, only crossings from positive to
+	// negative values are events, and if it is zero, all crossings are
+	// events.
+	Direction int
+
+	// Terminal specifies whether the integration stops at the event.
+	Terminal bool
+}
+
+// Crossing is the occurrence of an event.
+type Crossing struct {
+	// Event is the index of the event in the events of the Problem.
+	Event int
+
+	// T and Y are the time and the state of the crossing.
+	T float64
+	Y []float64
+}
+
+// eventState holds the values of the event functions at the end of the
+// last step.
+type eventState struct {
+	events []Event
+	g      []float64
+	tmp    []float64
+}
+
+func newEventState(events []Event, t0 float64, y0 []float64) *eventState {
+	e := &eventState{
+		events: events,
+		g:      make([]float64, len(events)),
+		tmp:    make([]float64, len(y0)),
+	}
+	for i, ev := range events {
+		e.g[i] = ev.Func(t0, y0)
+	}
+	return e
+}
+
+// check locates the crossings of the events in the step with the dense
+// output d ending in the state y. It returns the crossings in the order of
+// occurrence up to the first terminal event, and whether a terminal event
+// occurred, in which case it is the last crossing.
+func (e *eventState) check(d Interpolant, y []float64) (crossings []Crossing, stop bool) {
+	if len(e.events) == 0 {
+		return nil, false
+	}
+	t0, t1 := d.Interval()
+	for i, ev := range e.events {
+		g0 := e.g[i]
+		g1 := ev.Func(t1, y)
+		e.g[i] = g1
+		if g0 == 0 || (g0 < 0) == (g1 < 0) && g1 != 0 {
+			continue
+		}
+		if ev.Direction > 0 && g0 > 0 || ev.Direction < 0 && g0 < 0 {
+			continue
+		}
+
+		t := t1
+		if g1 != 0 {
+			f := func(t float64) float64 {
+				d.Eval(e.tmp, t)
+				return ev.Func(t, e.tmp)
+			}
+			settings := &univariate.Settings{
+				AbsTol: 4 * machEps * math.Max(math.Abs(t0), math.Abs(t1)),
+				RelTol: 4 * machEps,
+			}
+			res, err := univariate.Brent(f, t0, t1, settings)
+			if err == nil {
+				t = res.X
+			}
+		}
+		c := Crossing{Event: i, T: t, Y: make([]float64, len(y))}
+		if t == t1 {
+			copy(c.Y, y)
+		} else {
+			d.Eval(c.Y, t)
+		}
+		crossings = append(crossings, c)
+	}
+
+	forward := t0 < t1
+	sort.SliceStable(crossings, func(i, j int) bool {
+		if forward {
+			return crossings[i].T < crossings[j].T
+		}
+		return crossings[i].T > crossings[j].T
+	})
+	for k, c := range crossings {
+		if e.events[c.Event].Terminal {
+			return crossings[:k+1], true
+		}
+	}
+	return crossings, false
+}
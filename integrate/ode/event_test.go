@@ -0,0 +1,122 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+)
+
+func TestEvents(t *testing.T) {
+	t.Parallel()
+	oscillator := func(dy []float64, t float64, y []float64) {
+		dy[0] = y[1]
+		dy[1] = -y[0]
+	}
+	position := func(t float64, y []float64) float64 { return y[0] }
+	for _, method := range testMethods {
+		for _, test := range []struct {
+			name   string
+			t0, t1 float64
+			events []Event
+			want   []Crossing
+			end    float64
+		}{
+			{
+				name:   "all",
+				t0:     0.5,
+				t1:     10,
+				events: []Event{{Func: position}},
+				want: []Crossing{
+					{Event: 0, T: math.Pi},
+					{Event: 0, T: 2 * math.Pi},
+					{Event: 0, T: 3 * math.Pi},
+				},
+				end: 10,
+			},
+			{
+				name: "directions",
+				t0:   0.5,
+				t1:   10,
+				events: []Event{
+					{Func: position, Direction: 1},
+					{Func: position, Direction: -1},
+				},
+				want: []Crossing{
+					{Event: 1, T: math.Pi},
+					{Event: 0, T: 2 * math.Pi},
+					{Event: 1, T: 3 * math.Pi},
+				},
+				end: 10,
+			},
+			{
+				name: "terminal",
+				t0:   0.5,
+				t1:   10,
+				events: []Event{
+					{Func: position, Direction: 1, Terminal: true},
+					{Func: position},
+				},
+				want: []Crossing{
+					{Event: 1, T: math.Pi},
+					{Event: 0, T: 2 * math.Pi},
+				},
+				end: 2 * math.Pi,
+			},
+			{
+				name: "backward",
+				t0:   10,
+				t1:   -1,
+				events: []Event{
+					{Func: func(t float64, y []float64) float64 { return t - 5 }, Terminal: true},
+					{Func: position},
+				},
+				want: []Crossing{
+					{Event: 1, T: 3 * math.Pi},
+					{Event: 1, T: 2 * math.Pi},
+					{Event: 0, T: 5},
+				},
+				end: 5,
+			},
+		} {
+			p := Problem{
+				Func:   oscillator,
+				T0:     test.t0,
+				T1:     test.t1,
+				Y0:     []float64{math.Sin(test.t0), math.Cos(test.t0)},
+				Events: test.events,
+			}
+			sol, err := Solve(p, method.new(), &Settings{AbsTol: 1e-10, RelTol: 1e-10})
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", method.name, test.name, err)
+				continue
+			}
+			if len(sol.Events) != len(test.want) {
+				t.Errorf("%s %s: unexpected number of events: got:%d want:%d", method.name, test.name, len(sol.Events), len(test.want))
+				continue
+			}
+			for i, got := range sol.Events {
+				want := test.want[i]
+				if got.Event != want.Event || !scalar.EqualWithinAbs(got.T, want.T, 1e-8) {
+					t.Errorf("%s %s: unexpected event %d: got:%d at %v want:%d at %v",
+						method.name, test.name, i, got.Event, got.T, want.Event, want.T)
+				}
+				if !scalar.EqualWithinAbs(got.Y[0], math.Sin(got.T), 1e-8) {
+					t.Errorf("%s %s: unexpected state at event %d: got:%v want:%v",
+						method.name, test.name, i, got.Y[0], math.Sin(got.T))
+				}
+			}
+			_, end := sol.Interval()
+			if !scalar.EqualWithinAbs(end, test.end, 1e-8) {
+				t.Errorf("%s %s: unexpected end: got:%v want:%v", method.name, test.name, end, test.end)
+			}
+			if last := sol.Y[len(sol.Y)-1]; !scalar.EqualWithinAbs(last[0], math.Sin(end), 1e-8) {
+				t.Errorf("%s %s: unexpected final state: got:%v want:%v", method.name, test.name, last[0], math.Sin(end))
+			}
+		}
+	}
+}
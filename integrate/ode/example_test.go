@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jingcheng-WU/gonum/integrate/ode"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func ExampleSolve() {
+	// A one-compartment pharmacokinetic model with first-order
+	// absorption. The amount of drug in the gut, y[0], is absorbed into
+	// the plasma, y[1], from which it is eliminated.
+	const (
+		ka = 1.2 // Absorption rate constant per hour.
+		ke = 0.2 // Elimination rate constant per hour.
+	)
+	p := ode.Problem{
+		Func: func(dy []float64, t float64, y []float64) {
+			dy[0] = -ka * y[0]
+			dy[1] = ka*y[0] - ke*y[1]
+		},
+		T0: 0,
+		T1: 48,
+		Y0: []float64{100, 0},
+		Events: []ode.Event{
+			// The time of the peak plasma amount.
+			{Func: func(t float64, y []float64) float64 { return ka*y[0] - ke*y[1] }, Direction: -1},
+			// Stop when the plasma amount falls below 10.
+			{Func: func(t float64, y []float64) float64 { return y[1] - 10 }, Direction: -1, Terminal: true},
+		},
+	}
+	sol, err := ode.Solve(p, &ode.Tsitouras{}, &ode.Settings{AbsTol: 1e-8, RelTol: 1e-8})
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, c := range sol.Events {
+		fmt.Printf("event %d at t=%.4f h: plasma amount %.4f\n", c.Event, c.T, c.Y[1])
+	}
+	fmt.Printf("plasma amount at t=6 h: %.4f\n", sol.At(nil, 6)[1])
+	// Output:
+	// event 0 at t=1.7918 h: plasma amount 69.8827
+	// event 1 at t=12.4245 h: plasma amount 10.0000
+	// plasma amount at t=6 h: 36.0537
+}
+
+func ExampleBDF() {
+	// The chemical kinetics problem of Robertson, written as a
+	// differential-algebraic equation with the conservation of mass as
+	// the algebraic equation.
+	p := ode.Problem{
+		Func: func(dy []float64, t float64, y []float64) {
+			dy[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+			dy[1] = 0.04*y[0] - 1e4*y[1]*y[2] - 3e7*y[1]*y[1]
+			dy[2] = y[0] + y[1] + y[2] - 1
+		},
+		Mass: mat.NewDiagDense(3, []float64{1, 1, 0}),
+		T0:   0,
+		T1:   40,
+		Y0:   []float64{1, 0, 0},
+	}
+	sol, err := ode.Solve(p, &ode.BDF{NDF: true}, &ode.Settings{AbsTol: 1e-10, RelTol: 1e-6})
+	if err != nil {
+		log.Fatal(err)
+	}
+	y := sol.Y[len(sol.Y)-1]
+	fmt.Printf("y(40) = [%.5f %.3e %.5f]\n", y[0], y[1], y[2])
+	fmt.Printf("fewer than 500 steps: %t\n", sol.Stats.Steps < 500)
+	// Output:
+	// y(40) = [0.71583 9.186e-06 0.28416]
+	// fewer than 500 steps: true
+}
@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+// DormandPrince is the explicit Runge–Kutta method of Dormand and Prince of
+// order 5 with an embedded method of order 4 for error control, and dense
+// output of order 4. It is the method of MATLAB's ode45 and SciPy's RK45.
+//
+// The method is described in
+//  Dormand, J. R., Prince, P. J.: A family of embedded Runge-Kutta formulae.
+//  J. Comp. Appl. Math. 6(1), 19-26 (1980)
+type DormandPrince struct {
+	rk explicitRK
+}
+
+var dormandPrince = &tableau{
+	c: []float64{0, 1.0 / 5, 3.0 / 10, 4.0 / 5, 8.0 / 9, 1, 1},
+	a: [][]float64{
+		{1.0 / 5},
+		{3.0 / 40, 9.0 / 40},
+		{44.0 / 45, -56.0 / 15, 32.0 / 9},
+		{19372.0 / 6561, -25360.0 / 2187, 64448.0 / 6561, -212.0 / 729},
+		{9017.0 / 3168, -355.0 / 33, 46732.0 / 5247, 49.0 / 176, -5103.0 / 18656},
+		{35.0 / 384, 0, 500.0 / 1113, 125.0 / 192, -2187.0 / 6784, 11.0 / 84},
+	},
+	e: []float64{
+		-71.0 / 57600, 0, 71.0 / 16695, -71.0 / 1920, 17253.0 / 339200, -22.0 / 525, 1.0 / 40,
+	},
+	// The continuous extension of Shampine, as used by SciPy.
+	p: [][]float64{
+		{1, -8048581381.0 / 2820520608, 8663915743.0 / 2820520608, -12715105075.0 / 11282082432},
+		{0, 0, 0, 0},
+		{0, 131558114200.0 / 32700410799, -68118460800.0 / 10900136933, 87487479700.0 / 32700410799},
+		{0, -1754552775.0 / 470086768, 14199869525.0 / 1410260304, -10690763975.0 / 1880347072},
+		{0, 127303824393.0 / 49829197408, -318862633887.0 / 49829197408, 701980252875.0 / 199316789632},
+		{0, -282668133.0 / 205662961, 2019193451.0 / 616988883, -1453857185.0 / 822651844},
+		{0, 40617522.0 / 29380423, -110615467.0 / 29380423, 69997945.0 / 29380423},
+	},
+	order: 4,
+}
+
+// Init initializes the method. It is called by Solve.
+func (m *DormandPrince) Init(p *Problem, s *Settings, stats *Stats) {
+	m.rk.init(dormandPrince, p, s, stats)
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (m *DormandPrince) Step(tEnd float64) (Interpolant, error) {
+	return m.rk.step(tEnd)
+}
+
+// Tsitouras is the explicit Runge–Kutta method of Tsitouras of order 5
+// with an embedded method of order 4 for error control, and dense output of
+// order 4. Its coefficients are optimized without the simplifying
+// assumptions of DormandPrince, and it is often more efficient.
+//
+// The method is described in
+//  Tsitouras, Ch.: Runge–Kutta pairs of order 5(4) satisfying only the
+//  first column simplifying assumption. Comput. Math. Appl. 62(2),
+//  770-775 (2011)
+type Tsitouras struct {
+	rk explicitRK
+}
+
+var tsitouras = &tableau{
+	c: []float64{0, 0.161, 0.327, 0.9, 0.9800255409045097, 1, 1},
+	a: [][]float64{
+		{0.161},
+		{-0.008480655492356989, 0.335480655492357},
+		{2.897153057105493, -6.359448489975075, 4.3622954328695815},
+		{5.325864828439257, -11.748883564062828, 7.4955393428898365, -0.09249506636175525},
+		{5.86145544294642, -12.92096931784711, 8.159367898576159, -0.071584973281401, -0.028269050394068383},
+		{0.09646076681806523, 0.01, 0.4798896504144996, 1.379008574103742, -3.290069515436081, 2.324710524099774},
+	},
+	e: []float64{
+		-0.00178001105222577714, -0.0008164344596567469, 0.007880878010261995, -0.1447110071732629,
+		0.5823571654525552, -0.45808210592918697, 1.0 / 66,
+	},
+	p: [][]float64{
+		{1, -2.763706197274826, 2.9132554618219126, -1.0530884977290216},
+		{0, 0.13169999999999998, -0.2234, 0.1017},
+		{0, 3.9302962368947516, -5.941033872131505, 2.490627285651253},
+		{0, -12.411077166933676, 30.33818863028232, -16.548102889244902},
+		{0, 37.50931341651104, -88.1789048947664, 47.37952196281928},
+		{0, -27.896526289197286, 65.09189467479366, -34.87065786149660},
+		{0, 1.5, -4, 2.5},
+	},
+	order: 4,
+}
+
+// Init initializes the method. It is called by Solve.
+func (m *Tsitouras) Init(p *Problem, s *Settings, stats *Stats) {
+	m.rk.init(tsitouras, p, s, stats)
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (m *Tsitouras) Step(tEnd float64) (Interpolant, error) {
+	return m.rk.step(tEnd)
+}
@@ -0,0 +1,425 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var implicitMethods = []struct {
+	name string
+	new  func() Method
+}{
+	{name: "BDF", new: func() Method { return &BDF{} }},
+	{name: "NDF", new: func() Method { return &BDF{NDF: true} }},
+	{name: "BDF3", new: func() Method { return &BDF{MaxOrder: 3} }},
+	{name: "Radau", new: func() Method { return &Radau{} }},
+	{name: "Rosenbrock", new: func() Method { return &Rosenbrock{} }},
+}
+
+func TestRadauConstants(t *testing.T) {
+	t.Parallel()
+	s6 := math.Sqrt(6)
+	a := mat.NewDense(3, 3, []float64{
+		(88 - 7*s6) / 360, (296 - 169*s6) / 1800, (-2 + 3*s6) / 225,
+		(296 + 169*s6) / 1800, (88 + 7*s6) / 360, (-2 - 3*s6) / 225,
+		(16 - s6) / 36, (16 + s6) / 36, 1.0 / 9,
+	})
+	for i := 0; i < 3; i++ {
+		var sum float64
+		for j := 0; j < 3; j++ {
+			sum += a.At(i, j)
+		}
+		if !scalar.EqualWithinAbs(sum, radauC[i], 1e-15) {
+			t.Errorf("unexpected node %d: got:%v want:%v", i, radauC[i], sum)
+		}
+	}
+
+	var ainv, tm, tinv, got, tmp mat.Dense
+	if err := ainv.Inverse(a); err != nil {
+		t.Fatal(err)
+	}
+	tm.ReuseAs(3, 3)
+	tinv.ReuseAs(3, 3)
+	for i := 0; i < 3; i++ {
+		tm.SetRow(i, radauT[i][:])
+		tinv.SetRow(i, radauTI[i][:])
+	}
+	tmp.Mul(&tinv, &tm)
+	if !mat.EqualApprox(&tmp, eye(3), 1e-14) {
+		t.Errorf("TI is not the inverse of T:\n%v", mat.Formatted(&tmp))
+	}
+	tmp.Mul(&tinv, &ainv)
+	got.Mul(&tmp, &tm)
+	want := mat.NewDense(3, 3, []float64{
+		radauMuReal, 0, 0,
+		0, radauAlpha, radauBeta,
+		0, -radauBeta, radauAlpha,
+	})
+	if !mat.EqualApprox(&got, want, 1e-13) {
+		t.Errorf("unexpected transformed inverse:\n%v\nwant:\n%v", mat.Formatted(&got), mat.Formatted(want))
+	}
+
+	// The dense output interpolates the stages at the nodes.
+	for i := 0; i < 3; i++ {
+		for k, c := range radauC {
+			var sum float64
+			for j := 0; j < 3; j++ {
+				sum += radauP[i][j] * math.Pow(c, float64(j+1))
+			}
+			var want float64
+			if i == k {
+				want = 1
+			}
+			if !scalar.EqualWithinAbs(sum, want, 1e-13) {
+				t.Errorf("unexpected dense output weight of stage %d at node %d: got:%v want:%v", i, k, sum, want)
+			}
+		}
+	}
+}
+
+func eye(n int) *mat.Dense {
+	m := mat.NewDense(n, n, nil)
+	for i := 0; i < n; i++ {
+		m.Set(i, i, 1)
+	}
+	return m
+}
+
+func TestImplicitNonStiff(t *testing.T) {
+	t.Parallel()
+	for _, method := range implicitMethods {
+		for _, test := range testProblems {
+			for _, tol := range []float64{1e-5, 1e-8} {
+				y0 := make([]float64, test.n)
+				test.exact(y0, test.t0)
+				p := Problem{Func: test.f, T0: test.t0, T1: test.t1, Y0: y0}
+				settings := &Settings{AbsTol: tol, RelTol: tol}
+				if method.name == "Rosenbrock" && tol < 1e-6 {
+					// The method is of order 2 and needs too many
+					// steps at stringent tolerances.
+					continue
+				}
+				sol, err := Solve(p, method.new(), settings)
+				if err != nil {
+					t.Errorf("%s %s tol=%g: unexpected error: %v", method.name, test.name, tol, err)
+					continue
+				}
+				want := make([]float64, test.n)
+				got := make([]float64, test.n)
+				maxErr := 2000 * tol
+				for i, ti := range sol.T {
+					test.exact(want, ti)
+					if !floats.EqualApprox(sol.Y[i], want, maxErr) {
+						t.Errorf("%s %s tol=%g: unexpected state at t=%v: got:%v want:%v",
+							method.name, test.name, tol, ti, sol.Y[i], want)
+						break
+					}
+					if i == 0 {
+						continue
+					}
+					tm := (sol.T[i-1] + ti) / 2
+					sol.At(got, tm)
+					test.exact(want, tm)
+					if !floats.EqualApprox(got, want, maxErr) {
+						t.Errorf("%s %s tol=%g: unexpected dense output at t=%v: got:%v want:%v",
+							method.name, test.name, tol, tm, got, want)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+// robertson is the chemical kinetics problem of Robertson with its
+// reference solution at t = 40 from Hairer and Wanner.
+var (
+	robertson = func(dy []float64, t float64, y []float64) {
+		dy[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+		dy[1] = 0.04*y[0] - 1e4*y[1]*y[2] - 3e7*y[1]*y[1]
+		dy[2] = 3e7 * y[1] * y[1]
+	}
+	robertsonJac = func(jac *mat.Dense, t float64, y []float64) {
+		jac.SetRow(0, []float64{-0.04, 1e4 * y[2], 1e4 * y[1]})
+		jac.SetRow(1, []float64{0.04, -1e4*y[2] - 6e7*y[1], -1e4 * y[1]})
+		jac.SetRow(2, []float64{0, 6e7 * y[1], 0})
+	}
+	robertsonDAE = func(dy []float64, t float64, y []float64) {
+		dy[0] = -0.04*y[0] + 1e4*y[1]*y[2]
+		dy[1] = 0.04*y[0] - 1e4*y[1]*y[2] - 3e7*y[1]*y[1]
+		dy[2] = y[0] + y[1] + y[2] - 1
+	}
+	robertson40 = []float64{0.7158270687193941, 9.185534764557338e-06, 0.2841637457458413}
+)
+
+func TestStiff(t *testing.T) {
+	t.Parallel()
+	for _, method := range implicitMethods {
+		for _, test := range []struct {
+			name     string
+			p        Problem
+			want     []float64
+			tol      float64
+			maxSteps int
+		}{
+			{
+				name: "Robertson",
+				p:    Problem{Func: robertson, T0: 0, T1: 40, Y0: []float64{1, 0, 0}},
+				want: robertson40,
+				tol:  1e-4,
+			},
+			{
+				name: "Robertson Jacobian",
+				p:    Problem{Func: robertson, Jacobian: robertsonJac, T0: 0, T1: 40, Y0: []float64{1, 0, 0}},
+				want: robertson40,
+				tol:  1e-4,
+			},
+			{
+				name: "Robertson DAE",
+				p: Problem{
+					Func: robertsonDAE,
+					Mass: mat.NewDiagDense(3, []float64{1, 1, 0}),
+					T0:   0,
+					T1:   40,
+					Y0:   []float64{1, 0, 0},
+				},
+				want: robertson40,
+				tol:  1e-4,
+			},
+			{
+				name: "linear",
+				p: Problem{
+					Func: func(dy []float64, t float64, y []float64) {
+						dy[0] = -1e4*(y[0]-math.Cos(t)) - math.Sin(t)
+						dy[1] = -1e6*(y[1]-y[0]) + dy[0]
+					},
+					T0: 0,
+					T1: 10,
+					Y0: []float64{1, 1},
+				},
+				want:     []float64{math.Cos(10), math.Cos(10)},
+				tol:      1e-5,
+				maxSteps: 2000,
+			},
+		} {
+			settings := &Settings{AbsTol: 1e-10, RelTol: 1e-6}
+			if method.name == "Rosenbrock" {
+				settings = &Settings{AbsTol: 1e-8, RelTol: 1e-4}
+			}
+			sol, err := Solve(test.p, method.new(), settings)
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", method.name, test.name, err)
+				continue
+			}
+			got := sol.Y[len(sol.Y)-1]
+			if !floats.EqualApprox(got, test.want, test.tol) {
+				t.Errorf("%s %s: unexpected result: got:%v want:%v", method.name, test.name, got, test.want)
+			}
+			for i := range got {
+				if !scalar.EqualWithinRel(got[i], test.want[i], 1e-2) {
+					t.Errorf("%s %s: unexpected relative error of component %d: got:%v want:%v",
+						method.name, test.name, i, got[i], test.want[i])
+				}
+			}
+			if test.maxSteps != 0 && sol.Stats.Steps > test.maxSteps {
+				t.Errorf("%s %s: too many steps: %d", method.name, test.name, sol.Stats.Steps)
+			}
+			st := sol.Stats
+			if st.JacEvals == 0 || st.Decompositions == 0 || st.Solves == 0 {
+				t.Errorf("%s %s: missing statistics: %+v", method.name, test.name, st)
+			}
+			if test.p.Jacobian == nil && st.FuncEvals < 3*st.JacEvals {
+				t.Errorf("%s %s: finite difference evaluations not counted: %+v", method.name, test.name, st)
+			}
+		}
+	}
+}
+
+// heat returns the semi-discretized heat equation with n interior points
+// on [0, 1] and zero boundary values.
+func heat(n int) Func {
+	return func(dy []float64, t float64, y []float64) {
+		c := float64((n + 1) * (n + 1))
+		for i := range y {
+			var l, r float64
+			if i > 0 {
+				l = y[i-1]
+			}
+			if i < n-1 {
+				r = y[i+1]
+			}
+			dy[i] = c * (l - 2*y[i] + r)
+		}
+	}
+}
+
+func TestBanded(t *testing.T) {
+	t.Parallel()
+	const n = 50
+	y0 := make([]float64, n)
+	for i := range y0 {
+		x := float64(i+1) / (n + 1)
+		y0[i] = math.Sin(math.Pi*x) + math.Sin(3*math.Pi*x)
+	}
+	bandJac := func(jac *mat.BandDense, t float64, y []float64) {
+		c := float64((n + 1) * (n + 1))
+		for i := 0; i < n; i++ {
+			jac.SetBand(i, i, -2*c)
+			if i > 0 {
+				jac.SetBand(i, i-1, c)
+			}
+			if i < n-1 {
+				jac.SetBand(i, i+1, c)
+			}
+		}
+	}
+	for _, method := range implicitMethods {
+		p := Problem{Func: heat(n), T0: 0, T1: 0.1, Y0: y0}
+		settings := &Settings{AbsTol: 1e-10, RelTol: 1e-6}
+		dense, err := Solve(p, method.new(), settings)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", method.name, err)
+		}
+		for _, band := range []*Band{{KL: 1, KU: 1}, {KL: 1, KU: 1, Jacobian: bandJac}, {KL: 2, KU: 3}} {
+			p.Band = band
+			sol, err := Solve(p, method.new(), settings)
+			if err != nil {
+				t.Fatalf("%s: unexpected error for band %d %d: %v", method.name, band.KL, band.KU, err)
+			}
+			got := sol.Y[len(sol.Y)-1]
+			want := dense.Y[len(dense.Y)-1]
+			if !floats.EqualApprox(got, want, 1e-9) {
+				t.Errorf("%s: banded result differs from dense for band %d %d", method.name, band.KL, band.KU)
+			}
+			if band.Jacobian == nil {
+				perJac := band.KL + band.KU + 1
+				if sol.Stats.FuncEvals > dense.Stats.FuncEvals-(n-perJac)*sol.Stats.JacEvals+sol.Stats.FuncEvals/2 {
+					t.Errorf("%s: banded Jacobian not compressed: %d vs %d evaluations",
+						method.name, sol.Stats.FuncEvals, dense.Stats.FuncEvals)
+				}
+			}
+		}
+
+		// Check against the exact solution of the semi-discrete
+		// problem.
+		for i := range y0 {
+			x := float64(i+1) / (n + 1)
+			want := 0.0
+			for _, k := range []float64{1, 3} {
+				lambda := -4 * float64((n+1)*(n+1)) * math.Pow(math.Sin(k*math.Pi/(2*(n+1))), 2)
+				want += math.Exp(lambda*0.1) * math.Sin(k*math.Pi*x)
+			}
+			if got := dense.Y[len(dense.Y)-1][i]; !scalar.EqualWithinAbs(got, want, 1e-5) {
+				t.Errorf("%s: unexpected solution at %d: got:%v want:%v", method.name, i, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestBandLU(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ n, kl, ku int }{
+		{1, 0, 0}, {5, 0, 0}, {5, 1, 1}, {10, 2, 1}, {10, 1, 3}, {8, 7, 7}, {20, 3, 0}, {20, 0, 4},
+	} {
+		a := mat.NewDense(test.n, test.n, nil)
+		for i := 0; i < test.n; i++ {
+			for k := max(0, i-test.kl); k <= min(test.n-1, i+test.ku); k++ {
+				a.Set(i, k, rnd.NormFloat64())
+			}
+		}
+		b := make([]float64, test.n)
+		for i := range b {
+			b[i] = rnd.NormFloat64()
+		}
+		var f bandLU
+		if !f.factorize(test.n, test.kl, test.ku, a.At) {
+			t.Errorf("n=%d kl=%d ku=%d: unexpected singular matrix", test.n, test.kl, test.ku)
+			continue
+		}
+		x := append([]float64(nil), b...)
+		f.solve(x)
+		var want mat.VecDense
+		if err := want.SolveVec(a, mat.NewVecDense(test.n, b)); err != nil {
+			t.Fatal(err)
+		}
+		if !floats.EqualApprox(x, want.RawVector().Data, 1e-10) {
+			t.Errorf("n=%d kl=%d ku=%d: unexpected solution: got:%v want:%v", test.n, test.kl, test.ku, x, want.RawVector().Data)
+		}
+	}
+
+	var f bandLU
+	if f.factorize(3, 1, 1, func(i, k int) float64 { return 0 }) {
+		t.Errorf("expected singular matrix")
+	}
+}
+
+func TestRosenbrockOrder(t *testing.T) {
+	t.Parallel()
+	for _, test := range testProblems {
+		y0 := make([]float64, test.n)
+		test.exact(y0, test.t0)
+		want := make([]float64, test.n)
+		got := make([]float64, test.n)
+		dir := sign(test.t0, test.t1)
+		var prev float64
+		for k, h := range []float64{0.02, 0.01} {
+			var m Rosenbrock
+			p := Problem{Func: test.f, T0: test.t0, T1: test.t1, Y0: y0}
+			s := defaultSettings(&Settings{AbsTol: 1, RelTol: 1, InitialStep: h})
+			m.Init(&p, &s, &Stats{})
+			d, err := m.Step(test.t1)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", test.name, err)
+			}
+			t1 := test.t0 + dir*h
+			d.Eval(got, t1)
+			test.exact(want, t1)
+			e := floats.Distance(got, want, math.Inf(1))
+			// The local error of the method is O(h^3).
+			if k > 0 {
+				if r := prev / e; r < math.Pow(2, 2.5) {
+					t.Errorf("%s: local error ratio too small: %v", test.name, r)
+				}
+			}
+			prev = e
+		}
+	}
+}
+
+func TestExplicitMass(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: func(dy []float64, t float64, y []float64) { dy[0] = y[0] },
+		T0:   0,
+		T1:   1,
+		Y0:   []float64{1},
+		Mass: mat.NewDiagDense(1, []float64{2}),
+	}
+	for _, method := range testMethods {
+		if !panics(func() { _, _ = Solve(p, method.new(), nil) }) {
+			t.Errorf("%s: expected panic for mass matrix", method.name)
+		}
+	}
+	for _, method := range implicitMethods {
+		sol, err := Solve(p, method.new(), &Settings{AbsTol: 1e-8, RelTol: 1e-8})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", method.name, err)
+			continue
+		}
+		if got, want := sol.Y[len(sol.Y)-1][0], math.Exp(0.5); !scalar.EqualWithinRel(got, want, 1e-5) {
+			t.Errorf("%s: unexpected solution with mass matrix: got:%v want:%v", method.name, got, want)
+		}
+	}
+}
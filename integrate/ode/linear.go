@@ -0,0 +1,308 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/diff/fd"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Band specifies that the Jacobian of a problem and its mass matrix are
+// banded.
+type Band struct {
+	// KL and KU are the lower and upper bandwidths of the Jacobian
+	// and the mass matrix.
+	KL, KU int
+
+	// Jacobian stores the Jacobian ∂f/∂y at (t, y) in jac. If Jacobian
+	// is nil, the Jacobian is approximated by forward differences,
+	// which needs KL+KU+1 evaluations of the right-hand side.
+	Jacobian func(jac *mat.BandDense, t float64, y []float64)
+}
+
+// jacobian evaluates and holds the Jacobian of a problem.
+type jacobian struct {
+	p     *Problem
+	stats *Stats
+
+	dense *mat.Dense
+	band  *mat.BandDense
+
+	sparsity fd.SparsityPattern
+	work     *mat.Dense
+}
+
+func newJacobian(p *Problem, stats *Stats) *jacobian {
+	n := len(p.Y0)
+	j := &jacobian{p: p, stats: stats}
+	if p.Band == nil {
+		j.dense = mat.NewDense(n, n, nil)
+		return j
+	}
+	kl, ku := p.Band.KL, p.Band.KU
+	if kl < 0 || ku < 0 {
+		panic("ode: negative bandwidth")
+	}
+	kl = min(kl, n-1)
+	ku = min(ku, n-1)
+	j.band = mat.NewBandDense(n, n, kl, ku, nil)
+	if p.Band.Jacobian == nil {
+		j.sparsity = make(fd.SparsityPattern, n)
+		for i := range j.sparsity {
+			for k := max(0, i-kl); k <= min(n-1, i+ku); k++ {
+				j.sparsity[i] = append(j.sparsity[i], k)
+			}
+		}
+		j.work = mat.NewDense(n, n, nil)
+	}
+	return j
+}
+
+// eval evaluates the Jacobian at (t, y) where the right-hand side is fy.
+func (j *jacobian) eval(t float64, y, fy []float64) {
+	j.stats.JacEvals++
+	switch {
+	case j.band != nil && j.p.Band.Jacobian != nil:
+		j.p.Band.Jacobian(j.band, t, y)
+		return
+	case j.dense != nil && j.p.Jacobian != nil:
+		j.p.Jacobian(j.dense, t, y)
+		return
+	}
+
+	f := func(dy, y []float64) {
+		j.stats.FuncEvals++
+		j.p.Func(dy, t, y)
+	}
+	settings := &fd.JacobianSettings{
+		Formula:     fd.Forward,
+		OriginValue: fy,
+		Sparsity:    j.sparsity,
+	}
+	if j.dense != nil {
+		fd.Jacobian(j.dense, f, y, settings)
+		return
+	}
+	fd.Jacobian(j.work, f, y, settings)
+	kl, ku := j.band.Bandwidth()
+	n := len(y)
+	for i := 0; i < n; i++ {
+		for k := max(0, i-kl); k <= min(n-1, i+ku); k++ {
+			j.band.SetBand(i, k, j.work.At(i, k))
+		}
+	}
+}
+
+// at returns the element of the Jacobian at row i and column k.
+func (j *jacobian) at(i, k int) float64 {
+	if j.band != nil {
+		return j.band.At(i, k)
+	}
+	return j.dense.At(i, k)
+}
+
+// bandwidth returns the bandwidths of the Jacobian.
+func (j *jacobian) bandwidth() (kl, ku int, banded bool) {
+	if j.band == nil {
+		return 0, 0, false
+	}
+	kl, ku = j.band.Bandwidth()
+	return kl, ku, true
+}
+
+// massMatrix is the mass matrix of a problem.
+type massMatrix struct {
+	m mat.Matrix
+	v *mat.VecDense
+}
+
+func newMassMatrix(p *Problem) massMatrix {
+	if p.Mass == nil {
+		return massMatrix{}
+	}
+	n := len(p.Y0)
+	if r, c := p.Mass.Dims(); r != n || c != n {
+		panic("ode: mass matrix dimension mismatch")
+	}
+	return massMatrix{m: p.Mass, v: mat.NewVecDense(n, nil)}
+}
+
+// at returns the element of the mass matrix at row i and column k.
+func (m massMatrix) at(i, k int) float64 {
+	if m.m == nil {
+		if i == k {
+			return 1
+		}
+		return 0
+	}
+	return m.m.At(i, k)
+}
+
+// mulTo stores M*x in dst. dst and x must not be the same slice.
+func (m massMatrix) mulTo(dst, x []float64) {
+	if m.m == nil {
+		copy(dst, x)
+		return
+	}
+	m.v.MulVec(m.m, mat.NewVecDense(len(x), x))
+	copy(dst, m.v.RawVector().Data)
+}
+
+// luSolver solves linear systems with a factorized matrix.
+type luSolver struct {
+	dense *mat.Dense
+	lu    mat.LU
+	band  bandLU
+	n     int
+
+	banded bool
+	x, b   *mat.VecDense
+}
+
+// factorize factorizes the n×n matrix with elements entry(i, k), which are
+// zero outside the bandwidths kl and ku if banded is true, and returns
+// whether the matrix is non-singular.
+func (s *luSolver) factorize(n, kl, ku int, banded bool, entry func(i, k int) float64, stats *Stats) bool {
+	stats.Decompositions++
+	s.n = n
+	s.banded = banded
+	if banded {
+		return s.band.factorize(n, kl, ku, entry)
+	}
+	if s.dense == nil || s.dense.RawMatrix().Rows != n {
+		s.dense = mat.NewDense(n, n, nil)
+		s.x = mat.NewVecDense(n, nil)
+		s.b = mat.NewVecDense(n, nil)
+	}
+	for i := 0; i < n; i++ {
+		for k := 0; k < n; k++ {
+			s.dense.Set(i, k, entry(i, k))
+		}
+	}
+	s.lu.Factorize(s.dense)
+	return !math.IsInf(s.lu.Cond(), 1)
+}
+
+// solve stores the solution x of A x = b in x. x and b may be the same
+// slice.
+func (s *luSolver) solve(x, b []float64, stats *Stats) {
+	stats.Solves++
+	if s.banded {
+		copy(x, b)
+		s.band.solve(x)
+		return
+	}
+	copy(s.b.RawVector().Data, b)
+	// The matrix is known to be non-singular, so a condition
+	// error is not fatal.
+	_ = s.lu.SolveVecTo(s.x, false, s.b)
+	copy(x, s.x.RawVector().Data)
+}
+
+// bandLU is the LU factorization with partial pivoting of a band matrix.
+type bandLU struct {
+	n, kl, ku int
+	// data holds the rows of the factors, with room for the fill-in of
+	// the upper bandwidth by row interchanges. Element (i, k) is at
+	// data[i*w+k-i+kl] with w = 2*kl+ku+1.
+	data  []float64
+	pivot []int
+}
+
+func (f *bandLU) width() int {
+	return 2*f.kl + f.ku + 1
+}
+
+func (f *bandLU) idx(i, k int) int {
+	return i*f.width() + k - i + f.kl
+}
+
+func (f *bandLU) factorize(n, kl, ku int, entry func(i, k int) float64) bool {
+	f.n, f.kl, f.ku = n, kl, ku
+	w := f.width()
+	if cap(f.data) < n*w {
+		f.data = make([]float64, n*w)
+		f.pivot = make([]int, n)
+	}
+	f.data = f.data[:n*w]
+	f.pivot = f.pivot[:n]
+	for i := range f.data {
+		f.data[i] = 0
+	}
+	for i := 0; i < n; i++ {
+		for k := max(0, i-kl); k <= min(n-1, i+ku); k++ {
+			f.data[f.idx(i, k)] = entry(i, k)
+		}
+	}
+
+	for k := 0; k < n; k++ {
+		last := min(n-1, k+kl)
+		end := min(n-1, k+kl+ku)
+		p := k
+		for i := k + 1; i <= last; i++ {
+			if math.Abs(f.data[f.idx(i, k)]) > math.Abs(f.data[f.idx(p, k)]) {
+				p = i
+			}
+		}
+		f.pivot[k] = p
+		pivot := f.data[f.idx(p, k)]
+		if pivot == 0 {
+			return false
+		}
+		if p != k {
+			for c := k; c <= end; c++ {
+				a, b := f.idx(k, c), f.idx(p, c)
+				f.data[a], f.data[b] = f.data[b], f.data[a]
+			}
+		}
+		for i := k + 1; i <= last; i++ {
+			l := f.data[f.idx(i, k)] / pivot
+			f.data[f.idx(i, k)] = l
+			if l == 0 {
+				continue
+			}
+			for c := k + 1; c <= end; c++ {
+				f.data[f.idx(i, c)] -= l * f.data[f.idx(k, c)]
+			}
+		}
+	}
+	return true
+}
+
+// solve overwrites b with the solution of A x = b.
+func (f *bandLU) solve(b []float64) {
+	n := f.n
+	for k := 0; k < n; k++ {
+		if p := f.pivot[k]; p != k {
+			b[k], b[p] = b[p], b[k]
+		}
+		for i := k + 1; i <= min(n-1, k+f.kl); i++ {
+			b[i] -= f.data[f.idx(i, k)] * b[k]
+		}
+	}
+	for k := n - 1; k >= 0; k-- {
+		s := b[k]
+		for c := k + 1; c <= min(n-1, k+f.kl+f.ku); c++ {
+			s -= f.data[f.idx(k, c)] * b[c]
+		}
+		b[k] = s / f.data[f.idx(k, k)]
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
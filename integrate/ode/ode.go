@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"errors"
+	"math"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var (
+	// ErrMaxSteps is returned by Solve when the maximum number of steps
+	// is reached before the end of the integration interval.
+	ErrMaxSteps = errors.New("ode: maximum number of steps reached")
+
+	// ErrStepSize is returned by Solve when the step size needed to meet
+	// the tolerances becomes too small relative to the time, which may
+	// indicate a singularity of the solution.
+	ErrStepSize = errors.New("ode: step size too small")
+)
+
+// Func is the right-hand side f of the differential equation. It stores
+// the derivative dy/dt = f(t, y) in dy and must not modify y.
+type Func func(dy []float64, t float64, y []float64)
+
+// VecFunc returns a Func that calls f with vectors backed by the slices
+// passed to the Func.
+func VecFunc(f func(dy *mat.VecDense, t float64, y *mat.VecDense)) Func {
+	return func(dy []float64, t float64, y []float64) {
+		f(mat.NewVecDense(len(dy), dy), t, mat.NewVecDense(len(y), y))
+	}
+}
+
+// Problem is an initial value problem.
+type Problem struct {
+	// Func is the right-hand side of the differential equation.
+	Func Func
+
+	// T0 and T1 are the start and the end of the integration
+	// interval. T1 may be less than T0 to integrate backwards in time.
+	T0, T1 float64
+
+	// Y0 is the initial state at T0.
+	Y0 []float64
+
+	// Events are the events that are located during the integration.
+	Events []Event
+
+	// Jacobian stores the Jacobian ∂f/∂y at (t, y) in jac. If Jacobian
+	// is nil, the Jacobian is approximated by forward differences using
+	// package fd. The Jacobian is only used by implicit methods.
+	Jacobian func(jac *mat.Dense, t float64, y []float64)
+
+	// Band specifies a banded Jacobian and mass matrix. If Band is not
+	// nil, implicit methods use Band.Jacobian instead of Jacobian and
+	// solve their linear systems with banded LU factorizations.
+	Band *Band
+
+	// Mass is the constant mass matrix M of the problem
+	//  M dy/dt = f(t, y).
+	// If Mass is nil, M is the identity. If M is singular, the problem
+	// is a differential-algebraic equation, which must be of index 1
+	// and have a consistent initial state. Only implicit methods
+	// support a mass matrix.
+	Mass mat.Matrix
+}
+
+// Settings are the settings of Solve.
+type Settings struct {
+	// AbsTol and RelTol are the absolute and relative tolerances of
+	// the local error. The error estimate e of each step must satisfy
+	//  sqrt(1/n * Σ_i (e_i / (AbsTol + RelTol*|y_i|))^2) ≤ 1.
+	// If AbsTol is zero, it defaults to 1e-6, and if RelTol is zero, it
+	// defaults to 1e-3.
+	AbsTol, RelTol float64
+
+	// InitialStep is the size of the first step. If InitialStep is
+	// zero, it is chosen automatically.
+	InitialStep float64
+
+	// MaxStep is the maximum step size. If MaxStep is zero, the step
+	// size is not limited.
+	MaxStep float64
+
+	// MaxSteps is the maximum number of accepted steps. If MaxSteps is
+	// zero, the number of steps is not limited.
+	MaxSteps int
+}
+
+// defaultSettings returns the settings s with the defaults filled in.
+func defaultSettings(s *Settings) Settings {
+	var d Settings
+	if s != nil {
+		d = *s
+	}
+	if d.AbsTol < 0 || d.RelTol < 0 || d.InitialStep < 0 || d.MaxStep < 0 || d.MaxSteps < 0 {
+		panic("ode: negative setting")
+	}
+	if d.AbsTol == 0 {
+		d.AbsTol = 1e-6
+	}
+	if d.RelTol == 0 {
+		d.RelTol = 1e-3
+	}
+	if d.MaxStep == 0 {
+		d.MaxStep = math.Inf(1)
+	}
+	return d
+}
+
+// Stats are statistics of the integration.
+type Stats struct {
+	// Steps is the number of accepted steps.
+	Steps int
+	// Rejected is the number of rejected steps.
+	Rejected int
+	// FuncEvals is the number of evaluations of the right-hand side,
+	// including those for finite difference Jacobians.
+	FuncEvals int
+	// JacEvals is the number of evaluations of the Jacobian.
+	JacEvals int
+	// Decompositions is the number of LU decompositions.
+	Decompositions int
+	// Solves is the number of solutions of linear systems.
+	Solves int
+}
+
+// Interpolant is the dense output of a step of a Method.
+type Interpolant interface {
+	// Interval returns the start and the end of the step.
+	Interval() (t0, t1 float64)
+
+	// Eval stores the approximate solution at t in dst. t should be
+	// within the interval of the step.
+	Eval(dst []float64, t float64)
+}
+
+// Method is a method for advancing the solution of an initial value problem.
+type Method interface {
+	// Init initializes the method to integrate p from its initial state
+	// in the direction of p.T1 with the settings s, where the defaults
+	// of s have been filled in. Init and Step record their work in
+	// stats.
+	Init(p *Problem, s *Settings, stats *Stats)
+
+	// Step advances the solution by one step that satisfies the
+	// tolerances and does not go past tEnd, and returns the dense output
+	// of the step. Step returns ErrStepSize if no step can satisfy the
+	// tolerances.
+	Step(tEnd float64) (Interpolant, error)
+}
+
+// Solve integrates the initial value problem p from p.T0 to p.T1 using the
+// method m and the given settings. If m is nil, DormandPrince is used, and
+// if settings is nil, the default settings are used.
+//
+// If a terminal event occurs, the integration stops at the time of the
+// event. If an error occurs, the returned solution holds the integration up
+// to the last accepted step.
+func Solve(p Problem, m Method, settings *Settings) (*Solution, error) {
+	if p.Func == nil {
+		panic("ode: nil Func")
+	}
+	for _, ev := range p.Events {
+		if ev.Func == nil {
+			panic("ode: nil event Func")
+		}
+	}
+	if m == nil {
+		m = &DormandPrince{}
+	}
+	s := defaultSettings(settings)
+
+	sol := &Solution{
+		T: []float64{p.T0},
+		Y: [][]float64{append([]float64(nil), p.Y0...)},
+	}
+	if p.T0 == p.T1 {
+		return sol, nil
+	}
+	m.Init(&p, &s, &sol.Stats)
+
+	events := newEventState(p.Events, p.T0, p.Y0)
+	y := make([]float64, len(p.Y0))
+	for sol.T[len(sol.T)-1] != p.T1 {
+		if s.MaxSteps > 0 && sol.Stats.Steps >= s.MaxSteps {
+			return sol, ErrMaxSteps
+		}
+		dense, err := m.Step(p.T1)
+		if err != nil {
+			return sol, err
+		}
+		_, t1 := dense.Interval()
+		dense.Eval(y, t1)
+
+		crossings, stop := events.check(dense, y)
+		sol.Events = append(sol.Events, crossings...)
+		if stop {
+			c := crossings[len(crossings)-1]
+			t1 = c.T
+			copy(y, c.Y)
+		}
+		sol.T = append(sol.T, t1)
+		sol.Y = append(sol.Y, append([]float64(nil), y...))
+		sol.dense = append(sol.dense, dense)
+		if stop {
+			break
+		}
+	}
+	return sol, nil
+}
+
+// errorNorm returns the root mean square norm of the error estimate e
+// scaled by the tolerances relative to the larger of the states y and ynew.
+func errorNorm(e, y, ynew []float64, s *Settings) float64 {
+	var sum float64
+	for i, v := range e {
+		sc := s.AbsTol + s.RelTol*math.Max(math.Abs(y[i]), math.Abs(ynew[i]))
+		v /= sc
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(len(e)))
+}
+
+// scaledNorm returns the root mean square norm of x scaled by the
+// tolerances relative to y.
+func scaledNorm(x, y []float64, s *Settings) float64 {
+	var sum float64
+	for i, v := range x {
+		v /= s.AbsTol + s.RelTol*math.Abs(y[i])
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(len(x)))
+}
+
+// initialStep returns the size of the first step from (t0, y0) with
+// derivative f0 for a method whose error estimate has the given order,
+// using the algorithm of Hairer, Nørsett and Wanner, Solving Ordinary
+// Differential Equations I, Section II.4. The returned step has the sign
+// of dir.
+func initialStep(f Func, t0 float64, y0, f0 []float64, dir float64, order int, s *Settings, stats *Stats) float64 {
+	if s.InitialStep != 0 {
+		return dir * math.Min(s.InitialStep, s.MaxStep)
+	}
+	d0 := scaledNorm(y0, y0, s)
+	d1 := scaledNorm(f0, y0, s)
+	h0 := 1e-6
+	if d0 >= 1e-5 && d1 >= 1e-5 {
+		h0 = 0.01 * d0 / d1
+	}
+	h0 = math.Min(h0, s.MaxStep)
+
+	y1 := make([]float64, len(y0))
+	for i, v := range y0 {
+		y1[i] = v + dir*h0*f0[i]
+	}
+	f1 := make([]float64, len(y0))
+	f(f1, t0+dir*h0, y1)
+	stats.FuncEvals++
+	for i := range f1 {
+		f1[i] -= f0[i]
+	}
+	d2 := scaledNorm(f1, y0, s) / h0
+
+	var h1 float64
+	if d1 <= 1e-15 && d2 <= 1e-15 {
+		h1 = math.Max(1e-6, h0*1e-3)
+	} else {
+		h1 = math.Pow(0.01/math.Max(d1, d2), 1/float64(order+1))
+	}
+	return dir * math.Min(math.Min(100*h0, h1), s.MaxStep)
+}
+
+// tooSmall returns whether the step h is too small to advance from t.
+func tooSmall(t, h float64) bool {
+	return math.Abs(h) <= 10*math.Abs(math.Nextafter(t, math.Inf(1))-t) || t+h == t
+}
+
+// sign returns 1 if t1 is not less than t0 and -1 otherwise.
+func sign(t0, t1 float64) float64 {
+	if t1 < t0 {
+		return -1
+	}
+	return 1
+}
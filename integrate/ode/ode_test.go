@@ -0,0 +1,290 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var testProblems = []struct {
+	name  string
+	n     int
+	f     Func
+	exact func(y []float64, t float64)
+	t0    float64
+	t1    float64
+}{
+	{
+		name: "decay",
+		n:    1,
+		f: func(dy []float64, t float64, y []float64) {
+			dy[0] = -2 * y[0]
+		},
+		exact: func(y []float64, t float64) {
+			y[0] = 3 * math.Exp(-2*t)
+		},
+		t0: 0,
+		t1: 5,
+	},
+	{
+		name: "rational",
+		n:    1,
+		f: func(dy []float64, t float64, y []float64) {
+			dy[0] = -t * y[0] * y[0]
+		},
+		exact: func(y []float64, t float64) {
+			y[0] = 2 / (t*t + 2)
+		},
+		t0: -1,
+		t1: 4,
+	},
+	{
+		name: "nonlinear rotation",
+		n:    2,
+		f: func(dy []float64, t float64, y []float64) {
+			r2 := y[0]*y[0] + y[1]*y[1]
+			dy[0] = -y[1] * r2 * (1 + t)
+			dy[1] = y[0] * r2 * (1 + t)
+		},
+		exact: func(y []float64, t float64) {
+			phi := t + t*t/2
+			y[0] = math.Cos(phi)
+			y[1] = math.Sin(phi)
+		},
+		t0: 0,
+		t1: 3,
+	},
+	{
+		name: "backward",
+		n:    2,
+		f: func(dy []float64, t float64, y []float64) {
+			dy[0] = y[1]
+			dy[1] = -y[0] + math.Cos(t)
+		},
+		exact: func(y []float64, t float64) {
+			y[0] = t * math.Sin(t) / 2
+			y[1] = (math.Sin(t) + t*math.Cos(t)) / 2
+		},
+		t0: 10,
+		t1: -2,
+	},
+}
+
+var testMethods = []struct {
+	name string
+	new  func() Method
+}{
+	{name: "DormandPrince", new: func() Method { return &DormandPrince{} }},
+	{name: "Tsitouras", new: func() Method { return &Tsitouras{} }},
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	for _, method := range testMethods {
+		for _, test := range testProblems {
+			for _, tol := range []float64{1e-6, 1e-8, 1e-10} {
+				y0 := make([]float64, test.n)
+				test.exact(y0, test.t0)
+				p := Problem{Func: test.f, T0: test.t0, T1: test.t1, Y0: y0}
+				settings := &Settings{AbsTol: tol, RelTol: tol}
+				sol, err := Solve(p, method.new(), settings)
+				if err != nil {
+					t.Errorf("%s %s tol=%g: unexpected error: %v", method.name, test.name, tol, err)
+					continue
+				}
+				if t0, t1 := sol.Interval(); t0 != test.t0 || t1 != test.t1 {
+					t.Errorf("%s %s tol=%g: unexpected interval: got:[%v,%v] want:[%v,%v]",
+						method.name, test.name, tol, t0, t1, test.t0, test.t1)
+				}
+				if len(sol.T) != sol.Stats.Steps+1 || len(sol.Y) != len(sol.T) {
+					t.Errorf("%s %s tol=%g: unexpected number of steps", method.name, test.name, tol)
+				}
+				if want := 2 + 6*(sol.Stats.Steps+sol.Stats.Rejected); sol.Stats.FuncEvals != want {
+					t.Errorf("%s %s tol=%g: unexpected number of evaluations: got:%d want:%d",
+						method.name, test.name, tol, sol.Stats.FuncEvals, want)
+				}
+
+				// The global error is a modest multiple of the
+				// tolerance for these problems, both at the steps and
+				// in between.
+				want := make([]float64, test.n)
+				got := make([]float64, test.n)
+				maxErr := 100 * tol
+				for i, ti := range sol.T {
+					test.exact(want, ti)
+					if !floats.EqualApprox(sol.Y[i], want, maxErr) {
+						t.Errorf("%s %s tol=%g: unexpected state at t=%v: got:%v want:%v",
+							method.name, test.name, tol, ti, sol.Y[i], want)
+						break
+					}
+					if i == 0 {
+						continue
+					}
+					tm := (sol.T[i-1] + ti) / 2
+					sol.At(got, tm)
+					test.exact(want, tm)
+					if !floats.EqualApprox(got, want, maxErr) {
+						t.Errorf("%s %s tol=%g: unexpected dense output at t=%v: got:%v want:%v",
+							method.name, test.name, tol, tm, got, want)
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestOrder checks the order of the local error of the methods and their
+// dense output by taking single steps of decreasing size.
+func TestOrder(t *testing.T) {
+	t.Parallel()
+	for _, method := range testMethods {
+		for _, test := range testProblems {
+			y0 := make([]float64, test.n)
+			test.exact(y0, test.t0)
+			want := make([]float64, test.n)
+			got := make([]float64, test.n)
+			dir := sign(test.t0, test.t1)
+
+			var prevStep, prevDense float64
+			for k, h := range []float64{0.1, 0.05} {
+				m := method.new()
+				p := Problem{Func: test.f, T0: test.t0, T1: test.t1, Y0: y0}
+				s := defaultSettings(&Settings{AbsTol: 1, RelTol: 1, InitialStep: h})
+				m.Init(&p, &s, &Stats{})
+				d, err := m.Step(test.t1)
+				if err != nil {
+					t.Fatalf("%s %s: unexpected error: %v", method.name, test.name, err)
+				}
+				_, t1 := d.Interval()
+				if t1 != test.t0+dir*h {
+					t.Fatalf("%s %s: unexpected step: got:%v want:%v", method.name, test.name, t1-test.t0, dir*h)
+				}
+				d.Eval(got, t1)
+				test.exact(want, t1)
+				errStep := floats.Distance(got, want, math.Inf(1))
+				tm := test.t0 + dir*0.3*h
+				d.Eval(got, tm)
+				test.exact(want, tm)
+				errDense := floats.Distance(got, want, math.Inf(1))
+				if k > 0 {
+					// The local error of the method is O(h^6) and that
+					// of the dense output is O(h^5).
+					if r := prevStep / errStep; r < math.Pow(2, 5.5) {
+						t.Errorf("%s %s h=%v: local error ratio too small: %v", method.name, test.name, h, r)
+					}
+					if r := prevDense / errDense; r < math.Pow(2, 4.5) {
+						t.Errorf("%s %s h=%v: dense output error ratio too small: %v", method.name, test.name, h, r)
+					}
+				}
+				prevStep, prevDense = errStep, errDense
+			}
+		}
+	}
+}
+
+func TestSolveErrors(t *testing.T) {
+	t.Parallel()
+	blowUp := Problem{
+		Func: func(dy []float64, t float64, y []float64) {
+			dy[0] = y[0] * y[0]
+		},
+		T0: 0,
+		T1: 2,
+		Y0: []float64{1},
+	}
+	for _, method := range testMethods {
+		sol, err := Solve(blowUp, method.new(), nil)
+		if err != ErrStepSize {
+			t.Errorf("%s: unexpected error for blow up: got:%v want:%v", method.name, err, ErrStepSize)
+		}
+		if _, t1 := sol.Interval(); t1 >= 1 || t1 < 0.99 {
+			t.Errorf("%s: unexpected end of solution for blow up: %v", method.name, t1)
+		}
+
+		sol, err = Solve(blowUp, method.new(), &Settings{MaxSteps: 5})
+		if err != ErrMaxSteps {
+			t.Errorf("%s: unexpected error for max steps: got:%v want:%v", method.name, err, ErrMaxSteps)
+		}
+		if sol.Stats.Steps != 5 || len(sol.T) != 6 {
+			t.Errorf("%s: unexpected number of steps: %d", method.name, sol.Stats.Steps)
+		}
+	}
+}
+
+func TestSettings(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: func(dy []float64, t float64, y []float64) {
+			dy[0] = math.Cos(t)
+		},
+		T0: 0,
+		T1: 10,
+		Y0: []float64{0},
+	}
+	sol, err := Solve(p, nil, &Settings{MaxStep: 0.5, InitialStep: 0.125})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sol.T[1] != 0.125 {
+		t.Errorf("unexpected initial step: got:%v want:0.125", sol.T[1])
+	}
+	for i := 1; i < len(sol.T); i++ {
+		if h := sol.T[i] - sol.T[i-1]; h > 0.5 {
+			t.Errorf("step %d exceeds maximum step: %v", i, h)
+		}
+	}
+
+	sol, err = Solve(Problem{Func: p.Func, T0: 1, T1: 1, Y0: []float64{2}}, nil, nil)
+	if err != nil || len(sol.T) != 1 || sol.Y[0][0] != 2 {
+		t.Errorf("unexpected solution for empty interval: %v %v", sol.T, err)
+	}
+}
+
+func TestSolution(t *testing.T) {
+	t.Parallel()
+	p := Problem{
+		Func: VecFunc(func(dy *mat.VecDense, t float64, y *mat.VecDense) {
+			dy.ScaleVec(-1, y)
+		}),
+		T0: 1,
+		T1: 0,
+		Y0: []float64{1, 2},
+	}
+	sol, err := Solve(p, &Tsitouras{}, &Settings{AbsTol: 1e-10, RelTol: 1e-10})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, ti := range sol.T {
+		if got := sol.At(nil, ti); !floats.Equal(got, sol.Y[i]) {
+			t.Errorf("unexpected state at step %d: got:%v want:%v", i, got, sol.Y[i])
+		}
+	}
+	v := sol.AtVec(nil, 0.5)
+	for i, y0 := range p.Y0 {
+		if want := y0 * math.Exp(0.5); !scalar.EqualWithinAbsOrRel(v.AtVec(i), want, 1e-8, 1e-8) {
+			t.Errorf("unexpected state %d: got:%v want:%v", i, v.AtVec(i), want)
+		}
+	}
+	for _, tt := range []float64{-0.1, 1.1} {
+		if !panics(func() { sol.At(nil, tt) }) {
+			t.Errorf("expected panic for time %v out of range", tt)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}
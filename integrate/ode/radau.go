@@ -0,0 +1,415 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+)
+
+// Radau is the implicit Runge–Kutta method Radau IIA of order 5 with three
+// stages, with an error estimate of order 3 and dense output of order 3.
+// It is L-stable and stiffly accurate, and efficient for stiff problems and
+// differential-algebraic equations at stringent tolerances. The nonlinear
+// stage equations are solved by simplified Newton iterations, which reuse
+// the Jacobian over steps while they converge quickly.
+//
+// The method is described in
+//  Hairer, E., Wanner, G.: Solving Ordinary Differential Equations II:
+//  Stiff and Differential-Algebraic Problems, Section IV.8. Springer (1996)
+type Radau struct {
+	f     Func
+	s     *Settings
+	stats *Stats
+
+	jac        *jacobian
+	currentJac bool
+	mass       massMatrix
+	luReal     luSolver
+	luComplex  luSolver
+	validLU    bool
+	newtonTol  float64
+
+	t, h          float64
+	hOld, errOld  float64
+	y, fy         []float64
+	dense         *radauDense
+	z, w, dw, fz  [3][]float64
+	ynew, tmp, ev []float64
+	cplx          []float64
+}
+
+// Constants of Radau IIA of order 5, after SciPy's implementation of
+// RADAU5. The coefficient matrix A of the method satisfies
+//  T^-1 A^-1 T = [μ_r 0 0; 0 α β; 0 -β α]
+// with the complex eigenvalues α ± iβ.
+var (
+	radauC = [3]float64{(4 - math.Sqrt(6)) / 10, (4 + math.Sqrt(6)) / 10, 1}
+	radauE = [3]float64{(-13 - 7*math.Sqrt(6)) / 3, (-13 + 7*math.Sqrt(6)) / 3, -1.0 / 3}
+
+	radauMuReal = 3 + math.Cbrt(9) - math.Cbrt(3)
+	radauAlpha  = 3 + 0.5*(math.Cbrt(3)-math.Cbrt(9))
+	radauBeta   = 0.5 * (math.Pow(3, 5.0/6) + math.Pow(3, 7.0/6))
+	radauT      = [3][3]float64{
+		{0.09443876248897524, -0.14125529502095421, 0.03002919410514742},
+		{0.25021312296533332, 0.20412935229379994, -0.38294211275726192},
+		{1, 1, 0},
+	}
+	radauTI = [3][3]float64{
+		{4.17871859155190428, 0.32768282076106237, 0.52337644549944951},
+		{-4.17871859155190428, -0.32768282076106237, 0.47662355450055044},
+		{0.50287263494578682, -2.57192694985560522, 0.59603920482822492},
+	}
+	// radauP are the coefficients of the dense output, so that the
+	// solution at t0 + θh is y0 + Σ_i Σ_j z_i radauP[i][j] θ^(j+1).
+	radauP = [3][3]float64{
+		{13.0/3 + 7*math.Sqrt(6)/3, -23.0/3 - 22*math.Sqrt(6)/3, 10.0/3 + 5*math.Sqrt(6)},
+		{13.0/3 - 7*math.Sqrt(6)/3, -23.0/3 + 22*math.Sqrt(6)/3, 10.0/3 - 5*math.Sqrt(6)},
+		{1.0 / 3, -8.0 / 3, 10.0 / 3},
+	}
+)
+
+// radauNewtonMaxIter is the maximum number of Newton iterations of Radau.
+const radauNewtonMaxIter = 6
+
+// Init initializes the method. It is called by Solve.
+func (m *Radau) Init(p *Problem, s *Settings, stats *Stats) {
+	n := len(p.Y0)
+	m.f = p.Func
+	m.s = s
+	m.stats = stats
+	m.jac = newJacobian(p, stats)
+	m.mass = newMassMatrix(p)
+	m.validLU = false
+	m.newtonTol = math.Max(10*machEps/s.RelTol, math.Min(0.03, math.Sqrt(s.RelTol)))
+	m.t = p.T0
+	m.y = append([]float64(nil), p.Y0...)
+	m.fy = make([]float64, n)
+	for i := range m.z {
+		m.z[i] = make([]float64, n)
+		m.w[i] = make([]float64, n)
+		m.dw[i] = make([]float64, n)
+		m.fz[i] = make([]float64, n)
+	}
+	m.ynew = make([]float64, n)
+	m.tmp = make([]float64, n)
+	m.ev = make([]float64, n)
+	m.cplx = make([]float64, 2*n)
+	m.dense = nil
+	m.hOld = 0
+	m.errOld = 0
+
+	m.f(m.fy, m.t, m.y)
+	stats.FuncEvals++
+	m.h = initialStep(m.f, m.t, m.y, m.fy, sign(p.T0, p.T1), 3, s, stats)
+	m.jac.eval(m.t, m.y, m.fy)
+	m.currentJac = true
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (m *Radau) Step(tEnd float64) (Interpolant, error) {
+	n := len(m.y)
+	h := m.h
+	hOld, errOld := m.hOld, m.errOld
+	if math.Abs(h) > m.s.MaxStep {
+		h = math.Copysign(m.s.MaxStep, h)
+		hOld, errOld = 0, 0
+		m.validLU = false
+	}
+
+	rejected := false
+	var (
+		errNorm float64
+		iters   int
+		rate    float64
+		tnew    float64
+	)
+	for {
+		last := false
+		if (m.t+h-tEnd)*h >= 0 {
+			if h != tEnd-m.t {
+				m.validLU = false
+			}
+			h = tEnd - m.t
+			last = true
+		}
+		if tooSmall(m.t, h) && !last {
+			return nil, ErrStepSize
+		}
+		tnew = m.t + h
+		if last {
+			tnew = tEnd
+		}
+
+		// Predict the stages from the dense output of the last step.
+		for i := range m.z {
+			if m.dense == nil {
+				for k := range m.z[i] {
+					m.z[i][k] = 0
+				}
+				continue
+			}
+			m.dense.Eval(m.z[i], m.t+radauC[i]*h)
+			floats.Sub(m.z[i], m.y)
+		}
+
+		converged := false
+		for !converged {
+			if !m.validLU {
+				if !m.factorize(h) {
+					break
+				}
+			}
+			converged, iters, rate = m.solveCollocation(h)
+			if converged || m.currentJac {
+				break
+			}
+			m.jac.eval(m.t, m.y, m.fy)
+			m.currentJac = true
+			m.validLU = false
+		}
+		if !converged {
+			m.stats.Rejected++
+			rejected = true
+			h /= 2
+			m.validLU = false
+			if tooSmall(m.t, h) {
+				return nil, ErrStepSize
+			}
+			continue
+		}
+
+		for k := range m.ynew {
+			m.ynew[k] = m.y[k] + m.z[2][k]
+		}
+		// The error estimate is (μ_r/h M - J)^-1 (f + M ZE) with
+		// ZE = Σ_i E_i z_i / h.
+		for k := range m.tmp {
+			m.tmp[k] = (radauE[0]*m.z[0][k] + radauE[1]*m.z[1][k] + radauE[2]*m.z[2][k]) / h
+		}
+		m.mass.mulTo(m.dw[0], m.tmp)
+		floats.AddTo(m.ev, m.fy, m.dw[0])
+		m.luReal.solve(m.ev, m.ev, m.stats)
+		errNorm = errorNorm(m.ev, m.y, m.ynew, m.s)
+		if rejected && errNorm > 1 {
+			// Improve the estimate for stiff components after a
+			// rejection.
+			floats.AddTo(m.ev, m.y, m.ev)
+			m.f(m.dw[1], m.t, m.ev)
+			m.stats.FuncEvals++
+			floats.AddTo(m.ev, m.dw[1], m.dw[0])
+			m.luReal.solve(m.ev, m.ev, m.stats)
+			errNorm = errorNorm(m.ev, m.y, m.ynew, m.s)
+		}
+
+		if errNorm <= 1 {
+			break
+		}
+		m.stats.Rejected++
+		rejected = true
+		safety := 0.9 * (2*radauNewtonMaxIter + 1) / float64(2*radauNewtonMaxIter+iters)
+		h *= math.Max(minFac, safety*radauFactor(h, hOld, errNorm, errOld))
+		m.validLU = false
+		if tooSmall(m.t, h) {
+			return nil, ErrStepSize
+		}
+	}
+
+	// Accept the step.
+	recomputeJac := iters > 2 && rate > 1e-3
+	safety := 0.9 * (2*radauNewtonMaxIter + 1) / float64(2*radauNewtonMaxIter+iters)
+	fac := math.Min(maxFac, safety*radauFactor(h, hOld, errNorm, errOld))
+	if rejected {
+		fac = math.Min(1, fac)
+	}
+	if !recomputeJac && fac < 1.2 {
+		fac = 1
+	} else {
+		m.validLU = false
+	}
+
+	d := &radauDense{t0: m.t, t1: tnew, h: h, y0: append([]float64(nil), m.y...)}
+	for i := range d.q {
+		d.q[i] = make([]float64, n)
+		for k := range d.q[i] {
+			d.q[i][k] = m.z[0][k]*radauP[0][i] + m.z[1][k]*radauP[1][i] + m.z[2][k]*radauP[2][i]
+		}
+	}
+	m.dense = d
+
+	m.t = tnew
+	copy(m.y, m.ynew)
+	m.f(m.fy, m.t, m.y)
+	m.stats.FuncEvals++
+	m.stats.Steps++
+	if recomputeJac {
+		m.jac.eval(m.t, m.y, m.fy)
+		m.currentJac = true
+	} else {
+		m.currentJac = false
+	}
+	m.hOld = h
+	m.errOld = errNorm
+	m.h = h * fac
+	return d, nil
+}
+
+// radauFactor returns the step size factor predicted from the error norms
+// of the current and the last step using the controller of Gustafsson.
+func radauFactor(h, hOld, errNorm, errOld float64) float64 {
+	if errNorm == 0 {
+		return maxFac
+	}
+	mult := 1.0
+	if hOld != 0 && errOld != 0 {
+		mult = math.Abs(h/hOld) * math.Pow(errOld/errNorm, 0.25)
+	}
+	return math.Min(1, mult) * math.Pow(errNorm, -0.25)
+}
+
+// factorize factorizes the real and the complex iteration matrices for the
+// step h.
+func (m *Radau) factorize(h float64) bool {
+	n := len(m.y)
+	kl, ku, banded := m.jac.bandwidth()
+	mu := radauMuReal / h
+	ok := m.luReal.factorize(n, kl, ku, banded, func(i, k int) float64 {
+		return mu*m.mass.at(i, k) - m.jac.at(i, k)
+	}, m.stats)
+	if !ok {
+		return false
+	}
+
+	// The complex system ((α - iβ)/h M - J) (u + iv) = r + is is solved
+	// as a real system with interleaved real and imaginary parts.
+	a := radauAlpha / h
+	b := radauBeta / h
+	ok = m.luComplex.factorize(2*n, 2*kl+1, 2*ku+1, banded, func(i, k int) float64 {
+		mik := m.mass.at(i/2, k/2)
+		switch {
+		case i%2 == k%2:
+			return a*mik - m.jac.at(i/2, k/2)
+		case i%2 == 0:
+			return b * mik
+		default:
+			return -b * mik
+		}
+	}, m.stats)
+	m.validLU = ok
+	return ok
+}
+
+// solveCollocation solves the collocation equations of the step h by
+// simplified Newton iterations starting from m.z, and returns whether the
+// iterations converged, their number and the rate of convergence.
+func (m *Radau) solveCollocation(h float64) (converged bool, iters int, rate float64) {
+	n := len(m.y)
+	ti := &radauTI
+	for i := range m.w {
+		for k := 0; k < n; k++ {
+			m.w[i][k] = ti[i][0]*m.z[0][k] + ti[i][1]*m.z[1][k] + ti[i][2]*m.z[2][k]
+		}
+	}
+	scale := m.tmp
+	for k, v := range m.y {
+		scale[k] = m.s.AbsTol + m.s.RelTol*math.Abs(v)
+	}
+	mu := radauMuReal / h
+	a := radauAlpha / h
+	b := radauBeta / h
+
+	var normOld float64
+	rate = math.NaN()
+	for iters = 1; iters <= radauNewtonMaxIter; iters++ {
+		for i := range m.fz {
+			floats.AddTo(m.ynew, m.y, m.z[i])
+			m.f(m.fz[i], m.t+radauC[i]*h, m.ynew)
+		}
+		m.stats.FuncEvals += 3
+		for i := range m.fz {
+			for _, v := range m.fz[i] {
+				if math.IsNaN(v) || math.IsInf(v, 0) {
+					return false, iters, rate
+				}
+			}
+		}
+
+		// Real system.
+		m.mass.mulTo(m.dw[0], m.w[0])
+		for k := 0; k < n; k++ {
+			m.dw[0][k] = ti[0][0]*m.fz[0][k] + ti[0][1]*m.fz[1][k] + ti[0][2]*m.fz[2][k] - mu*m.dw[0][k]
+		}
+		m.luReal.solve(m.dw[0], m.dw[0], m.stats)
+
+		// Complex system.
+		m.mass.mulTo(m.dw[1], m.w[1])
+		m.mass.mulTo(m.dw[2], m.w[2])
+		for k := 0; k < n; k++ {
+			fr := ti[1][0]*m.fz[0][k] + ti[1][1]*m.fz[1][k] + ti[1][2]*m.fz[2][k]
+			fi := ti[2][0]*m.fz[0][k] + ti[2][1]*m.fz[1][k] + ti[2][2]*m.fz[2][k]
+			// (α - iβ)/h (w1 + i w2) = a w1 + b w2 + i(a w2 - b w1).
+			m.cplx[2*k] = fr - (a*m.dw[1][k] + b*m.dw[2][k])
+			m.cplx[2*k+1] = fi - (a*m.dw[2][k] - b*m.dw[1][k])
+		}
+		m.luComplex.solve(m.cplx, m.cplx, m.stats)
+		for k := 0; k < n; k++ {
+			m.dw[1][k] = m.cplx[2*k]
+			m.dw[2][k] = m.cplx[2*k+1]
+		}
+
+		var sum float64
+		for i := range m.dw {
+			for k, v := range m.dw[i] {
+				v /= scale[k]
+				sum += v * v
+			}
+		}
+		norm := math.Sqrt(sum / float64(3*n))
+		if iters > 1 {
+			rate = norm / normOld
+			if rate >= 1 || math.Pow(rate, float64(radauNewtonMaxIter-iters+1))/(1-rate)*norm > m.newtonTol {
+				return false, iters, rate
+			}
+		}
+
+		for i := range m.w {
+			floats.Add(m.w[i], m.dw[i])
+		}
+		t := &radauT
+		for i := range m.z {
+			for k := 0; k < n; k++ {
+				m.z[i][k] = t[i][0]*m.w[0][k] + t[i][1]*m.w[1][k] + t[i][2]*m.w[2][k]
+			}
+		}
+		if norm == 0 || iters > 1 && rate/(1-rate)*norm < m.newtonTol {
+			return true, iters, rate
+		}
+		normOld = norm
+	}
+	return false, radauNewtonMaxIter, rate
+}
+
+// radauDense is the dense output of a step of Radau.
+type radauDense struct {
+	t0, t1 float64
+	h      float64
+	y0     []float64
+	q      [3][]float64
+}
+
+func (d *radauDense) Interval() (t0, t1 float64) {
+	return d.t0, d.t1
+}
+
+func (d *radauDense) Eval(dst []float64, t float64) {
+	if len(dst) != len(d.y0) {
+		panic("ode: destination length mismatch")
+	}
+	x := (t - d.t0) / d.h
+	for k, y := range d.y0 {
+		dst[k] = y + x*(d.q[0][k]+x*(d.q[1][k]+x*d.q[2][k]))
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+)
+
+const machEps = 0x1p-52
+
+// tableau is the Butcher tableau of an explicit embedded Runge–Kutta pair
+// with the first same as last property and a continuous extension. The
+// last stage is evaluated at the new state, so that it is the first stage
+// of the next step.
+type tableau struct {
+	// c are the nodes and a are the rows of the coefficient matrix below
+	// the diagonal, starting with the second stage. The last row holds
+	// the weights of the new state.
+	c []float64
+	a [][]float64
+
+	// e are the differences of the weights of the pair, giving the
+	// error estimate h * Σ_i e_i k_i.
+	e []float64
+
+	// p are the coefficients of the continuous extension, so that the
+	// weight of stage i at θ in [0, 1] is Σ_j p[i][j] θ^(j+1).
+	p [][]float64
+
+	// order is the order of the error estimate.
+	order int
+}
+
+// explicitRK implements the integration with an explicit embedded
+// Runge–Kutta pair.
+type explicitRK struct {
+	tab *tableau
+
+	f     Func
+	s     *Settings
+	stats *Stats
+
+	t, h float64
+	y    []float64
+	k    [][]float64
+
+	ynew, tmp, errv []float64
+}
+
+func (rk *explicitRK) init(tab *tableau, p *Problem, s *Settings, stats *Stats) {
+	if p.Mass != nil {
+		panic("ode: mass matrix not supported by explicit method")
+	}
+	n := len(p.Y0)
+	rk.tab = tab
+	rk.f = p.Func
+	rk.s = s
+	rk.stats = stats
+	rk.t = p.T0
+	rk.y = append(rk.y[:0], p.Y0...)
+	rk.k = make([][]float64, len(tab.c))
+	for i := range rk.k {
+		rk.k[i] = make([]float64, n)
+	}
+	rk.ynew = make([]float64, n)
+	rk.tmp = make([]float64, n)
+	rk.errv = make([]float64, n)
+
+	rk.f(rk.k[0], rk.t, rk.y)
+	stats.FuncEvals++
+	rk.h = initialStep(rk.f, rk.t, rk.y, rk.k[0], sign(p.T0, p.T1), tab.order, s, stats)
+}
+
+// Constants of the step size control.
+const (
+	safety = 0.9
+	minFac = 0.2
+	maxFac = 10.0
+)
+
+func (rk *explicitRK) step(tEnd float64) (Interpolant, error) {
+	tab := rk.tab
+	rejected := false
+	for {
+		h := rk.h
+		if math.Abs(h) > rk.s.MaxStep {
+			h = math.Copysign(rk.s.MaxStep, h)
+		}
+		last := false
+		if (rk.t+h-tEnd)*h >= 0 {
+			h = tEnd - rk.t
+			last = true
+		}
+		if tooSmall(rk.t, h) && !last {
+			return nil, ErrStepSize
+		}
+
+		// Evaluate the stages. The last row of a gives the new state.
+		for i, row := range tab.a {
+			dst := rk.tmp
+			if i == len(tab.a)-1 {
+				dst = rk.ynew
+			}
+			copy(dst, rk.y)
+			for j, aij := range row {
+				if aij != 0 {
+					floats.AddScaled(dst, h*aij, rk.k[j])
+				}
+			}
+			ti := rk.t + tab.c[i+1]*h
+			if i == len(tab.a)-1 {
+				ti = rk.t + h
+				if last {
+					ti = tEnd
+				}
+			}
+			rk.f(rk.k[i+1], ti, dst)
+		}
+		rk.stats.FuncEvals += len(tab.a)
+
+		for i := range rk.errv {
+			rk.errv[i] = 0
+		}
+		for i, ei := range tab.e {
+			if ei != 0 {
+				floats.AddScaled(rk.errv, h*ei, rk.k[i])
+			}
+		}
+		errNorm := errorNorm(rk.errv, rk.y, rk.ynew, rk.s)
+
+		exp := -1 / float64(tab.order+1)
+		if errNorm <= 1 {
+			fac := float64(maxFac)
+			if errNorm > 0 {
+				fac = math.Min(maxFac, safety*math.Pow(errNorm, exp))
+			}
+			if rejected {
+				fac = math.Min(1, fac)
+			}
+
+			d := &rkDense{
+				t0: rk.t,
+				h:  h,
+				y0: append([]float64(nil), rk.y...),
+				k:  make([][]float64, len(rk.k)),
+				p:  tab.p,
+			}
+			for i, ki := range rk.k {
+				d.k[i] = append([]float64(nil), ki...)
+			}
+			if last {
+				rk.t = tEnd
+			} else {
+				rk.t += h
+			}
+			d.t1 = rk.t
+			copy(rk.y, rk.ynew)
+			rk.k[0], rk.k[len(rk.k)-1] = rk.k[len(rk.k)-1], rk.k[0]
+			if !last {
+				rk.h = h * fac
+			}
+			rk.stats.Steps++
+			return d, nil
+		}
+
+		// Reject the step. A step with a non-finite error estimate is
+		// strongly reduced.
+		rk.stats.Rejected++
+		rejected = true
+		fac := minFac
+		if !math.IsNaN(errNorm) && !math.IsInf(errNorm, 1) {
+			fac = math.Max(minFac, safety*math.Pow(errNorm, exp))
+		}
+		rk.h = h * fac
+		if tooSmall(rk.t, rk.h) {
+			return nil, ErrStepSize
+		}
+	}
+}
+
+// rkDense is the continuous extension of a Runge–Kutta step.
+type rkDense struct {
+	t0, t1 float64
+	h      float64
+	y0     []float64
+	k      [][]float64
+	p      [][]float64
+}
+
+func (d *rkDense) Interval() (t0, t1 float64) {
+	return d.t0, d.t1
+}
+
+func (d *rkDense) Eval(dst []float64, t float64) {
+	if len(dst) != len(d.y0) {
+		panic("ode: destination length mismatch")
+	}
+	theta := (t - d.t0) / d.h
+	copy(dst, d.y0)
+	for i, pi := range d.p {
+		var b float64
+		for j := len(pi) - 1; j >= 0; j-- {
+			b = (b + pi[j]) * theta
+		}
+		if b != 0 {
+			floats.AddScaled(dst, d.h*b, d.k[i])
+		}
+	}
+}
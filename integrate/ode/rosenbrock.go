@@ -0,0 +1,224 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+)
+
+// Rosenbrock is the linearly implicit Rosenbrock method of order 2 of
+// Shampine and Reichelt with an error estimate of order 3, the method of
+// MATLAB's ode23s. It is a W-method, which retains its order with an
+// approximate Jacobian, so the Jacobian is evaluated once per step and
+// reused when a step is rejected. It is efficient for stiff problems at
+// crude tolerances. The dense output is of order 2.
+//
+// The method is described in
+//  Shampine, L. F., Reichelt, M. W.: The MATLAB ODE Suite. SIAM J. Sci.
+//  Comput. 18(1), 1-22 (1997)
+type Rosenbrock struct {
+	f     Func
+	s     *Settings
+	stats *Stats
+
+	jac  *jacobian
+	mass massMatrix
+	lu   luSolver
+
+	t, h   float64
+	y, f0  []float64
+	dfdt   []float64
+	k1, k2 []float64
+	f1, f2 []float64
+	ynew   []float64
+	tmp    []float64
+	errv   []float64
+}
+
+// Constants of the Rosenbrock method.
+var (
+	rosD   = 1 / (2 + math.Sqrt2)
+	rosE32 = 6 + math.Sqrt2
+)
+
+// Init initializes the method. It is called by Solve.
+func (m *Rosenbrock) Init(p *Problem, s *Settings, stats *Stats) {
+	n := len(p.Y0)
+	m.f = p.Func
+	m.s = s
+	m.stats = stats
+	m.jac = newJacobian(p, stats)
+	m.mass = newMassMatrix(p)
+	m.t = p.T0
+	m.y = append([]float64(nil), p.Y0...)
+	m.f0 = make([]float64, n)
+	m.dfdt = make([]float64, n)
+	m.k1 = make([]float64, n)
+	m.k2 = make([]float64, n)
+	m.f1 = make([]float64, n)
+	m.f2 = make([]float64, n)
+	m.ynew = make([]float64, n)
+	m.tmp = make([]float64, n)
+	m.errv = make([]float64, n)
+
+	m.f(m.f0, m.t, m.y)
+	stats.FuncEvals++
+	m.h = initialStep(m.f, m.t, m.y, m.f0, sign(p.T0, p.T1), 2, s, stats)
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (m *Rosenbrock) Step(tEnd float64) (Interpolant, error) {
+	n := len(m.y)
+	m.jac.eval(m.t, m.y, m.f0)
+	timeDerivative(m.dfdt, m.f, m.t, m.h, m.y, m.f0, m.stats)
+	kl, ku, banded := m.jac.bandwidth()
+
+	rejected := false
+	for {
+		h := m.h
+		if math.Abs(h) > m.s.MaxStep {
+			h = math.Copysign(m.s.MaxStep, h)
+		}
+		last := false
+		if (m.t+h-tEnd)*h >= 0 {
+			h = tEnd - m.t
+			last = true
+		}
+		if tooSmall(m.t, h) && !last {
+			return nil, ErrStepSize
+		}
+		tnew := m.t + h
+		if last {
+			tnew = tEnd
+		}
+
+		hd := h * rosD
+		ok := m.lu.factorize(n, kl, ku, banded, func(i, k int) float64 {
+			return m.mass.at(i, k) - hd*m.jac.at(i, k)
+		}, m.stats)
+		if !ok {
+			m.stats.Rejected++
+			rejected = true
+			m.h = h / 2
+			continue
+		}
+
+		// k1 = W \ (F0 + h d T).
+		for i := range m.k1 {
+			m.k1[i] = m.f0[i] + hd*m.dfdt[i]
+		}
+		m.lu.solve(m.k1, m.k1, m.stats)
+
+		// k2 = W \ (F1 - M k1) + k1.
+		floats.AddScaledTo(m.tmp, m.y, h/2, m.k1)
+		m.f(m.f1, m.t+h/2, m.tmp)
+		m.mass.mulTo(m.tmp, m.k1)
+		floats.SubTo(m.k2, m.f1, m.tmp)
+		m.lu.solve(m.k2, m.k2, m.stats)
+		floats.Add(m.k2, m.k1)
+
+		floats.AddScaledTo(m.ynew, m.y, h, m.k2)
+		m.f(m.f2, tnew, m.ynew)
+		m.stats.FuncEvals += 2
+
+		// k3 = W \ (F2 - e32 (M k2 - F1) - 2 (M k1 - F0) + h d T),
+		// stored in errv.
+		m.mass.mulTo(m.tmp, m.k2)
+		for i := range m.errv {
+			m.errv[i] = m.f2[i] - rosE32*(m.tmp[i]-m.f1[i]) + hd*m.dfdt[i]
+		}
+		m.mass.mulTo(m.tmp, m.k1)
+		for i := range m.errv {
+			m.errv[i] -= 2 * (m.tmp[i] - m.f0[i])
+		}
+		m.lu.solve(m.errv, m.errv, m.stats)
+
+		// The error estimate is h/6 (k1 - 2 k2 + k3).
+		for i := range m.errv {
+			m.errv[i] = h / 6 * (m.k1[i] - 2*m.k2[i] + m.errv[i])
+		}
+		errNorm := errorNorm(m.errv, m.y, m.ynew, m.s)
+
+		if errNorm <= 1 {
+			d := &rosenbrockDense{
+				t0: m.t,
+				t1: tnew,
+				h:  h,
+				y0: append([]float64(nil), m.y...),
+				k1: append([]float64(nil), m.k1...),
+				k2: append([]float64(nil), m.k2...),
+			}
+			fac := float64(maxFac)
+			if errNorm > 0 {
+				fac = math.Min(maxFac, safety*math.Pow(errNorm, -1.0/3))
+			}
+			if rejected {
+				fac = math.Min(1, fac)
+			}
+			if !last {
+				m.h = h * fac
+			}
+			m.t = tnew
+			copy(m.y, m.ynew)
+			m.f0, m.f2 = m.f2, m.f0
+			m.stats.Steps++
+			return d, nil
+		}
+
+		m.stats.Rejected++
+		rejected = true
+		fac := 0.5
+		if !math.IsNaN(errNorm) && !math.IsInf(errNorm, 1) {
+			fac = math.Max(0.5, safety*math.Pow(errNorm, -1.0/3))
+		}
+		m.h = h * fac
+		if tooSmall(m.t, m.h) {
+			return nil, ErrStepSize
+		}
+	}
+}
+
+// rosenbrockDense is the dense output of a step of Rosenbrock.
+type rosenbrockDense struct {
+	t0, t1 float64
+	h      float64
+	y0     []float64
+	k1, k2 []float64
+}
+
+func (d *rosenbrockDense) Interval() (t0, t1 float64) {
+	return d.t0, d.t1
+}
+
+func (d *rosenbrockDense) Eval(dst []float64, t float64) {
+	if len(dst) != len(d.y0) {
+		panic("ode: destination length mismatch")
+	}
+	s := (t - d.t0) / d.h
+	c1 := d.h * s * (1 - s) / (1 - 2*rosD)
+	c2 := d.h * s * (s - 2*rosD) / (1 - 2*rosD)
+	for i, y := range d.y0 {
+		dst[i] = y + c1*d.k1[i] + c2*d.k2[i]
+	}
+}
+
+// timeDerivative stores a forward difference approximation of ∂f/∂t at
+// (t, y), where the right-hand side is fy, in dst. The difference is taken
+// in the direction of the step h.
+func timeDerivative(dst []float64, f Func, t, h float64, y, fy []float64, stats *Stats) {
+	delta := math.Sqrt(machEps) * math.Max(math.Abs(t), math.Abs(h))
+	if delta == 0 {
+		delta = math.Sqrt(machEps)
+	}
+	delta = math.Copysign(delta, h)
+	delta = (t + delta) - t
+	f(dst, t+delta, y)
+	stats.FuncEvals++
+	for i := range dst {
+		dst[i] = (dst[i] - fy[i]) / delta
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ode
+
+import (
+	"sort"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Solution is the solution of an initial value problem.
+type Solution struct {
+	// T and Y are the times and the states at the ends of the accepted
+	// steps, starting with the initial state. If a terminal event
+	// occurred, the last time and state are those of the event.
+	T []float64
+	Y [][]float64
+
+	// Events are the crossings of events in the order of occurrence.
+	Events []Crossing
+
+	// Stats are the statistics of the integration.
+	Stats Stats
+
+	// dense holds the dense output of the steps.
+	dense []Interpolant
+}
+
+// Interval returns the start and the end of the integration.
+func (s *Solution) Interval() (t0, t1 float64) {
+	return s.T[0], s.T[len(s.T)-1]
+}
+
+// At returns the approximate solution at time t using the dense output of
+// the integration method. If dst is nil, a new slice is allocated and
+// returned, otherwise the result is stored in dst, which must have the
+// length of the state.
+//
+// At panics if t is outside the interval of the solution.
+func (s *Solution) At(dst []float64, t float64) []float64 {
+	n := len(s.Y[0])
+	if dst == nil {
+		dst = make([]float64, n)
+	}
+	if len(dst) != n {
+		panic("ode: destination length mismatch")
+	}
+	t0, t1 := s.Interval()
+	lo, hi := t0, t1
+	if hi < lo {
+		lo, hi = hi, lo
+	}
+	if t < lo || hi < t {
+		panic("ode: time out of range")
+	}
+	switch t {
+	case t0:
+		copy(dst, s.Y[0])
+		return dst
+	case t1:
+		copy(dst, s.Y[len(s.Y)-1])
+		return dst
+	}
+
+	// Find the step that contains t.
+	forward := t0 < t1
+	i := sort.Search(len(s.T)-1, func(i int) bool {
+		if forward {
+			return s.T[i+1] >= t
+		}
+		return s.T[i+1] <= t
+	})
+	s.dense[i].Eval(dst, t)
+	return dst
+}
+
+// AtVec returns the approximate solution at time t as a vector. If dst is
+// nil, a new vector is allocated and returned, otherwise the result is
+// stored in dst, which must have the length of the state.
+//
+// AtVec panics if t is outside the interval of the solution.
+func (s *Solution) AtVec(dst *mat.VecDense, t float64) *mat.VecDense {
+	n := len(s.Y[0])
+	if dst == nil {
+		dst = mat.NewVecDense(n, nil)
+	}
+	if dst.Len() != n {
+		panic("ode: destination length mismatch")
+	}
+	for i, v := range s.At(nil, t) {
+		dst.SetVec(i, v)
+	}
+	return dst
+}
@@ -0,0 +1,520 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrMaxSubdivisions is returned by Adaptive when the maximum number
+	// of subdivisions is reached before the tolerance is met.
+	ErrMaxSubdivisions = errors.New("quad: maximum number of subdivisions reached")
+
+	// ErrRoundoff is returned by Adaptive when roundoff error prevents
+	// the tolerance from being met.
+	ErrRoundoff = errors.New("quad: roundoff error prevents reaching tolerance")
+
+	// ErrBadIntegrand is returned by Adaptive when the integrand behaves
+	// so badly that subintervals become too small to subdivide, for
+	// example at a non-integrable singularity.
+	ErrBadIntegrand = errors.New("quad: bad integrand behavior")
+
+	// ErrDivergent is returned by Adaptive when the integral is probably
+	// divergent or converges too slowly.
+	ErrDivergent = errors.New("quad: integral probably divergent")
+)
+
+// AdaptiveSettings are the settings of Adaptive.
+type AdaptiveSettings struct {
+	// AbsTol and RelTol are the requested absolute and relative
+	// tolerances. Adaptive attempts to find an estimate of the integral I
+	// with
+	//  |I - estimate| ≤ max(AbsTol, RelTol * |I|).
+	// If both are zero, they default to 1.49e-8, the square root of the
+	// machine epsilon.
+	AbsTol, RelTol float64
+
+	// MaxSubdivisions is the maximum number of subintervals. If
+	// MaxSubdivisions is zero, a default of 1000 is used.
+	MaxSubdivisions int
+
+	// Concurrent is the maximum number of simultaneous evaluations of the
+	// integrand. If Concurrent <= 0, the integrand is evaluated serially.
+	Concurrent int
+}
+
+// Result is the result of an adaptive integration.
+type Result struct {
+	// Value is the estimate of the integral.
+	Value float64
+	// Error is the estimate of the absolute error of Value.
+	Error float64
+	// Evals is the number of evaluations of the integrand.
+	Evals int
+	// Intervals is the number of subintervals.
+	Intervals int
+}
+
+// Adaptive approximates the integral of the function f from min to max to
+// the tolerance in settings using adaptive global subdivision with
+// Gauss–Kronrod rules, as in the QAGS and QAGI routines of QUADPACK. The
+// subinterval with the largest estimated error is bisected until the sum
+// of the errors meets the tolerance, and the sequence of estimates is
+// extrapolated with Wynn's epsilon algorithm, so that integrable
+// singularities at the ends of subintervals, such as at the bounds of the
+// integral, are handled efficiently.
+//
+// Finite bounds are integrated with the 21-point Kronrod rule. If a bound
+// is infinite, the integral is transformed to one over (0, 1] by
+// x = a ± (1-t)/t, and integrated with the 15-point Kronrod rule.
+//
+// If settings is nil, the default settings are used. The result contains
+// the best estimate of the integral even if an error is returned. If the
+// tolerance cannot be met, the error is one of ErrMaxSubdivisions,
+// ErrRoundoff, ErrBadIntegrand and ErrDivergent.
+//
+// The integrand is evaluated in batches for the points of the rules on
+// both halves of a bisected subinterval. If settings.Concurrent > 0, f may
+// be evaluated with at most Concurrent simultaneous evaluations.
+//
+// Adaptive panics if min is greater than max, or if AbsTol is not positive
+// and RelTol is less than 50 times the machine epsilon.
+func Adaptive(f func(float64) float64, min, max float64, settings *AdaptiveSettings) (Result, error) {
+	if min > max {
+		panic("quad: min > max")
+	}
+	absTol := 1.49e-8
+	relTol := 1.49e-8
+	limit := 1000
+	var concurrent int
+	if settings != nil {
+		if settings.AbsTol != 0 || settings.RelTol != 0 {
+			absTol = settings.AbsTol
+			relTol = settings.RelTol
+		}
+		if settings.MaxSubdivisions < 0 {
+			panic("quad: negative maximum number of subdivisions")
+		}
+		if settings.MaxSubdivisions != 0 {
+			limit = settings.MaxSubdivisions
+		}
+		concurrent = settings.Concurrent
+	}
+	if absTol <= 0 && relTol < 50*eps {
+		panic("quad: tolerance too small")
+	}
+	if min == max {
+		return Result{}, nil
+	}
+
+	// Infinite bounds are transformed to the interval (0, 1], and the
+	// integrand is evaluated only at interior points of the rule.
+	rule := kronrod21
+	g := f
+	a, b := min, max
+	calls := 1
+	switch {
+	case math.IsInf(min, -1) && math.IsInf(max, 1):
+		rule = kronrod15
+		g = func(t float64) float64 {
+			x := (1 - t) / t
+			return (f(x) + f(-x)) / (t * t)
+		}
+		a, b = 0, 1
+		calls = 2
+	case math.IsInf(max, 1):
+		rule = kronrod15
+		g = func(t float64) float64 {
+			return f(min+(1-t)/t) / (t * t)
+		}
+		a, b = 0, 1
+	case math.IsInf(min, -1):
+		rule = kronrod15
+		g = func(t float64) float64 {
+			return f(max-(1-t)/t) / (t * t)
+		}
+		a, b = 0, 1
+	}
+
+	q := adaptive{
+		eval:   evaluator{f: g, calls: calls, concurrent: concurrent},
+		rule:   rule,
+		absTol: absTol,
+		relTol: relTol,
+		limit:  limit,
+	}
+	return q.integrate(a, b)
+}
+
+// evaluator evaluates a function at batches of points.
+type evaluator struct {
+	f func(float64) float64
+	// calls is the number of evaluations of the integrand
+	// for each evaluation of f.
+	calls      int
+	concurrent int
+	evals      int
+}
+
+// evaluate stores the values of the function at the points in x into fx.
+func (e *evaluator) evaluate(fx, x []float64) {
+	e.evals += e.calls * len(x)
+	concurrent := e.concurrent
+	if concurrent > len(x) {
+		concurrent = len(x)
+	}
+	if concurrent <= 0 {
+		for i, v := range x {
+			fx[i] = e.f(v)
+		}
+		return
+	}
+
+	tasks := make(chan int)
+	go func() {
+		for i := range x {
+			tasks <- i
+		}
+		close(tasks)
+	}()
+	var wg sync.WaitGroup
+	wg.Add(concurrent)
+	for i := 0; i < concurrent; i++ {
+		go func() {
+			defer wg.Done()
+			for k := range tasks {
+				fx[k] = e.f(x[k])
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// subinterval is a subinterval of the integration range with the estimates
+// of the rule on it.
+type subinterval struct {
+	a, b   float64
+	result float64
+	err    float64
+	level  int
+}
+
+// adaptive holds the state of the QAGS algorithm.
+type adaptive struct {
+	eval   evaluator
+	rule   kronrodRule
+	absTol float64
+	relTol float64
+	limit  int
+
+	intervals []subinterval
+	// order holds the indices of intervals in decreasing order of their
+	// errors, and nrMax is the position in order of the next interval to
+	// bisect.
+	order    []int
+	nrMax    int
+	maxLevel int
+}
+
+// integrate returns the integral over [a, b].
+func (q *adaptive) integrate(a, b float64) (Result, error) {
+	m := q.rule.points()
+	x := make([]float64, 2*m)
+	fx := make([]float64, 2*m)
+
+	q.rule.locations(x[:m], a, b)
+	q.eval.evaluate(fx[:m], x[:m])
+	result0, absErr0, resAbs0, resAsc0 := q.rule.estimate(fx[:m], a, b)
+
+	q.intervals = append(q.intervals, subinterval{a: a, b: b, result: result0, err: absErr0})
+	q.order = append(q.order, 0)
+	res := func(value, err float64) Result {
+		return Result{Value: value, Error: err, Evals: q.eval.evals, Intervals: len(q.intervals)}
+	}
+	if !isFinite(result0) || !isFinite(absErr0) {
+		return res(result0, math.Inf(1)), ErrBadIntegrand
+	}
+
+	tolerance := math.Max(q.absTol, q.relTol*math.Abs(result0))
+	roundOff := 50 * eps * resAbs0
+	switch {
+	case absErr0 <= roundOff && absErr0 > tolerance:
+		return res(result0, absErr0), ErrRoundoff
+	case (absErr0 <= tolerance && absErr0 != resAsc0) || absErr0 == 0:
+		return res(result0, absErr0), nil
+	case q.limit == 1:
+		return res(result0, absErr0), ErrMaxSubdivisions
+	}
+
+	var table epsilonTable
+	table.append(result0)
+
+	area := result0
+	errSum := absErr0
+	resExt := result0
+	errExt := math.MaxFloat64
+	positive := math.Abs(result0) >= (1-50*eps)*resAbs0
+
+	const (
+		noError = iota
+		maxSubdivisions
+		roundoff
+		badIntegrand
+		extrapolationRoundoff
+		divergent
+	)
+	var (
+		errType, errType2                  int
+		roundoff1, roundoff2, roundoff3    int
+		ktMin                              int
+		extrapolate, disallowExtrapolation bool
+		errOverLarge, errTest, correction  float64
+	)
+
+	iteration := 1
+	for iteration < q.limit {
+		// Bisect the subinterval with the largest error estimate.
+		i := q.order[q.nrMax]
+		cur := q.intervals[i]
+		level := cur.level + 1
+		a1, b1 := cur.a, 0.5*(cur.a+cur.b)
+		a2, b2 := b1, cur.b
+		iteration++
+
+		q.rule.locations(x[:m], a1, b1)
+		q.rule.locations(x[m:], a2, b2)
+		q.eval.evaluate(fx, x)
+		area1, err1, _, resAsc1 := q.rule.estimate(fx[:m], a1, b1)
+		area2, err2, _, resAsc2 := q.rule.estimate(fx[m:], a2, b2)
+
+		area12 := area1 + area2
+		err12 := err1 + err2
+		if !isFinite(area12) || !isFinite(err12) {
+			// The integrand overflowed or is not a number on the
+			// subinterval, so the estimates cannot be improved.
+			errType = badIntegrand
+			break
+		}
+		lastErr := cur.err
+		errSum += err12 - cur.err
+		area += area12 - cur.result
+		tolerance = math.Max(q.absTol, q.relTol*math.Abs(area))
+
+		if resAsc1 != err1 && resAsc2 != err2 {
+			delta := cur.result - area12
+			if math.Abs(delta) <= 1e-5*math.Abs(area12) && err12 >= 0.99*cur.err {
+				if !extrapolate {
+					roundoff1++
+				} else {
+					roundoff2++
+				}
+			}
+			if iteration > 10 && err12 > cur.err {
+				roundoff3++
+			}
+		}
+		if roundoff1+roundoff2 >= 10 || roundoff3 >= 20 {
+			errType = roundoff
+		}
+		if roundoff2 >= 5 {
+			errType2 = 1
+		}
+		if subintervalTooSmall(a1, a2, b2) {
+			errType = badIntegrand
+		}
+
+		q.update(i, level, subinterval{a: a1, b: b1, result: area1, err: err1}, subinterval{a: a2, b: b2, result: area2, err: err2})
+
+		if errSum <= tolerance {
+			return res(q.sum(), errSum), nil
+		}
+		if errType != noError {
+			break
+		}
+		if iteration >= q.limit {
+			errType = maxSubdivisions
+			break
+		}
+		if iteration == 2 {
+			errOverLarge = errSum
+			errTest = tolerance
+			table.append(area)
+			continue
+		}
+		if disallowExtrapolation {
+			continue
+		}
+
+		errOverLarge -= lastErr
+		if level < q.maxLevel {
+			errOverLarge += err12
+		}
+		if !extrapolate {
+			// Continue to bisect the largest subintervals until the
+			// subinterval to bisect next is one of the smallest.
+			if q.largeInterval() {
+				continue
+			}
+			extrapolate = true
+			q.nrMax = 1
+		}
+		if errType2 == 0 && errOverLarge > errTest {
+			// Bisect the large subintervals before extrapolating.
+			if q.increaseNrMax() {
+				continue
+			}
+		}
+
+		table.append(area)
+		reseps, abseps := table.extrapolate()
+		ktMin++
+		if ktMin > 5 && errExt < 1e-3*errSum {
+			errType = extrapolationRoundoff
+		}
+		if abseps < errExt {
+			ktMin = 0
+			errExt = abseps
+			resExt = reseps
+			correction = errOverLarge
+			errTest = math.Max(q.absTol, q.relTol*math.Abs(reseps))
+			if errExt <= errTest {
+				break
+			}
+		}
+		if table.n == 1 {
+			disallowExtrapolation = true
+		}
+		if errType == extrapolationRoundoff {
+			break
+		}
+
+		// Continue with the subinterval with the largest error.
+		q.nrMax = 0
+		extrapolate = false
+		errOverLarge = errSum
+	}
+
+	if errExt == math.MaxFloat64 {
+		return res(q.sum(), errSum), qagsError(errType)
+	}
+	if errType != noError || errType2 != 0 {
+		if errType2 != 0 {
+			errExt += correction
+		}
+		if errType == noError {
+			errType = badIntegrand
+		}
+		if resExt != 0 && area != 0 {
+			if errExt/math.Abs(resExt) > errSum/math.Abs(area) {
+				return res(q.sum(), errSum), qagsError(errType)
+			}
+		} else if errExt > errSum {
+			return res(q.sum(), errSum), qagsError(errType)
+		} else if area == 0 {
+			return res(resExt, errExt), qagsError(errType)
+		}
+	}
+
+	// Test on divergence.
+	if !positive && math.Max(math.Abs(resExt), math.Abs(area)) < 0.01*resAbs0 {
+		return res(resExt, errExt), qagsError(errType)
+	}
+	ratio := resExt / area
+	if ratio < 0.01 || ratio > 100 || errSum > math.Abs(area) {
+		errType = divergent
+	}
+	return res(resExt, errExt), qagsError(errType)
+}
+
+// qagsError returns the error for the QAGS error type t.
+func qagsError(t int) error {
+	switch t {
+	case 0:
+		return nil
+	case 1:
+		return ErrMaxSubdivisions
+	case 2, 4:
+		return ErrRoundoff
+	case 3:
+		return ErrBadIntegrand
+	default:
+		return ErrDivergent
+	}
+}
+
+// isFinite returns whether x is neither infinite nor NaN.
+func isFinite(x float64) bool {
+	return !math.IsInf(x, 0) && !math.IsNaN(x)
+}
+
+// subintervalTooSmall returns whether the subinterval [a1, b2] bisected at
+// a2 is too small to be bisected further.
+func subintervalTooSmall(a1, a2, b2 float64) bool {
+	tmp := (1 + 100*eps) * (math.Abs(a2) + 1000*minNormal)
+	return math.Abs(a1) <= tmp && math.Abs(b2) <= tmp
+}
+
+// update replaces the bisected subinterval i by its halves l and r at the
+// given level and restores the order of the subintervals.
+func (q *adaptive) update(i, level int, l, r subinterval) {
+	l.level = level
+	r.level = level
+	if r.err > l.err {
+		l, r = r, l
+	}
+	q.intervals[i] = l
+	q.intervals = append(q.intervals, r)
+	q.order = append(q.order, len(q.intervals)-1)
+	if level > q.maxLevel {
+		q.maxLevel = level
+	}
+	sort.SliceStable(q.order, func(a, b int) bool {
+		return q.intervals[q.order[a]].err > q.intervals[q.order[b]].err
+	})
+	if q.nrMax >= len(q.order) {
+		q.nrMax = len(q.order) - 1
+	}
+}
+
+// largeInterval returns whether the subinterval to bisect next is larger
+// than the smallest subintervals.
+func (q *adaptive) largeInterval() bool {
+	return q.intervals[q.order[q.nrMax]].level < q.maxLevel
+}
+
+// increaseNrMax moves the position of the subinterval to bisect next to the
+// subinterval with the largest error that is larger than the smallest
+// subintervals, and returns whether there is one. Only as many subintervals
+// are considered as can still be bisected.
+func (q *adaptive) increaseNrMax() bool {
+	last := len(q.intervals) - 1
+	jupbnd := last
+	if last > 1+q.limit/2 {
+		jupbnd = q.limit + 1 - last
+	}
+	for k := q.nrMax; k <= jupbnd && q.nrMax < len(q.order); k++ {
+		if q.largeInterval() {
+			return true
+		}
+		q.nrMax++
+	}
+	if q.nrMax >= len(q.order) {
+		q.nrMax = len(q.order) - 1
+	}
+	return false
+}
+
+// sum returns the sum of the estimates on all subintervals.
+func (q *adaptive) sum() float64 {
+	var sum float64
+	for _, s := range q.intervals {
+		sum += s.result
+	}
+	return sum
+}
@@ -0,0 +1,362 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+)
+
+func TestKronrodRule(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		rule   kronrodRule
+		degree int
+	}{
+		{name: "kronrod15", rule: kronrod15, degree: 22},
+		{name: "kronrod21", rule: kronrod21, degree: 31},
+	} {
+		n := test.rule.points()
+		x := make([]float64, n)
+		fx := make([]float64, n)
+		test.rule.locations(x, -1, 1)
+		for k := 0; k <= test.degree; k++ {
+			for i, v := range x {
+				fx[i] = math.Pow(v, float64(k))
+			}
+			got, _, _, _ := test.rule.estimate(fx, -1, 1)
+			var want float64
+			if k%2 == 0 {
+				want = 2 / float64(k+1)
+			}
+			if !scalar.EqualWithinAbs(got, want, 1e-14) {
+				t.Errorf("%s: unexpected integral of x^%d: got:%v want:%v", test.name, k, got, want)
+			}
+		}
+
+		// The Gauss nodes must be the Legendre nodes.
+		gx := make([]float64, (n-1)/2)
+		gw := make([]float64, len(gx))
+		Legendre{}.FixedLocations(gx, gw, -1, 1)
+		for i, w := range test.rule.wg {
+			j := 2*i + 1
+			found := false
+			for k, v := range gx {
+				if scalar.EqualWithinAbs(v, test.rule.xgk[j], 1e-14) && scalar.EqualWithinAbs(gw[k], w, 1e-14) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Errorf("%s: Gauss node %v with weight %v not a Legendre node", test.name, test.rule.xgk[j], w)
+			}
+		}
+	}
+}
+
+func TestAdaptive(t *testing.T) {
+	t.Parallel()
+	for i, test := range []struct {
+		f        func(float64) float64
+		min, max float64
+		ans      float64
+		tol      float64
+	}{
+		{
+			f:   math.Exp,
+			min: -3,
+			max: 5,
+			ans: math.Exp(5) - math.Exp(-3),
+			tol: 1e-10,
+		},
+		{
+			f:   func(x float64) float64 { return math.Log(x) / math.Sqrt(x) },
+			min: 0,
+			max: 1,
+			ans: -4,
+			tol: 1e-10,
+		},
+		{
+			f:   func(x float64) float64 { return 1 / math.Sqrt(x) },
+			min: 0,
+			max: 1,
+			ans: 2,
+			tol: 1e-10,
+		},
+		{
+			f:   func(x float64) float64 { return math.Pow(x, 2.6) * math.Log(1/x) },
+			min: 0,
+			max: 1,
+			ans: 1 / (3.6 * 3.6),
+			tol: 1e-10,
+		},
+		{
+			f:   func(x float64) float64 { return 1 / math.Sqrt(math.Abs(x-0.3)) },
+			min: 0,
+			max: 1,
+			ans: 2*math.Sqrt(0.3) + 2*math.Sqrt(0.7),
+			tol: 1e-8,
+		},
+		{
+			f:   func(x float64) float64 { return math.Sin(50 * x) },
+			min: 0,
+			max: 2 * math.Pi,
+			ans: 0,
+			tol: 1e-10,
+		},
+		{
+			f:   func(x float64) float64 { return math.Exp(-x) },
+			min: 0,
+			max: math.Inf(1),
+			ans: 1,
+			tol: 1e-10,
+		},
+		{
+			f:   math.Exp,
+			min: math.Inf(-1),
+			max: -5,
+			ans: math.Exp(-5),
+			tol: 1e-10,
+		},
+		{
+			f:   func(x float64) float64 { return math.Exp(-x * x) },
+			min: math.Inf(-1),
+			max: math.Inf(1),
+			ans: math.Sqrt(math.Pi),
+			tol: 1e-10,
+		},
+		{
+			f:   func(x float64) float64 { return math.Log(x) / (1 + 100*x*x) },
+			min: 0,
+			max: math.Inf(1),
+			ans: -math.Log(10) * math.Pi / 20,
+			tol: 1e-10,
+		},
+		{
+			f:   math.Exp,
+			min: 3,
+			max: 3,
+			ans: 0,
+			tol: 0,
+		},
+	} {
+		for _, concurrent := range []int{0, 1, 3} {
+			settings := &AdaptiveSettings{AbsTol: 1e-12, RelTol: 1e-12, Concurrent: concurrent}
+			got, err := Adaptive(test.f, test.min, test.max, settings)
+			if err != nil {
+				t.Errorf("case %d, concurrent %d: unexpected error: %v", i, concurrent, err)
+			}
+			if !scalar.EqualWithinAbsOrRel(got.Value, test.ans, test.tol, test.tol) {
+				t.Errorf("case %d, concurrent %d: unexpected result: got:%v want:%v", i, concurrent, got.Value, test.ans)
+			}
+			if math.Abs(got.Value-test.ans) > got.Error+1e-14*math.Abs(test.ans) {
+				t.Errorf("case %d, concurrent %d: error estimate %v does not bound error %v",
+					i, concurrent, got.Error, math.Abs(got.Value-test.ans))
+			}
+			if test.min != test.max && got.Evals == 0 {
+				t.Errorf("case %d, concurrent %d: no evaluations counted", i, concurrent)
+			}
+		}
+	}
+}
+
+func TestAdaptiveQUADPACK(t *testing.T) {
+	t.Parallel()
+	// Reference values from the QUADPACK test problems as reported by the
+	// GSL test suite.
+	for i, test := range []struct {
+		f         func(float64) float64
+		min, max  float64
+		relTol    float64
+		ans, err  float64
+		evals     int
+		intervals int
+	}{
+		{
+			f:         func(x float64) float64 { return math.Pow(x, 2.6) * math.Log(1/x) },
+			min:       0,
+			max:       1,
+			relTol:    1e-10,
+			ans:       7.716049382715789440e-02,
+			err:       2.216394961010438404e-12,
+			evals:     189,
+			intervals: 5,
+		},
+		{
+			f:         func(x float64) float64 { return math.Log(x) / (1 + 100*x*x) },
+			min:       0,
+			max:       math.Inf(1),
+			relTol:    1e-3,
+			ans:       -3.616892186127022568e-01,
+			err:       3.016716913328831851e-06,
+			evals:     285,
+			intervals: 10,
+		},
+	} {
+		got, err := Adaptive(test.f, test.min, test.max, &AdaptiveSettings{RelTol: test.relTol})
+		if err != nil {
+			t.Errorf("case %d: unexpected error: %v", i, err)
+		}
+		if !scalar.EqualWithinRel(got.Value, test.ans, 1e-14) {
+			t.Errorf("case %d: unexpected result: got:%v want:%v", i, got.Value, test.ans)
+		}
+		if !scalar.EqualWithinRel(got.Error, test.err, 1e-6) {
+			t.Errorf("case %d: unexpected error estimate: got:%v want:%v", i, got.Error, test.err)
+		}
+		if got.Evals != test.evals || got.Intervals != test.intervals {
+			t.Errorf("case %d: unexpected evaluations and intervals: got:%d, %d want:%d, %d",
+				i, got.Evals, got.Intervals, test.evals, test.intervals)
+		}
+	}
+}
+
+func TestAdaptiveConcurrent(t *testing.T) {
+	t.Parallel()
+	f := func(x float64) float64 { return math.Log(x) * math.Cos(10*x) }
+	want, err := Adaptive(f, 0, 1, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, concurrent := range []int{1, 2, 5, 100} {
+		got, err := Adaptive(f, 0, 1, &AdaptiveSettings{Concurrent: concurrent})
+		if err != nil {
+			t.Errorf("concurrent %d: unexpected error: %v", concurrent, err)
+		}
+		if got != want {
+			t.Errorf("concurrent %d: result mismatch: got:%+v want:%+v", concurrent, got, want)
+		}
+	}
+}
+
+func TestAdaptiveEvals(t *testing.T) {
+	t.Parallel()
+	var n int
+	f := func(x float64) float64 {
+		n++
+		return 1 / math.Sqrt(x)
+	}
+	got, _ := Adaptive(f, 0, 1, nil)
+	if got.Evals != n {
+		t.Errorf("unexpected evaluation count: got:%d want:%d", got.Evals, n)
+	}
+	if want := 21 * (2*got.Intervals - 1); got.Evals != want {
+		t.Errorf("unexpected evaluation count for %d intervals: got:%d want:%d", got.Intervals, got.Evals, want)
+	}
+
+	// The integrand is evaluated twice for each point of the transformed
+	// integral over (-∞, ∞).
+	for _, test := range []struct {
+		min, max float64
+		calls    int
+	}{
+		{min: 0, max: math.Inf(1), calls: 1},
+		{min: math.Inf(-1), max: 0, calls: 1},
+		{min: math.Inf(-1), max: math.Inf(1), calls: 2},
+	} {
+		n = 0
+		f := func(x float64) float64 {
+			n++
+			return math.Exp(-x * x)
+		}
+		got, err := Adaptive(f, test.min, test.max, nil)
+		if err != nil {
+			t.Errorf("[%v, %v]: unexpected error: %v", test.min, test.max, err)
+		}
+		if got.Evals != n {
+			t.Errorf("[%v, %v]: unexpected evaluation count: got:%d want:%d", test.min, test.max, got.Evals, n)
+		}
+		if want := test.calls * 15 * (2*got.Intervals - 1); got.Evals != want {
+			t.Errorf("[%v, %v]: unexpected evaluation count for %d intervals: got:%d want:%d",
+				test.min, test.max, got.Intervals, got.Evals, want)
+		}
+	}
+}
+
+func TestAdaptiveMaxSubdivisions(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		f        func(float64) float64
+		min, max float64
+		relTol   float64
+	}{
+		{
+			name:   "oscillating singular",
+			f:      func(x float64) float64 { return math.Sin(1/x) / math.Sqrt(x) },
+			min:    0,
+			max:    1,
+			relTol: 1e-12,
+		},
+		{
+			name:   "oscillating",
+			f:      func(x float64) float64 { return math.Sin(1000 * x) },
+			min:    0,
+			max:    10,
+			relTol: 1e-12,
+		},
+		{
+			name:   "oscillating infinite",
+			f:      func(x float64) float64 { return math.Cos(x) / (1 + x*x) },
+			min:    math.Inf(-1),
+			max:    math.Inf(1),
+			relTol: 1e-12,
+		},
+	} {
+		for _, limit := range []int{1, 2, 3, 5, 10} {
+			got, err := Adaptive(test.f, test.min, test.max, &AdaptiveSettings{RelTol: test.relTol, MaxSubdivisions: limit})
+			if err != ErrMaxSubdivisions {
+				if got.Intervals > limit {
+					t.Errorf("%s: too many subintervals for limit %d: got:%d", test.name, limit, got.Intervals)
+				}
+				continue
+			}
+			if got.Intervals != limit {
+				t.Errorf("%s: unexpected number of subintervals with ErrMaxSubdivisions: got:%d want:%d",
+					test.name, got.Intervals, limit)
+			}
+		}
+	}
+}
+
+func TestAdaptiveFailure(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		f        func(float64) float64
+		min, max float64
+		settings *AdaptiveSettings
+	}{
+		{
+			name: "divergent",
+			f:    func(x float64) float64 { return 1 / x },
+			min:  0,
+			max:  1,
+		},
+		{
+			name: "divergent infinite",
+			f:    func(x float64) float64 { return 1 / (1 + x) },
+			min:  0,
+			max:  math.Inf(1),
+		},
+		{
+			name:     "max subdivisions",
+			f:        func(x float64) float64 { return math.Sin(1000 * x) },
+			min:      0,
+			max:      10,
+			settings: &AdaptiveSettings{MaxSubdivisions: 3},
+		},
+	} {
+		got, err := Adaptive(test.f, test.min, test.max, test.settings)
+		if err == nil {
+			t.Errorf("%s: expected error, got result %+v", test.name, got)
+		}
+		if test.settings != nil && got.Intervals > test.settings.MaxSubdivisions {
+			t.Errorf("%s: too many subintervals: got:%d want<=%d", test.name, got.Intervals, test.settings.MaxSubdivisions)
+		}
+	}
+}
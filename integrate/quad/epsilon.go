@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// limExp is the maximum number of elements of the epsilon table.
+const limExp = 50
+
+// epsilonTable is the table of Wynn's epsilon algorithm used to extrapolate
+// the sequence of integral estimates to the limit, as in QUADPACK's qelg.
+type epsilonTable struct {
+	n     int
+	list  [limExp + 2]float64
+	nRes  int
+	res3a [3]float64
+}
+
+// append adds the element y of the sequence to the table.
+func (t *epsilonTable) append(y float64) {
+	t.list[t.n] = y
+	t.n++
+}
+
+// extrapolate returns the extrapolated limit of the sequence and an
+// estimate of its absolute error.
+func (t *epsilonTable) extrapolate() (result, absErr float64) {
+	e := &t.list
+	n := t.n - 1
+	current := e[n]
+	nRes := t.nRes
+	t.nRes++
+
+	absolute := math.MaxFloat64
+	relative := 5 * eps * math.Abs(current)
+	if n < 2 {
+		return current, math.Max(absolute, relative)
+	}
+
+	result = current
+	absErr = math.MaxFloat64
+	newElm := n / 2
+	nOrig := n
+	nFinal := n
+
+	e[n+2] = e[n]
+	e[n] = math.MaxFloat64
+	for i := 0; i < newElm; i++ {
+		res := e[n-2*i+2]
+		e0 := e[n-2*i-2]
+		e1 := e[n-2*i-1]
+		e2 := res
+
+		e1abs := math.Abs(e1)
+		delta2 := e2 - e1
+		err2 := math.Abs(delta2)
+		tol2 := math.Max(math.Abs(e2), e1abs) * eps
+		delta3 := e1 - e0
+		err3 := math.Abs(delta3)
+		tol3 := math.Max(e1abs, math.Abs(e0)) * eps
+
+		if err2 < tol2 && err3 < tol3 {
+			// e0, e1 and e2 are equal to within machine accuracy, so
+			// convergence is assumed.
+			return res, math.Max(err2+err3, 5*eps*math.Abs(res))
+		}
+
+		e3 := e[n-2*i]
+		e[n-2*i] = e1
+		delta1 := e1 - e3
+		err1 := math.Abs(delta1)
+		tol1 := math.Max(e1abs, math.Abs(e3)) * eps
+
+		// If two elements are very close to each other, omit a part of
+		// the table.
+		if err1 < tol1 || err2 < tol2 || err3 < tol3 {
+			nFinal = 2 * i
+			break
+		}
+
+		ss := (1/delta1 + 1/delta2) - 1/delta3
+
+		// Omit a part of the table if its behavior is irregular.
+		if math.Abs(ss*e1) <= 1e-4 {
+			nFinal = 2 * i
+			break
+		}
+
+		res = e1 + 1/ss
+		e[n-2*i] = res
+		if err := err2 + math.Abs(res-e2) + err3; err <= absErr {
+			absErr = err
+			result = res
+		}
+	}
+
+	// Shift the table.
+	if nFinal == limExp-1 {
+		nFinal = 2 * ((limExp - 1) / 2)
+	}
+	if nOrig%2 == 1 {
+		for i := 0; i <= newElm; i++ {
+			e[1+2*i] = e[2*i+3]
+		}
+	} else {
+		for i := 0; i <= newElm; i++ {
+			e[2*i] = e[2*i+2]
+		}
+	}
+	if nOrig != nFinal {
+		for i := 0; i <= nFinal; i++ {
+			e[i] = e[nOrig-nFinal+i]
+		}
+	}
+	t.n = nFinal + 1
+
+	if nRes < 3 {
+		t.res3a[nRes] = result
+		absErr = math.MaxFloat64
+	} else {
+		absErr = math.Abs(result-t.res3a[2]) + math.Abs(result-t.res3a[1]) + math.Abs(result-t.res3a[0])
+		t.res3a[0], t.res3a[1], t.res3a[2] = t.res3a[1], t.res3a[2], result
+	}
+	return result, math.Max(absErr, 5*eps*math.Abs(result))
+}
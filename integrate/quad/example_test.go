@@ -45,3 +45,46 @@ func Example() {
 	// Estimate using parallel evaluations of f.
 	// EV = 4.19064
 }
+
+func ExampleAdaptive() {
+	// The integrand has a singularity at the lower bound.
+	f := func(x float64) float64 { return math.Log(x) / math.Sqrt(x) }
+	res, err := quad.Adaptive(f, 0, 1, &quad.AdaptiveSettings{RelTol: 1e-10})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("integral = %.10f\n", res.Value)
+	fmt.Printf("error estimate < 1e-10: %t\n", res.Error < 1e-10)
+
+	// The Gaussian integral over the real line.
+	g := func(x float64) float64 { return math.Exp(-x * x) }
+	res, err = quad.Adaptive(g, math.Inf(-1), math.Inf(1), nil)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Printf("integral = %.10f, sqrt(pi) = %.10f\n", res.Value, math.Sqrt(math.Pi))
+	// Output:
+	// integral = -4.0000000000
+	// error estimate < 1e-10: true
+	// integral = 1.7724538509, sqrt(pi) = 1.7724538509
+}
+
+func ExampleLaguerre() {
+	// The second moment of a gamma distribution with shape k and rate λ
+	// is the expectation of (y/λ)^2 under the weight y^(k-1) e^(-y)
+	// normalized by Γ(k), which a Gauss–Laguerre rule computes exactly.
+	const (
+		k      = 3.0
+		lambda = 2.0
+	)
+	f := func(y float64) float64 {
+		return (y / lambda) * (y / lambda) / math.Gamma(k)
+	}
+	ev := quad.Fixed(f, 0, math.Inf(1), 2, quad.Laguerre{Alpha: k - 1}, 0)
+	fmt.Printf("E[X^2] = %.6f, want %.6f\n", ev, k*(k+1)/(lambda*lambda))
+
+	// Output:
+	// E[X^2] = 3.000000, want 3.000000
+}
@@ -0,0 +1,212 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// jacobiMatrix is the symmetric tridiagonal Jacobi matrix of the three-term
+// recurrence
+//  sqrt(β_(j+1)) p_(j+1)(x) = (x - α_j) p_j(x) - sqrt(β_j) p_(j-1)(x)
+// of the orthonormal polynomials of a weight function. The eigenvalues of
+// the matrix are the nodes of the Gauss rule of the weight function and the
+// weights are β_0 times the squared first components of the normalized
+// eigenvectors, as shown by
+//  G. H. Golub and J. A. Welsch, "Calculation of Gauss quadrature rules",
+//  Math. Comp. 23:221-230, 1969.
+//
+// Instead of a full eigendecomposition, each node is computed individually
+// by Newton's method safeguarded by Sturm sequence bisection, and the weight is
+// computed from the values of the orthonormal polynomials at the node, so a
+// single node and weight is computed in O(n) time.
+type jacobiMatrix struct {
+	// a holds the recurrence coefficients α_0, ..., α_(n-1).
+	a []float64
+	// b holds the recurrence coefficients β_0, ..., β_(n-1), where β_0 is
+	// the integral of the weight function.
+	b []float64
+	// symmetric is whether the weight function is symmetric about zero.
+	symmetric bool
+}
+
+// location returns node k of n, in increasing order, and its weight.
+func (j jacobiMatrix) location(k int) (x, weight float64) {
+	n := len(j.a)
+	if j.symmetric && 2*k+1 >= n {
+		if 2*k+1 == n {
+			return 0, j.weight(0)
+		}
+		x, weight = j.location(n - 1 - k)
+		return -x, weight
+	}
+	x = j.node(k)
+	return x, j.weight(x)
+}
+
+// node returns eigenvalue k of the matrix in increasing order.
+func (j jacobiMatrix) node(k int) float64 {
+	n := len(j.a)
+
+	// Bracket the eigenvalue by the Gershgorin bounds.
+	lo := math.Inf(1)
+	hi := math.Inf(-1)
+	for i, a := range j.a {
+		var r float64
+		if i > 0 {
+			r += math.Sqrt(j.b[i])
+		}
+		if i < n-1 {
+			r += math.Sqrt(j.b[i+1])
+		}
+		lo = math.Min(lo, a-r)
+		hi = math.Max(hi, a+r)
+	}
+
+	// Use Newton's method on the characteristic polynomial safeguarded by
+	// bisection on the bracket, which is updated from the Sturm counts.
+	// Newton steps are only taken once the bracket isolates the
+	// eigenvalue, so that they cannot converge to another eigenvalue.
+	cLo, cHi := 0, n
+	x := lo + (hi-lo)/2
+	dxOld := hi - lo
+	for {
+		c, r, exact := j.sturm(x)
+		if exact {
+			if c == k {
+				return x
+			}
+			if c < k {
+				// Exclude the eigenvalue at x from the bracket.
+				c++
+			}
+			r = math.Inf(1)
+		}
+		if c > k {
+			hi = x
+			cHi = c
+		} else {
+			lo = x
+			cLo = c
+		}
+		xNew := x - r
+		if cHi-cLo > 1 || !(lo < xNew && xNew < hi) || math.Abs(r) > dxOld/2 {
+			// Bisect if the bracket holds other eigenvalues or if the
+			// Newton step leaves the bracket or does not converge fast
+			// enough.
+			xNew = lo + (hi-lo)/2
+			if xNew <= lo || xNew >= hi {
+				return x
+			}
+		} else if math.Abs(r) <= dlamchE*math.Abs(xNew) {
+			return xNew
+		}
+		dxOld = math.Abs(xNew - x)
+		x = xNew
+	}
+}
+
+const dlamchE = 1.0 / (1 << 53)
+
+// sturm returns the number of eigenvalues less than x and the Newton
+// correction f(x)/f'(x) for the characteristic polynomial f of the matrix,
+// computed from the pivots of the LDLᵀ factorization of the shifted matrix.
+// exact is true if x is an eigenvalue to working precision, in which case
+// the eigenvalue x is not included in count.
+func (j jacobiMatrix) sturm(x float64) (count int, ratio float64, exact bool) {
+	var q, dq, s float64
+	for i, a := range j.a {
+		if i == 0 {
+			q = a - x
+			dq = -1
+		} else {
+			dq = -1 + j.b[i]*dq/(q*q)
+			q = a - x - j.b[i]/q
+		}
+		if q == 0 {
+			if i == len(j.a)-1 {
+				return count, 0, true
+			}
+			// Perturb a zero pivot of a leading submatrix.
+			q = -dlamchE * math.Abs(x)
+			if q == 0 {
+				q = -math.SmallestNonzeroFloat64
+			}
+		}
+		if q < 0 {
+			count++
+		}
+		s += dq / q
+	}
+	return count, 1 / s, false
+}
+
+// weight returns the weight of the node x, which is β_0 divided by the sum
+// of the squares of the polynomials of the recurrence with p_0 = 1.
+func (j jacobiMatrix) weight(x float64) float64 {
+	const (
+		big   = 0x1p300
+		small = 0x1p-300
+	)
+	p0 := 0.0
+	p1 := 1.0
+	sum := 1.0
+	var scales int
+	n := len(j.a)
+	for i := 0; i < n-1; i++ {
+		var p2 float64
+		if i == 0 {
+			p2 = (x - j.a[i]) * p1 / math.Sqrt(j.b[i+1])
+		} else {
+			p2 = ((x-j.a[i])*p1 - math.Sqrt(j.b[i])*p0) / math.Sqrt(j.b[i+1])
+		}
+		p0, p1 = p1, p2
+		sum += p1 * p1
+		if math.Abs(p1) > big {
+			// Rescale to avoid overflow for nodes far in the tails,
+			// whose weights underflow.
+			p0 *= small
+			p1 *= small
+			sum *= small * small
+			scales++
+		}
+	}
+	w := j.b[0] / sum
+	for ; scales > 0 && w != 0; scales-- {
+		w *= small * small
+	}
+	return w
+}
+
+// checkRule panics with a message prefixed by name if x and weight do not
+// have equal length or if min is not less than max.
+func checkRule(name string, x, weight []float64, min, max float64) {
+	if len(x) != len(weight) {
+		panic(name + ": slice length mismatch")
+	}
+	if min >= max {
+		panic(name + ": min >= max")
+	}
+}
+
+// checkLocation panics with a message prefixed by name if k is not a valid
+// index of an n-point rule or if min is not less than max.
+func checkLocation(name string, n, k int, min, max float64) {
+	if n <= 0 {
+		panic(name + ": non-positive number of locations")
+	}
+	if k < 0 || n <= k {
+		panic(name + ": location index out of range")
+	}
+	if min >= max {
+		panic(name + ": min >= max")
+	}
+}
+
+// checkFinite panics with a message prefixed by name if min or max is
+// infinite.
+func checkFinite(name string, min, max float64) {
+	if math.IsInf(min, 0) || math.IsInf(max, 0) {
+		panic(name + ": infinite bound")
+	}
+}
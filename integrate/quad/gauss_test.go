@@ -0,0 +1,361 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mathext"
+)
+
+type rule interface {
+	FixedLocationer
+	FixedLocationSingler
+}
+
+// monomialMoment returns the integral of x^k against the weight function
+// of a rule on [min, max].
+type monomialMoment func(k int, min, max float64) float64
+
+// unweightedMoment is the integral of x^k over [min, max].
+func unweightedMoment(k int, min, max float64) float64 {
+	return (math.Pow(max, float64(k+1)) - math.Pow(min, float64(k+1))) / float64(k+1)
+}
+
+// betaMoment returns the moment of the weight (max-x)^alpha (x-min)^beta
+// for min = 0 and max = 1.
+func betaMoment(alpha, beta float64) monomialMoment {
+	return func(k int, min, max float64) float64 {
+		if min != 0 || max != 1 {
+			panic("bad test")
+		}
+		return mathext.Beta(beta+float64(k)+1, alpha+1)
+	}
+}
+
+func TestGaussExactness(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		rule     rule
+		min, max float64
+		n        []int
+		// degree returns the degree of exactness of an n-point rule.
+		degree func(n int) int
+		moment monomialMoment
+	}{
+		{
+			name: "Laguerre", rule: Laguerre{}, min: 0, max: math.Inf(1),
+			n:      []int{1, 2, 5, 10},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: func(k int, _, _ float64) float64 { return math.Gamma(float64(k) + 1) },
+		},
+		{
+			name: "Laguerre α=-0.5", rule: Laguerre{Alpha: -0.5}, min: 0, max: math.Inf(1),
+			n:      []int{1, 3, 8},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: func(k int, _, _ float64) float64 { return math.Gamma(float64(k) + 0.5) },
+		},
+		{
+			name: "Laguerre α=2.5", rule: Laguerre{Alpha: 2.5}, min: 0, max: math.Inf(1),
+			n:      []int{1, 4, 9},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: func(k int, _, _ float64) float64 { return math.Gamma(float64(k) + 3.5) },
+		},
+		{
+			name: "Jacobi", rule: Jacobi{}, min: -2, max: 3,
+			n:      []int{1, 2, 7, 12},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: unweightedMoment,
+		},
+		{
+			name: "Jacobi α=1.5 β=-0.5", rule: Jacobi{Alpha: 1.5, Beta: -0.5}, min: 0, max: 1,
+			n:      []int{1, 2, 5, 10},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: betaMoment(1.5, -0.5),
+		},
+		{
+			name: "Jacobi α=-0.7 β=3", rule: Jacobi{Alpha: -0.7, Beta: 3}, min: 0, max: 1,
+			n:      []int{1, 3, 6, 11},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: betaMoment(-0.7, 3),
+		},
+		{
+			name: "Jacobi α=β=2", rule: Jacobi{Alpha: 2, Beta: 2}, min: 0, max: 1,
+			n:      []int{1, 4, 7},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: betaMoment(2, 2),
+		},
+		{
+			name: "ChebyshevFirst", rule: ChebyshevFirst{}, min: 0, max: 1,
+			n:      []int{1, 2, 5, 10},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: betaMoment(-0.5, -0.5),
+		},
+		{
+			name: "ChebyshevSecond", rule: ChebyshevSecond{}, min: 0, max: 1,
+			n:      []int{1, 2, 5, 10},
+			degree: func(n int) int { return 2*n - 1 },
+			moment: betaMoment(0.5, 0.5),
+		},
+		{
+			name: "Lobatto", rule: Lobatto{}, min: -1, max: 2,
+			n:      []int{2, 3, 4, 9},
+			degree: func(n int) int { return 2*n - 3 },
+			moment: unweightedMoment,
+		},
+		{
+			name: "Radau", rule: Radau{}, min: -1, max: 2,
+			n:      []int{1, 2, 3, 8},
+			degree: func(n int) int { return 2*n - 2 },
+			moment: unweightedMoment,
+		},
+		{
+			name: "Radau IncludeMax", rule: Radau{IncludeMax: true}, min: -1, max: 2,
+			n:      []int{1, 2, 3, 8},
+			degree: func(n int) int { return 2*n - 2 },
+			moment: unweightedMoment,
+		},
+		{
+			name: "Kronrod", rule: Kronrod{}, min: -1, max: 2,
+			n: []int{3, 5, 7, 9, 15},
+			degree: func(n int) int {
+				m := (n - 1) / 2
+				return 3*m + 1 + m%2
+			},
+			moment: unweightedMoment,
+		},
+	} {
+		for _, n := range test.n {
+			x := make([]float64, n)
+			w := make([]float64, n)
+			test.rule.FixedLocations(x, w, test.min, test.max)
+			for k := 0; k <= test.degree(n); k++ {
+				var got float64
+				for i, v := range x {
+					got += w[i] * math.Pow(v, float64(k))
+				}
+				want := test.moment(k, test.min, test.max)
+				if !scalar.EqualWithinAbsOrRel(got, want, 1e-13, 1e-13) {
+					t.Errorf("%s: n=%d: unexpected moment %d: got %v, want %v", test.name, n, k, got, want)
+				}
+			}
+			if !isSorted(x) {
+				t.Errorf("%s: n=%d: locations not in increasing order: %v", test.name, n, x)
+			}
+		}
+	}
+}
+
+func isSorted(x []float64) bool {
+	for i := 1; i < len(x); i++ {
+		if x[i] <= x[i-1] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestGaussSingle(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		rule     rule
+		n        int
+		min, max float64
+	}{
+		{rule: Laguerre{Alpha: 0.3}, n: 50, min: 1, max: inf},
+		{rule: Jacobi{Alpha: 0.5, Beta: -0.3}, n: 41, min: -1, max: 4},
+		{rule: Jacobi{Alpha: 0.5, Beta: 0.5}, n: 40, min: -1, max: 4},
+		{rule: ChebyshevFirst{}, n: 31, min: -1, max: 1},
+		{rule: ChebyshevSecond{}, n: 30, min: -1, max: 1},
+		{rule: Lobatto{}, n: 21, min: 2, max: 3},
+		{rule: Radau{}, n: 20, min: 2, max: 3},
+		{rule: Radau{IncludeMax: true}, n: 21, min: 2, max: 3},
+		{rule: Kronrod{}, n: 31, min: 2, max: 3},
+	} {
+		n := test.n
+		xs := make([]float64, n)
+		weights := make([]float64, n)
+		test.rule.FixedLocations(xs, weights, test.min, test.max)
+
+		xsSingle := make([]float64, n)
+		weightsSingle := make([]float64, n)
+		for i := range xsSingle {
+			xsSingle[i], weightsSingle[i] = test.rule.FixedLocationSingle(n, i, test.min, test.max)
+		}
+		if !floats.Equal(xs, xsSingle) {
+			t.Errorf("%T: xs mismatch batch and single", test.rule)
+		}
+		if !floats.Equal(weights, weightsSingle) {
+			t.Errorf("%T: weights mismatch batch and single", test.rule)
+		}
+	}
+}
+
+func TestGaussLegendreConsistency(t *testing.T) {
+	t.Parallel()
+	// The Gauss–Jacobi rules with the weights of the Legendre and
+	// Chebyshev rules must agree with those rules.
+	for _, test := range []struct {
+		rule, jacobi rule
+	}{
+		{rule: Legendre{}, jacobi: Jacobi{}},
+		{rule: ChebyshevFirst{}, jacobi: Jacobi{Alpha: -0.5, Beta: -0.5}},
+		{rule: ChebyshevSecond{}, jacobi: Jacobi{Alpha: 0.5, Beta: 0.5}},
+	} {
+		for _, n := range []int{1, 2, 10, 51, 200} {
+			x1 := make([]float64, n)
+			w1 := make([]float64, n)
+			x2 := make([]float64, n)
+			w2 := make([]float64, n)
+			test.rule.FixedLocations(x1, w1, -1, 3)
+			test.jacobi.FixedLocations(x2, w2, -1, 3)
+			if _, ok := test.rule.(Legendre); ok {
+				// Legendre returns the locations in decreasing order.
+				floats.Reverse(x1)
+				floats.Reverse(w1)
+			}
+			if !floats.EqualApprox(x1, x2, 1e-14) {
+				t.Errorf("%T: n=%d: location mismatch", test.rule, n)
+			}
+			if !floats.EqualApprox(w1, w2, 1e-12) {
+				t.Errorf("%T: n=%d: weight mismatch", test.rule, n)
+			}
+		}
+	}
+
+	// The Kronrod rules must agree with those of QUADPACK and embed the
+	// Gauss–Legendre rules.
+	for _, r := range []kronrodRule{kronrod15, kronrod21} {
+		n := r.points()
+		m := (n - 1) / 2
+		x := make([]float64, n)
+		w := make([]float64, n)
+		Kronrod{}.FixedLocations(x, w, -1, 1)
+		for i, v := range r.xgk {
+			if math.Abs(x[n-1-i]-v) > 1e-15 || math.Abs(w[n-1-i]-r.wgk[i]) > 1e-15 {
+				t.Errorf("n=%d: mismatch with QUADPACK rule at %d", n, i)
+			}
+		}
+		xg := make([]float64, m)
+		wg := make([]float64, m)
+		Legendre{}.FixedLocations(xg, wg, -1, 1)
+		floats.Reverse(xg)
+		for i, v := range xg {
+			if math.Abs(x[2*i+1]-v) > 1e-15 {
+				t.Errorf("n=%d: Gauss location %d mismatch: got %v, want %v", n, i, x[2*i+1], v)
+			}
+		}
+	}
+}
+
+func TestGaussLarge(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		rule     rule
+		n        int
+		min, max float64
+		f        func(float64) float64
+		want     float64
+		tol      float64
+	}{
+		{
+			// The mean of a gamma distribution with shape 3.5.
+			rule: Laguerre{Alpha: 2.5}, n: 300, min: 0, max: math.Inf(1),
+			f:    func(x float64) float64 { return x / math.Gamma(3.5) },
+			want: 3.5,
+			tol:  1e-12,
+		},
+		{
+			rule: Laguerre{}, n: 500, min: 2, max: math.Inf(1),
+			f:    func(x float64) float64 { return math.Cos(x - 2) },
+			want: 0.5,
+			tol:  1e-12,
+		},
+		{
+			rule: Jacobi{Alpha: -0.5, Beta: 1.5}, n: 300, min: 0, max: 1,
+			f:    func(x float64) float64 { return 1 },
+			want: mathext.Beta(2.5, 0.5),
+			tol:  1e-13,
+		},
+		{
+			rule: Jacobi{Alpha: 0.5, Beta: 0.5}, n: 301, min: -1, max: 1,
+			f:    func(x float64) float64 { return math.Exp(x) },
+			want: math.Pi * 0.565159103992485027207696, // π I₁(1)
+			tol:  1e-13,
+		},
+		{
+			rule: Lobatto{}, n: 300, min: 0, max: 1,
+			f:    math.Exp,
+			want: math.E - 1,
+			tol:  1e-13,
+		},
+		{
+			rule: Radau{}, n: 300, min: 0, max: 1,
+			f:    math.Exp,
+			want: math.E - 1,
+			tol:  1e-13,
+		},
+		{
+			rule: Kronrod{}, n: 201, min: 0, max: 1,
+			f:    math.Exp,
+			want: math.E - 1,
+			tol:  1e-14,
+		},
+	} {
+		got := Fixed(test.f, test.min, test.max, test.n, test.rule, 0)
+		if !scalar.EqualWithinAbsOrRel(got, test.want, test.tol, test.tol) {
+			t.Errorf("%T: n=%d: got %v, want %v", test.rule, test.n, got, test.want)
+		}
+	}
+}
+
+func TestGaussPanics(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	x := make([]float64, 4)
+	for _, test := range []struct {
+		rule     rule
+		n        int
+		min, max float64
+	}{
+		{rule: Laguerre{}, n: 4, min: 0, max: 1},
+		{rule: Laguerre{}, n: 4, min: -inf, max: inf},
+		{rule: Laguerre{Alpha: -1}, n: 4, min: 0, max: inf},
+		{rule: Jacobi{}, n: 4, min: 0, max: inf},
+		{rule: Jacobi{Beta: -1.5}, n: 4, min: 0, max: 1},
+		{rule: Jacobi{}, n: 4, min: 1, max: 0},
+		{rule: ChebyshevFirst{}, n: 4, min: -inf, max: 0},
+		{rule: ChebyshevSecond{}, n: 4, min: 1, max: 1},
+		{rule: Lobatto{}, n: 1, min: 0, max: 1},
+		{rule: Radau{}, n: 4, min: 0, max: inf},
+		{rule: Kronrod{}, n: 4, min: 0, max: 1},
+		{rule: Kronrod{}, n: 1, min: 0, max: 1},
+	} {
+		name := fmt.Sprintf("%T%+v n=%d [%v, %v]", test.rule, test.rule, test.n, test.min, test.max)
+		if !panics(func() { test.rule.FixedLocations(x[:test.n], x[:test.n], test.min, test.max) }) {
+			t.Errorf("%s: expected panic from FixedLocations", name)
+		}
+		if !panics(func() { test.rule.FixedLocationSingle(test.n, 0, test.min, test.max) }) {
+			t.Errorf("%s: expected panic from FixedLocationSingle", name)
+		}
+	}
+	if !panics(func() { Jacobi{}.FixedLocationSingle(4, 4, 0, 1) }) {
+		t.Errorf("expected panic for out of range index")
+	}
+}
+
+func panics(f func()) (ok bool) {
+	defer func() {
+		ok = recover() != nil
+	}()
+	f()
+	return false
+}
@@ -0,0 +1,113 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+// Kronrod generates sample locations and weights for performing
+// Gauss–Kronrod quadrature of an unweighted function over finite bounds
+//  int_min^max f(x) dx .
+// The number of locations must be odd, n = 2m+1 with m ≥ 1, and the rule is
+// the Kronrod extension of the m-point Gauss–Legendre rule. The locations
+// with odd indices are the locations of the Gauss–Legendre rule, so the
+// difference between the estimates of the two rules from the same function
+// values is an estimate of the error of the Gauss rule.
+//
+// The rule is exact for polynomials f of degree at most 3m+1, or 3m+2 if m
+// is odd. The locations and weights are computed from the Jacobi–Kronrod
+// matrix, which takes O(m^2) time, so the cost of computing the locations
+// individually with FixedLocationSingle is O(m^2) for each location.
+type Kronrod struct{}
+
+func (r Kronrod) FixedLocations(x, weight []float64, min, max float64) {
+	checkRule("kronrod", x, weight, min, max)
+	checkFinite("kronrod", min, max)
+	m := r.matrix(len(x))
+	for i := range x {
+		x[i], weight[i] = r.bounded(m, i, min, max)
+	}
+}
+
+func (r Kronrod) FixedLocationSingle(n, k int, min, max float64) (x, weight float64) {
+	checkLocation("kronrod", n, k, min, max)
+	checkFinite("kronrod", min, max)
+	return r.bounded(r.matrix(n), k, min, max)
+}
+
+func (Kronrod) bounded(m jacobiMatrix, k int, min, max float64) (x, weight float64) {
+	x, weight = m.location(k)
+	half := (max - min) / 2
+	return (x+1)*half + min, weight * half
+}
+
+// matrix returns the Jacobi–Kronrod matrix of the n-point rule on [-1, 1].
+func (Kronrod) matrix(n int) jacobiMatrix {
+	if n < 3 || n%2 == 0 {
+		panic("kronrod: number of locations not odd and greater than one")
+	}
+	m := (n - 1) / 2
+	nc := (3*m+1)/2 + 1
+	a := make([]float64, nc)
+	b := make([]float64, nc)
+	b[0] = 2
+	for k := 1; k < nc; k++ {
+		fk := float64(k)
+		b[k] = fk * fk / (4*fk*fk - 1)
+	}
+	j := kronrodMatrix(m, a, b)
+	j.symmetric = true
+	return j
+}
+
+// kronrodMatrix returns the Jacobi–Kronrod matrix of the (2n+1)-point
+// Kronrod extension of the n-point Gauss rule for the recurrence
+// coefficients a0 and b0, which must have at least ⌊3n/2⌋+1 and ⌈3n/2⌉+1
+// elements, using the algorithm of
+//  D. P. Laurie, "Calculation of Gauss-Kronrod quadrature rules",
+//  Math. Comp. 66:1133-1145, 1997.
+func kronrodMatrix(n int, a0, b0 []float64) jacobiMatrix {
+	// The indices are offset from the reference by one for a and b and by
+	// two for s and t, whose indices start at -1 in the reference.
+	a := make([]float64, 2*n+2)
+	b := make([]float64, 2*n+2)
+	for k := 0; k <= 3*n/2; k++ {
+		a[k+1] = a0[k]
+	}
+	for k := 0; k <= (3*n+1)/2; k++ {
+		b[k+1] = b0[k]
+	}
+	s := make([]float64, n/2+3)
+	t := make([]float64, n/2+3)
+	t[2] = b[n+2]
+	for m := 0; m <= n-2; m++ {
+		var sum float64
+		for k := (m + 1) / 2; k >= 0; k-- {
+			l := m - k
+			sum += (a[k+n+2]-a[l+1])*t[k+2] + b[k+n+2]*s[k+1] - b[l+1]*s[k+2]
+			s[k+2] = sum
+		}
+		s, t = t, s
+	}
+	for j := n / 2; j >= 0; j-- {
+		s[j+2] = s[j+1]
+	}
+	for m := n - 1; m <= 2*n-3; m++ {
+		var sum float64
+		var j int
+		for k := m + 1 - n; k <= (m-1)/2; k++ {
+			l := m - k
+			j = n - 1 - l
+			sum += -(a[k+n+2]-a[l+1])*t[j+2] - b[k+n+2]*s[j+2] + b[l+1]*s[j+3]
+			s[j+2] = sum
+		}
+		k := (m + 1) / 2
+		if m%2 == 0 {
+			a[k+n+2] = a[k+1] + (s[j+2]-b[k+n+2]*s[j+3])/t[j+3]
+		} else {
+			b[k+n+2] = s[j+2] / s[j+3]
+		}
+		s, t = t, s
+	}
+	a[2*n+1] = a[n] - b[2*n+1]*s[2]/t[2]
+	return jacobiMatrix{a: a[1:], b: b[1:]}
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Jacobi generates sample locations and weights for performing Gauss–Jacobi
+// quadrature with the weight of a beta distribution
+//  int_min^max (max-x)^Alpha (x-min)^Beta f(x) dx .
+// The bounds must be finite and Alpha and Beta must be greater than -1. The
+// zero value is the Gauss–Legendre rule.
+//
+// An n-point rule is exact for polynomials f of degree at most 2n-1. The
+// locations and weights are computed from the Jacobi matrix of the Jacobi
+// polynomials, each in O(n) time.
+type Jacobi struct {
+	Alpha, Beta float64
+}
+
+func (j Jacobi) FixedLocations(x, weight []float64, min, max float64) {
+	checkRule("jacobi", x, weight, min, max)
+	checkFinite("jacobi", min, max)
+	j.checkParameters()
+	m := j.matrix(len(x))
+	for i := range x {
+		x[i], weight[i] = j.bounded(m, i, min, max)
+	}
+}
+
+func (j Jacobi) FixedLocationSingle(n, k int, min, max float64) (x, weight float64) {
+	checkLocation("jacobi", n, k, min, max)
+	checkFinite("jacobi", min, max)
+	j.checkParameters()
+	return j.bounded(j.matrix(n), k, min, max)
+}
+
+func (j Jacobi) checkParameters() {
+	if !(j.Alpha > -1) || !(j.Beta > -1) {
+		panic("jacobi: Alpha or Beta <= -1")
+	}
+}
+
+// bounded returns location k of the rule with the Jacobi matrix m
+// transformed from [-1, 1] to [min, max].
+func (j Jacobi) bounded(m jacobiMatrix, k int, min, max float64) (x, weight float64) {
+	x, weight = m.location(k)
+	half := (max - min) / 2
+	return (x+1)*half + min, weight * math.Pow(half, j.Alpha+j.Beta+1)
+}
+
+// matrix returns the Jacobi matrix of the n-point rule on [-1, 1].
+func (j Jacobi) matrix(n int) jacobiMatrix {
+	a, b := j.Alpha, j.Beta
+	m := jacobiMatrix{
+		a:         make([]float64, n),
+		b:         make([]float64, n),
+		symmetric: a == b,
+	}
+	if n == 0 {
+		return m
+	}
+	ab := a + b
+	lg1, _ := math.Lgamma(a + 1)
+	lg2, _ := math.Lgamma(b + 1)
+	lg3, _ := math.Lgamma(ab + 2)
+	m.b[0] = math.Exp((ab+1)*math.Ln2 + lg1 + lg2 - lg3)
+	for i := range m.a {
+		k := float64(i)
+		switch i {
+		case 0:
+			m.a[i] = (b - a) / (ab + 2)
+		default:
+			m.a[i] = (b*b - a*a) / ((2*k + ab) * (2*k + ab + 2))
+		}
+		switch i {
+		case 0:
+		case 1:
+			m.b[i] = 4 * (a + 1) * (b + 1) / ((ab + 2) * (ab + 2) * (ab + 3))
+		default:
+			s := 2*k + ab
+			m.b[i] = 4 * k * (k + a) * (k + b) * (k + ab) / (s * s * (s + 1) * (s - 1))
+		}
+	}
+	if m.symmetric {
+		for i := range m.a {
+			m.a[i] = 0
+		}
+	}
+	return m
+}
+
+// Lobatto generates sample locations and weights for performing
+// Gauss–Lobatto quadrature of an unweighted function over finite bounds
+//  int_min^max f(x) dx ,
+// where the rule includes min and max as locations. The number of locations
+// must be at least two.
+//
+// An n-point rule is exact for polynomials f of degree at most 2n-3. The
+// interior locations are computed from the Gauss–Jacobi rule with Alpha and
+// Beta equal to one.
+type Lobatto struct{}
+
+func (l Lobatto) FixedLocations(x, weight []float64, min, max float64) {
+	checkRule("lobatto", x, weight, min, max)
+	checkFinite("lobatto", min, max)
+	n := len(x)
+	if n < 2 {
+		panic("lobatto: fewer than two locations")
+	}
+	m := Jacobi{Alpha: 1, Beta: 1}.matrix(n - 2)
+	for i := range x {
+		x[i], weight[i] = l.bounded(m, n, i, min, max)
+	}
+}
+
+func (l Lobatto) FixedLocationSingle(n, k int, min, max float64) (x, weight float64) {
+	checkLocation("lobatto", n, k, min, max)
+	checkFinite("lobatto", min, max)
+	if n < 2 {
+		panic("lobatto: fewer than two locations")
+	}
+	return l.bounded(Jacobi{Alpha: 1, Beta: 1}.matrix(n-2), n, k, min, max)
+}
+
+// bounded returns location k of the n-point rule on [min, max], where m is
+// the Jacobi matrix of the interior locations.
+func (l Lobatto) bounded(m jacobiMatrix, n, k int, min, max float64) (x, weight float64) {
+	half := (max - min) / 2
+	switch k {
+	case 0:
+		return min, 2 / float64(n*(n-1)) * half
+	case n - 1:
+		return max, 2 / float64(n*(n-1)) * half
+	}
+	x, weight = m.location(k - 1)
+	return (x+1)*half + min, weight / (1 - x*x) * half
+}
+
+// Radau generates sample locations and weights for performing Gauss–Radau
+// quadrature of an unweighted function over finite bounds
+//  int_min^max f(x) dx ,
+// where the rule includes min as a location, or max if IncludeMax is true.
+//
+// An n-point rule is exact for polynomials f of degree at most 2n-2. The
+// other locations are computed from the Gauss–Jacobi rule with weight
+// 1+x or 1-x on [-1, 1].
+type Radau struct {
+	IncludeMax bool
+}
+
+func (r Radau) FixedLocations(x, weight []float64, min, max float64) {
+	checkRule("radau", x, weight, min, max)
+	checkFinite("radau", min, max)
+	m := r.matrix(len(x))
+	for i := range x {
+		x[i], weight[i] = r.bounded(m, len(x), i, min, max)
+	}
+}
+
+func (r Radau) FixedLocationSingle(n, k int, min, max float64) (x, weight float64) {
+	checkLocation("radau", n, k, min, max)
+	checkFinite("radau", min, max)
+	return r.bounded(r.matrix(n), n, k, min, max)
+}
+
+// matrix returns the Jacobi matrix of the locations of the n-point rule
+// other than the bound.
+func (r Radau) matrix(n int) jacobiMatrix {
+	if n == 0 {
+		return jacobiMatrix{}
+	}
+	if r.IncludeMax {
+		return Jacobi{Alpha: 1}.matrix(n - 1)
+	}
+	return Jacobi{Beta: 1}.matrix(n - 1)
+}
+
+// bounded returns location k of the n-point rule on [min, max], where m is
+// the Jacobi matrix of the locations other than the bound.
+func (r Radau) bounded(m jacobiMatrix, n, k int, min, max float64) (x, weight float64) {
+	half := (max - min) / 2
+	w0 := 2 / float64(n*n) * half
+	if r.IncludeMax {
+		if k == n-1 {
+			return max, w0
+		}
+		x, weight = m.location(k)
+		return (x+1)*half + min, weight / (1 - x) * half
+	}
+	if k == 0 {
+		return min, w0
+	}
+	x, weight = m.location(k - 1)
+	return (x+1)*half + min, weight / (1 + x) * half
+}
@@ -0,0 +1,67 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package quad
+
+import "math"
+
+// Laguerre generates sample locations and weights for performing
+// generalized Gauss–Laguerre quadrature with the weight of a gamma
+// distribution
+//  int_min^inf (x-min)^Alpha e^(-(x-min)) f(x) dx .
+// max must be +Inf and Alpha must be greater than -1. The zero value is the
+// classical Gauss–Laguerre rule.
+//
+// An n-point rule is exact for polynomials f of degree at most 2n-1. The
+// locations and weights are computed from the Jacobi matrix of the
+// Laguerre polynomials, each in O(n) time.
+type Laguerre struct {
+	Alpha float64
+}
+
+func (l Laguerre) FixedLocations(x, weight []float64, min, max float64) {
+	checkRule("laguerre", x, weight, min, max)
+	l.checkBounds(min, max)
+	j := l.matrix(len(x))
+	for i := range x {
+		x[i], weight[i] = j.location(i)
+		x[i] += min
+	}
+}
+
+func (l Laguerre) FixedLocationSingle(n, k int, min, max float64) (x, weight float64) {
+	checkLocation("laguerre", n, k, min, max)
+	l.checkBounds(min, max)
+	x, weight = l.matrix(n).location(k)
+	return x + min, weight
+}
+
+func (l Laguerre) checkBounds(min, max float64) {
+	if math.IsInf(min, 0) || !math.IsInf(max, 1) {
+		panic("laguerre: bounds must be finite min and infinite max")
+	}
+	if !(l.Alpha > -1) {
+		panic("laguerre: Alpha <= -1")
+	}
+}
+
+// matrix returns the Jacobi matrix of the n-point rule.
+func (l Laguerre) matrix(n int) jacobiMatrix {
+	j := jacobiMatrix{
+		a: make([]float64, n),
+		b: make([]float64, n),
+	}
+	if n == 0 {
+		return j
+	}
+	j.b[0] = math.Gamma(l.Alpha + 1)
+	for i := range j.a {
+		k := float64(i)
+		j.a[i] = 2*k + l.Alpha + 1
+		if i > 0 {
+			j.b[i] = k * (k + l.Alpha)
+		}
+	}
+	return j
+}
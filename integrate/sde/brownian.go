@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+)
+
+// BrownianPath is a sample path of an m-dimensional standard Wiener process
+// W starting at zero. Along with W, the path holds the time integrals of W
+// that are needed by methods of strong order 1.5.
+//
+// The path is generated lazily. Values at times after the last generated
+// time are drawn from independent increments, and values at times between
+// generated times are drawn from the Brownian bridge conditioned on the
+// values at the neighboring times, so that all values drawn from a path are
+// consistent with each other. The values of a path depend on the source and
+// the sequence of times at which it is sampled.
+type BrownianPath struct {
+	m   int
+	rnd *rand.Rand
+
+	// t are the generated times, w[k] is W(t[k]), and z[k] is the
+	// integral of W(s) - W(t[k]) over [t[k], t[k+1]].
+	t []float64
+	w [][]float64
+	z [][]float64
+}
+
+// NewBrownianPath returns a new path of an m-dimensional Wiener process
+// starting at time t0, using the source of randomness src.
+func NewBrownianPath(m int, t0 float64, src rand.Source) *BrownianPath {
+	if m <= 0 {
+		panic("sde: non-positive dimension")
+	}
+	return &BrownianPath{
+		m:   m,
+		rnd: rand.New(src),
+		t:   []float64{t0},
+		w:   [][]float64{make([]float64, m)},
+	}
+}
+
+// Dim returns the dimension of the Wiener process.
+func (p *BrownianPath) Dim() int {
+	return p.m
+}
+
+// At returns the value of the Wiener process at time t. If dst is nil, a
+// new slice is allocated and returned, otherwise the result is stored in
+// dst, which must have length Dim.
+//
+// At panics if t is before the start of the path.
+func (p *BrownianPath) At(dst []float64, t float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, p.m)
+	}
+	if len(dst) != p.m {
+		panic("sde: destination length mismatch")
+	}
+	copy(dst, p.w[p.point(t)])
+	return dst
+}
+
+// Increment stores the increment of the Wiener process over [t0, t1] in
+// dw and, if dz is not nil, the integral
+//  ∫_t0^t1 (W(s) - W(t0)) ds
+// in dz. dw and dz must have length Dim.
+//
+// Increment panics if t0 is before the start of the path or if t1 is
+// before t0.
+func (p *BrownianPath) Increment(dw, dz []float64, t0, t1 float64) {
+	if t1 < t0 {
+		panic("sde: t1 < t0")
+	}
+	if len(dw) != p.m || dz != nil && len(dz) != p.m {
+		panic("sde: destination length mismatch")
+	}
+	i := p.point(t0)
+	j := p.point(t1)
+	for c := range dw {
+		dw[c] = p.w[j][c] - p.w[i][c]
+	}
+	if dz == nil {
+		return
+	}
+	for c := range dz {
+		dz[c] = 0
+	}
+	for k := i; k < j; k++ {
+		h := p.t[k+1] - p.t[k]
+		for c := range dz {
+			dz[c] += p.z[k][c] + (p.w[k][c]-p.w[i][c])*h
+		}
+	}
+}
+
+// point returns the index of the time t in the generated times, generating
+// the path at t if necessary.
+func (p *BrownianPath) point(t float64) int {
+	if t < p.t[0] {
+		panic("sde: time before start of path")
+	}
+	k := sort.SearchFloat64s(p.t, t)
+	if k < len(p.t) && p.t[k] == t {
+		return k
+	}
+
+	w := make([]float64, p.m)
+	if k == len(p.t) {
+		// Draw independent increments. The integral of the
+		// increment is Gaussian with variance h^3/3 and covariance
+		// h^2/2 with the increment.
+		last := p.w[k-1]
+		z := make([]float64, p.m)
+		h := t - p.t[k-1]
+		sh := math.Sqrt(h)
+		for c := range w {
+			dw := sh * p.rnd.NormFloat64()
+			w[c] = last[c] + dw
+			z[c] = h / 2 * (dw + sh/math.Sqrt(3)*p.rnd.NormFloat64())
+		}
+		p.t = append(p.t, t)
+		p.w = append(p.w, w)
+		p.z = append(p.z, z)
+		return k
+	}
+
+	// Draw from the bridge on [t[k-1], t[k]] conditioned on the
+	// increment and its integral over the interval.
+	z1 := make([]float64, p.m)
+	z2 := make([]float64, p.m)
+	h := p.t[k] - p.t[k-1]
+	a := (t - p.t[k-1]) / h
+	b := newBridge(a)
+	sh := math.Sqrt(h)
+	for c := range w {
+		dw := (p.w[k][c] - p.w[k-1][c]) / sh
+		iw := p.z[k-1][c] / (h * sh)
+		x, y := b.sample(dw, iw, p.rnd)
+		x *= sh
+		z1[c] = y * h * sh
+		w[c] = p.w[k-1][c] + x
+		z2[c] = p.z[k-1][c] - z1[c] - x*(p.t[k]-t)
+	}
+	p.t = append(p.t, 0)
+	copy(p.t[k+1:], p.t[k:])
+	p.t[k] = t
+	p.w = append(p.w, nil)
+	copy(p.w[k+1:], p.w[k:])
+	p.w[k] = w
+	p.z[k-1] = z1
+	p.z = append(p.z, nil)
+	copy(p.z[k+1:], p.z[k:])
+	p.z[k] = z2
+	return k
+}
+
+// bridge is the conditional distribution of the value X = B(a) and the
+// integral Y of B over [0, a] of a standard Wiener process B on [0, 1],
+// given B(1) and the integral of B over [0, 1].
+type bridge struct {
+	// gx and gy are the coefficients of the conditional means, and
+	// l11, l21 and l22 is the Cholesky factor of the conditional
+	// covariance.
+	gx, gy        [2]float64
+	l11, l21, l22 float64
+}
+
+func newBridge(a float64) bridge {
+	a2 := a * a
+	a3 := a2 * a
+	vxx := a * (1 - a) * (1 - 3*a + 3*a2)
+	vxy := a2/2 - 2*a3 + 2.5*a2*a2 - a2*a3
+	vyy := a3/3 - a2*a2 + a2*a3 - a3*a3/3
+	var b bridge
+	b.gx = [2]float64{-2*a + 3*a2, 6*a - 6*a2}
+	b.gy = [2]float64{-a2 + a3, 3*a2 - 2*a3}
+	b.l11 = math.Sqrt(math.Max(vxx, 0))
+	if b.l11 > 0 {
+		b.l21 = vxy / b.l11
+	}
+	b.l22 = math.Sqrt(math.Max(vyy-b.l21*b.l21, 0))
+	return b
+}
+
+// sample returns a sample of X and Y given B(1) = w and the integral iw.
+func (b bridge) sample(w, iw float64, rnd *rand.Rand) (x, y float64) {
+	n1 := rnd.NormFloat64()
+	n2 := rnd.NormFloat64()
+	x = b.gx[0]*w + b.gx[1]*iw + b.l11*n1
+	y = b.gy[0]*w + b.gy[1]*iw + b.l21*n1 + b.l22*n2
+	return x, y
+}
@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package sde provides numerical solution of Itô stochastic differential
+// equations
+//  dY = a(t, Y) dt + b(t, Y) dW,  Y(t0) = y0,
+// where W is a multi-dimensional standard Wiener process.
+//
+// Sample paths of the Wiener process are represented by BrownianPath,
+// which draws its values from an explicit rand.Source and refines itself
+// with the Brownian bridge, so that a path is reproducible and can be
+// sampled with any sequence of step sizes. Solve integrates a Problem along
+// a path with a fixed step size using the methods EulerMaruyama, Milstein
+// and KloedenPlaten, of strong order 0.5, 1 and 1.5 for diagonal and other
+// commutative noise. For general non-commutative noise, the methods of
+// higher order approximate the Lévy areas of the path and have strong order
+// 0.5 with a smaller error than EulerMaruyama. Ensemble solves a problem for
+// many independent paths concurrently.
+package sde // import "github.com/jingcheng-WU/gonum/integrate/sde"
@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde_test
+
+import (
+	"fmt"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/integrate/sde"
+	"github.com/jingcheng-WU/gonum/mathext/prng"
+)
+
+func ExampleEnsemble() {
+	// Estimate the expected value at t = 1 of the Ornstein–Uhlenbeck
+	// process
+	//  dX = θ (μ - X) dt + σ dW,  X(0) = x0,
+	// which is μ + (x0 - μ) exp(-θ).
+	const (
+		theta = 2.0
+		mu    = 1.0
+		sigma = 0.5
+		x0    = 3.0
+		paths = 10000
+	)
+	p := sde.Problem{
+		Drift: func(dst []float64, _ float64, y []float64) {
+			dst[0] = theta * (mu - y[0])
+		},
+		DiagDiffusion: func(dst []float64, _ float64, _ []float64) {
+			dst[0] = sigma
+		},
+		T1: 1,
+		Y0: []float64{x0},
+	}
+	x := make([]float64, paths)
+	sde.Ensemble(p, paths, &sde.EnsembleSettings{
+		NewMethod: func() sde.Method { return &sde.KloedenPlaten{} },
+		Steps:     50,
+		NewSource: func(path int) rand.Source {
+			return prng.NewXoshiro256plus(uint64(path))
+		},
+	}, func(path int, sol *sde.Solution) {
+		x[path] = sol.Y[len(sol.Y)-1][0]
+	})
+
+	var mean float64
+	for _, v := range x {
+		mean += v
+	}
+	mean /= paths
+	fmt.Printf("mean = %.2f, want %.2f\n", mean, mu+(x0-mu)*math.Exp(-theta))
+
+	// Output:
+	// mean = 1.27, want 1.27
+}
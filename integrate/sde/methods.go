@@ -0,0 +1,384 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// EulerMaruyama is the Euler–Maruyama method
+//  Y_(n+1) = Y_n + a h + b ΔW,
+// of strong order 0.5 and weak order 1. It supports general noise.
+type EulerMaruyama struct {
+	p *Problem
+	d *diffusion
+	a []float64
+}
+
+// Init initializes the method. It is called by Solve.
+func (e *EulerMaruyama) Init(p *Problem, m int) {
+	e.p = p
+	e.d = newDiffusion(p, m)
+	e.a = make([]float64, len(p.Y0))
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (e *EulerMaruyama) Step(ynew []float64, t, h float64, y, dw, dz []float64) {
+	e.p.Drift(e.a, t, y)
+	e.d.eval(t, y)
+	for i, v := range y {
+		ynew[i] = v + h*e.a[i]
+	}
+	e.d.addNoise(ynew, dw)
+}
+
+// Milstein is the derivative-free Milstein method of Platen
+//  Y_(n+1) = Y_n + a h + Σ_j b^j ΔW_j + Σ_(j1,j2) (b^j2(Ŷ^j1) - b^j2) I_(j1,j2) / sqrt(h),
+//  Ŷ^j = Y_n + a h + b^j sqrt(h),
+// where b^j is column j of the diffusion matrix and I_(j1,j2) are the double
+// Itô integrals of the Wiener process over the step.
+//
+// The method has strong order 1 and weak order 1 for commutative noise,
+// which includes diagonal noise and noise driven by a one-dimensional Wiener
+// process. For general noise, the Lévy areas in the double integrals are
+// approximated from the increments and integrals of the path over the step,
+// and the strong order is reduced to 0.5. Each step with general noise
+// evaluates the diffusion m+1 times for an m-dimensional Wiener process.
+//
+// The method is described in
+//  Kloeden, P. E., Platen, E.: Numerical Solution of Stochastic
+//  Differential Equations, Section 11.1. Springer (1992)
+type Milstein struct {
+	p        *Problem
+	d        *diffusion
+	a, b, bh []float64
+	yh       []float64
+
+	// b0 and bj are the diffusion matrices at the state and at Ŷ^j
+	// for general noise.
+	b0, bj *mat.Dense
+}
+
+// Init initializes the method. It is called by Solve.
+func (m *Milstein) Init(p *Problem, dim int) {
+	m.p = p
+	m.d = newDiffusion(p, dim)
+	n := len(p.Y0)
+	m.a = make([]float64, n)
+	m.b = make([]float64, n)
+	m.bh = make([]float64, n)
+	m.yh = make([]float64, n)
+	if !m.d.scalar() {
+		m.b0 = mat.NewDense(n, dim, nil)
+		m.bj = mat.NewDense(n, dim, nil)
+	}
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (m *Milstein) Step(ynew []float64, t, h float64, y, dw, dz []float64) {
+	if !m.d.scalar() {
+		m.stepGeneral(ynew, t, h, y, dw, dz)
+		return
+	}
+	sh := math.Sqrt(h)
+	m.p.Drift(m.a, t, y)
+	m.d.vec(m.b, t, y)
+	for i, v := range y {
+		m.yh[i] = v + h*m.a[i] + sh*m.b[i]
+	}
+	m.d.vec(m.bh, t, m.yh)
+	for i, v := range y {
+		w, _ := m.d.noise(i, dw, dz)
+		ynew[i] = v + h*m.a[i] + m.b[i]*w + (m.bh[i]-m.b[i])*(w*w-h)/(2*sh)
+	}
+}
+
+// stepGeneral advances the solution by one step for general noise.
+func (m *Milstein) stepGeneral(ynew []float64, t, h float64, y, dw, dz []float64) {
+	sh := math.Sqrt(h)
+	m.p.Drift(m.a, t, y)
+	m.p.Diffusion(m.b0, t, y)
+	for i, v := range y {
+		ynew[i] = v + h*m.a[i] + dotRow(m.b0, i, dw)
+	}
+	for j1 := range dw {
+		for i, v := range y {
+			m.yh[i] = v + h*m.a[i] + sh*m.b0.At(i, j1)
+		}
+		m.p.Diffusion(m.bj, t, m.yh)
+		for j2 := range dw {
+			iw := itoIntegral2(dw, dz, h, j1, j2)
+			for i := range ynew {
+				ynew[i] += (m.bj.At(i, j2) - m.b0.At(i, j2)) * iw / sh
+			}
+		}
+	}
+}
+
+// KloedenPlaten is the explicit stochastic Runge–Kutta method of Kloeden
+// and Platen of strong order 1.5 and weak order 2, which uses the integral
+// of the Wiener process over the steps.
+//
+// The method has strong order 1.5 for additive noise and for noise that is
+// commutative of the second kind, which includes diagonal noise and noise
+// driven by a one-dimensional Wiener process. For general noise, the triple
+// Itô integrals are replaced by their symmetrized values, which are exact for
+// commutative noise of the second kind, and the Lévy areas in the double
+// integrals are approximated as in Milstein. The strong order is then 1 for
+// commutative noise and 0.5 otherwise.
+//
+// For diagonal noise, each step evaluates the drift twice for each component
+// with non-zero diffusion. For general noise with an m-dimensional Wiener
+// process, each step evaluates the drift 2m+2 times and the diffusion
+// 2m^2+2m+2 times.
+//
+// The method is described in
+//  Kloeden, P. E., Platen, E.: Numerical Solution of Stochastic
+//  Differential Equations, Section 11.2. Springer (1992)
+type KloedenPlaten struct {
+	p *Problem
+	d *diffusion
+
+	a0, abar, ap, am []float64
+	b0, bp, bm       []float64
+	bpp, bpm         []float64
+	ybar, up, um     []float64
+	tmp              []float64
+
+	// The diffusion matrices for general noise are stored in
+	// gb0 at the state, gbbar at Ȳ, gbp[j] and gbm[j] at Υ±^j, and
+	// gbpp and gbpm at Φ±^(j1,j2). yp[j] and ym[j] hold Υ±^j.
+	gb0, gbbar, gbpp, gbpm *mat.Dense
+	gbp, gbm               []*mat.Dense
+	yp, ym                 [][]float64
+}
+
+// Init initializes the method. It is called by Solve.
+func (k *KloedenPlaten) Init(p *Problem, m int) {
+	k.p = p
+	k.d = newDiffusion(p, m)
+	n := len(p.Y0)
+	for _, v := range []*[]float64{
+		&k.a0, &k.abar, &k.ap, &k.am,
+		&k.b0, &k.bp, &k.bm, &k.bpp, &k.bpm,
+		&k.ybar, &k.up, &k.um, &k.tmp,
+	} {
+		*v = make([]float64, n)
+	}
+	if k.d.scalar() {
+		return
+	}
+	k.gb0 = mat.NewDense(n, m, nil)
+	k.gbbar = mat.NewDense(n, m, nil)
+	k.gbpp = mat.NewDense(n, m, nil)
+	k.gbpm = mat.NewDense(n, m, nil)
+	k.gbp = make([]*mat.Dense, m)
+	k.gbm = make([]*mat.Dense, m)
+	k.yp = make([][]float64, m)
+	k.ym = make([][]float64, m)
+	for j := 0; j < m; j++ {
+		k.gbp[j] = mat.NewDense(n, m, nil)
+		k.gbm[j] = mat.NewDense(n, m, nil)
+		k.yp[j] = make([]float64, n)
+		k.ym[j] = make([]float64, n)
+	}
+}
+
+// Step advances the solution by one step. It is called by Solve.
+func (k *KloedenPlaten) Step(ynew []float64, t, h float64, y, dw, dz []float64) {
+	if !k.d.scalar() {
+		k.stepGeneral(ynew, t, h, y, dw, dz)
+		return
+	}
+	sh := math.Sqrt(h)
+	t1 := t + h
+	k.p.Drift(k.a0, t, y)
+	k.d.vec(k.b0, t, y)
+
+	// The supporting values are
+	//  Ȳ = Y + a h,  Υ± = Ȳ ± b sqrt(h),  Φ± = Υ+ ± b(Υ+) sqrt(h),
+	// evaluated at t+h.
+	for i, v := range y {
+		k.ybar[i] = v + h*k.a0[i]
+		k.up[i] = k.ybar[i] + sh*k.b0[i]
+		k.um[i] = k.ybar[i] - sh*k.b0[i]
+	}
+	k.p.Drift(k.abar, t1, k.ybar)
+	k.d.vec(k.bp, t1, k.up)
+	k.d.vec(k.bm, t1, k.um)
+	for i, v := range k.up {
+		k.tmp[i] = v + sh*k.bp[i]
+	}
+	k.d.vec(k.bpp, t1, k.tmp)
+	for i, v := range k.up {
+		k.tmp[i] = v - sh*k.bp[i]
+	}
+	k.d.vec(k.bpm, t1, k.tmp)
+
+	// Deterministic part of order 2 and the diffusion terms.
+	for i, v := range y {
+		w, z := k.d.noise(i, dw, dz)
+		bd := k.bp[i] - k.bm[i]
+		ynew[i] = v + h/2*(k.a0[i]+k.abar[i]) +
+			k.b0[i]*w +
+			bd/(4*sh)*(w*w-h) +
+			(k.bp[i]-2*k.b0[i]+k.bm[i])/(2*h)*(w*h-z) +
+			(k.bpp[i]-k.bpm[i]-bd)/(4*h)*(w*w/3-h)*w
+	}
+
+	// Drift terms of the noise, perturbing the state in the directions
+	// of the noise.
+	if !k.d.diag {
+		k.p.Drift(k.ap, t1, k.up)
+		k.p.Drift(k.am, t1, k.um)
+		k.addDriftNoise(ynew, h, dz[0])
+		return
+	}
+	for j, bj := range k.b0 {
+		if bj == 0 {
+			continue
+		}
+		copy(k.tmp, k.ybar)
+		k.tmp[j] += sh * bj
+		k.p.Drift(k.ap, t1, k.tmp)
+		k.tmp[j] = k.ybar[j] - sh*bj
+		k.p.Drift(k.am, t1, k.tmp)
+		k.addDriftNoise(ynew, h, dz[j])
+	}
+}
+
+// addDriftNoise adds the terms of the drift evaluated at the perturbed
+// states in k.ap and k.am to ynew.
+func (k *KloedenPlaten) addDriftNoise(ynew []float64, h, z float64) {
+	sh := math.Sqrt(h)
+	for i := range ynew {
+		ynew[i] += h/4*(k.ap[i]-2*k.abar[i]+k.am[i]) + (k.ap[i]-k.am[i])/(2*sh)*z
+	}
+}
+
+// stepGeneral advances the solution by one step for general noise.
+func (k *KloedenPlaten) stepGeneral(ynew []float64, t, h float64, y, dw, dz []float64) {
+	sh := math.Sqrt(h)
+	t1 := t + h
+	k.p.Drift(k.a0, t, y)
+	k.p.Diffusion(k.gb0, t, y)
+
+	// The supporting values are
+	//  Ȳ = Y + a h,  Υ±^j = Ȳ ± b^j sqrt(h),  Φ±^(j1,j2) = Υ+^j1 ± b^j2(Υ+^j1) sqrt(h),
+	// evaluated at t+h.
+	for i, v := range y {
+		k.ybar[i] = v + h*k.a0[i]
+	}
+	k.p.Drift(k.abar, t1, k.ybar)
+	k.p.Diffusion(k.gbbar, t1, k.ybar)
+	for i, v := range y {
+		ynew[i] = v + h/2*(k.a0[i]+k.abar[i]) + dotRow(k.gb0, i, dw)
+	}
+	for j := range dw {
+		for i, v := range k.ybar {
+			k.yp[j][i] = v + sh*k.gb0.At(i, j)
+			k.ym[j][i] = v - sh*k.gb0.At(i, j)
+		}
+		k.p.Diffusion(k.gbp[j], t1, k.yp[j])
+		k.p.Diffusion(k.gbm[j], t1, k.ym[j])
+		k.p.Drift(k.ap, t1, k.yp[j])
+		k.p.Drift(k.am, t1, k.ym[j])
+		k.addDriftNoise(ynew, h, dz[j])
+	}
+
+	// Terms of the double integrals I_(0,j2) and I_(j1,j2). The
+	// coefficient of I_(0,j2) is L^0 b^j2, which is approximated by
+	//  (b^j2(Ȳ) - b^j2) / h + Σ_j1 (b^j2(Υ+^j1) - 2 b^j2(Ȳ) + b^j2(Υ-^j1)) / (2 h).
+	for j2, w := range dw {
+		i0 := w*h - dz[j2]
+		for i := range ynew {
+			ynew[i] += (k.gbbar.At(i, j2) - k.gb0.At(i, j2)) / h * i0
+		}
+	}
+	for j1 := range dw {
+		bp, bm := k.gbp[j1], k.gbm[j1]
+		for j2, w := range dw {
+			i0 := w*h - dz[j2]
+			iw := itoIntegral2(dw, dz, h, j1, j2)
+			for i := range ynew {
+				p, m := bp.At(i, j2), bm.At(i, j2)
+				ynew[i] += (p-m)/(2*sh)*iw + (p-2*k.gbbar.At(i, j2)+m)/(2*h)*i0
+			}
+		}
+	}
+
+	// Terms of the triple integrals I_(j1,j2,j3), with coefficients
+	// L^j1 L^j2 b^j3 approximated by
+	//  (b^j3(Φ+^(j1,j2)) - b^j3(Φ-^(j1,j2)) - b^j3(Υ+^j2) + b^j3(Υ-^j2)) / (2 h).
+	for j1 := range dw {
+		for j2 := range dw {
+			for i, v := range k.yp[j1] {
+				k.up[i] = v + sh*k.gbp[j1].At(i, j2)
+				k.um[i] = v - sh*k.gbp[j1].At(i, j2)
+			}
+			k.p.Diffusion(k.gbpp, t1, k.up)
+			k.p.Diffusion(k.gbpm, t1, k.um)
+			for j3 := range dw {
+				iw := itoIntegral3(dw, h, j1, j2, j3)
+				for i := range ynew {
+					c := k.gbpp.At(i, j3) - k.gbpm.At(i, j3) - k.gbp[j2].At(i, j3) + k.gbm[j2].At(i, j3)
+					ynew[i] += c / (2 * h) * iw
+				}
+			}
+		}
+	}
+}
+
+// dotRow returns the dot product of row i of b with x.
+func dotRow(b *mat.Dense, i int, x []float64) float64 {
+	var s float64
+	for j, v := range x {
+		s += b.At(i, j) * v
+	}
+	return s
+}
+
+// itoIntegral2 returns the double Itô integral
+//  I_(i,j) = ∫_t^(t+h) (W_i(s) - W_i(t)) dW_j(s)
+// over a step of size h with path increments dw and integrals dz. The
+// integral is exact for i == j. For i != j, it is the sum of ΔW_i ΔW_j / 2
+// and the Lévy area A_ij, which is not determined by the increments and
+// integrals and is replaced by its conditional expectation
+//  E[A_ij | ΔW, Z] = H_i ΔW_j - ΔW_i H_j,  H = Z / h - ΔW / 2.
+// The Lévy areas cancel in the methods for commutative noise.
+//
+// The conditional expectation is given in
+//  Foster, J., Lyons, T., Oberhauser, H.: An optimal polynomial
+//  approximation of Brownian motion. SIAM J. Numer. Anal. 58(3),
+//  1393–1421 (2020)
+func itoIntegral2(dw, dz []float64, h float64, i, j int) float64 {
+	if i == j {
+		return (dw[i]*dw[i] - h) / 2
+	}
+	hi := dz[i]/h - dw[i]/2
+	hj := dz[j]/h - dw[j]/2
+	return dw[i]*dw[j]/2 + hi*dw[j] - dw[i]*hj
+}
+
+// itoIntegral3 returns the symmetrized triple Itô integral I_(i,j,k) over a
+// step of size h with path increments dw, which is the mean of the triple
+// integrals over the permutations of (i, j, k),
+//  (ΔW_i ΔW_j ΔW_k - h (δ_ij ΔW_k + δ_ik ΔW_j + δ_jk ΔW_i)) / 6.
+// It is exact for i == j == k.
+func itoIntegral3(dw []float64, h float64, i, j, k int) float64 {
+	v := dw[i] * dw[j] * dw[k]
+	if i == j {
+		v -= h * dw[k]
+	}
+	if i == k {
+		v -= h * dw[j]
+	}
+	if j == k {
+		v -= h * dw[i]
+	}
+	return v / 6
+}
@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import (
+	"runtime"
+	"sync"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Problem is an Itô stochastic differential equation with an initial
+// state.
+//
+// The noise is general if Diffusion is set, and diagonal if DiagDiffusion
+// is set. Exactly one of them must be set.
+type Problem struct {
+	// Drift stores the drift a(t, y) in dst. Drift must not modify y.
+	Drift func(dst []float64, t float64, y []float64)
+
+	// Diffusion stores the n×m diffusion matrix b(t, y) in dst, where n
+	// is the dimension of the state and m is the dimension of the
+	// Wiener process. Diffusion must not modify y.
+	Diffusion func(dst *mat.Dense, t float64, y []float64)
+
+	// DiagDiffusion stores the diagonal of the diffusion matrix b(t, y)
+	// in dst for diagonal noise, where each component of the state is
+	// driven by its own component of the Wiener process. Element i of
+	// the diagonal must depend only on t and y[i]. DiagDiffusion must
+	// not modify y.
+	DiagDiffusion func(dst []float64, t float64, y []float64)
+
+	// T0 and T1 are the start and the end of the integration
+	// interval. T1 must not be less than T0.
+	T0, T1 float64
+
+	// Y0 is the initial state at T0.
+	Y0 []float64
+}
+
+// Method is a method for advancing the solution of a stochastic
+// differential equation by a step.
+type Method interface {
+	// Init initializes the method for the problem p driven by an
+	// m-dimensional Wiener process.
+	Init(p *Problem, m int)
+
+	// Step stores in ynew the approximate solution at t+h from the
+	// state y at t, where dw is the increment of the Wiener process
+	// over the step and dz is the integral of W(s) - W(t) over the
+	// step.
+	Step(ynew []float64, t, h float64, y, dw, dz []float64)
+}
+
+// Solution is the solution of a stochastic differential equation along a
+// sample path.
+type Solution struct {
+	// T and Y are the times and the states at the steps, starting
+	// with the initial state.
+	T []float64
+	Y [][]float64
+}
+
+// Solve integrates the problem p from p.T0 to p.T1 along the path w with
+// the given number of steps of equal size using the method m. If m is nil,
+// EulerMaruyama is used. The path must start at or before p.T0.
+func Solve(p Problem, m Method, w *BrownianPath, steps int) *Solution {
+	if steps <= 0 {
+		panic("sde: non-positive number of steps")
+	}
+	if p.T1 < p.T0 {
+		panic("sde: T1 < T0")
+	}
+	if m == nil {
+		m = &EulerMaruyama{}
+	}
+	m.Init(&p, w.Dim())
+
+	sol := &Solution{
+		T: make([]float64, steps+1),
+		Y: make([][]float64, steps+1),
+	}
+	sol.T[0] = p.T0
+	sol.Y[0] = append([]float64(nil), p.Y0...)
+	dw := make([]float64, w.Dim())
+	dz := make([]float64, w.Dim())
+	h := (p.T1 - p.T0) / float64(steps)
+	for i := 1; i <= steps; i++ {
+		t0 := sol.T[i-1]
+		t1 := p.T0 + float64(i)*h
+		if i == steps {
+			t1 = p.T1
+		}
+		w.Increment(dw, dz, t0, t1)
+		sol.T[i] = t1
+		sol.Y[i] = make([]float64, len(p.Y0))
+		m.Step(sol.Y[i], t0, t1-t0, sol.Y[i-1], dw, dz)
+	}
+	return sol
+}
+
+// EnsembleSettings are the settings of Ensemble.
+type EnsembleSettings struct {
+	// NewMethod returns the method used for a path. If NewMethod is
+	// nil, EulerMaruyama is used.
+	NewMethod func() Method
+
+	// NoiseDim is the dimension of the Wiener process. If NoiseDim is
+	// zero, it is the dimension of the state.
+	NoiseDim int
+
+	// Steps is the number of steps of each path.
+	Steps int
+
+	// NewSource returns the source of randomness of the Brownian path
+	// with the given index. The sources should be independent, for
+	// example generators with different seeds.
+	NewSource func(path int) rand.Source
+
+	// Concurrent is the maximum number of paths that are solved
+	// concurrently. If Concurrent is zero, runtime.GOMAXPROCS(0) is
+	// used.
+	Concurrent int
+}
+
+// Ensemble solves the problem p along the given number of independent
+// sample paths of the Wiener process and calls fn with the index and the
+// solution of each path. The paths are solved concurrently and fn may be
+// called concurrently, so it must be safe for concurrent use, for example
+// by only writing to elements of a slice indexed by the path.
+//
+// The solution of each path only depends on its source, so the results are
+// reproducible regardless of the concurrency.
+func Ensemble(p Problem, paths int, settings *EnsembleSettings, fn func(path int, sol *Solution)) {
+	if settings == nil || settings.NewSource == nil {
+		panic("sde: nil source")
+	}
+	if settings.Concurrent < 0 {
+		panic("sde: negative concurrency")
+	}
+	m := settings.NoiseDim
+	if m == 0 {
+		m = len(p.Y0)
+	}
+	concurrent := settings.Concurrent
+	if concurrent == 0 {
+		concurrent = runtime.GOMAXPROCS(0)
+	}
+	if concurrent > paths {
+		concurrent = paths
+	}
+
+	solve := func(path int) {
+		var method Method
+		if settings.NewMethod != nil {
+			method = settings.NewMethod()
+		}
+		w := NewBrownianPath(m, p.T0, settings.NewSource(path))
+		fn(path, Solve(p, method, w, settings.Steps))
+	}
+
+	tasks := make(chan int)
+	go func() {
+		for i := 0; i < paths; i++ {
+			tasks <- i
+		}
+		close(tasks)
+	}()
+	var wg sync.WaitGroup
+	wg.Add(concurrent)
+	for i := 0; i < concurrent; i++ {
+		go func() {
+			defer wg.Done()
+			for path := range tasks {
+				solve(path)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// diffusion evaluates the diffusion of a problem.
+type diffusion struct {
+	p    *Problem
+	n, m int
+	diag bool
+	b    *mat.Dense
+	v    []float64
+}
+
+func newDiffusion(p *Problem, m int) *diffusion {
+	if p.Drift == nil {
+		panic("sde: nil drift")
+	}
+	if (p.Diffusion == nil) == (p.DiagDiffusion == nil) {
+		panic("sde: exactly one of Diffusion and DiagDiffusion must be set")
+	}
+	n := len(p.Y0)
+	d := &diffusion{p: p, n: n, m: m, diag: p.DiagDiffusion != nil}
+	if d.diag {
+		if m != n {
+			panic("sde: noise dimension mismatch for diagonal noise")
+		}
+		d.v = make([]float64, n)
+	} else {
+		d.b = mat.NewDense(n, m, nil)
+	}
+	return d
+}
+
+// scalar returns whether the noise is diagonal or driven by a single
+// Wiener process, so that it is commutative and the diffusion can be
+// represented by a vector.
+func (d *diffusion) scalar() bool {
+	return d.diag || d.m == 1
+}
+
+// eval evaluates the diffusion at (t, y).
+func (d *diffusion) eval(t float64, y []float64) {
+	if d.diag {
+		d.p.DiagDiffusion(d.v, t, y)
+	} else {
+		d.p.Diffusion(d.b, t, y)
+	}
+}
+
+// vec evaluates the diffusion at (t, y) and stores it as a vector in dst.
+// The noise must be scalar.
+func (d *diffusion) vec(dst []float64, t float64, y []float64) {
+	d.eval(t, y)
+	if d.diag {
+		copy(dst, d.v)
+		return
+	}
+	mat.Col(dst, 0, d.b)
+}
+
+// noise returns the increment and its integral that drive component i of
+// the state for scalar noise.
+func (d *diffusion) noise(i int, dw, dz []float64) (w, z float64) {
+	if d.diag {
+		return dw[i], dz[i]
+	}
+	return dw[0], dz[0]
+}
+
+// addNoise adds b(t, y) dw for the last evaluated diffusion to dst.
+func (d *diffusion) addNoise(dst, dw []float64) {
+	if d.diag {
+		for i, v := range d.v {
+			dst[i] += v * dw[i]
+		}
+		return
+	}
+	for i := 0; i < d.n; i++ {
+		var s float64
+		for j, w := range dw {
+			s += d.b.At(i, j) * w
+		}
+		dst[i] += s
+	}
+}
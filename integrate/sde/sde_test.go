@@ -0,0 +1,399 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sde
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func TestBrownianPath(t *testing.T) {
+	t.Parallel()
+	const (
+		n   = 20000
+		tol = 0.05
+	)
+	// The path is generated at 1 and 2 and then refined at 0.3 and
+	// 0.5, so the values at the refined times are drawn from the
+	// bridge.
+	var sw, sww, sz, szz, swz, sw2 float64
+	dw := make([]float64, 1)
+	dz := make([]float64, 1)
+	for i := 0; i < n; i++ {
+		p := NewBrownianPath(1, 0, rand.NewSource(uint64(i)))
+		p.At(nil, 2)
+		p.At(nil, 1)
+		p.At(nil, 0.3)
+		p.Increment(dw, dz, 0, 0.5)
+		sw += dw[0]
+		sww += dw[0] * dw[0]
+		sz += dz[0]
+		szz += dz[0] * dz[0]
+		swz += dw[0] * dz[0]
+
+		p.Increment(dw, nil, 0.3, 1)
+		sw2 += dw[0] * dw[0]
+	}
+	const h = 0.5
+	for _, test := range []struct {
+		name      string
+		got, want float64
+		scale     float64
+	}{
+		{name: "E[W]", got: sw / n, want: 0, scale: math.Sqrt(h)},
+		{name: "E[Z]", got: sz / n, want: 0, scale: math.Sqrt(h * h * h / 3)},
+		{name: "Var[W]", got: sww / n, want: h, scale: h},
+		{name: "Var[Z]", got: szz / n, want: h * h * h / 3, scale: h * h * h / 3},
+		{name: "Cov[W,Z]", got: swz / n, want: h * h / 2, scale: h * h / 2},
+		{name: "Var[W(1)-W(0.3)]", got: sw2 / n, want: 0.7, scale: 0.7},
+	} {
+		if math.Abs(test.got-test.want) > tol*test.scale {
+			t.Errorf("unexpected %s: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestBrownianPathConsistent(t *testing.T) {
+	t.Parallel()
+	p := NewBrownianPath(2, 1, rand.NewSource(1))
+	times := []float64{1.5, 3, 2, 1.25, 2.5, 1.75, 1}
+	for _, tm := range times {
+		p.At(nil, tm)
+	}
+	// Increments and integrals must be additive over adjacent
+	// intervals.
+	dw := make([]float64, 2)
+	dz := make([]float64, 2)
+	dw1 := make([]float64, 2)
+	dz1 := make([]float64, 2)
+	dw2 := make([]float64, 2)
+	dz2 := make([]float64, 2)
+	p.Increment(dw, dz, 1.25, 2.5)
+	p.Increment(dw1, dz1, 1.25, 1.75)
+	p.Increment(dw2, dz2, 1.75, 2.5)
+	for j := range dw {
+		if math.Abs(dw[j]-(dw1[j]+dw2[j])) > 1e-14 {
+			t.Errorf("increment not additive: %v != %v + %v", dw[j], dw1[j], dw2[j])
+		}
+		want := dz1[j] + dz2[j] + dw1[j]*(2.5-1.75)
+		if math.Abs(dz[j]-want) > 1e-14 {
+			t.Errorf("integral not additive: %v != %v", dz[j], want)
+		}
+	}
+	w0 := p.At(nil, 1)
+	if w0[0] != 0 || w0[1] != 0 {
+		t.Errorf("path does not start at zero: %v", w0)
+	}
+
+	// A path with the same source sampled at the same times is the
+	// same.
+	q := NewBrownianPath(2, 1, rand.NewSource(1))
+	for _, tm := range times {
+		if !floatsEqual(p.At(nil, tm), q.At(nil, tm)) {
+			t.Errorf("path not reproducible at %v", tm)
+		}
+	}
+}
+
+func floatsEqual(a, b []float64) bool {
+	for i, v := range a {
+		if b[i] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// gbm returns geometric Brownian motion dX = μ X dt + σ X dW and its
+// exact solution for the value W(T) of the Wiener process.
+func gbm() (Problem, func(w []float64) float64) {
+	const (
+		mu    = 1.5
+		sigma = 1.0
+		x0    = 1
+		t1    = 1
+	)
+	p := Problem{
+		Drift: func(dst []float64, _ float64, y []float64) {
+			dst[0] = mu * y[0]
+		},
+		DiagDiffusion: func(dst []float64, _ float64, y []float64) {
+			dst[0] = sigma * y[0]
+		},
+		T1: t1,
+		Y0: []float64{x0},
+	}
+	exact := func(w []float64) float64 {
+		return x0 * math.Exp((mu-sigma*sigma/2)*t1+sigma*w[0])
+	}
+	return p, exact
+}
+
+// gbm2 returns geometric Brownian motion driven by a two-dimensional
+// Wiener process, dX = μ X dt + σ1 X dW1 + σ2 X dW2, which has commutative
+// noise of the second kind, and its exact solution for the value W(T) of
+// the Wiener process.
+func gbm2() (Problem, func(w []float64) float64) {
+	const (
+		mu     = 1.0
+		sigma1 = 0.8
+		sigma2 = 0.6
+		x0     = 1
+		t1     = 1
+	)
+	p := Problem{
+		Drift: func(dst []float64, _ float64, y []float64) {
+			dst[0] = mu * y[0]
+		},
+		Diffusion: func(dst *mat.Dense, _ float64, y []float64) {
+			dst.Set(0, 0, sigma1*y[0])
+			dst.Set(0, 1, sigma2*y[0])
+		},
+		T1: t1,
+		Y0: []float64{x0},
+	}
+	exact := func(w []float64) float64 {
+		return x0 * math.Exp((mu-(sigma1*sigma1+sigma2*sigma2)/2)*t1+sigma1*w[0]+sigma2*w[1])
+	}
+	return p, exact
+}
+
+func TestStrongOrder(t *testing.T) {
+	t.Parallel()
+	const paths = 200
+	steps := []int{32, 64, 128, 256, 512}
+	for _, test := range []struct {
+		name    string
+		problem func() (Problem, func(w []float64) float64)
+		dim     int
+		method  func() Method
+		order   float64
+	}{
+		{name: "EulerMaruyama", problem: gbm, dim: 1, method: func() Method { return &EulerMaruyama{} }, order: 0.5},
+		{name: "Milstein", problem: gbm, dim: 1, method: func() Method { return &Milstein{} }, order: 1},
+		{name: "KloedenPlaten", problem: gbm, dim: 1, method: func() Method { return &KloedenPlaten{} }, order: 1.5},
+		{name: "EulerMaruyama general", problem: gbm2, dim: 2, method: func() Method { return &EulerMaruyama{} }, order: 0.5},
+		{name: "Milstein general", problem: gbm2, dim: 2, method: func() Method { return &Milstein{} }, order: 1},
+		{name: "KloedenPlaten general", problem: gbm2, dim: 2, method: func() Method { return &KloedenPlaten{} }, order: 1.5},
+	} {
+		p, exact := test.problem()
+		errs := make([]float64, len(steps))
+		for i := 0; i < paths; i++ {
+			w := NewBrownianPath(test.dim, 0, rand.NewSource(uint64(i)))
+			want := exact(w.At(nil, p.T1))
+			for k, n := range steps {
+				sol := Solve(p, test.method(), w, n)
+				errs[k] += math.Abs(sol.Y[n][0] - want)
+			}
+		}
+		// Least squares slope of the log error.
+		var sx, sy, sxx, sxy float64
+		for k, n := range steps {
+			x := math.Log(p.T1 / float64(n))
+			y := math.Log(errs[k] / paths)
+			sx += x
+			sy += y
+			sxx += x * x
+			sxy += x * y
+		}
+		nk := float64(len(steps))
+		order := (nk*sxy - sx*sy) / (nk*sxx - sx*sx)
+		if math.Abs(order-test.order) > 0.15 {
+			t.Errorf("unexpected strong order of %s: got %.3f, want %v", test.name, order, test.order)
+		}
+	}
+}
+
+func TestItoIntegral2(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 2000
+		fine = 500
+		h    = 0.5
+		tol  = 0.1
+	)
+	// The mean squared error of the double integral with the Lévy area
+	// replaced by its conditional expectation is the variance h^2/12 of
+	// the remaining part of the area, compared to the variance h^2/4 of
+	// the area itself.
+	dw := make([]float64, 2)
+	dz := make([]float64, 2)
+	prev := make([]float64, 2)
+	var mse, area float64
+	for i := 0; i < n; i++ {
+		p := NewBrownianPath(2, 0, rand.NewSource(uint64(i)))
+		var want float64
+		for k := 1; k <= fine; k++ {
+			p.At(prev, h*float64(k-1)/fine)
+			w := p.At(nil, h*float64(k)/fine)
+			want += prev[0] * (w[1] - prev[1])
+		}
+		p.Increment(dw, dz, 0, h)
+		got := itoIntegral2(dw, dz, h, 0, 1)
+		mse += (got - want) * (got - want)
+		sym := dw[0] * dw[1] / 2
+		area += (sym - want) * (sym - want)
+	}
+	mse /= n
+	area /= n
+	// The discretization of the reference integral adds h^2/(2 fine)
+	// to both errors.
+	disc := h * h / (2 * fine)
+	if math.Abs(mse-h*h/12-disc) > tol*h*h/12 {
+		t.Errorf("unexpected mean squared error: got %v, want %v", mse, h*h/12+disc)
+	}
+	if math.Abs(area-h*h/4-disc) > tol*h*h/4 {
+		t.Errorf("unexpected variance of Lévy area: got %v, want %v", area, h*h/4+disc)
+	}
+}
+
+func TestItoIntegral3(t *testing.T) {
+	t.Parallel()
+	dw := []float64{0.3, -1.2, 0.7}
+	const h = 0.25
+	// The symmetrized integrals sum over the permutations of the
+	// indices to the sums of the triple integrals, which are given by
+	// products of the increments.
+	for _, test := range []struct {
+		i, j, k int
+		sum     float64
+	}{
+		{i: 0, j: 0, k: 0, sum: 6 * (dw[0]*dw[0]/3 - h) * dw[0] / 2},
+		{i: 0, j: 0, k: 1, sum: 2 * (dw[0]*dw[0] - h) * dw[1] / 2},
+		{i: 0, j: 1, k: 2, sum: dw[0] * dw[1] * dw[2]},
+	} {
+		var got float64
+		for _, perm := range [][3]int{
+			{test.i, test.j, test.k}, {test.i, test.k, test.j},
+			{test.j, test.i, test.k}, {test.j, test.k, test.i},
+			{test.k, test.i, test.j}, {test.k, test.j, test.i},
+		} {
+			got += itoIntegral3(dw, h, perm[0], perm[1], perm[2])
+		}
+		if math.Abs(got-test.sum) > 1e-14 {
+			t.Errorf("unexpected sum for (%d,%d,%d): got %v, want %v", test.i, test.j, test.k, got, test.sum)
+		}
+	}
+}
+
+func TestKloedenPlatenIntegral(t *testing.T) {
+	t.Parallel()
+	// For dX1 = dW and dX2 = X1 dt, the method is exact and X2 is the
+	// integral of the path.
+	p := Problem{
+		Drift: func(dst []float64, _ float64, y []float64) {
+			dst[0] = 0
+			dst[1] = y[0]
+		},
+		Diffusion: func(dst *mat.Dense, _ float64, _ []float64) {
+			dst.Set(0, 0, 1)
+			dst.Set(1, 0, 0)
+		},
+		T0: 0.5,
+		T1: 2,
+		Y0: []float64{0, 0},
+	}
+	w := NewBrownianPath(1, 0, rand.NewSource(1))
+	for _, n := range []int{1, 3, 10} {
+		sol := Solve(p, &KloedenPlaten{}, w, n)
+		dw := make([]float64, 1)
+		dz := make([]float64, 1)
+		w.Increment(dw, dz, p.T0, p.T1)
+		got := sol.Y[n]
+		if math.Abs(got[0]-dw[0]) > 1e-14 || math.Abs(got[1]-dz[0]) > 1e-14 {
+			t.Errorf("unexpected result for %d steps: got %v, want [%v %v]", n, got, dw[0], dz[0])
+		}
+	}
+}
+
+func TestGeneralNoise(t *testing.T) {
+	t.Parallel()
+	// A diagonal problem written with general noise must give the same
+	// result.
+	drift := func(dst []float64, _ float64, y []float64) {
+		dst[0] = -y[0]
+		dst[1] = y[0] - 2*y[1]
+	}
+	diag := Problem{
+		Drift: drift,
+		DiagDiffusion: func(dst []float64, _ float64, y []float64) {
+			dst[0] = 0.5 * y[0]
+			dst[1] = 0.2
+		},
+		T1: 1,
+		Y0: []float64{1, 2},
+	}
+	general := diag
+	general.DiagDiffusion = nil
+	general.Diffusion = func(dst *mat.Dense, _ float64, y []float64) {
+		dst.Zero()
+		dst.Set(0, 0, 0.5*y[0])
+		dst.Set(1, 1, 0.2)
+	}
+	// The Milstein and Kloeden–Platen methods for general noise include
+	// terms for the derivatives of each column of the diffusion along the
+	// other columns. The finite differences that approximate these terms
+	// vanish only in the limit for diagonal noise, so the results differ
+	// from those of the methods for diagonal noise by higher order terms.
+	for _, test := range []struct {
+		method func() Method
+		tol    float64
+	}{
+		{method: func() Method { return &EulerMaruyama{} }, tol: 0},
+		{method: func() Method { return &Milstein{} }, tol: 5e-3},
+		{method: func() Method { return &KloedenPlaten{} }, tol: 1e-4},
+	} {
+		a := Solve(diag, test.method(), NewBrownianPath(2, 0, rand.NewSource(1)), 50)
+		b := Solve(general, test.method(), NewBrownianPath(2, 0, rand.NewSource(1)), 50)
+		for i := range a.Y {
+			for j, v := range a.Y[i] {
+				if math.Abs(v-b.Y[i][j]) > test.tol {
+					t.Errorf("mismatch for %T at step %d: %v != %v", test.method(), i, a.Y[i], b.Y[i])
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestEnsemble(t *testing.T) {
+	t.Parallel()
+	const paths = 100
+	p, _ := gbm()
+	run := func(concurrent int) []float64 {
+		y := make([]float64, paths)
+		Ensemble(p, paths, &EnsembleSettings{
+			NewMethod:  func() Method { return &Milstein{} },
+			Steps:      20,
+			NewSource:  func(path int) rand.Source { return rand.NewSource(uint64(path)) },
+			Concurrent: concurrent,
+		}, func(path int, sol *Solution) {
+			y[path] = sol.Y[len(sol.Y)-1][0]
+		})
+		return y
+	}
+	want := run(1)
+	for _, concurrent := range []int{0, 3, 2 * paths} {
+		got := run(concurrent)
+		if !floatsEqual(got, want) {
+			t.Errorf("result depends on concurrency %d", concurrent)
+		}
+	}
+	if !panics(func() { Ensemble(p, paths, &EnsembleSettings{Steps: 1}, func(int, *Solution) {}) }) {
+		t.Errorf("expected panic for nil source")
+	}
+}
+
+func panics(f func()) (ok bool) {
+	defer func() {
+		ok = recover() != nil
+	}()
+	f()
+	return false
+}
@@ -0,0 +1,319 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"errors"
+	"sort"
+)
+
+// BSpline is a piecewise polynomial 1-dimensional interpolator of arbitrary
+// degree represented as a linear combination of B-splines
+//  s(x) = \sum_i c_i B_{i,k}(x)
+// where B_{i,k} is the B-spline of degree k on the knots t_i, ..., t_{i+k+1}.
+// A spline with n coefficients is defined on the base interval
+// [t_k, t_n], and outside it the polynomials of the first and last
+// intervals are extended.
+//
+// The zero value of BSpline can be fitted to data with Fit. A spline with
+// given knots and coefficients is constructed with NewBSpline.
+type BSpline struct {
+	// Degree is the degree of the spline fitted by Fit. If Degree is
+	// zero, a default of 3 is used.
+	Degree int
+
+	degree int
+	knots  []float64
+	coeffs []float64
+
+	// Coefficients of the first derivative on knots[1:len(knots)-1].
+	dcoeffs []float64
+}
+
+// NewBSpline returns a B-spline of the given degree with the given knots and
+// coefficients. NewBSpline panics if degree is negative, if the number of
+// knots is not len(coeffs)+degree+1, if len(coeffs) <= degree, if the knots
+// are not non-decreasing, or if the base interval is empty.
+func NewBSpline(degree int, knots, coeffs []float64) *BSpline {
+	if degree < 0 {
+		panic("interp: negative B-spline degree")
+	}
+	n := len(coeffs)
+	if n <= degree {
+		panic(tooFewPoints)
+	}
+	if len(knots) != n+degree+1 {
+		panic(differentLengths)
+	}
+	for i := 1; i < len(knots); i++ {
+		if knots[i] < knots[i-1] {
+			panic("interp: knots not non-decreasing")
+		}
+	}
+	if knots[degree] == knots[n] {
+		panic("interp: empty B-spline base interval")
+	}
+	b := &BSpline{
+		Degree: degree,
+		knots:  make([]float64, len(knots)),
+		coeffs: make([]float64, n),
+	}
+	copy(b.knots, knots)
+	copy(b.coeffs, coeffs)
+	b.init(degree)
+	return b
+}
+
+// init sets the degree and computes the coefficients of the derivative.
+func (b *BSpline) init(degree int) {
+	b.degree = degree
+	b.dcoeffs = derivativeCoeffs(b.knots, b.coeffs, degree)
+}
+
+// Knots returns a copy of the knots of the spline.
+func (b *BSpline) Knots() []float64 {
+	return append([]float64(nil), b.knots...)
+}
+
+// Coefficients returns a copy of the B-spline coefficients of the spline.
+func (b *BSpline) Coefficients() []float64 {
+	return append([]float64(nil), b.coeffs...)
+}
+
+// Fit fits an interpolating spline of degree Degree to (X, Y) value pairs
+// provided as two slices. The knots are chosen with the not-a-knot
+// condition: for odd degrees, the knots are the data locations without the
+// first and last (Degree+1)/2 interior locations, and for even degrees, they
+// are the midpoints between the data locations without the first and last
+// Degree/2, with Degree+1 repeated knots at the ends of the data interval.
+// For degree 3, this is the same interpolant as NotAKnotCubic.
+//
+// Fit panics if Degree is negative, if len(xs) <= Degree, if elements of xs
+// are not strictly increasing or if len(xs) != len(ys). It returns an error
+// if solving the required system of linear equations fails.
+func (b *BSpline) Fit(xs, ys []float64) error {
+	k := b.Degree
+	if k < 0 {
+		panic("interp: negative B-spline degree")
+	}
+	if k == 0 {
+		k = 3
+	}
+	n := len(xs)
+	if len(ys) != n {
+		panic(differentLengths)
+	}
+	if n <= k || n < 2 {
+		panic(tooFewPoints)
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] <= xs[i-1] {
+			panic(xsNotStrictlyIncreasing)
+		}
+	}
+
+	knots := make([]float64, 0, n+k+1)
+	for i := 0; i <= k; i++ {
+		knots = append(knots, xs[0])
+	}
+	if k%2 == 1 {
+		h := (k + 1) / 2
+		knots = append(knots, xs[h:n-h]...)
+	} else {
+		h := k / 2
+		for i := h; i < n-1-h; i++ {
+			knots = append(knots, (xs[i]+xs[i+1])/2)
+		}
+	}
+	for i := 0; i <= k; i++ {
+		knots = append(knots, xs[n-1])
+	}
+
+	// The collocation matrix is banded with k diagonals on each side of
+	// the main diagonal and totally positive, so it can be factorized
+	// without pivoting.
+	w := 2*k + 1
+	band := make([]float64, n*w)
+	basis := make([]float64, k+1)
+	for i, x := range xs {
+		l := knotInterval(knots, k, n, x)
+		bsplineBasis(basis, knots, k, l, x)
+		for r, v := range basis {
+			j := l - k + r
+			if j-i < -k || k < j-i {
+				if v != 0 {
+					return errors.New("interp: invalid B-spline collocation")
+				}
+				continue
+			}
+			band[i*w+j-i+k] = v
+		}
+	}
+	coeffs := make([]float64, n)
+	copy(coeffs, ys)
+	if !bandSolveNoPivot(band, n, k, coeffs) {
+		return errors.New("interp: singular B-spline collocation matrix")
+	}
+
+	b.knots = knots
+	b.coeffs = coeffs
+	b.init(k)
+	return nil
+}
+
+// Predict returns the value of the spline at x.
+func (b *BSpline) Predict(x float64) float64 {
+	return deBoor(b.knots, b.coeffs, b.degree, x)
+}
+
+// PredictDerivative returns the derivative of the spline at x.
+func (b *BSpline) PredictDerivative(x float64) float64 {
+	if b.degree == 0 {
+		return 0
+	}
+	return deBoor(b.knots[1:len(b.knots)-1], b.dcoeffs, b.degree-1, x)
+}
+
+// Derivative returns the spline of degree max(k-order, 0) that is the
+// derivative of the given order of the spline of degree k. It panics if order
+// is negative.
+func (b *BSpline) Derivative(order int) *BSpline {
+	if order < 0 {
+		panic("interp: negative derivative order")
+	}
+	knots := b.knots
+	coeffs := b.coeffs
+	k := b.degree
+	for ; order > 0; order-- {
+		if k == 0 {
+			coeffs = make([]float64, len(coeffs))
+			break
+		}
+		coeffs = derivativeCoeffs(knots, coeffs, k)
+		knots = knots[1 : len(knots)-1]
+		k--
+	}
+	return NewBSpline(k, knots, coeffs)
+}
+
+// Integrate returns the integral of the spline from from to to. Outside the base
+// interval, the polynomials of the first and last intervals are integrated.
+func (b *BSpline) Integrate(from, to float64) float64 {
+	// The antiderivative is a spline of degree k+1 on the knots with
+	// an extra knot at each end.
+	k := b.degree
+	n := len(b.coeffs)
+	knots := make([]float64, 0, len(b.knots)+2)
+	knots = append(knots, b.knots[0])
+	knots = append(knots, b.knots...)
+	knots = append(knots, b.knots[len(b.knots)-1])
+	coeffs := make([]float64, n+1)
+	for i, c := range b.coeffs {
+		coeffs[i+1] = coeffs[i] + c*(b.knots[i+k+1]-b.knots[i])/float64(k+1)
+	}
+	return deBoor(knots, coeffs, k+1, to) - deBoor(knots, coeffs, k+1, from)
+}
+
+// derivativeCoeffs returns the B-spline coefficients of the derivative of the
+// spline of degree k > 0 with the given knots and coefficients. The derivative
+// is a spline of degree k-1 on knots[1:len(knots)-1].
+func derivativeCoeffs(knots, coeffs []float64, k int) []float64 {
+	if k == 0 {
+		return nil
+	}
+	d := make([]float64, len(coeffs)-1)
+	for i := range d {
+		dt := knots[i+k+1] - knots[i+1]
+		if dt > 0 {
+			d[i] = float64(k) * (coeffs[i+1] - coeffs[i]) / dt
+		}
+	}
+	return d
+}
+
+// knotInterval returns the index k <= l < n of the knot interval
+// [knots[l], knots[l+1]) containing x, where n is the number of coefficients.
+// Values of x outside the base interval are assigned to the first or last
+// non-empty interval.
+func knotInterval(knots []float64, k, n int, x float64) int {
+	l := sort.Search(len(knots), func(i int) bool { return knots[i] > x }) - 1
+	if l < k {
+		l = k
+	}
+	if l > n-1 {
+		l = n - 1
+	}
+	for l > k && knots[l] == knots[l+1] {
+		l--
+	}
+	return l
+}
+
+// deBoor returns the value at x of the spline of degree k with the given
+// knots and coefficients using de Boor's algorithm.
+func deBoor(knots, coeffs []float64, k int, x float64) float64 {
+	l := knotInterval(knots, k, len(coeffs), x)
+	d := make([]float64, k+1)
+	copy(d, coeffs[l-k:l+1])
+	for r := 1; r <= k; r++ {
+		for j := k; j >= r; j-- {
+			i := j + l - k
+			alpha := (x - knots[i]) / (knots[i+k+1-r] - knots[i])
+			d[j] = (1-alpha)*d[j-1] + alpha*d[j]
+		}
+	}
+	return d[k]
+}
+
+// bsplineBasis stores in dst the values at x of the k+1 B-splines of degree k
+// that are non-zero in the knot interval l, B_{l-k,k}, ..., B_{l,k}.
+func bsplineBasis(dst, knots []float64, k, l int, x float64) {
+	left := make([]float64, k+1)
+	right := make([]float64, k+1)
+	dst[0] = 1
+	for j := 1; j <= k; j++ {
+		left[j] = x - knots[l+1-j]
+		right[j] = knots[l+j] - x
+		var saved float64
+		for r := 0; r < j; r++ {
+			tmp := dst[r] / (right[r+1] + left[j-r])
+			dst[r] = saved + right[r+1]*tmp
+			saved = left[j-r] * tmp
+		}
+		dst[j] = saved
+	}
+}
+
+// bandSolveNoPivot solves the n×n system with k sub- and super-diagonals
+// stored row-wise in band, with the element (i, j) at band[i*(2k+1)+j-i+k],
+// by Gaussian elimination without pivoting, overwriting b with the solution.
+// It returns false if a zero pivot is encountered.
+func bandSolveNoPivot(band []float64, n, k int, b []float64) bool {
+	w := 2*k + 1
+	for p := 0; p < n; p++ {
+		piv := band[p*w+k]
+		if piv == 0 {
+			return false
+		}
+		for r := p + 1; r <= p+k && r < n; r++ {
+			f := band[r*w+p-r+k] / piv
+			if f == 0 {
+				continue
+			}
+			for c := p; c <= p+k && c < n; c++ {
+				band[r*w+c-r+k] -= f * band[p*w+c-p+k]
+			}
+			b[r] -= f * b[p]
+		}
+	}
+	for p := n - 1; p >= 0; p-- {
+		s := b[p]
+		for c := p + 1; c <= p+k && c < n; c++ {
+			s -= band[p*w+c-p+k] * b[c]
+		}
+		b[p] = s / band[p*w+k]
+	}
+	return true
+}
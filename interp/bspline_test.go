@@ -0,0 +1,177 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+)
+
+var (
+	_ FittablePredictor   = (*BSpline)(nil)
+	_ DerivativePredictor = (*BSpline)(nil)
+	_ FittablePredictor   = (*NaturalCubic)(nil)
+	_ DerivativePredictor = (*NaturalCubic)(nil)
+	_ FittablePredictor   = (*ClampedCubic)(nil)
+	_ DerivativePredictor = (*ClampedCubic)(nil)
+	_ FittablePredictor   = (*NotAKnotCubic)(nil)
+	_ DerivativePredictor = (*NotAKnotCubic)(nil)
+	_ FittablePredictor   = (*SmoothingSpline)(nil)
+	_ DerivativePredictor = (*SmoothingSpline)(nil)
+)
+
+// poly returns the value at x of the polynomial with the given coefficients
+// in increasing order of power.
+func poly(c []float64, x float64) float64 {
+	var v float64
+	for i := len(c) - 1; i >= 0; i-- {
+		v = v*x + c[i]
+	}
+	return v
+}
+
+func TestBSplineFitPolynomial(t *testing.T) {
+	t.Parallel()
+	c := []float64{0.5, -1, 2, 0.3, -0.2, 0.1}
+	xs := []float64{-1, -0.5, 0, 0.2, 0.9, 1.3, 2, 2.1, 3}
+	testXs := []float64{-1, -0.8, 0.1, 0.5, 1, 1.7, 2.5, 3}
+	for k := 1; k <= 5; k++ {
+		// Splines of degree k reproduce polynomials of degree k.
+		p := c[:k+1]
+		f := func(x float64) float64 { return poly(p, x) }
+		dp := make([]float64, k)
+		for i := range dp {
+			dp[i] = float64(i+1) * p[i+1]
+		}
+		df := func(x float64) float64 { return poly(dp, x) }
+		d2p := make([]float64, k)
+		for i := 1; i < k; i++ {
+			d2p[i-1] = float64(i) * dp[i]
+		}
+
+		b := BSpline{Degree: k}
+		if err := b.Fit(xs, applyFunc(xs, f)); err != nil {
+			t.Fatalf("degree %d: unexpected error: %v", k, err)
+		}
+		if got := len(b.Knots()); got != len(xs)+k+1 {
+			t.Errorf("degree %d: unexpected number of knots: got %d, want %d", k, got, len(xs)+k+1)
+		}
+		for _, x := range testXs {
+			if got, want := b.Predict(x), f(x); !scalar.EqualWithinAbs(got, want, 1e-12) {
+				t.Errorf("degree %d: unexpected value at %v: got %v, want %v", k, x, got, want)
+			}
+			if got, want := b.PredictDerivative(x), df(x); !scalar.EqualWithinAbs(got, want, 1e-11) {
+				t.Errorf("degree %d: unexpected derivative at %v: got %v, want %v", k, x, got, want)
+			}
+			if got, want := b.Derivative(2).Predict(x), poly(d2p, x); !scalar.EqualWithinAbs(got, want, 1e-10) {
+				t.Errorf("degree %d: unexpected second derivative at %v: got %v, want %v", k, x, got, want)
+			}
+		}
+		if got := b.Derivative(k + 1).Predict(0.3); got != 0 {
+			t.Errorf("degree %d: unexpected derivative of order %d: %v", k, k+1, got)
+		}
+
+		// Integrate the polynomial, including beyond the data.
+		ip := make([]float64, k+2)
+		for i, v := range p {
+			ip[i+1] = v / float64(i+1)
+		}
+		for _, ab := range [][2]float64{{-1, 3}, {0.1, 0.2}, {2.5, -0.5}, {-2, 4}} {
+			got := b.Integrate(ab[0], ab[1])
+			want := poly(ip, ab[1]) - poly(ip, ab[0])
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-11, 1e-11) {
+				t.Errorf("degree %d: unexpected integral over %v: got %v, want %v", k, ab, got, want)
+			}
+		}
+	}
+}
+
+func TestBSplineNotAKnot(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0, 1, 1.5, 3, 4, 4.5, 6}
+	ys := []float64{1, -1, 2, 0.5, 0, 3, 1}
+	var b BSpline
+	var nak NotAKnotCubic
+	if err := b.Fit(xs, ys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	nak.Fit(xs, ys)
+	for x := 0.0; x <= 6; x += 0.1 {
+		if got, want := b.Predict(x), nak.Predict(x); !scalar.EqualWithinAbs(got, want, 1e-12) {
+			t.Errorf("unexpected value at %v: got %v, want %v", x, got, want)
+		}
+		if got, want := b.PredictDerivative(x), nak.PredictDerivative(x); !scalar.EqualWithinAbs(got, want, 1e-11) {
+			t.Errorf("unexpected derivative at %v: got %v, want %v", x, got, want)
+		}
+	}
+
+	// Even degrees interpolate the data.
+	b = BSpline{Degree: 4}
+	if err := b.Fit(xs, ys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testInterpolatorPredict(t, &b, xs, ys, 1e-13)
+}
+
+func TestNewBSpline(t *testing.T) {
+	t.Parallel()
+	// A spline on a single interval with repeated end knots is a
+	// polynomial in Bernstein form.
+	c := []float64{1, -2, 3}
+	b := NewBSpline(2, []float64{0, 0, 0, 1, 1, 1}, c)
+	for _, x := range []float64{0, 0.25, 0.5, 1} {
+		want := c[0]*(1-x)*(1-x) + 2*c[1]*x*(1-x) + c[2]*x*x
+		if got := b.Predict(x); !scalar.EqualWithinAbs(got, want, 1e-15) {
+			t.Errorf("unexpected value at %v: got %v, want %v", x, got, want)
+		}
+	}
+
+	// Linear B-splines interpolate the coefficients at the knots.
+	knots := []float64{0, 0, 1, 3, 4, 4}
+	lin := NewBSpline(1, knots, []float64{2, -1, 0, 5})
+	testInterpolatorPredict(t, lin, []float64{0, 1, 3, 4, 2, 3.5}, []float64{2, -1, 0, 5, -0.5, 2.5}, 1e-15)
+	if got := lin.Integrate(0, 4); !scalar.EqualWithinAbs(got, 0.5-1+2.5, 1e-15) {
+		t.Errorf("unexpected integral: got %v, want 2", got)
+	}
+
+	// A degree 0 spline is piecewise constant.
+	pc := NewBSpline(0, []float64{0, 1, 2}, []float64{3, 4})
+	testInterpolatorPredict(t, pc, []float64{0.5, 1, 1.5}, []float64{3, 4, 4}, 0)
+	if got := pc.PredictDerivative(0.5); got != 0 {
+		t.Errorf("unexpected derivative: %v", got)
+	}
+
+	for _, test := range []struct {
+		name   string
+		degree int
+		knots  []float64
+		coeffs []float64
+	}{
+		{"negative degree", -1, []float64{0, 1}, []float64{1}},
+		{"too few coefficients", 2, []float64{0, 0, 1, 1, 1}, []float64{1, 2}},
+		{"wrong number of knots", 1, []float64{0, 1, 2}, []float64{1, 2}},
+		{"decreasing knots", 1, []float64{0, 2, 1, 3}, []float64{1, 2}},
+		{"empty interval", 1, []float64{0, 1, 1, 3}, []float64{1, 2}},
+	} {
+		if !panics(func() { NewBSpline(test.degree, test.knots, test.coeffs) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func TestBSplineFitErrors(t *testing.T) {
+	t.Parallel()
+	testPiecewiseInterpolatorCreation(t, &BSpline{})
+	if !panics(func() { (&BSpline{Degree: 3}).Fit([]float64{0, 1, 2}, []float64{0, 1, 2}) }) {
+		t.Error("expected panic for too few points")
+	}
+	if !panics(func() { (&BSpline{Degree: -1}).Fit([]float64{0, 1, 2}, []float64{0, 1, 2}) }) {
+		t.Error("expected panic for negative degree")
+	}
+	if !panics(func() { (&BSpline{}).Derivative(-1) }) {
+		t.Error("expected panic for negative derivative order")
+	}
+}
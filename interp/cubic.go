@@ -5,8 +5,12 @@
 package interp
 
 import (
+	"errors"
 	"math"
 
+	"github.com/jingcheng-WU/gonum/blas"
+	"github.com/jingcheng-WU/gonum/blas/blas64"
+	"github.com/jingcheng-WU/gonum/lapack/lapack64"
 	"github.com/jingcheng-WU/gonum/mat"
 )
 
@@ -297,3 +301,180 @@ func fritschButlandEdgeDerivative(xs, ys, slopes []float64, leftEdge bool) float
 	}
 	return g
 }
+
+// NaturalCubic is a piecewise cubic 1-dimensional interpolator with
+// continuous value, first and second derivatives, which can be fitted to (X, Y)
+// value pairs without providing derivatives. It uses the boundary conditions
+// Y′′(left end) = Y′′(right end) = 0.
+type NaturalCubic struct {
+	cubic PiecewiseCubic
+}
+
+// Predict returns the interpolation value at x.
+func (nc *NaturalCubic) Predict(x float64) float64 {
+	return nc.cubic.Predict(x)
+}
+
+// PredictDerivative returns the predicted derivative at x.
+func (nc *NaturalCubic) PredictDerivative(x float64) float64 {
+	return nc.cubic.PredictDerivative(x)
+}
+
+// Fit fits a predictor to (X, Y) value pairs provided as two slices.
+// It panics if len(xs) < 2, elements of xs are not strictly increasing
+// or len(xs) != len(ys). It returns an error if solving the required system
+// of linear equations fails.
+func (nc *NaturalCubic) Fit(xs, ys []float64) error {
+	return fitCubicSpline(&nc.cubic, xs, ys, naturalBoundary, 0, 0)
+}
+
+// ClampedCubic is a piecewise cubic 1-dimensional interpolator with
+// continuous value, first and second derivatives, which can be fitted to (X, Y)
+// value pairs without providing derivatives. It uses the boundary conditions
+// Y′(left end) = LeftDerivative and Y′(right end) = RightDerivative, which
+// are zero for the zero value.
+type ClampedCubic struct {
+	// LeftDerivative and RightDerivative are the derivatives
+	// at the ends of the interpolation interval.
+	LeftDerivative, RightDerivative float64
+
+	cubic PiecewiseCubic
+}
+
+// Predict returns the interpolation value at x.
+func (cc *ClampedCubic) Predict(x float64) float64 {
+	return cc.cubic.Predict(x)
+}
+
+// PredictDerivative returns the predicted derivative at x.
+func (cc *ClampedCubic) PredictDerivative(x float64) float64 {
+	return cc.cubic.PredictDerivative(x)
+}
+
+// Fit fits a predictor to (X, Y) value pairs provided as two slices.
+// It panics if len(xs) < 2, elements of xs are not strictly increasing
+// or len(xs) != len(ys). It returns an error if solving the required system
+// of linear equations fails.
+func (cc *ClampedCubic) Fit(xs, ys []float64) error {
+	return fitCubicSpline(&cc.cubic, xs, ys, clampedBoundary, cc.LeftDerivative, cc.RightDerivative)
+}
+
+// NotAKnotCubic is a piecewise cubic 1-dimensional interpolator with
+// continuous value, first and second derivatives, which can be fitted to (X, Y)
+// value pairs without providing derivatives. It imposes the condition that
+// the third derivative of the interpolant is continuous in the first and
+// last interior node, so that the first two and the last two pieces are
+// the same cubic polynomials. With two or three nodes, the interpolant is
+// the linear or quadratic polynomial through them.
+type NotAKnotCubic struct {
+	cubic PiecewiseCubic
+}
+
+// Predict returns the interpolation value at x.
+func (nak *NotAKnotCubic) Predict(x float64) float64 {
+	return nak.cubic.Predict(x)
+}
+
+// PredictDerivative returns the predicted derivative at x.
+func (nak *NotAKnotCubic) PredictDerivative(x float64) float64 {
+	return nak.cubic.PredictDerivative(x)
+}
+
+// Fit fits a predictor to (X, Y) value pairs provided as two slices.
+// It panics if len(xs) < 2, elements of xs are not strictly increasing
+// or len(xs) != len(ys). It returns an error if solving the required system
+// of linear equations fails.
+func (nak *NotAKnotCubic) Fit(xs, ys []float64) error {
+	return fitCubicSpline(&nak.cubic, xs, ys, notAKnotBoundary, 0, 0)
+}
+
+// splineBoundary is the boundary condition of a cubic spline.
+type splineBoundary int
+
+const (
+	naturalBoundary splineBoundary = iota
+	clampedBoundary
+	notAKnotBoundary
+)
+
+// fitCubicSpline fits pc to the (X, Y) value pairs with the derivatives at
+// the nodes chosen so that the second derivative is continuous, subject to
+// the boundary condition. The derivatives at the ends of the interval, left
+// and right, are only used for clampedBoundary.
+func fitCubicSpline(pc *PiecewiseCubic, xs, ys []float64, boundary splineBoundary, left, right float64) error {
+	slopes := calculateSlopes(xs, ys)
+	n := len(xs)
+	dydxs := make([]float64, n)
+
+	if boundary == notAKnotBoundary && n <= 3 {
+		if n == 2 {
+			dydxs[0] = slopes[0]
+			dydxs[1] = slopes[0]
+		} else {
+			// The derivatives of the parabola through the nodes.
+			for k := range dydxs {
+				start, w := derivativeWeights(xs, k)
+				for i, v := range w {
+					dydxs[k] += v * ys[start+i]
+				}
+			}
+		}
+		pc.FitWithDerivatives(xs, ys, dydxs)
+		return nil
+	}
+
+	// Set up the tridiagonal system for the derivatives at the nodes,
+	// where the rows for the interior nodes enforce the continuity of the
+	// second derivative.
+	dl := make([]float64, n-1)
+	d := make([]float64, n)
+	du := make([]float64, n-1)
+	for i := 1; i < n-1; i++ {
+		h0 := xs[i] - xs[i-1]
+		h1 := xs[i+1] - xs[i]
+		dl[i-1] = h1
+		d[i] = 2 * (h0 + h1)
+		du[i] = h0
+		dydxs[i] = 3 * (h1*slopes[i-1] + h0*slopes[i])
+	}
+	switch boundary {
+	default:
+		panic("interp: unknown spline boundary")
+	case naturalBoundary:
+		d[0] = 2
+		du[0] = 1
+		dydxs[0] = 3 * slopes[0]
+		dl[n-2] = 1
+		d[n-1] = 2
+		dydxs[n-1] = 3 * slopes[n-2]
+	case clampedBoundary:
+		d[0] = 1
+		du[0] = 0
+		dydxs[0] = left
+		dl[n-2] = 0
+		d[n-1] = 1
+		dydxs[n-1] = right
+	case notAKnotBoundary:
+		h0 := xs[1] - xs[0]
+		h1 := xs[2] - xs[1]
+		d[0] = h1
+		du[0] = h0 + h1
+		dydxs[0] = ((h0+2*(h0+h1))*h1*slopes[0] + h0*h0*slopes[1]) / (h0 + h1)
+		h0 = xs[n-2] - xs[n-3]
+		h1 = xs[n-1] - xs[n-2]
+		dl[n-2] = h0 + h1
+		d[n-1] = h0
+		dydxs[n-1] = (h1*h1*slopes[n-3] + (2*(h0+h1)+h1)*h0*slopes[n-2]) / (h0 + h1)
+	}
+	ok := lapack64.Gtsv(blas.NoTrans, lapack64.Tridiagonal{N: n, DL: dl, D: d, DU: du}, blas64.General{
+		Rows:   n,
+		Cols:   1,
+		Stride: 1,
+		Data:   dydxs,
+	})
+	if !ok {
+		return errors.New("interp: singular spline system")
+	}
+	pc.FitWithDerivatives(xs, ys, dydxs)
+	return nil
+}
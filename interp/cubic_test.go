@@ -671,3 +671,80 @@ func TestFritschButlandErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestCubicSplines(t *testing.T) {
+	t.Parallel()
+	for _, fp := range []FittablePredictor{&NaturalCubic{}, &ClampedCubic{}, &NotAKnotCubic{}} {
+		testPiecewiseInterpolatorCreation(t, fp)
+	}
+
+	xs := []float64{-1, 0, 0.3, 1, 2.5, 3, 4.2}
+	cubicPoly := func(x float64) float64 { return 1 - 2*x + 0.5*x*x - 0.3*x*x*x }
+	cubicDeriv := func(x float64) float64 { return -2 + x - 0.9*x*x }
+	ys := applyFunc(xs, cubicPoly)
+	testXs := []float64{-1, -0.7, 0.1, 0.5, 1.7, 2.9, 4, 4.2}
+
+	// Not-a-knot and clamped splines with exact end derivatives
+	// reproduce cubic polynomials.
+	for _, fp := range []DerivativePredictor{
+		&NotAKnotCubic{},
+		&ClampedCubic{LeftDerivative: cubicDeriv(xs[0]), RightDerivative: cubicDeriv(xs[len(xs)-1])},
+	} {
+		err := fp.(Fitter).Fit(xs, ys)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		testInterpolatorPredict(t, fp, testXs, applyFunc(testXs, cubicPoly), 1e-13)
+		for _, x := range testXs {
+			if got, want := fp.PredictDerivative(x), cubicDeriv(x); math.Abs(got-want) > 1e-12 {
+				t.Errorf("%T: unexpected derivative at %v: got %v, want %v", fp, x, got, want)
+			}
+		}
+	}
+
+	// With three nodes the not-a-knot spline is a parabola.
+	var nak NotAKnotCubic
+	quad := func(x float64) float64 { return 2 - x + 3*x*x }
+	nak.Fit([]float64{-1, 0.5, 2}, applyFunc([]float64{-1, 0.5, 2}, quad))
+	testInterpolatorPredict(t, &nak, testXs[1:5], applyFunc(testXs[1:5], quad), 1e-13)
+
+	// The natural spline through (0, 0), (1, 1), (2, 0) has derivatives
+	// 1.5, 0 and -1.5 at the nodes.
+	var nc NaturalCubic
+	nc.Fit([]float64{0, 1, 2}, []float64{0, 1, 0})
+	testInterpolatorPredict(t, &nc, []float64{0.5, 1, 1.5}, []float64{0.6875, 1, 0.6875}, 1e-15)
+
+	// All the splines have continuous second derivatives, and the
+	// natural spline has zero second derivatives at the ends.
+	ys = []float64{0.3, -1, 2, 0.5, 0.1, -0.4, 1.1}
+	cc := ClampedCubic{LeftDerivative: 1}
+	for _, test := range []struct {
+		fp    FittablePredictor
+		cubic *PiecewiseCubic
+	}{
+		{&nc, &nc.cubic},
+		{&cc, &cc.cubic},
+		{&nak, &nak.cubic},
+	} {
+		if err := test.fp.Fit(xs, ys); err != nil {
+			t.Fatalf("%T: unexpected error: %v", test.fp, err)
+		}
+		testInterpolatorPredict(t, test.fp, xs, ys, 1e-14)
+		a := &test.cubic.coeffs
+		for i := 1; i < len(xs)-1; i++ {
+			h := xs[i] - xs[i-1]
+			left := 2*a.At(i-1, 2) + 6*a.At(i-1, 3)*h
+			right := 2 * a.At(i, 2)
+			if !scalar.EqualWithinAbsOrRel(left, right, 1e-12, 1e-12) {
+				t.Errorf("%T: discontinuous second derivative at %v: %v != %v", test.fp, xs[i], left, right)
+			}
+		}
+	}
+	m := len(xs) - 1
+	if d2 := 2 * nc.cubic.coeffs.At(0, 2); math.Abs(d2) > 1e-14 {
+		t.Errorf("unexpected second derivative of natural spline at left end: %v", d2)
+	}
+	if d2 := 2*nc.cubic.coeffs.At(m-1, 2) + 6*nc.cubic.coeffs.At(m-1, 3)*(xs[m]-xs[m-1]); math.Abs(d2) > 1e-12 {
+		t.Errorf("unexpected second derivative of natural spline at right end: %v", d2)
+	}
+}
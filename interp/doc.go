@@ -6,4 +6,12 @@
 // Outside of the interpolation interval determined by the interpolated data,
 // the returned value is undefined (but we do our best to return something
 // reasonable).
+//
+// Cubic splines with natural, clamped and not-a-knot boundary conditions,
+// B-splines of arbitrary degree and smoothing splines for noisy data are
+// provided alongside the local piecewise cubic interpolators.
+//
+// Functions of several variables can be interpolated from values on a
+// rectilinear grid with Grid, or from scattered data with radial basis
+// functions using RBF.
 package interp // import "github.com/jingcheng-WU/gonum/interp"
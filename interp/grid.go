@@ -0,0 +1,247 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import "math"
+
+// GridMethod specifies the interpolation method used by Grid.
+type GridMethod int
+
+const (
+	// GridLinear is multilinear interpolation, which is continuous
+	// and exact for functions that are linear in each variable.
+	GridLinear GridMethod = iota
+
+	// GridCubic is tensor product cubic Hermite interpolation with the
+	// derivatives at the grid nodes estimated by second order finite
+	// differences, which is continuous with continuous first derivatives
+	// and exact for quadratic functions. In two dimensions it is bicubic
+	// interpolation and in three dimensions it is the tricubic
+	// interpolation of Lekien and Marsden with finite difference estimates
+	// of the derivatives and cross derivatives.
+	GridCubic
+)
+
+// Extrapolation specifies how values are predicted outside the grid.
+type Extrapolation int
+
+const (
+	// ExtrapolateClamp predicts the value at the nearest point of
+	// the grid boundary.
+	ExtrapolateClamp Extrapolation = iota
+
+	// ExtrapolateExtend extends the interpolating polynomial of the
+	// nearest boundary cell.
+	ExtrapolateExtend
+
+	// ExtrapolateNaN predicts NaN outside the grid.
+	ExtrapolateNaN
+)
+
+// Grid is an interpolator of a function of several variables from its values
+// on a rectilinear grid, such as a lookup table.
+type Grid struct {
+	// Method is the interpolation method.
+	Method GridMethod
+
+	// Extrapolation specifies the prediction outside the grid.
+	Extrapolation Extrapolation
+
+	// Grid coordinates along each axis.
+	axes [][]float64
+
+	// Values at the grid nodes in row-major order.
+	values []float64
+
+	// Strides of the axes in values.
+	strides []int
+}
+
+// Fit fits the interpolator to the values at the nodes of the grid with the
+// coordinates along each dimension given in axes. The values are in row-major
+// order, so the value at the node with indices (i_0, ..., i_{d-1}) is
+//  values[((i_0 * len(axes[1]) + i_1) * len(axes[2]) + ...) + i_{d-1}] .
+// It panics if axes is empty, if the length of any axis is less than 2, if
+// any axis is not strictly increasing or if the length of values does not
+// match the number of grid nodes. Always returns nil.
+func (g *Grid) Fit(axes [][]float64, values []float64) error {
+	d := len(axes)
+	if d == 0 {
+		panic(tooFewPoints)
+	}
+	g.axes = make([][]float64, d)
+	g.strides = make([]int, d)
+	n := 1
+	for i := d - 1; i >= 0; i-- {
+		axis := axes[i]
+		if len(axis) < 2 {
+			panic(tooFewPoints)
+		}
+		for j := 1; j < len(axis); j++ {
+			if axis[j] <= axis[j-1] {
+				panic(xsNotStrictlyIncreasing)
+			}
+		}
+		g.axes[i] = make([]float64, len(axis))
+		copy(g.axes[i], axis)
+		g.strides[i] = n
+		n *= len(axis)
+	}
+	if len(values) != n {
+		panic(differentLengths)
+	}
+	g.values = make([]float64, n)
+	copy(g.values, values)
+	return nil
+}
+
+// Dims returns the number of dimensions of the grid.
+func (g *Grid) Dims() int {
+	return len(g.axes)
+}
+
+// Predict returns the interpolated value at x. It panics if the length of x
+// does not match the dimension of the grid.
+func (g *Grid) Predict(x []float64) float64 {
+	d := len(g.axes)
+	if len(x) != d {
+		panic(differentLengths)
+	}
+	// Each dimension contributes weights for at most four consecutive
+	// nodes starting at base.
+	base := make([]int, d)
+	weights := make([][4]float64, d)
+	for i, v := range x {
+		axis := g.axes[i]
+		n := len(axis)
+		if v < axis[0] || axis[n-1] < v {
+			switch g.Extrapolation {
+			default:
+				panic("interp: unknown extrapolation")
+			case ExtrapolateClamp:
+				v = math.Max(axis[0], math.Min(v, axis[n-1]))
+			case ExtrapolateExtend:
+			case ExtrapolateNaN:
+				return math.NaN()
+			}
+		} else if math.IsNaN(v) {
+			return math.NaN()
+		}
+		j := findSegment(axis, v)
+		if j < 0 {
+			j = 0
+		}
+		if j > n-2 {
+			j = n - 2
+		}
+		switch g.Method {
+		default:
+			panic("interp: unknown grid method")
+		case GridLinear:
+			base[i] = j
+			t := (v - axis[j]) / (axis[j+1] - axis[j])
+			weights[i] = [4]float64{1 - t, t}
+		case GridCubic:
+			base[i] = j - 1
+			weights[i] = cubicGridWeights(axis, j, v)
+		}
+	}
+
+	// Sum the products of the weights over the nodes of the stencil.
+	width := 2
+	if g.Method == GridCubic {
+		width = 4
+	}
+	offset := make([]int, d)
+	var sum float64
+	for {
+		w := 1.0
+		idx := 0
+		for i, o := range offset {
+			w *= weights[i][o]
+			idx += (base[i] + o) * g.strides[i]
+		}
+		if w != 0 {
+			sum += w * g.values[idx]
+		}
+		i := d - 1
+		for ; i >= 0; i-- {
+			offset[i]++
+			if offset[i] < width {
+				break
+			}
+			offset[i] = 0
+		}
+		if i < 0 {
+			break
+		}
+	}
+	return sum
+}
+
+// cubicGridWeights returns the weights of the values at the nodes j-1, j, j+1
+// and j+2 of axis in the cubic Hermite interpolant at x on the interval
+// [axis[j], axis[j+1]]. The weights of nodes outside the axis are zero.
+func cubicGridWeights(axis []float64, j int, x float64) [4]float64 {
+	h := axis[j+1] - axis[j]
+	t := (x - axis[j]) / h
+	t2 := t * t
+	t3 := t2 * t
+	h00 := 2*t3 - 3*t2 + 1
+	h10 := (t3 - 2*t2 + t) * h
+	h01 := -2*t3 + 3*t2
+	h11 := (t3 - t2) * h
+
+	var w [4]float64
+	w[1] = h00
+	w[2] = h01
+	start, d0 := derivativeWeights(axis, j)
+	for k, v := range d0 {
+		w[start+k-j+1] += h10 * v
+	}
+	start, d1 := derivativeWeights(axis, j+1)
+	for k, v := range d1 {
+		w[start+k-j+1] += h11 * v
+	}
+	return w
+}
+
+// derivativeWeights returns the weights of the values at three consecutive
+// nodes of axis, starting at the returned index, in the second order finite
+// difference estimate of the derivative at node k. For an axis with two nodes,
+// the derivative is the slope between them and the weight of the third node
+// is zero.
+func derivativeWeights(axis []float64, k int) (start int, w [3]float64) {
+	n := len(axis)
+	if n == 2 {
+		s := 1 / (axis[1] - axis[0])
+		return 0, [3]float64{-s, s, 0}
+	}
+	switch k {
+	case 0:
+		h0 := axis[1] - axis[0]
+		h1 := axis[2] - axis[1]
+		return 0, [3]float64{
+			-(2*h0 + h1) / (h0 * (h0 + h1)),
+			(h0 + h1) / (h0 * h1),
+			-h0 / (h1 * (h0 + h1)),
+		}
+	case n - 1:
+		h0 := axis[n-2] - axis[n-3]
+		h1 := axis[n-1] - axis[n-2]
+		return n - 3, [3]float64{
+			h1 / (h0 * (h0 + h1)),
+			-(h0 + h1) / (h0 * h1),
+			(2*h1 + h0) / (h1 * (h0 + h1)),
+		}
+	}
+	h0 := axis[k] - axis[k-1]
+	h1 := axis[k+1] - axis[k]
+	return k - 1, [3]float64{
+		-h1 / (h0 * (h0 + h1)),
+		(h1 - h0) / (h0 * h1),
+		h0 / (h1 * (h0 + h1)),
+	}
+}
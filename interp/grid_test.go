@@ -0,0 +1,210 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+)
+
+// gridValues returns the values of f at the nodes of the grid with the
+// given axes in row-major order.
+func gridValues(axes [][]float64, f func(x []float64) float64) []float64 {
+	var values []float64
+	x := make([]float64, len(axes))
+	var gen func(i int)
+	gen = func(i int) {
+		if i == len(axes) {
+			values = append(values, f(x))
+			return
+		}
+		for _, v := range axes[i] {
+			x[i] = v
+			gen(i + 1)
+		}
+	}
+	gen(0)
+	return values
+}
+
+func TestGridExact(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i, test := range []struct {
+		method GridMethod
+		axes   [][]float64
+		f      func(x []float64) float64
+	}{
+		{
+			method: GridLinear,
+			axes:   [][]float64{{0, 0.5, 2, 3}, {-1, 1}},
+			f:      func(x []float64) float64 { return 1 + 2*x[0] - 3*x[1] + 0.5*x[0]*x[1] },
+		},
+		{
+			method: GridLinear,
+			axes:   [][]float64{{0, 0.5, 2, 3}, {-1, 0, 0.25, 1}, {1, 1.5, 4}},
+			f:      func(x []float64) float64 { return 1 + x[0]*x[1]*x[2] - x[2] + 2*x[0]*x[2] },
+		},
+		{
+			method: GridCubic,
+			axes:   [][]float64{{0, 0.5, 2, 3}, {-1, 0, 0.25, 1}},
+			f: func(x []float64) float64 {
+				return 1 + 2*x[0] - x[1] + x[0]*x[0] - 3*x[0]*x[1] + 0.5*x[1]*x[1] + x[0]*x[0]*x[1]*x[1]
+			},
+		},
+		{
+			method: GridCubic,
+			axes:   [][]float64{{0, 0.5, 2, 3, 3.5}, {-1, 0, 0.25}, {1, 1.5, 4, 4.1}},
+			f: func(x []float64) float64 {
+				return x[0]*x[0]*x[1]*x[2]*x[2] - x[1]*x[1] + x[0]*x[1]*x[2]
+			},
+		},
+		{
+			// Axes with two nodes are linear.
+			method: GridCubic,
+			axes:   [][]float64{{0, 0.5, 2, 3}, {-1, 1}},
+			f:      func(x []float64) float64 { return x[0]*x[0]*x[1] - x[1] + x[0] },
+		},
+	} {
+		values := gridValues(test.axes, test.f)
+		g := Grid{Method: test.method}
+		if err := g.Fit(test.axes, values); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		d := len(test.axes)
+		if g.Dims() != d {
+			t.Errorf("case %d: unexpected dimension: got %d, want %d", i, g.Dims(), d)
+		}
+		x := make([]float64, d)
+		for k := 0; k < 100; k++ {
+			for j, axis := range test.axes {
+				x[j] = axis[0] + rnd.Float64()*(axis[len(axis)-1]-axis[0])
+			}
+			got := g.Predict(x)
+			want := test.f(x)
+			if !scalar.EqualWithinAbsOrRel(got, want, 1e-12, 1e-12) {
+				t.Errorf("case %d: unexpected value at %v: got %v, want %v", i, x, got, want)
+			}
+		}
+		// The interpolant passes through the values at the nodes.
+		for k, v := range gridValues(test.axes, g.Predict) {
+			if !scalar.EqualWithinAbsOrRel(v, values[k], 1e-13, 1e-13) {
+				t.Errorf("case %d: unexpected value at node %d: got %v, want %v", i, k, v, values[k])
+			}
+		}
+	}
+}
+
+func TestGridCubicConvergence(t *testing.T) {
+	t.Parallel()
+	f := func(x []float64) float64 { return math.Sin(2*x[0]) * math.Cos(x[1]+x[0]) }
+	maxErr := func(n int) float64 {
+		axes := [][]float64{make([]float64, n), make([]float64, n)}
+		for i := 0; i < n; i++ {
+			axes[0][i] = 2 * float64(i) / float64(n-1)
+			axes[1][i] = -1 + 3*float64(i)/float64(n-1)
+		}
+		g := Grid{Method: GridCubic}
+		g.Fit(axes, gridValues(axes, f))
+		var max float64
+		x := make([]float64, 2)
+		for i := 0; i <= 50; i++ {
+			for j := 0; j <= 50; j++ {
+				x[0] = 2 * float64(i) / 50
+				x[1] = -1 + 3*float64(j)/50
+				max = math.Max(max, math.Abs(g.Predict(x)-f(x)))
+			}
+		}
+		return max
+	}
+	// The error is third order in the grid spacing.
+	e1 := maxErr(21)
+	e2 := maxErr(41)
+	if rate := math.Log2(e1 / e2); rate < 2.7 {
+		t.Errorf("unexpected convergence rate: got %v, want 3", rate)
+	}
+}
+
+func TestGridCubicSmooth(t *testing.T) {
+	t.Parallel()
+	// The cubic interpolant has continuous first derivatives across
+	// the cell boundaries.
+	axes := [][]float64{{0, 1, 1.5, 3, 4}, {0, 2, 2.5, 3}}
+	rnd := rand.New(rand.NewSource(1))
+	values := make([]float64, 20)
+	for i := range values {
+		values[i] = rnd.NormFloat64()
+	}
+	g := Grid{Method: GridCubic}
+	g.Fit(axes, values)
+	const h = 1e-6
+	for _, y := range []float64{0.3, 2.2, 2.9} {
+		for _, x := range axes[0][1:4] {
+			left := (g.Predict([]float64{x, y}) - g.Predict([]float64{x - h, y})) / h
+			right := (g.Predict([]float64{x + h, y}) - g.Predict([]float64{x, y})) / h
+			if !scalar.EqualWithinAbs(left, right, 1e-4) {
+				t.Errorf("discontinuous derivative at (%v, %v): %v != %v", x, y, left, right)
+			}
+		}
+	}
+}
+
+func TestGridExtrapolation(t *testing.T) {
+	t.Parallel()
+	axes := [][]float64{{0, 1, 3}, {-1, 0, 2}}
+	f := func(x []float64) float64 { return 1 + 2*x[0] - x[1] }
+	values := gridValues(axes, f)
+	for _, method := range []GridMethod{GridLinear, GridCubic} {
+		for _, x := range [][]float64{{-1, 0.5}, {4, 3}, {2, -2}, {5, -3}} {
+			g := Grid{Method: method, Extrapolation: ExtrapolateClamp}
+			g.Fit(axes, values)
+			clamped := []float64{math.Max(0, math.Min(x[0], 3)), math.Max(-1, math.Min(x[1], 2))}
+			if got, want := g.Predict(x), f(clamped); !scalar.EqualWithinAbs(got, want, 1e-12) {
+				t.Errorf("method %d: unexpected clamped value at %v: got %v, want %v", method, x, got, want)
+			}
+
+			g.Extrapolation = ExtrapolateExtend
+			if got, want := g.Predict(x), f(x); !scalar.EqualWithinAbs(got, want, 1e-12) {
+				t.Errorf("method %d: unexpected extended value at %v: got %v, want %v", method, x, got, want)
+			}
+
+			g.Extrapolation = ExtrapolateNaN
+			if got := g.Predict(x); !math.IsNaN(got) {
+				t.Errorf("method %d: unexpected value at %v: got %v, want NaN", method, x, got)
+			}
+			if got, want := g.Predict([]float64{3, 2}), f([]float64{3, 2}); !scalar.EqualWithinAbs(got, want, 1e-12) {
+				t.Errorf("method %d: unexpected value at the corner: got %v, want %v", method, got, want)
+			}
+		}
+	}
+}
+
+func TestGridPanics(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		axes   [][]float64
+		values []float64
+	}{
+		{"no axes", nil, []float64{1}},
+		{"short axis", [][]float64{{0, 1}, {0}}, []float64{1, 2}},
+		{"unsorted axis", [][]float64{{0, 1}, {1, 0}}, []float64{1, 2, 3, 4}},
+		{"wrong values length", [][]float64{{0, 1}, {0, 1, 2}}, []float64{1, 2, 3, 4}},
+	} {
+		var g Grid
+		if !panics(func() { g.Fit(test.axes, test.values) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+	var g Grid
+	g.Fit([][]float64{{0, 1}, {0, 1}}, []float64{1, 2, 3, 4})
+	if !panics(func() { g.Predict([]float64{0.5}) }) {
+		t.Error("expected panic for dimension mismatch")
+	}
+}
@@ -10,7 +10,10 @@ import (
 	"os"
 	"text/tabwriter"
 
+	"golang.org/x/exp/rand"
+
 	"github.com/jingcheng-WU/gonum/interp"
+	"github.com/jingcheng-WU/gonum/mat"
 )
 
 func ExamplePredictor() {
@@ -102,3 +105,86 @@ func ExamplePredictor() {
 	//    10.75    2.55    2.54    2.55    2.55
 	//    11.00    2.55    2.55    2.55    2.55
 }
+
+func ExampleGrid() {
+	// Interpolate a lookup table of the efficiency of a machine as a
+	// function of speed and load, measured on a non-uniform grid.
+	speed := []float64{1000, 2000, 3000, 4500}
+	load := []float64{0.1, 0.25, 0.5, 1}
+	efficiency := []float64{
+		0.60, 0.72, 0.80, 0.83,
+		0.65, 0.78, 0.86, 0.88,
+		0.63, 0.77, 0.85, 0.87,
+		0.55, 0.70, 0.79, 0.82,
+	}
+	for _, method := range []interp.GridMethod{interp.GridLinear, interp.GridCubic} {
+		g := interp.Grid{Method: method}
+		err := g.Fit([][]float64{speed, load}, efficiency)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("%.4f %.4f\n", g.Predict([]float64{2500, 0.4}), g.Predict([]float64{5000, 0.75}))
+	}
+
+	// Output:
+	// 0.8230 0.8050
+	// 0.8411 0.8300
+}
+
+func ExampleRBF() {
+	// Interpolate scattered measurements of f(x, y) = x^2 + y^2 with
+	// a thin plate spline. With only a few points, the interpolant is
+	// much more accurate if the polynomial term can represent f.
+	xs := mat.NewDense(9, 2, []float64{
+		0, 0,
+		1, 0,
+		0, 1,
+		-1, 0,
+		0, -1,
+		0.7, 0.7,
+		-0.7, 0.7,
+		0.7, -0.7,
+		-0.7, -0.7,
+	})
+	ys := make([]float64, 9)
+	for i := range ys {
+		x, y := xs.At(i, 0), xs.At(i, 1)
+		ys[i] = x*x + y*y
+	}
+	for _, degree := range []int{1, 2} {
+		rbf := interp.RBF{Basis: interp.ThinPlate, Degree: degree}
+		err := rbf.Fit(xs, ys)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("degree %d: f(0.5, 0.5) ≈ %.3f, want 0.500\n", degree, rbf.Predict([]float64{0.5, 0.5}))
+	}
+
+	// Output:
+	// degree 1: f(0.5, 0.5) ≈ 0.666, want 0.500
+	// degree 2: f(0.5, 0.5) ≈ 0.500, want 0.500
+}
+
+func ExampleSmoothingSpline() {
+	// Recover a smooth curve and its slope from noisy measurements,
+	// with the amount of smoothing chosen by cross-validation.
+	rnd := rand.New(rand.NewSource(1))
+	n := 100
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+	for i := range xs {
+		xs[i] = float64(i) / float64(n-1)
+		ys[i] = math.Exp(-xs[i]) + 0.05*rnd.NormFloat64()
+	}
+	var ss interp.SmoothingSpline
+	err := ss.Fit(xs, ys)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("f(0.5) ≈ %.2f, want %.2f\n", ss.Predict(0.5), math.Exp(-0.5))
+	fmt.Printf("f'(0.5) ≈ %.1f, want %.1f\n", ss.PredictDerivative(0.5), -math.Exp(-0.5))
+
+	// Output:
+	// f(0.5) ≈ 0.60, want 0.61
+	// f'(0.5) ≈ -0.6, want -0.6
+}
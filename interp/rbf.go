@@ -0,0 +1,248 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// RadialBasis specifies the radial basis function φ(r) of an RBF
+// interpolator, where r is the distance between points.
+type RadialBasis int
+
+const (
+	// ThinPlate is the thin plate spline φ(r) = r^2 log(r), which
+	// requires a polynomial of at least degree 1.
+	ThinPlate RadialBasis = iota
+
+	// Gaussian is φ(r) = exp(-(εr)^2).
+	Gaussian
+
+	// Multiquadric is φ(r) = -sqrt(1 + (εr)^2), which requires a
+	// polynomial of at least degree 0.
+	Multiquadric
+)
+
+// minDegree returns the minimum degree of the polynomial for which the
+// interpolation problem is well posed.
+func (b RadialBasis) minDegree() int {
+	switch b {
+	default:
+		panic("interp: unknown radial basis")
+	case ThinPlate:
+		return 1
+	case Gaussian:
+		return -1
+	case Multiquadric:
+		return 0
+	}
+}
+
+// eval returns φ(r).
+func (b RadialBasis) eval(r, eps float64) float64 {
+	switch b {
+	default:
+		panic("interp: unknown radial basis")
+	case ThinPlate:
+		if r == 0 {
+			return 0
+		}
+		return r * r * math.Log(r)
+	case Gaussian:
+		return math.Exp(-(eps * r) * (eps * r))
+	case Multiquadric:
+		return -math.Hypot(1, eps*r)
+	}
+}
+
+// RBF is a radial basis function interpolator of scattered data in several
+// dimensions. The interpolant is
+//  s(x) = \sum_i c_i φ(|x - x_i|) + p(x)
+// where x_i are the data locations and p is a polynomial of total degree at
+// most Degree. The coefficients satisfy
+//  s(x_i) + Smoothing * c_i = y_i
+// and the orthogonality conditions \sum_i c_i q(x_i) = 0 for all polynomials
+// q of the same degree.
+type RBF struct {
+	// Basis is the radial basis function.
+	Basis RadialBasis
+
+	// Epsilon is the shape parameter ε of the Gaussian and Multiquadric
+	// basis functions. If Epsilon is zero, a default of 1 is used.
+	Epsilon float64
+
+	// Degree is the degree of the polynomial added to the interpolant.
+	// If Degree is less than the minimum degree required by the basis,
+	// the minimum degree is used. A negative Degree with the Gaussian
+	// basis adds no polynomial.
+	Degree int
+
+	// Smoothing is the non-negative smoothing parameter. If Smoothing is
+	// zero, the interpolant passes through the data.
+	Smoothing float64
+
+	eps    float64
+	xs     *mat.Dense
+	coeffs []float64
+
+	// Polynomial coefficients, exponents of the monomials, and the
+	// shift and scale applied to x before evaluating the polynomial.
+	poly      []float64
+	exponents [][]int
+	shift     []float64
+	scale     []float64
+}
+
+// Fit fits the interpolator to the values ys at the locations given by the
+// rows of xs. It panics if the number of rows of xs and the length of ys
+// differ, if there are fewer points than terms of the polynomial or if
+// Smoothing is negative.
+//
+// If the interpolation system is singular or ill-conditioned, for example
+// when locations are repeated, Fit returns a mat.Condition error. The fit is
+// retained unless the system is exactly singular, but may be inaccurate.
+func (rbf *RBF) Fit(xs mat.Matrix, ys []float64) error {
+	n, d := xs.Dims()
+	if len(ys) != n {
+		panic(differentLengths)
+	}
+	if rbf.Smoothing < 0 {
+		panic("interp: negative smoothing")
+	}
+	eps := rbf.Epsilon
+	if eps == 0 {
+		eps = 1
+	}
+	degree := rbf.Degree
+	if min := rbf.Basis.minDegree(); degree < min {
+		degree = min
+	}
+	exponents := monomialExponents(d, degree)
+	m := len(exponents)
+	if n == 0 || n < m {
+		panic(tooFewPoints)
+	}
+
+	x := mat.DenseCopyOf(xs)
+
+	// Center and scale the locations for the polynomial to improve
+	// conditioning.
+	shift := make([]float64, d)
+	scale := make([]float64, d)
+	col := make([]float64, n)
+	for j := 0; j < d; j++ {
+		mat.Col(col, j, x)
+		lo, hi := floats.Min(col), floats.Max(col)
+		shift[j] = (lo + hi) / 2
+		scale[j] = (hi - lo) / 2
+		if scale[j] == 0 {
+			scale[j] = 1
+		}
+	}
+
+	a := mat.NewDense(n+m, n+m, nil)
+	for i := 0; i < n; i++ {
+		xi := x.RawRowView(i)
+		for k := i; k < n; k++ {
+			v := rbf.Basis.eval(floats.Distance(xi, x.RawRowView(k), 2), eps)
+			a.Set(i, k, v)
+			a.Set(k, i, v)
+		}
+		a.Set(i, i, a.At(i, i)+rbf.Smoothing)
+	}
+	p := make([]float64, m)
+	for i := 0; i < n; i++ {
+		evalMonomials(p, x.RawRowView(i), exponents, shift, scale)
+		for k, v := range p {
+			a.Set(i, n+k, v)
+			a.Set(n+k, i, v)
+		}
+	}
+	b := mat.NewVecDense(n+m, nil)
+	for i, v := range ys {
+		b.SetVec(i, v)
+	}
+	// The system is symmetric but indefinite, and its determinant may
+	// underflow for many points, so solve it by QR factorization.
+	var qr mat.QR
+	qr.Factorize(a)
+	var c mat.VecDense
+	err := qr.SolveVecTo(&c, false, b)
+	if cond, ok := err.(mat.Condition); ok && math.IsInf(float64(cond), 1) {
+		return err
+	}
+
+	rbf.eps = eps
+	rbf.xs = x
+	rbf.coeffs = make([]float64, n)
+	rbf.poly = make([]float64, m)
+	for i := range rbf.coeffs {
+		rbf.coeffs[i] = c.AtVec(i)
+	}
+	for k := range rbf.poly {
+		rbf.poly[k] = c.AtVec(n + k)
+	}
+	rbf.exponents = exponents
+	rbf.shift = shift
+	rbf.scale = scale
+	return err
+}
+
+// Predict returns the interpolated value at x. It panics if the length of x
+// does not match the dimension of the data.
+func (rbf *RBF) Predict(x []float64) float64 {
+	n, d := rbf.xs.Dims()
+	if len(x) != d {
+		panic(differentLengths)
+	}
+	var sum float64
+	for i := 0; i < n; i++ {
+		r := floats.Distance(x, rbf.xs.RawRowView(i), 2)
+		sum += rbf.coeffs[i] * rbf.Basis.eval(r, rbf.eps)
+	}
+	p := make([]float64, len(rbf.poly))
+	evalMonomials(p, x, rbf.exponents, rbf.shift, rbf.scale)
+	return sum + floats.Dot(p, rbf.poly)
+}
+
+// monomialExponents returns the exponents of the monomials in d variables
+// of total degree at most degree.
+func monomialExponents(d, degree int) [][]int {
+	var exponents [][]int
+	e := make([]int, d)
+	var gen func(j, remaining int)
+	gen = func(j, remaining int) {
+		if j == d {
+			exponents = append(exponents, append([]int(nil), e...))
+			return
+		}
+		for k := 0; k <= remaining; k++ {
+			e[j] = k
+			gen(j+1, remaining-k)
+		}
+		e[j] = 0
+	}
+	if degree >= 0 {
+		gen(0, degree)
+	}
+	return exponents
+}
+
+// evalMonomials stores in dst the values of the monomials with the given
+// exponents at (x - shift) / scale.
+func evalMonomials(dst, x []float64, exponents [][]int, shift, scale []float64) {
+	for k, e := range exponents {
+		v := 1.0
+		for j, p := range e {
+			if p != 0 {
+				v *= math.Pow((x[j]-shift[j])/scale[j], float64(p))
+			}
+		}
+		dst[k] = v
+	}
+}
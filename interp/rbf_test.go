@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func randomPoints(rnd *rand.Rand, n, d int) *mat.Dense {
+	x := mat.NewDense(n, d, nil)
+	for i := 0; i < n; i++ {
+		for j := 0; j < d; j++ {
+			x.Set(i, j, rnd.Float64())
+		}
+	}
+	return x
+}
+
+func TestRBFInterpolates(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	f := func(x []float64) float64 { return math.Sin(3*x[0]) + x[1]*x[1] }
+	for _, basis := range []RadialBasis{ThinPlate, Gaussian, Multiquadric} {
+		for _, degree := range []int{-1, 0, 1, 2} {
+			xs := randomPoints(rnd, 40, 2)
+			ys := make([]float64, 40)
+			for i := range ys {
+				ys[i] = f(xs.RawRowView(i))
+			}
+			rbf := RBF{Basis: basis, Epsilon: 3, Degree: degree}
+			if err := rbf.Fit(xs, ys); err != nil {
+				t.Fatalf("basis %d, degree %d: unexpected error: %v", basis, degree, err)
+			}
+			for i, y := range ys {
+				if got := rbf.Predict(xs.RawRowView(i)); !scalar.EqualWithinAbs(got, y, 1e-8) {
+					t.Errorf("basis %d, degree %d: unexpected value at node %d: got %v, want %v", basis, degree, i, got, y)
+				}
+			}
+		}
+	}
+}
+
+func TestRBFPolynomialReproduction(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		basis  RadialBasis
+		degree int
+		d      int
+		f      func(x []float64) float64
+	}{
+		{ThinPlate, 1, 2, func(x []float64) float64 { return 1 + 2*x[0] - 3*x[1] }},
+		{ThinPlate, 2, 2, func(x []float64) float64 { return x[0]*x[0] - x[0]*x[1] + 2 }},
+		{Multiquadric, 0, 3, func(x []float64) float64 { return 5 }},
+		{Gaussian, 1, 3, func(x []float64) float64 { return x[0] + x[1] - x[2] }},
+	} {
+		xs := randomPoints(rnd, 30, test.d)
+		ys := make([]float64, 30)
+		for i := range ys {
+			ys[i] = test.f(xs.RawRowView(i))
+		}
+		rbf := RBF{Basis: test.basis, Degree: test.degree}
+		if err := rbf.Fit(xs, ys); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		x := make([]float64, test.d)
+		for k := 0; k < 20; k++ {
+			for j := range x {
+				x[j] = 2*rnd.Float64() - 0.5
+			}
+			if got, want := rbf.Predict(x), test.f(x); !scalar.EqualWithinAbs(got, want, 1e-8) {
+				t.Errorf("basis %d, degree %d: unexpected value at %v: got %v, want %v", test.basis, test.degree, x, got, want)
+			}
+		}
+	}
+}
+
+func TestRBFAccuracy(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	f := func(x []float64) float64 { return math.Exp(-x[0]) * math.Cos(2*x[1]) }
+	xs := randomPoints(rnd, 300, 2)
+	ys := make([]float64, 300)
+	for i := range ys {
+		ys[i] = f(xs.RawRowView(i))
+	}
+	var rbf RBF
+	if err := rbf.Fit(xs, ys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	x := make([]float64, 2)
+	for k := 0; k < 100; k++ {
+		x[0] = 0.1 + 0.8*rnd.Float64()
+		x[1] = 0.1 + 0.8*rnd.Float64()
+		if got, want := rbf.Predict(x), f(x); !scalar.EqualWithinAbs(got, want, 1e-3) {
+			t.Errorf("unexpected value at %v: got %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestRBFSmoothing(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	xs := randomPoints(rnd, 50, 2)
+	ys := make([]float64, 50)
+	for i := range ys {
+		ys[i] = rnd.NormFloat64()
+	}
+	const lambda = 0.1
+	rbf := RBF{Smoothing: lambda}
+	if err := rbf.Fit(xs, ys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, y := range ys {
+		got := rbf.Predict(xs.RawRowView(i)) + lambda*rbf.coeffs[i]
+		if !scalar.EqualWithinAbs(got, y, 1e-10) {
+			t.Errorf("smoothing condition not satisfied at node %d: got %v, want %v", i, got, y)
+		}
+	}
+}
+
+func TestRBFErrors(t *testing.T) {
+	t.Parallel()
+	xs := mat.NewDense(4, 2, []float64{0, 0, 1, 0, 0, 1, 0, 0})
+	var rbf RBF
+	if err := rbf.Fit(xs, []float64{1, 2, 3, 4}); err == nil {
+		t.Error("expected error for repeated locations")
+	}
+	if !panics(func() { rbf.Fit(xs, []float64{1, 2}) }) {
+		t.Error("expected panic for length mismatch")
+	}
+	if !panics(func() { rbf.Fit(mat.NewDense(2, 2, []float64{0, 0, 1, 1}), []float64{1, 2}) }) {
+		t.Error("expected panic for too few points")
+	}
+	if !panics(func() { (&RBF{Smoothing: -1}).Fit(xs, []float64{1, 2, 3, 4}) }) {
+		t.Error("expected panic for negative smoothing")
+	}
+	rbf = RBF{Basis: Gaussian}
+	rbf.Fit(mat.NewDense(2, 2, []float64{0, 0, 1, 1}), []float64{1, 2})
+	if !panics(func() { rbf.Predict([]float64{1}) }) {
+		t.Error("expected panic for dimension mismatch")
+	}
+}
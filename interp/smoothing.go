@@ -0,0 +1,349 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"errors"
+	"math"
+)
+
+// SmoothingSpline is a cubic smoothing spline for noisy (X, Y) data. The
+// fitted spline g minimizes the penalized sum of squares
+//  \sum_i w_i (y_i - g(x_i))^2 + λ \int g''(x)^2 dx
+// over all functions with square integrable second derivative, and is a
+// natural cubic spline with knots at the data locations. As λ tends to
+// zero, g tends to the natural cubic interpolant of the data, and as λ
+// tends to infinity, g tends to the weighted least squares regression line.
+//
+// The spline is computed with the algorithm of Reinsch in O(n) operations.
+// If λ is not given, it is chosen by minimizing the generalized
+// cross-validation score
+//  GCV(λ) = n \sum_i w_i (y_i - g(x_i))^2 / (n - tr(A(λ)))^2
+// where A(λ) is the influence matrix that maps the data values to the
+// fitted values, see
+//  Craven, P. and Wahba, G. "Smoothing noisy data with spline functions"
+//  (1979), Numerische Mathematik, 31, pp. 377-403.
+type SmoothingSpline struct {
+	// Lambda is the smoothing parameter λ. If Lambda is zero, it is
+	// chosen by generalized cross-validation.
+	Lambda float64
+
+	// Weights are the weights w_i of the data. If Weights is nil, all
+	// weights are one.
+	Weights []float64
+
+	lambda float64
+	cubic  PiecewiseCubic
+}
+
+// Predict returns the value of the smoothing spline at x.
+func (ss *SmoothingSpline) Predict(x float64) float64 {
+	return ss.cubic.Predict(x)
+}
+
+// PredictDerivative returns the derivative of the smoothing spline at x.
+func (ss *SmoothingSpline) PredictDerivative(x float64) float64 {
+	return ss.cubic.PredictDerivative(x)
+}
+
+// SmoothingParameter returns the smoothing parameter λ used in the last call
+// to Fit.
+func (ss *SmoothingSpline) SmoothingParameter() float64 {
+	return ss.lambda
+}
+
+// Fit fits the smoothing spline to (X, Y) value pairs provided as two slices.
+// It panics if len(xs) < 2, elements of xs are not strictly increasing,
+// len(xs) != len(ys), Lambda is negative, or if Weights is not nil and
+// len(Weights) != len(xs) or any weight is not positive. It returns an error
+// if solving the required system of linear equations fails.
+func (ss *SmoothingSpline) Fit(xs, ys []float64) error {
+	n := len(xs)
+	if len(ys) != n {
+		panic(differentLengths)
+	}
+	if n < 2 {
+		panic(tooFewPoints)
+	}
+	if ss.Lambda < 0 {
+		panic("interp: negative smoothing parameter")
+	}
+	w := ss.Weights
+	if w == nil {
+		w = make([]float64, n)
+		for i := range w {
+			w[i] = 1
+		}
+	}
+	if len(w) != n {
+		panic(differentLengths)
+	}
+	for _, v := range w {
+		if v <= 0 {
+			panic("interp: non-positive weight")
+		}
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] <= xs[i-1] {
+			panic(xsNotStrictlyIncreasing)
+		}
+	}
+	if n == 2 {
+		// The spline is the line through the data.
+		slope := (ys[1] - ys[0]) / (xs[1] - xs[0])
+		ss.cubic.FitWithDerivatives(xs, ys, []float64{slope, slope})
+		ss.lambda = ss.Lambda
+		return nil
+	}
+
+	rs := newReinsch(xs, w)
+	lambda := ss.Lambda
+	if lambda == 0 {
+		lambda = rs.minimizeGCV(ys)
+	}
+	g, gamma, _, ok := rs.solve(ys, lambda, false)
+	if !ok {
+		return errors.New("interp: singular smoothing spline system")
+	}
+
+	// Construct the natural cubic spline from the values and the second
+	// derivatives at the nodes.
+	h := rs.h
+	dydxs := make([]float64, n)
+	for i := 0; i < n-1; i++ {
+		dydxs[i] = (g[i+1]-g[i])/h[i] - h[i]*(2*gamma[i]+gamma[i+1])/6
+	}
+	dydxs[n-1] = (g[n-1]-g[n-2])/h[n-2] + h[n-2]*(gamma[n-2]+2*gamma[n-1])/6
+	ss.cubic.FitWithDerivatives(xs, g, dydxs)
+	ss.lambda = lambda
+	return nil
+}
+
+// reinsch holds the band matrices of the Reinsch algorithm for the cubic
+// smoothing spline in the notation of
+//  Green, P. J. and Silverman, B. W. "Nonparametric Regression and
+//  Generalized Linear Models" (1994), Chapman and Hall, Chapter 2.
+// The n×(n-2) matrix Q has three non-zero elements in each column and the
+// (n-2)×(n-2) matrix R is tridiagonal. The second derivatives γ at the
+// interior nodes and the fitted values g satisfy
+//  (R + λ Qᵀ W⁻¹ Q) γ = Qᵀ y
+//  g = y - λ W⁻¹ Q γ .
+type reinsch struct {
+	n int
+	h []float64
+	w []float64
+
+	// Non-zero elements of column j of Q in rows j, j+1 and j+2.
+	q [][3]float64
+
+	// Diagonal and first super-diagonal of R.
+	r0, r1 []float64
+
+	// Diagonal and first two super-diagonals of Qᵀ W⁻¹ Q.
+	b0, b1, b2 []float64
+}
+
+func newReinsch(xs, w []float64) *reinsch {
+	n := len(xs)
+	m := n - 2
+	rs := &reinsch{
+		n:  n,
+		h:  make([]float64, n-1),
+		w:  w,
+		q:  make([][3]float64, m),
+		r0: make([]float64, m),
+		r1: make([]float64, m),
+		b0: make([]float64, m),
+		b1: make([]float64, m),
+		b2: make([]float64, m),
+	}
+	for i := range rs.h {
+		rs.h[i] = xs[i+1] - xs[i]
+	}
+	h := rs.h
+	for j := 0; j < m; j++ {
+		rs.q[j] = [3]float64{1 / h[j], -1/h[j] - 1/h[j+1], 1 / h[j+1]}
+		rs.r0[j] = (h[j] + h[j+1]) / 3
+		if j < m-1 {
+			rs.r1[j] = h[j+1] / 6
+		}
+	}
+	// Column j of Q has non-zeros in rows j..j+2, so columns j and j+d
+	// overlap in rows j+d..j+2.
+	for j := 0; j < m; j++ {
+		for d := 0; d <= 2 && j+d < m; d++ {
+			var s float64
+			for row := j + d; row <= j+2; row++ {
+				s += rs.q[j][row-j] * rs.q[j+d][row-j-d] / w[row]
+			}
+			switch d {
+			case 0:
+				rs.b0[j] = s
+			case 1:
+				rs.b1[j] = s
+			case 2:
+				rs.b2[j] = s
+			}
+		}
+	}
+	return rs
+}
+
+// solve returns the fitted values g and the second derivatives at all the
+// nodes for the smoothing parameter lambda. If trace is true, it also returns
+// tr(I - A), where A is the influence matrix. It returns false if the system
+// is singular.
+func (rs *reinsch) solve(ys []float64, lambda float64, trace bool) (g, gamma []float64, tr float64, ok bool) {
+	n := rs.n
+	m := n - 2
+
+	// Compute the LDLᵀ factorization of the pentadiagonal matrix
+	// M = R + λ Qᵀ W⁻¹ Q, where L is unit lower triangular with two
+	// sub-diagonals l1 and l2.
+	d := make([]float64, m)
+	l1 := make([]float64, m)
+	l2 := make([]float64, m)
+	for j := 0; j < m; j++ {
+		v := rs.r0[j] + lambda*rs.b0[j]
+		if j >= 1 {
+			v -= l1[j-1] * l1[j-1] * d[j-1]
+		}
+		if j >= 2 {
+			v -= l2[j-2] * l2[j-2] * d[j-2]
+		}
+		if v <= 0 {
+			return nil, nil, 0, false
+		}
+		d[j] = v
+		if j+1 < m {
+			v = rs.r1[j] + lambda*rs.b1[j]
+			if j >= 1 {
+				v -= l2[j-1] * l1[j-1] * d[j-1]
+			}
+			l1[j] = v / d[j]
+		}
+		if j+2 < m {
+			l2[j] = lambda * rs.b2[j] / d[j]
+		}
+	}
+
+	// Solve M γ = Qᵀ y.
+	z := make([]float64, m)
+	for j := range z {
+		z[j] = rs.q[j][0]*ys[j] + rs.q[j][1]*ys[j+1] + rs.q[j][2]*ys[j+2]
+	}
+	for j := 1; j < m; j++ {
+		z[j] -= l1[j-1] * z[j-1]
+		if j >= 2 {
+			z[j] -= l2[j-2] * z[j-2]
+		}
+	}
+	for j := range z {
+		z[j] /= d[j]
+	}
+	for j := m - 2; j >= 0; j-- {
+		z[j] -= l1[j] * z[j+1]
+		if j+2 < m {
+			z[j] -= l2[j] * z[j+2]
+		}
+	}
+
+	gamma = make([]float64, n)
+	copy(gamma[1:n-1], z)
+	g = make([]float64, n)
+	copy(g, ys)
+	for j, v := range z {
+		for k := 0; k < 3; k++ {
+			g[j+k] -= lambda * rs.q[j][k] * v / rs.w[j+k]
+		}
+	}
+	if !trace {
+		return g, gamma, 0, true
+	}
+
+	// Compute the elements of M⁻¹ within the band, s0 on the diagonal and
+	// s1 and s2 on the first two sub-diagonals, by the recursion of
+	//  Hutchinson, M. F. and de Hoog, F. R. "Smoothing noisy data with
+	//  spline functions" (1985), Numerische Mathematik, 47, pp. 99-106.
+	// Then tr(I - A) = λ tr(M⁻¹ Qᵀ W⁻¹ Q).
+	s0 := make([]float64, m+2)
+	s1 := make([]float64, m+2)
+	s2 := make([]float64, m+2)
+	for j := m - 1; j >= 0; j-- {
+		s2[j] = -l1[j]*s1[j+1] - l2[j]*s0[j+2]
+		s1[j] = -l1[j]*s0[j+1] - l2[j]*s1[j+1]
+		s0[j] = 1/d[j] - l1[j]*s1[j] - l2[j]*s2[j]
+	}
+	for j := 0; j < m; j++ {
+		tr += s0[j]*rs.b0[j] + 2*s1[j]*rs.b1[j] + 2*s2[j]*rs.b2[j]
+	}
+	return g, gamma, lambda * tr, true
+}
+
+// gcv returns the generalized cross-validation score for lambda.
+func (rs *reinsch) gcv(ys []float64, lambda float64) float64 {
+	g, _, tr, ok := rs.solve(ys, lambda, true)
+	if !ok {
+		return math.Inf(1)
+	}
+	var rss float64
+	for i, v := range ys {
+		r := v - g[i]
+		rss += rs.w[i] * r * r
+	}
+	return float64(rs.n) * rss / (tr * tr)
+}
+
+// minimizeGCV returns the smoothing parameter that minimizes the generalized
+// cross-validation score. The score is evaluated on a logarithmic grid around
+// the value for which R and λ Qᵀ W⁻¹ Q have the same trace, and the best grid
+// point is refined by golden section search.
+func (rs *reinsch) minimizeGCV(ys []float64) float64 {
+	var trR, trB float64
+	for j := range rs.r0 {
+		trR += rs.r0[j]
+		trB += rs.b0[j]
+	}
+	scale := trR / trB
+	score := func(p float64) float64 {
+		return rs.gcv(ys, scale*math.Pow(10, p))
+	}
+
+	const (
+		lo   = -8.0
+		hi   = 8.0
+		step = 0.5
+	)
+	best := lo
+	bestScore := math.Inf(1)
+	for p := lo; p <= hi; p += step {
+		if v := score(p); v < bestScore {
+			best = p
+			bestScore = v
+		}
+	}
+
+	a, b := best-step, best+step
+	invPhi := (math.Sqrt(5) - 1) / 2
+	c := b - invPhi*(b-a)
+	d := a + invPhi*(b-a)
+	fc, fd := score(c), score(d)
+	for i := 0; i < 40; i++ {
+		if fc < fd {
+			b, d, fd = d, c, fc
+			c = b - invPhi*(b-a)
+			fc = score(c)
+		} else {
+			a, c, fc = c, d, fd
+			d = a + invPhi*(b-a)
+			fd = score(d)
+		}
+	}
+	p := (a + b) / 2
+	if score(p) > bestScore {
+		p = best
+	}
+	return scale * math.Pow(10, p)
+}
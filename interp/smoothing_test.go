@@ -0,0 +1,160 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interp
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+)
+
+func TestSmoothingSplineTrace(t *testing.T) {
+	t.Parallel()
+	// Compare tr(I - A) with the trace of the influence matrix computed
+	// column by column from the fits to the unit vectors.
+	rnd := rand.New(rand.NewSource(1))
+	const n = 9
+	xs := make([]float64, n)
+	w := make([]float64, n)
+	for i := range xs {
+		xs[i] = float64(i) + 0.8*rnd.Float64()
+		w[i] = 0.5 + rnd.Float64()
+	}
+	rs := newReinsch(xs, w)
+	for _, lambda := range []float64{1e-3, 0.1, 1, 30} {
+		var trA float64
+		e := make([]float64, n)
+		for j := range e {
+			e[j] = 1
+			g, _, _, ok := rs.solve(e, lambda, false)
+			if !ok {
+				t.Fatal("unexpected singular system")
+			}
+			trA += g[j]
+			e[j] = 0
+		}
+		_, _, tr, _ := rs.solve(e, lambda, true)
+		if !scalar.EqualWithinAbsOrRel(tr, n-trA, 1e-12, 1e-12) {
+			t.Errorf("lambda=%v: unexpected trace: got %v, want %v", lambda, tr, n-trA)
+		}
+	}
+}
+
+func TestSmoothingSplineLimits(t *testing.T) {
+	t.Parallel()
+	xs := []float64{0, 0.5, 1.5, 2, 3, 4.5, 5}
+	ys := []float64{1, 3, 2, 2.5, 0, 1, -1}
+
+	// For small λ the smoothing spline is the natural interpolating spline.
+	var nc NaturalCubic
+	nc.Fit(xs, ys)
+	ss := SmoothingSpline{Lambda: 1e-10}
+	if err := ss.Fit(xs, ys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for x := 0.0; x <= 5; x += 0.25 {
+		if got, want := ss.Predict(x), nc.Predict(x); !scalar.EqualWithinAbs(got, want, 1e-8) {
+			t.Errorf("unexpected value at %v: got %v, want %v", x, got, want)
+		}
+	}
+
+	// For large λ it is the weighted least squares regression line.
+	w := []float64{1, 2, 1, 0.5, 3, 1, 2}
+	ss = SmoothingSpline{Lambda: 1e12, Weights: w}
+	if err := ss.Fit(xs, ys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var sw, swx, swy, swxx, swxy float64
+	for i, x := range xs {
+		sw += w[i]
+		swx += w[i] * x
+		swy += w[i] * ys[i]
+		swxx += w[i] * x * x
+		swxy += w[i] * x * ys[i]
+	}
+	slope := (sw*swxy - swx*swy) / (sw*swxx - swx*swx)
+	intercept := (swy - slope*swx) / sw
+	for x := 0.0; x <= 5; x += 0.25 {
+		if got, want := ss.Predict(x), intercept+slope*x; !scalar.EqualWithinAbs(got, want, 1e-6) {
+			t.Errorf("unexpected value at %v: got %v, want %v", x, got, want)
+		}
+		if got := ss.PredictDerivative(x); !scalar.EqualWithinAbs(got, slope, 1e-6) {
+			t.Errorf("unexpected derivative at %v: got %v, want %v", x, got, slope)
+		}
+	}
+	if ss.SmoothingParameter() != 1e12 {
+		t.Errorf("unexpected smoothing parameter: %v", ss.SmoothingParameter())
+	}
+}
+
+func TestSmoothingSplineGCV(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	const (
+		n     = 200
+		sigma = 0.2
+	)
+	f := func(x float64) float64 { return math.Sin(2*math.Pi*x) + x }
+	xs := make([]float64, n)
+	ys := make([]float64, n)
+	for i := range xs {
+		xs[i] = (float64(i) + rnd.Float64()) / n
+		ys[i] = f(xs[i]) + sigma*rnd.NormFloat64()
+	}
+	var ss SmoothingSpline
+	if err := ss.Fit(xs, ys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lambda := ss.SmoothingParameter()
+	if lambda <= 0 {
+		t.Fatalf("unexpected smoothing parameter: %v", lambda)
+	}
+
+	// The chosen λ is a local minimum of the GCV score.
+	w := make([]float64, n)
+	for i := range w {
+		w[i] = 1
+	}
+	rs := newReinsch(xs, w)
+	v := rs.gcv(ys, lambda)
+	for _, s := range []float64{0.8, 1.25} {
+		if other := rs.gcv(ys, s*lambda); other < v {
+			t.Errorf("GCV score not minimal: %v at %v < %v at %v", other, s*lambda, v, lambda)
+		}
+	}
+
+	// The fit is closer to the function than the data.
+	var rmse float64
+	for _, x := range xs {
+		d := ss.Predict(x) - f(x)
+		rmse += d * d
+	}
+	rmse = math.Sqrt(rmse / n)
+	if rmse > sigma/3 {
+		t.Errorf("unexpected RMS error: got %v, want less than %v", rmse, sigma/3)
+	}
+}
+
+func TestSmoothingSplineFitErrors(t *testing.T) {
+	t.Parallel()
+	testPiecewiseInterpolatorCreation(t, &SmoothingSpline{})
+	xs := []float64{0, 1, 2}
+	ys := []float64{0, 1, 0}
+	for _, test := range []struct {
+		name string
+		ss   SmoothingSpline
+	}{
+		{"negative lambda", SmoothingSpline{Lambda: -1}},
+		{"wrong weights length", SmoothingSpline{Weights: []float64{1, 1}}},
+		{"zero weight", SmoothingSpline{Weights: []float64{1, 0, 1}}},
+	} {
+		if !panics(func() { test.ss.Fit(xs, ys) }) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// Add returns an enclosure of the sum of x and y.
+func Add(x, y Interval) Interval {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return Interval{Min: addDown(x.Min, y.Min), Max: addUp(x.Max, y.Max)}
+}
+
+// Sub returns an enclosure of the difference of x and y, x-y.
+func Sub(x, y Interval) Interval {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return Interval{Min: subDown(x.Min, y.Max), Max: subUp(x.Max, y.Min)}
+}
+
+// Neg returns the negation of x.
+func Neg(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	return Interval{Min: -x.Max, Max: -x.Min}
+}
+
+// Mul returns an enclosure of the product of x and y.
+func Mul(x, y Interval) Interval {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	return Interval{
+		Min: math.Min(
+			math.Min(mulDown(x.Min, y.Min), mulDown(x.Min, y.Max)),
+			math.Min(mulDown(x.Max, y.Min), mulDown(x.Max, y.Max)),
+		),
+		Max: math.Max(
+			math.Max(mulUp(x.Min, y.Min), mulUp(x.Min, y.Max)),
+			math.Max(mulUp(x.Max, y.Min), mulUp(x.Max, y.Max)),
+		),
+	}
+}
+
+// Scale returns an enclosure of x scaled by f.
+func Scale(f float64, x Interval) Interval {
+	return Mul(Point(f), x)
+}
+
+// Div returns an enclosure of the quotient of x and y. If y contains zero,
+// Div returns Entire, unless y is [0, 0] or x is empty, when Div returns
+// Empty. See DivExtended for a tighter enclosure when y contains zero.
+func Div(x, y Interval) Interval {
+	switch {
+	case x.IsEmpty() || y.IsEmpty() || (y.Min == 0 && y.Max == 0):
+		return Empty()
+	case y.Contains(0):
+		return Entire()
+	case y.Min > 0:
+		switch {
+		case x.Min >= 0:
+			return Interval{Min: divDown(x.Min, y.Max), Max: divUp(x.Max, y.Min)}
+		case x.Max <= 0:
+			return Interval{Min: divDown(x.Min, y.Min), Max: divUp(x.Max, y.Max)}
+		default:
+			return Interval{Min: divDown(x.Min, y.Min), Max: divUp(x.Max, y.Min)}
+		}
+	default:
+		switch {
+		case x.Min >= 0:
+			return Interval{Min: divDown(x.Max, y.Max), Max: divUp(x.Min, y.Min)}
+		case x.Max <= 0:
+			return Interval{Min: divDown(x.Max, y.Min), Max: divUp(x.Min, y.Max)}
+		default:
+			return Interval{Min: divDown(x.Max, y.Max), Max: divUp(x.Min, y.Max)}
+		}
+	}
+}
+
+// DivExtended returns an enclosure of the quotient of x and y as the union
+// of two intervals. When y contains zero in its interior and x does not
+// contain zero, the quotient is the union of two disjoint unbounded
+// intervals, a and b, with a below b. Otherwise the quotient is returned in
+// a, and b is empty.
+func DivExtended(x, y Interval) (a, b Interval) {
+	switch {
+	case x.IsEmpty() || y.IsEmpty() || (y.Min == 0 && y.Max == 0):
+		return Empty(), Empty()
+	case !y.Contains(0):
+		return Div(x, y), Empty()
+	case x.Contains(0):
+		return Entire(), Empty()
+	case x.Max < 0:
+		switch {
+		case y.Max == 0:
+			return Interval{Min: divDown(x.Max, y.Min), Max: posInf}, Empty()
+		case y.Min == 0:
+			return Interval{Min: negInf, Max: divUp(x.Max, y.Max)}, Empty()
+		default:
+			return Interval{Min: negInf, Max: divUp(x.Max, y.Max)}, Interval{Min: divDown(x.Max, y.Min), Max: posInf}
+		}
+	default:
+		switch {
+		case y.Max == 0:
+			return Interval{Min: negInf, Max: divUp(x.Min, y.Min)}, Empty()
+		case y.Min == 0:
+			return Interval{Min: divDown(x.Min, y.Max), Max: posInf}, Empty()
+		default:
+			return Interval{Min: negInf, Max: divUp(x.Min, y.Min)}, Interval{Min: divDown(x.Min, y.Max), Max: posInf}
+		}
+	}
+}
+
+// Inv returns an enclosure of the reciprocal of x.
+func Inv(x Interval) Interval {
+	return Div(Point(1), x)
+}
+
+// Abs returns the absolute value of x.
+func Abs(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	return Interval{Min: x.Mig(), Max: x.Mag()}
+}
+
+// Sqr returns an enclosure of the square of x. Sqr(x) is a tighter
+// enclosure than Mul(x, x) when x contains zero.
+func Sqr(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	lo, hi := x.Mig(), x.Mag()
+	return Interval{Min: mulDown(lo, lo), Max: mulUp(hi, hi)}
+}
+
+// Pow returns an enclosure of x raised to the integer power n. Pow(x, 0)
+// is [1, 1] for a non-empty x.
+func Pow(x Interval, n int) Interval {
+	switch {
+	case x.IsEmpty():
+		return Empty()
+	case n == 0:
+		return Point(1)
+	case n < 0:
+		return Inv(Pow(x, -n))
+	case n%2 == 0:
+		return Interval{Min: powDown(x.Mig(), n), Max: powUp(x.Mag(), n)}
+	}
+	// Odd powers are increasing.
+	lo := powDown(x.Min, n)
+	if x.Min < 0 {
+		lo = -powUp(-x.Min, n)
+	}
+	hi := powUp(x.Max, n)
+	if x.Max < 0 {
+		hi = -powDown(-x.Max, n)
+	}
+	return Interval{Min: lo, Max: hi}
+}
+
+// powDown and powUp return bounds of a^n for a ≥ 0 and n > 0 by repeated
+// squaring, which is monotonic for non-negative operands.
+func powDown(a float64, n int) float64 {
+	r := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			r = mulDown(r, a)
+		}
+		a = mulDown(a, a)
+	}
+	return math.Max(r, 0)
+}
+
+func powUp(a float64, n int) float64 {
+	r := 1.0
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			r = mulUp(r, a)
+		}
+		a = mulUp(a, a)
+	}
+	return r
+}
+
+// Sqrt returns an enclosure of the square root of the non-negative members
+// of x. Sqrt returns Empty if x has no non-negative members.
+func Sqrt(x Interval) Interval {
+	if x.IsEmpty() || x.Max < 0 {
+		return Empty()
+	}
+	return Interval{Min: sqrtDown(math.Max(x.Min, 0)), Max: sqrtUp(x.Max)}
+}
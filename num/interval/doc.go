@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package interval provides interval arithmetic with outward rounding for
+// verified computation.
+//
+// An Interval is a closed set of real numbers [Min, Max]. The operations of
+// the package return enclosures: for every choice of real numbers in the
+// operand intervals, the exact real result of the operation lies in the
+// returned Interval. Go does not provide control over the floating-point
+// rounding mode, so the arithmetic operations and Sqrt determine the
+// direction of the rounding error exactly with error-free transformations,
+// and round the bounds outward only when the result is inexact. The bounds
+// of the elementary functions, which are computed by the math package, are
+// widened by two units in the last place, assuming that the math package
+// functions are accurate to within one unit in the last place.
+//
+// See https://en.wikipedia.org/wiki/Interval_arithmetic for details of
+// interval arithmetic and its uses.
+package interval // import "github.com/jingcheng-WU/gonum/num/interval"
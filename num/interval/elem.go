@@ -0,0 +1,166 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+var (
+	// pi is an enclosure of π. The float64 nearest to π is below π.
+	pi = Interval{Min: math.Pi, Max: math.Nextafter(math.Pi, 4)}
+
+	halfPi = Scale(0.5, pi)
+	twoPi  = Scale(2, pi)
+)
+
+// Pi returns an enclosure of π.
+func Pi() Interval {
+	return pi
+}
+
+// Exp returns an enclosure of e**x, the base-e exponential of x.
+func Exp(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	return Interval{Min: expDown(x.Min), Max: expUp(x.Max)}
+}
+
+func expDown(x float64) float64 {
+	if x == 0 || math.IsInf(x, 0) {
+		return math.Exp(x)
+	}
+	return math.Max(widenDown(math.Exp(x)), 0)
+}
+
+func expUp(x float64) float64 {
+	if x == 0 || math.IsInf(x, 0) {
+		return math.Exp(x)
+	}
+	return widenUp(math.Exp(x))
+}
+
+// Log returns an enclosure of the natural logarithm of the positive members
+// of x. Log returns Empty if x has no positive members.
+func Log(x Interval) Interval {
+	if x.IsEmpty() || x.Max <= 0 {
+		return Empty()
+	}
+	lo := negInf
+	if x.Min > 0 {
+		lo = logDown(x.Min)
+	}
+	return Interval{Min: lo, Max: logUp(x.Max)}
+}
+
+func logDown(x float64) float64 {
+	if x == 1 || math.IsInf(x, 0) {
+		return math.Log(x)
+	}
+	return widenDown(math.Log(x))
+}
+
+func logUp(x float64) float64 {
+	if x == 1 || math.IsInf(x, 0) {
+		return math.Log(x)
+	}
+	return widenUp(math.Log(x))
+}
+
+// containsInteger returns whether x may contain an integer.
+func containsInteger(x Interval) bool {
+	return !x.IsEmpty() && math.Ceil(x.Min) <= math.Floor(x.Max)
+}
+
+// hasPeriodicPoint returns whether x may contain a point offset+2kπ for an
+// integer k.
+func hasPeriodicPoint(x, offset Interval) bool {
+	return containsInteger(Div(Sub(x, offset), twoPi))
+}
+
+// Sin returns an enclosure of the sine of x.
+func Sin(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	if math.IsInf(x.Min, 0) || math.IsInf(x.Max, 0) {
+		return Interval{Min: -1, Max: 1}
+	}
+	// Between the extrema of the sine, which are included when x may
+	// contain them, the sine is monotonic.
+	sa, sb := math.Sin(x.Min), math.Sin(x.Max)
+	r := Interval{Min: math.Min(sa, sb), Max: math.Max(sa, sb)}
+	if hasPeriodicPoint(x, halfPi) {
+		r.Max = 1
+	}
+	if hasPeriodicPoint(x, Neg(halfPi)) {
+		r.Min = -1
+	}
+	return clampUnit(r, x.Min == 0 && x.Max == 0)
+}
+
+// Cos returns an enclosure of the cosine of x.
+func Cos(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	if math.IsInf(x.Min, 0) || math.IsInf(x.Max, 0) {
+		return Interval{Min: -1, Max: 1}
+	}
+	ca, cb := math.Cos(x.Min), math.Cos(x.Max)
+	r := Interval{Min: math.Min(ca, cb), Max: math.Max(ca, cb)}
+	if hasPeriodicPoint(x, Point(0)) {
+		r.Max = 1
+	}
+	if hasPeriodicPoint(x, pi) {
+		r.Min = -1
+	}
+	return clampUnit(r, x.Min == 0 && x.Max == 0)
+}
+
+// clampUnit widens the bounds of r computed by the math package, except
+// bounds of ±1 from extrema, and clamps them to [-1, 1]. If exact is true,
+// r is returned unaltered.
+func clampUnit(r Interval, exact bool) Interval {
+	if exact {
+		return r
+	}
+	if r.Min != -1 {
+		r.Min = math.Max(widenDown(r.Min), -1)
+	}
+	if r.Max != 1 {
+		r.Max = math.Min(widenUp(r.Max), 1)
+	}
+	return r
+}
+
+// Tan returns an enclosure of the tangent of x. Tan returns Entire if x
+// may contain a pole of the tangent.
+func Tan(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	if math.IsInf(x.Min, 0) || math.IsInf(x.Max, 0) ||
+		containsInteger(Div(Sub(x, halfPi), pi)) {
+		return Entire()
+	}
+	if x.Min == 0 && x.Max == 0 {
+		return x
+	}
+	return Interval{Min: widenDown(math.Tan(x.Min)), Max: widenUp(math.Tan(x.Max))}
+}
+
+// Atan returns an enclosure of the inverse tangent of x.
+func Atan(x Interval) Interval {
+	if x.IsEmpty() {
+		return Empty()
+	}
+	if x.Min == 0 && x.Max == 0 {
+		return x
+	}
+	return Interval{
+		Min: math.Max(widenDown(math.Atan(x.Min)), -halfPi.Max),
+		Max: math.Min(widenUp(math.Atan(x.Max)), halfPi.Max),
+	}
+}
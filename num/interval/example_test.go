@@ -0,0 +1,38 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval_test
+
+import (
+	"fmt"
+
+	"github.com/jingcheng-WU/gonum/num/interval"
+)
+
+func ExampleNewton() {
+	// Find verified enclosures of the roots of
+	//  f(x) = x^2 - 2
+	// in [-4, 4].
+	f := func(x interval.Interval) interval.Interval {
+		return interval.Sub(interval.Sqr(x), interval.Point(2))
+	}
+	df := func(x interval.Interval) interval.Interval {
+		return interval.Scale(2, x)
+	}
+	for _, r := range interval.Newton(f, df, interval.New(-4, 4), nil) {
+		fmt.Printf("%v unique=%t\n", r.Interval, r.Unique)
+	}
+
+	// Output:
+	// [-1.4142135623730951, -1.414213562373095] unique=true
+	// [1.414213562373095, 1.4142135623730951] unique=true
+}
+
+func ExampleSin() {
+	// The range of the sine over [1, 2] includes its maximum at π/2.
+	fmt.Println(interval.Sin(interval.New(1, 2)))
+
+	// Output:
+	// [0.8414709848078963, 1]
+}
@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/jingcheng-WU/gonum/spatial/r1"
+)
+
+// Interval is a closed interval of real numbers, [Min, Max]. Infinite bounds
+// denote unbounded intervals, and are not members of the interval. An
+// Interval with Min greater than Max, or with a NaN bound, is empty.
+//
+// Interval has the same underlying type as r1.Interval, so values can be
+// converted between the two types.
+type Interval r1.Interval
+
+// New returns the interval [min, max]. New panics if min is greater than
+// max or either bound is NaN.
+func New(min, max float64) Interval {
+	if !(min <= max) {
+		panic("interval: min greater than max")
+	}
+	return Interval{Min: min, Max: max}
+}
+
+// Point returns the degenerate interval [x, x].
+func Point(x float64) Interval {
+	return Interval{Min: x, Max: x}
+}
+
+// Empty returns the empty interval.
+func Empty() Interval {
+	return Interval{Min: posInf, Max: negInf}
+}
+
+// Entire returns the interval of all real numbers.
+func Entire() Interval {
+	return Interval{Min: negInf, Max: posInf}
+}
+
+// FromR1 returns the interval with the bounds of r.
+func FromR1(r r1.Interval) Interval {
+	return Interval(r)
+}
+
+// R1 returns the bounds of x as an r1.Interval.
+func (x Interval) R1() r1.Interval {
+	return r1.Interval(x)
+}
+
+// IsEmpty returns whether x contains no real number.
+func (x Interval) IsEmpty() bool {
+	return !(x.Min <= x.Max)
+}
+
+// Contains returns whether v is a member of x.
+func (x Interval) Contains(v float64) bool {
+	return x.Min <= v && v <= x.Max && !math.IsInf(v, 0)
+}
+
+// Subset returns whether y is a subset of x.
+func (x Interval) Subset(y Interval) bool {
+	return y.IsEmpty() || (x.Min <= y.Min && y.Max <= x.Max)
+}
+
+// Interior returns whether y is a subset of the interior of x.
+func (x Interval) Interior(y Interval) bool {
+	if y.IsEmpty() {
+		return true
+	}
+	return (x.Min < y.Min || math.IsInf(x.Min, -1)) && (y.Max < x.Max || math.IsInf(x.Max, 1))
+}
+
+// Width returns an upper bound of the width of x, Max-Min. Width returns
+// zero for an empty interval.
+func (x Interval) Width() float64 {
+	if x.IsEmpty() {
+		return 0
+	}
+	return subUp(x.Max, x.Min)
+}
+
+// Mid returns a point of x close to its midpoint. For intervals with an
+// infinite bound, Mid returns a finite member of x. Mid returns NaN for an
+// empty interval.
+func (x Interval) Mid() float64 {
+	switch {
+	case x.IsEmpty():
+		return math.NaN()
+	case math.IsInf(x.Min, -1) && math.IsInf(x.Max, 1):
+		return 0
+	case math.IsInf(x.Min, -1):
+		return -math.MaxFloat64
+	case math.IsInf(x.Max, 1):
+		return math.MaxFloat64
+	}
+	m := x.Min/2 + x.Max/2
+	return math.Max(x.Min, math.Min(m, x.Max))
+}
+
+// Mag returns the magnitude of x, the largest absolute value of its
+// members.
+func (x Interval) Mag() float64 {
+	return math.Max(math.Abs(x.Min), math.Abs(x.Max))
+}
+
+// Mig returns the mignitude of x, the smallest absolute value of its
+// members.
+func (x Interval) Mig() float64 {
+	if x.Contains(0) {
+		return 0
+	}
+	return math.Min(math.Abs(x.Min), math.Abs(x.Max))
+}
+
+// String returns a string representation of x.
+func (x Interval) String() string {
+	if x.IsEmpty() {
+		return "[]"
+	}
+	return fmt.Sprintf("[%v, %v]", x.Min, x.Max)
+}
+
+// Intersect returns the intersection of x and y.
+func Intersect(x, y Interval) Interval {
+	if x.IsEmpty() || y.IsEmpty() {
+		return Empty()
+	}
+	r := Interval{Min: math.Max(x.Min, y.Min), Max: math.Min(x.Max, y.Max)}
+	if r.IsEmpty() {
+		return Empty()
+	}
+	return r
+}
+
+// Hull returns the smallest interval containing x and y.
+func Hull(x, y Interval) Interval {
+	switch {
+	case x.IsEmpty():
+		return y
+	case y.IsEmpty():
+		return x
+	}
+	return Interval{Min: math.Min(x.Min, y.Min), Max: math.Max(x.Max, y.Max)}
+}
+
+// Bisect returns the two halves of x split at its midpoint.
+func Bisect(x Interval) (lo, hi Interval) {
+	m := x.Mid()
+	return Interval{Min: x.Min, Max: m}, Interval{Min: m, Max: x.Max}
+}
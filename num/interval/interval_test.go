@@ -0,0 +1,391 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/spatial/r1"
+)
+
+const prec = 2200
+
+// exactContains returns whether the exact value v lies in x.
+func exactContains(x Interval, v *big.Float) bool {
+	if x.IsEmpty() {
+		return false
+	}
+	return big.NewFloat(x.Min).Cmp(v) <= 0 && v.Cmp(big.NewFloat(x.Max)) <= 0
+}
+
+// tight returns whether x has adjacent or equal bounds.
+func tight(x Interval) bool {
+	return x.Min == x.Max || math.Nextafter(x.Min, posInf) == x.Max
+}
+
+func newFloat(v float64) *big.Float {
+	return new(big.Float).SetPrec(prec).SetFloat64(v)
+}
+
+// isTiny returns whether any of the non-zero values in v is small enough
+// for the rounding error of an operation to be inexact.
+func isTiny(v ...float64) bool {
+	for _, x := range v {
+		if x != 0 && math.Abs(x) < tiny {
+			return true
+		}
+	}
+	return false
+}
+
+func randFloat(rnd *rand.Rand) float64 {
+	switch rnd.Intn(8) {
+	case 0:
+		// Subnormal numbers.
+		return (rnd.Float64() - 0.5) * 0x1p-1060
+	case 1:
+		// Large numbers.
+		return (rnd.Float64() - 0.5) * 0x1p1020
+	case 2:
+		return float64(rnd.Intn(21) - 10)
+	}
+	return (rnd.Float64() - 0.5) * math.Pow(2, float64(rnd.Intn(80)-40))
+}
+
+func TestArithmeticPoints(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		a, b := randFloat(rnd), randFloat(rnd)
+		x, y := Point(a), Point(b)
+		ea, eb := newFloat(a), newFloat(b)
+		for _, test := range []struct {
+			name  string
+			got   Interval
+			exact *big.Float
+		}{
+			{name: "Add", got: Add(x, y), exact: new(big.Float).SetPrec(prec).Add(ea, eb)},
+			{name: "Sub", got: Sub(x, y), exact: new(big.Float).SetPrec(prec).Sub(ea, eb)},
+			{name: "Mul", got: Mul(x, y), exact: new(big.Float).SetPrec(prec).Mul(ea, eb)},
+			{name: "Div", got: Div(x, y), exact: func() *big.Float {
+				if b == 0 {
+					return nil
+				}
+				return new(big.Float).SetPrec(prec).Quo(ea, eb)
+			}()},
+			{name: "Sqrt", got: Sqrt(x), exact: func() *big.Float {
+				if a < 0 {
+					return nil
+				}
+				return new(big.Float).SetPrec(prec).Sqrt(ea)
+			}()},
+		} {
+			if test.exact == nil {
+				if !test.got.IsEmpty() {
+					t.Errorf("%s(%v, %v): expected empty interval, got %v", test.name, a, b, test.got)
+				}
+				continue
+			}
+			if !exactContains(test.got, test.exact) {
+				t.Errorf("%s(%v, %v): %v does not contain %v", test.name, a, b, test.got, test.exact)
+			}
+			v, acc := test.exact.Float64()
+			if math.IsInf(v, 0) || (test.name != "Add" && test.name != "Sub" && isTiny(a, b, v)) {
+				// Overflowed results and products, quotients and
+				// square roots close to underflow are not tight.
+				continue
+			}
+			if !tight(test.got) {
+				t.Errorf("%s(%v, %v): %v is not tight", test.name, a, b, test.got)
+			}
+			if acc == big.Exact && test.got != Point(v) {
+				t.Errorf("%s(%v, %v): %v is not exact", test.name, a, b, test.got)
+			}
+		}
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name string
+		got  Interval
+		want Interval
+	}{
+		{name: "Add", got: Add(New(1, 2), New(-3, 0.5)), want: New(-2, 2.5)},
+		{name: "AddOverflow", got: Add(Point(math.MaxFloat64), Point(math.MaxFloat64)), want: New(math.MaxFloat64, inf)},
+		{name: "AddUnbounded", got: Add(New(-inf, 1), Point(1)), want: New(-inf, 2)},
+		{name: "AddEmpty", got: Add(Empty(), Point(1)), want: Empty()},
+		{name: "Sub", got: Sub(New(1, 2), New(-3, 0.5)), want: New(0.5, 5)},
+		{name: "Neg", got: Neg(New(-3, 0.5)), want: New(-0.5, 3)},
+		{name: "Mul", got: Mul(New(-1, 2), New(-3, 4)), want: New(-6, 8)},
+		{name: "MulZero", got: Mul(Entire(), Point(0)), want: Point(0)},
+		{name: "MulUnbounded", got: Mul(New(1, inf), New(-2, -1)), want: New(-inf, -1)},
+		{name: "Scale", got: Scale(-2, New(-1, 3)), want: New(-6, 2)},
+		{name: "Div", got: Div(New(1, 2), New(4, 8)), want: New(0.125, 0.5)},
+		{name: "DivNegative", got: Div(New(-2, 1), New(-4, -2)), want: New(-0.5, 1)},
+		{name: "DivUnbounded", got: Div(New(1, inf), New(1, inf)), want: New(0, inf)},
+		{name: "DivZero", got: Div(New(1, 2), New(-1, 1)), want: Entire()},
+		{name: "DivPointZero", got: Div(New(1, 2), Point(0)), want: Empty()},
+		{name: "Inv", got: Inv(New(2, 4)), want: New(0.25, 0.5)},
+		{name: "Abs", got: Abs(New(-3, 2)), want: New(0, 3)},
+		{name: "AbsNegative", got: Abs(New(-3, -2)), want: New(2, 3)},
+		{name: "Sqr", got: Sqr(New(-2, 1)), want: New(0, 4)},
+		{name: "PowEven", got: Pow(New(-2, 3), 2), want: New(0, 9)},
+		{name: "PowOdd", got: Pow(New(-2, 3), 3), want: New(-8, 27)},
+		{name: "PowNegativeOdd", got: Pow(New(-3, -2), 3), want: New(-27, -8)},
+		{name: "PowZero", got: Pow(New(-3, 2), 0), want: Point(1)},
+		{name: "PowInverse", got: Pow(New(2, 4), -2), want: New(0.0625, 0.25)},
+		{name: "Sqrt", got: Sqrt(New(-4, 9)), want: New(0, 3)},
+		{name: "SqrtNegative", got: Sqrt(New(-4, -1)), want: Empty()},
+		{name: "Intersect", got: Intersect(New(-1, 2), New(1, 3)), want: New(1, 2)},
+		{name: "IntersectDisjoint", got: Intersect(New(-1, 0), New(1, 3)), want: Empty()},
+		{name: "Hull", got: Hull(New(-1, 0), New(1, 3)), want: New(-1, 3)},
+		{name: "HullEmpty", got: Hull(Empty(), New(1, 3)), want: New(1, 3)},
+	} {
+		if test.got != test.want && !(test.got.IsEmpty() && test.want.IsEmpty()) {
+			t.Errorf("unexpected %s result: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestDivExtended(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		x, y   Interval
+		wantA  Interval
+		wantB  Interval
+		twoSet bool
+	}{
+		{x: New(1, 2), y: New(-1, 4), wantA: New(-inf, -1), wantB: New(0.25, inf), twoSet: true},
+		{x: New(-2, -1), y: New(-1, 4), wantA: New(-inf, -0.25), wantB: New(1, inf), twoSet: true},
+		{x: New(1, 2), y: New(0, 4), wantA: New(0.25, inf)},
+		{x: New(1, 2), y: New(-4, 0), wantA: New(-inf, -0.25)},
+		{x: New(-2, -1), y: New(0, 4), wantA: New(-inf, -0.25)},
+		{x: New(-2, -1), y: New(-4, 0), wantA: New(0.25, inf)},
+		{x: New(-1, 2), y: New(-1, 4), wantA: Entire()},
+		{x: New(1, 2), y: New(2, 4), wantA: New(0.25, 1)},
+		{x: New(1, 2), y: Point(0), wantA: Empty()},
+	} {
+		a, b := DivExtended(test.x, test.y)
+		if a != test.wantA && !(a.IsEmpty() && test.wantA.IsEmpty()) {
+			t.Errorf("unexpected first result for %v/%v: got %v, want %v", test.x, test.y, a, test.wantA)
+		}
+		if test.twoSet {
+			if b != test.wantB {
+				t.Errorf("unexpected second result for %v/%v: got %v, want %v", test.x, test.y, b, test.wantB)
+			}
+		} else if !b.IsEmpty() {
+			t.Errorf("unexpected second result for %v/%v: got %v, want empty", test.x, test.y, b)
+		}
+	}
+}
+
+// Known values to 40 significant digits.
+const (
+	e      = "2.718281828459045235360287471352662497757"
+	ln2    = "0.6931471805599453094172321214581765680755"
+	ln10   = "2.302585092994045684017960440315669582622"
+	piStr  = "3.141592653589793238462643383279502884197"
+	sin1   = "0.8414709848078965066525023216302989996226"
+	cos1   = "0.5403023058681397174009366074429766037323"
+	tan1   = "1.557407724654902230506974807458360173087"
+	sqrt2  = "1.414213562373095048801688724209698078570"
+	piBy4  = "0.7853981633974483096156608458198757210493"
+	expM10 = "0.00004539992976248485153559151556055061023791"
+)
+
+func TestKnownEnclosures(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		got  Interval
+		want string
+		ulps int
+	}{
+		{name: "Pi", got: Pi(), want: piStr, ulps: 1},
+		{name: "Exp(1)", got: Exp(Point(1)), want: e, ulps: 4},
+		{name: "Exp(-10)", got: Exp(Point(-10)), want: expM10, ulps: 4},
+		{name: "Log(2)", got: Log(Point(2)), want: ln2, ulps: 4},
+		{name: "Log(10)", got: Log(Point(10)), want: ln10, ulps: 4},
+		{name: "Sin(1)", got: Sin(Point(1)), want: sin1, ulps: 4},
+		{name: "Cos(1)", got: Cos(Point(1)), want: cos1, ulps: 4},
+		{name: "Tan(1)", got: Tan(Point(1)), want: tan1, ulps: 4},
+		{name: "Atan(1)", got: Atan(Point(1)), want: piBy4, ulps: 4},
+		{name: "Sqrt(2)", got: Sqrt(Point(2)), want: sqrt2, ulps: 1},
+		{name: "Log(Exp(1))", got: Log(Exp(Point(1))), want: "1", ulps: 12},
+		{name: "Sqr(Sqrt(2))", got: Sqr(Sqrt(Point(2))), want: "2", ulps: 4},
+	} {
+		want, _, err := big.ParseFloat(test.want, 10, prec, big.ToNearestEven)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.name, err)
+		}
+		if !exactContains(test.got, want) {
+			t.Errorf("%s: %v does not contain %s", test.name, test.got, test.want)
+		}
+		max := test.got.Min
+		for i := 0; i < test.ulps; i++ {
+			max = math.Nextafter(max, posInf)
+		}
+		if test.got.Max > max {
+			t.Errorf("%s: %v wider than %d ulps", test.name, test.got, test.ulps)
+		}
+	}
+}
+
+func TestElementary(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	for _, test := range []struct {
+		name string
+		got  Interval
+		want Interval
+	}{
+		{name: "Exp(0)", got: Exp(Point(0)), want: Point(1)},
+		{name: "Exp(-Inf, 0)", got: Exp(New(-inf, 0)), want: New(0, 1)},
+		{name: "Log(1)", got: Log(Point(1)), want: Point(0)},
+		{name: "Log(0, 1)", got: Log(New(0, 1)), want: New(-inf, 0)},
+		{name: "Log(-1, 0)", got: Log(New(-1, 0)), want: Empty()},
+		{name: "Sin(0)", got: Sin(Point(0)), want: Point(0)},
+		{name: "Sin(0, 7)", got: Sin(New(0, 7)), want: New(-1, 1)},
+		{name: "Sin(Entire)", got: Sin(Entire()), want: New(-1, 1)},
+		{name: "Cos(0)", got: Cos(Point(0)), want: Point(1)},
+		{name: "Cos(-1, 4)", got: Cos(New(-1, 4)), want: New(-1, 1)},
+		{name: "Tan(0)", got: Tan(Point(0)), want: Point(0)},
+		{name: "Tan(1, 2)", got: Tan(New(1, 2)), want: Entire()},
+		{name: "Atan(Entire)", got: Atan(Entire()), want: New(-halfPi.Max, halfPi.Max)},
+	} {
+		if test.got != test.want && !(test.got.IsEmpty() && test.want.IsEmpty()) {
+			t.Errorf("unexpected %s result: got %v, want %v", test.name, test.got, test.want)
+		}
+	}
+}
+
+func TestElementarySoundness(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name   string
+		f      func(Interval) Interval
+		g      func(float64) float64
+		lo, hi float64
+	}{
+		{name: "Exp", f: Exp, g: math.Exp, lo: -50, hi: 50},
+		{name: "Log", f: Log, g: math.Log, lo: 1e-10, hi: 100},
+		{name: "Sin", f: Sin, g: math.Sin, lo: -20, hi: 20},
+		{name: "Cos", f: Cos, g: math.Cos, lo: -20, hi: 20},
+		{name: "Tan", f: Tan, g: math.Tan, lo: -5, hi: 5},
+		{name: "Atan", f: Atan, g: math.Atan, lo: -20, hi: 20},
+		{name: "Sqrt", f: Sqrt, g: math.Sqrt, lo: 0, hi: 100},
+		{name: "Sqr", f: Sqr, g: func(x float64) float64 { return x * x }, lo: -10, hi: 10},
+	} {
+		for i := 0; i < 1000; i++ {
+			a := test.lo + (test.hi-test.lo)*rnd.Float64()
+			b := a + (test.hi-a)*math.Pow(rnd.Float64(), 4)
+			x := New(a, b)
+			got := test.f(x)
+			for j := 0; j <= 20; j++ {
+				v := math.Min(a+(b-a)*float64(j)/20, b)
+				if gv := test.g(v); !got.Contains(gv) {
+					t.Errorf("%s(%v) = %v does not contain %s(%v) = %v", test.name, x, got, test.name, v, gv)
+				}
+			}
+		}
+	}
+}
+
+func TestIntervalMethods(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	x := New(-1, 3)
+	if !x.Contains(0) || x.Contains(4) || Entire().Contains(inf) {
+		t.Error("unexpected Contains result")
+	}
+	if !x.Subset(New(0, 1)) || x.Subset(New(0, 4)) || !x.Subset(Empty()) {
+		t.Error("unexpected Subset result")
+	}
+	if !x.Interior(New(0, 1)) || x.Interior(New(-1, 1)) || !Entire().Interior(Entire()) {
+		t.Error("unexpected Interior result")
+	}
+	if x.Width() != 4 || x.Mid() != 1 || x.Mag() != 3 || x.Mig() != 0 || New(-3, -2).Mig() != 2 {
+		t.Error("unexpected measure of interval")
+	}
+	for _, v := range []Interval{Entire(), New(-inf, 0), New(0, inf), Point(math.MaxFloat64)} {
+		if m := v.Mid(); !v.Contains(m) {
+			t.Errorf("midpoint %v not in %v", m, v)
+		}
+	}
+	lo, hi := Bisect(x)
+	if lo != New(-1, 1) || hi != New(1, 3) {
+		t.Errorf("unexpected bisection: got %v and %v", lo, hi)
+	}
+	if s := x.String(); s != "[-1, 3]" {
+		t.Errorf("unexpected string: got %q", s)
+	}
+	r := r1.Interval{Min: -1, Max: 3}
+	if FromR1(r) != x || x.R1() != r {
+		t.Error("unexpected r1.Interval conversion")
+	}
+	panicked := func() (panicked bool) {
+		defer func() {
+			panicked = recover() != nil
+		}()
+		New(1, 0)
+		return false
+	}()
+	if !panicked {
+		t.Error("expected panic for reversed bounds")
+	}
+}
+
+func TestVector(t *testing.T) {
+	t.Parallel()
+	x := []Interval{New(-1, 1), New(2, 3)}
+	y := []Interval{New(1, 2), New(-1, 0)}
+	if got, want := Dot(x, y), New(-5, 2); got != want {
+		t.Errorf("unexpected Dot: got %v, want %v", got, want)
+	}
+	norm := Norm(Points(nil, []float64{3, 4}))
+	if !norm.Contains(5) || !tight(norm) {
+		t.Errorf("unexpected Norm: got %v", norm)
+	}
+	dst := make([]Interval, 2)
+	AddTo(dst, x, y)
+	if dst[0] != New(0, 3) || dst[1] != New(1, 3) {
+		t.Errorf("unexpected AddTo: got %v", dst)
+	}
+	SubTo(dst, x, y)
+	if dst[0] != New(-3, 0) || dst[1] != New(2, 4) {
+		t.Errorf("unexpected SubTo: got %v", dst)
+	}
+	ScaleTo(dst, New(-1, 2), x)
+	if dst[0] != New(-2, 2) || dst[1] != New(-3, 6) {
+		t.Errorf("unexpected ScaleTo: got %v", dst)
+	}
+	if mids := Mids(nil, x); mids[0] != 0 || mids[1] != 2.5 {
+		t.Errorf("unexpected Mids: got %v", mids)
+	}
+	if w := MaxWidth(x); w != 2 {
+		t.Errorf("unexpected MaxWidth: got %v", w)
+	}
+	if !ContainsPoint(x, []float64{0, 2}) || ContainsPoint(x, []float64{0, 4}) {
+		t.Error("unexpected ContainsPoint result")
+	}
+	box := []r1.Interval{{Min: -1, Max: 1}, {Min: 2, Max: 3}}
+	got := FromR1s(nil, box)
+	if got[0] != x[0] || got[1] != x[1] {
+		t.Errorf("unexpected FromR1s: got %v", got)
+	}
+	back := ToR1s(nil, got)
+	if back[0] != box[0] || back[1] != box[1] {
+		t.Errorf("unexpected ToR1s: got %v", back)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "sort"
+
+// Root is an enclosure of roots of a function.
+type Root struct {
+	Interval
+
+	// Unique is true if the Interval is verified to contain exactly one
+	// root. Otherwise the Interval may contain zero, one or several roots.
+	Unique bool
+}
+
+// NewtonSettings are the settings of Newton.
+type NewtonSettings struct {
+	// Tol is the width below which enclosures are not refined further.
+	// If Tol is zero, enclosures are refined until the Newton step makes
+	// no progress.
+	Tol float64
+
+	// MaxIter is the maximum number of Newton steps. If MaxIter is zero,
+	// a default of 1000 is used.
+	MaxIter int
+}
+
+// Newton finds enclosures of all roots of a continuously differentiable
+// function in x using the interval Newton method with bisection. The
+// functions f and df must be interval extensions of the function and its
+// derivative, returning enclosures of their ranges over an interval, for
+// example when they are written in terms of the functions of this package.
+//
+// Every root in x is contained in one of the returned Roots, which are
+// sorted in increasing order. Roots that could not be resolved within
+// MaxIter steps are returned with Unique false. If settings is nil, the
+// default settings are used. Newton panics if Tol is negative.
+func Newton(f, df func(Interval) Interval, x Interval, settings *NewtonSettings) []Root {
+	tol := 0.0
+	maxIter := 1000
+	if settings != nil {
+		if settings.Tol < 0 {
+			panic("interval: negative tolerance")
+		}
+		tol = settings.Tol
+		if settings.MaxIter > 0 {
+			maxIter = settings.MaxIter
+		}
+	}
+
+	var roots []Root
+	work := []Root{{Interval: x}}
+	for iter := 0; len(work) > 0; iter++ {
+		r := work[len(work)-1]
+		work = work[:len(work)-1]
+		if r.IsEmpty() || !f(r.Interval).Contains(0) {
+			continue
+		}
+		if iter >= maxIter {
+			roots = append(roots, Root{Interval: r.Interval})
+			continue
+		}
+		if tol > 0 && r.Width() <= tol {
+			roots = append(roots, r)
+			continue
+		}
+
+		// The Newton step is N = m - f(m)/f'(X).
+		m := r.Mid()
+		d := df(r.Interval)
+		q1, q2 := DivExtended(f(Point(m)), d)
+		n1 := Intersect(Sub(Point(m), q1), r.Interval)
+		n2 := Intersect(Sub(Point(m), q2), r.Interval)
+		if n1.IsEmpty() && n2.IsEmpty() {
+			continue
+		}
+		if n2.IsEmpty() {
+			// If N is in the interior of X, X contains exactly one
+			// root, and so does N.
+			unique := r.Unique || (!d.Contains(0) && r.Interior(Sub(Point(m), q1)))
+			if n1 != r.Interval {
+				work = append(work, Root{Interval: n1, Unique: unique})
+				continue
+			}
+			lo, hi := split(r.Interval)
+			if unique || lo == r.Interval || hi == r.Interval {
+				// No further progress is possible.
+				roots = append(roots, Root{Interval: r.Interval, Unique: unique})
+				continue
+			}
+			work = append(work, Root{Interval: lo}, Root{Interval: hi})
+			continue
+		}
+		work = append(work, Root{Interval: n1})
+		work = append(work, Root{Interval: n2})
+	}
+
+	sort.Slice(roots, func(i, j int) bool { return roots[i].Min < roots[j].Min })
+	return roots
+}
+
+// split returns the two parts of x split near its midpoint. The split is
+// placed slightly off the midpoint so that roots at simple numbers, like
+// zero, are unlikely to lie on the boundary of both parts.
+func split(x Interval) (lo, hi Interval) {
+	const t = 0.4921875
+	s := x.Min + t*(x.Max-x.Min)
+	if !(x.Min < s && s < x.Max) {
+		return Bisect(x)
+	}
+	return Interval{Min: x.Min, Max: s}, Interval{Min: s, Max: x.Max}
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+	"testing"
+)
+
+func TestNewton(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		f, df    func(Interval) Interval
+		x        Interval
+		settings *NewtonSettings
+
+		roots  []float64
+		unique []bool
+	}{
+		{
+			name:   "Sqrt2",
+			f:      func(x Interval) Interval { return Sub(Sqr(x), Point(2)) },
+			df:     func(x Interval) Interval { return Scale(2, x) },
+			x:      New(-4, 4),
+			roots:  []float64{-math.Sqrt2, math.Sqrt2},
+			unique: []bool{true, true},
+		},
+		{
+			name: "Sin",
+			f:    Sin,
+			df:   Cos,
+			x:    New(-10, 10),
+			roots: []float64{
+				-3 * math.Pi, -2 * math.Pi, -math.Pi, 0,
+				math.Pi, 2 * math.Pi, 3 * math.Pi,
+			},
+			unique: []bool{true, true, true, true, true, true, true},
+		},
+		{
+			name: "Transcendental",
+			// exp(-x) = x has the root of the omega constant.
+			f:      func(x Interval) Interval { return Sub(Exp(Neg(x)), x) },
+			df:     func(x Interval) Interval { return Sub(Neg(Exp(Neg(x))), Point(1)) },
+			x:      New(-1, 10),
+			roots:  []float64{0.5671432904097838},
+			unique: []bool{true},
+		},
+		{
+			name:     "Tol",
+			f:        func(x Interval) Interval { return Sub(Sqr(x), Point(2)) },
+			df:       func(x Interval) Interval { return Scale(2, x) },
+			x:        New(0, 4),
+			settings: &NewtonSettings{Tol: 1e-3},
+			roots:    []float64{math.Sqrt2},
+		},
+		{
+			name:  "NoRoot",
+			f:     func(x Interval) Interval { return Add(Sqr(x), Point(1)) },
+			df:    func(x Interval) Interval { return Scale(2, x) },
+			x:     New(-4, 4),
+			roots: nil,
+		},
+	} {
+		roots := Newton(test.f, test.df, test.x, test.settings)
+		if len(roots) != len(test.roots) {
+			t.Errorf("%s: unexpected number of roots: got %v, want %d", test.name, roots, len(test.roots))
+			continue
+		}
+		for i, r := range roots {
+			if !r.Contains(test.roots[i]) && math.Abs(r.Mid()-test.roots[i]) > 1e-15*math.Abs(test.roots[i]) {
+				t.Errorf("%s: root %d: %v does not contain %v", test.name, i, r.Interval, test.roots[i])
+			}
+			if test.settings == nil && r.Width() > 4*math.Nextafter(math.Abs(r.Mid()), 1)*0x1p-52+1e-300 {
+				t.Errorf("%s: root %d: %v not refined", test.name, i, r.Interval)
+			}
+			if test.settings != nil && r.Width() > test.settings.Tol {
+				t.Errorf("%s: root %d: %v wider than tolerance", test.name, i, r.Interval)
+			}
+			if test.unique != nil && r.Unique != test.unique[i] {
+				t.Errorf("%s: root %d: unexpected uniqueness: got %t, want %t", test.name, i, r.Unique, test.unique[i])
+			}
+		}
+	}
+}
+
+func TestNewtonMultipleRoot(t *testing.T) {
+	t.Parallel()
+	// x^2 (x-1) has a double root at zero, which cannot be verified as
+	// unique, and a simple root at one.
+	f := func(x Interval) Interval { return Mul(Sqr(x), Sub(x, Point(1))) }
+	df := func(x Interval) Interval { return Sub(Scale(3, Sqr(x)), Scale(2, x)) }
+	roots := Newton(f, df, New(-2, 3), &NewtonSettings{Tol: 1e-12})
+	if len(roots) == 0 {
+		t.Fatal("no roots found")
+	}
+	var haveZero bool
+	for i, r := range roots {
+		if i > 0 && roots[i-1].Max > r.Min {
+			t.Errorf("roots not sorted: %v", roots)
+		}
+		if r.Contains(0) {
+			haveZero = true
+		}
+	}
+	if !haveZero {
+		t.Errorf("double root not enclosed: %v", roots)
+	}
+	last := roots[len(roots)-1]
+	if !last.Contains(1) || !last.Unique || last.Width() > 1e-12 {
+		t.Errorf("unexpected simple root: %+v", last)
+	}
+}
+
+func TestNewtonMaxIter(t *testing.T) {
+	t.Parallel()
+	// Unresolved work is returned, so that all roots remain enclosed.
+	roots := Newton(Sin, Cos, New(-10, 10), &NewtonSettings{MaxIter: 5})
+	for k := -3; k <= 3; k++ {
+		root := float64(k) * math.Pi
+		var found bool
+		for _, r := range roots {
+			if r.Contains(root) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("root %v not enclosed by %v", root, roots)
+		}
+	}
+}
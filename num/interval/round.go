@@ -0,0 +1,168 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import "math"
+
+// tiny is the magnitude below which the rounding error of products,
+// quotients and square roots may not be exactly representable.
+const tiny = 0x1p-969
+
+var (
+	negInf = math.Inf(-1)
+	posInf = math.Inf(1)
+)
+
+// finite returns whether a and b are both finite.
+func finite(a, b float64) bool {
+	return !math.IsInf(a, 0) && !math.IsInf(b, 0)
+}
+
+// down returns the largest float64 not greater than the exact result of an
+// operation that was rounded to r with an error of sign e. If the operands
+// of the operation were finite, an infinite r is the result of an overflow.
+func down(r, e float64, finite bool) float64 {
+	switch {
+	case math.IsInf(r, 1) && finite:
+		return math.MaxFloat64
+	case e < 0:
+		return math.Nextafter(r, negInf)
+	}
+	return r
+}
+
+// up returns the smallest float64 not less than the exact result of an
+// operation that was rounded to r with an error of sign e. If the operands
+// of the operation were finite, an infinite r is the result of an overflow.
+func up(r, e float64, finite bool) float64 {
+	switch {
+	case math.IsInf(r, -1) && finite:
+		return -math.MaxFloat64
+	case e > 0:
+		return math.Nextafter(r, posInf)
+	}
+	return r
+}
+
+// addErr returns a+b rounded to nearest and the exact rounding error.
+func addErr(a, b float64) (s, e float64) {
+	s = a + b
+	bb := s - a
+	return s, (a - (s - bb)) + (b - bb)
+}
+
+func addDown(a, b float64) float64 {
+	s, e := addErr(a, b)
+	return down(s, e, finite(a, b))
+}
+
+func addUp(a, b float64) float64 {
+	s, e := addErr(a, b)
+	return up(s, e, finite(a, b))
+}
+
+func subDown(a, b float64) float64 { return addDown(a, -b) }
+func subUp(a, b float64) float64   { return addUp(a, -b) }
+
+// mulErr returns a*b rounded to nearest and the sign of the rounding error.
+// Products with a zero operand are zero, including those with an infinite
+// operand, since the infinite bounds of an interval are not members of it.
+func mulErr(a, b float64) (p, e float64) {
+	if a == 0 || b == 0 {
+		return 0, 0
+	}
+	p = a * b
+	if math.Abs(p) < tiny {
+		return p, math.NaN()
+	}
+	return p, math.FMA(a, b, -p)
+}
+
+func mulDown(a, b float64) float64 {
+	p, e := mulErr(a, b)
+	if math.IsNaN(e) {
+		return math.Nextafter(p, negInf)
+	}
+	return down(p, e, finite(a, b))
+}
+
+func mulUp(a, b float64) float64 {
+	p, e := mulErr(a, b)
+	if math.IsNaN(e) {
+		return math.Nextafter(p, posInf)
+	}
+	return up(p, e, finite(a, b))
+}
+
+// divErr returns a/b rounded to nearest and the sign of the rounding error.
+func divErr(a, b float64) (q, e float64) {
+	q = a / b
+	if a == 0 || !finite(a, b) {
+		return q, 0
+	}
+	if math.Abs(q) < tiny || math.Abs(a) < tiny {
+		return q, math.NaN()
+	}
+	e = math.FMA(-q, b, a)
+	if b < 0 {
+		e = -e
+	}
+	return q, e
+}
+
+func divDown(a, b float64) float64 {
+	q, e := divErr(a, b)
+	if math.IsNaN(e) {
+		return math.Nextafter(q, negInf)
+	}
+	return down(q, e, finite(a, b))
+}
+
+func divUp(a, b float64) float64 {
+	q, e := divErr(a, b)
+	if math.IsNaN(e) {
+		return math.Nextafter(q, posInf)
+	}
+	return up(q, e, finite(a, b))
+}
+
+// sqrtErr returns the square root of a ≥ 0 rounded to nearest and the sign
+// of the rounding error.
+func sqrtErr(a float64) (s, e float64) {
+	s = math.Sqrt(a)
+	if a == 0 || math.IsInf(a, 1) {
+		return s, 0
+	}
+	if a < tiny {
+		return s, math.NaN()
+	}
+	return s, math.FMA(-s, s, a)
+}
+
+func sqrtDown(a float64) float64 {
+	s, e := sqrtErr(a)
+	if math.IsNaN(e) {
+		return math.Nextafter(s, negInf)
+	}
+	return down(s, e, true)
+}
+
+func sqrtUp(a float64) float64 {
+	s, e := sqrtErr(a)
+	if math.IsNaN(e) {
+		return math.Nextafter(s, posInf)
+	}
+	return up(s, e, true)
+}
+
+// widenDown and widenUp return a lower and an upper bound of the exact
+// value of a function that was computed as r by the math package.
+func widenDown(r float64) float64 {
+	return math.Nextafter(math.Nextafter(r, negInf), negInf)
+}
+
+func widenUp(r float64) float64 {
+	return math.Nextafter(math.Nextafter(r, posInf), posInf)
+}
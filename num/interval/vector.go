@@ -0,0 +1,163 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package interval
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/spatial/r1"
+)
+
+const badLength = "interval: slice length mismatch"
+
+// Points returns the degenerate intervals of the elements of x. If dst is
+// not nil, the result is stored in-place into dst and returned, otherwise a
+// new slice is allocated first. Points panics if the lengths of dst and x
+// differ.
+func Points(dst []Interval, x []float64) []Interval {
+	if dst == nil {
+		dst = make([]Interval, len(x))
+	}
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	for i, v := range x {
+		dst[i] = Point(v)
+	}
+	return dst
+}
+
+// Mids returns the midpoints of the intervals of x as returned by the Mid
+// method. If dst is not nil, the result is stored in-place into dst and
+// returned, otherwise a new slice is allocated first. Mids panics if the
+// lengths of dst and x differ.
+func Mids(dst []float64, x []Interval) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	for i, v := range x {
+		dst[i] = v.Mid()
+	}
+	return dst
+}
+
+// MaxWidth returns the largest width of the intervals of x.
+func MaxWidth(x []Interval) float64 {
+	var w float64
+	for _, v := range x {
+		w = math.Max(w, v.Width())
+	}
+	return w
+}
+
+// ContainsPoint returns whether each element of p is a member of the
+// corresponding interval of x, that is whether the box x contains p.
+// ContainsPoint panics if the lengths of x and p differ.
+func ContainsPoint(x []Interval, p []float64) bool {
+	if len(x) != len(p) {
+		panic(badLength)
+	}
+	for i, v := range x {
+		if !v.Contains(p[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// AddTo adds the intervals of x and y element-wise, storing the result in
+// dst. AddTo panics if the lengths of dst, x and y differ.
+func AddTo(dst, x, y []Interval) []Interval {
+	if len(x) != len(dst) || len(y) != len(dst) {
+		panic(badLength)
+	}
+	for i := range dst {
+		dst[i] = Add(x[i], y[i])
+	}
+	return dst
+}
+
+// SubTo subtracts the intervals of y from those of x element-wise, storing
+// the result in dst. SubTo panics if the lengths of dst, x and y differ.
+func SubTo(dst, x, y []Interval) []Interval {
+	if len(x) != len(dst) || len(y) != len(dst) {
+		panic(badLength)
+	}
+	for i := range dst {
+		dst[i] = Sub(x[i], y[i])
+	}
+	return dst
+}
+
+// ScaleTo multiplies the intervals of x by a, storing the result in dst.
+// ScaleTo panics if the lengths of dst and x differ.
+func ScaleTo(dst []Interval, a Interval, x []Interval) []Interval {
+	if len(x) != len(dst) {
+		panic(badLength)
+	}
+	for i, v := range x {
+		dst[i] = Mul(a, v)
+	}
+	return dst
+}
+
+// Dot returns an enclosure of the dot product of x and y. Dot panics if the
+// lengths of x and y differ.
+func Dot(x, y []Interval) Interval {
+	if len(x) != len(y) {
+		panic(badLength)
+	}
+	var sum Interval
+	for i, v := range x {
+		sum = Add(sum, Mul(v, y[i]))
+	}
+	return sum
+}
+
+// Norm returns an enclosure of the Euclidean norm of x.
+func Norm(x []Interval) Interval {
+	var sum Interval
+	for _, v := range x {
+		sum = Add(sum, Sqr(v))
+	}
+	return Sqrt(sum)
+}
+
+// FromR1s returns the intervals with the bounds of the elements of b, for
+// example the box of a stat/distmv.Uniform distribution. If dst is not nil,
+// the result is stored in-place into dst and returned, otherwise a new slice
+// is allocated first. FromR1s panics if the lengths of dst and b differ.
+func FromR1s(dst []Interval, b []r1.Interval) []Interval {
+	if dst == nil {
+		dst = make([]Interval, len(b))
+	}
+	if len(dst) != len(b) {
+		panic(badLength)
+	}
+	for i, v := range b {
+		dst[i] = Interval(v)
+	}
+	return dst
+}
+
+// ToR1s returns the bounds of the intervals of x as r1.Intervals. If dst is
+// not nil, the result is stored in-place into dst and returned, otherwise a
+// new slice is allocated first. ToR1s panics if the lengths of dst and x
+// differ.
+func ToR1s(dst []r1.Interval, x []Interval) []r1.Interval {
+	if dst == nil {
+		dst = make([]r1.Interval, len(x))
+	}
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	for i, v := range x {
+		dst[i] = r1.Interval(v)
+	}
+	return dst
+}
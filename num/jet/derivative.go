@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jet
+
+import "github.com/jingcheng-WU/gonum/mat"
+
+// Func is a scalar function of the jets in x. A Func must not retain or
+// modify x.
+type Func func(x []Number) Number
+
+// Gradient computes the gradient of f at x with a single evaluation of f.
+// If dst is not nil, the result is stored in-place into dst and returned,
+// otherwise a new slice is allocated first. Gradient panics if the lengths
+// of dst and x differ.
+func Gradient(dst []float64, f Func, x []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	r := f(Vars(x, false))
+	if r.Grad == nil {
+		// f is constant.
+		for i := range dst {
+			dst[i] = 0
+		}
+		return dst
+	}
+	copy(dst, r.Grad)
+	return dst
+}
+
+// Hessian computes the Hessian matrix of f at x with a single evaluation of
+// f and stores it in dst. It is an exact alternative to the finite
+// difference approximation of diff/fd.Hessian.
+//
+// If the dst matrix is empty it will be resized to the correct dimensions,
+// otherwise the dimensions of dst must match the length of x or Hessian
+// will panic.
+func Hessian(dst *mat.SymDense, f Func, x []float64) {
+	n := len(x)
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(n)
+	} else if dst.Symmetric() != n {
+		panic(badLength)
+	}
+	r := f(Vars(x, true))
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			var v float64
+			if r.Hess != nil {
+				v = r.Hess[i*n+j]
+			}
+			dst.SetSym(i, j, v)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package jet provides the jet numeric type and functions. A jet is a
+// truncated Taylor expansion of a function of n variables at a point. It
+// holds the value of the function, its gradient and optionally its dense
+// Hessian, which are propagated through arithmetic and elementary functions
+// by the chain rule.
+//
+// Jets generalize dual numbers to many infinitesimal parts, so that the full
+// gradient of a function of n variables is obtained in a single evaluation
+// instead of the n evaluations needed with the num/dual package, and the
+// full Hessian in a single evaluation instead of the n(n+1)/2 evaluations
+// needed with the num/hyperdual package. They correspond to the Jet type of
+// the Ceres Solver library, extended to second order.
+package jet // import "github.com/jingcheng-WU/gonum/num/jet"
@@ -0,0 +1,168 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jet
+
+import "math"
+
+const badLength = "jet: mismatched number of variables"
+
+// Number is a float64 precision jet of a function of n variables.
+//
+// A Number with nil Grad is a constant. A Number with nil Hess does not
+// track second derivatives, and all variables of a computation must be
+// created either with or without second derivatives, see Vars.
+type Number struct {
+	// Real is the value of the function.
+	Real float64
+	// Grad holds the n first-order partial derivatives of the function.
+	Grad []float64
+	// Hess holds the n×n second-order partial derivatives of the function
+	// in row-major order.
+	Hess []float64
+}
+
+// Const returns a constant jet with value v.
+func Const(v float64) Number {
+	return Number{Real: v}
+}
+
+// Vars returns the jets of the n=len(x) variables at x, with unit
+// gradients. If hess is true, the jets track second derivatives.
+func Vars(x []float64, hess bool) []Number {
+	n := len(x)
+	v := make([]Number, n)
+	for i, xi := range x {
+		v[i] = Number{Real: xi, Grad: make([]float64, n)}
+		v[i].Grad[i] = 1
+		if hess {
+			v[i].Hess = make([]float64, n*n)
+		}
+	}
+	return v
+}
+
+// dims returns the number of variables of x and y and whether second
+// derivatives are tracked.
+func dims(x, y Number) (n int, hess bool) {
+	switch {
+	case x.Grad == nil:
+		n = len(y.Grad)
+	case y.Grad == nil:
+		n = len(x.Grad)
+	default:
+		n = len(x.Grad)
+		if len(y.Grad) != n {
+			panic(badLength)
+		}
+	}
+	return n, x.Hess != nil || y.Hess != nil
+}
+
+// alloc returns a jet with value v for n variables.
+func alloc(v float64, n int, hess bool) Number {
+	d := Number{Real: v}
+	if n == 0 {
+		return d
+	}
+	d.Grad = make([]float64, n)
+	if hess {
+		d.Hess = make([]float64, n*n)
+	}
+	return d
+}
+
+// addScaled adds alpha*src to dst if src is not nil.
+func addScaled(dst []float64, alpha float64, src []float64) {
+	if src == nil {
+		return
+	}
+	for i, v := range src {
+		dst[i] += alpha * v
+	}
+}
+
+// addOuter adds alpha*(x*y^T + y*x^T) to the n×n matrix dst if x and y
+// are not nil.
+func addOuter(dst []float64, alpha float64, x, y []float64) {
+	if x == nil || y == nil {
+		return
+	}
+	n := len(x)
+	for i, xi := range x {
+		for j, yj := range y {
+			dst[i*n+j] += alpha * (xi*yj + y[i]*x[j])
+		}
+	}
+}
+
+// chain returns f(d) for a function f with value v, first derivative d1 and
+// second derivative d2 at d.Real.
+func chain(d Number, v, d1, d2 float64) Number {
+	r := alloc(v, len(d.Grad), d.Hess != nil)
+	addScaled(r.Grad, d1, d.Grad)
+	if r.Hess != nil {
+		addScaled(r.Hess, d1, d.Hess)
+		addOuter(r.Hess, d2/2, d.Grad, d.Grad)
+	}
+	return r
+}
+
+// Add returns the sum of x and y.
+func Add(x, y Number) Number {
+	n, hess := dims(x, y)
+	r := alloc(x.Real+y.Real, n, hess)
+	if n != 0 {
+		addScaled(r.Grad, 1, x.Grad)
+		addScaled(r.Grad, 1, y.Grad)
+	}
+	if hess {
+		addScaled(r.Hess, 1, x.Hess)
+		addScaled(r.Hess, 1, y.Hess)
+	}
+	return r
+}
+
+// Sub returns the difference of x and y, x-y.
+func Sub(x, y Number) Number {
+	return Add(x, Scale(-1, y))
+}
+
+// Mul returns the jet product of x and y.
+func Mul(x, y Number) Number {
+	n, hess := dims(x, y)
+	r := alloc(x.Real*y.Real, n, hess)
+	if n != 0 {
+		addScaled(r.Grad, y.Real, x.Grad)
+		addScaled(r.Grad, x.Real, y.Grad)
+	}
+	if hess {
+		addScaled(r.Hess, y.Real, x.Hess)
+		addScaled(r.Hess, x.Real, y.Hess)
+		addOuter(r.Hess, 1, x.Grad, y.Grad)
+	}
+	return r
+}
+
+// Inv returns the jet inverse of d.
+func Inv(d Number) Number {
+	v := 1 / d.Real
+	return chain(d, v, -v*v, 2*v*v*v)
+}
+
+// Scale returns d scaled by f.
+func Scale(f float64, d Number) Number {
+	r := alloc(f*d.Real, len(d.Grad), d.Hess != nil)
+	addScaled(r.Grad, f, d.Grad)
+	addScaled(r.Hess, f, d.Hess)
+	return r
+}
+
+// Abs returns the absolute value of d.
+func Abs(d Number) Number {
+	if !math.Signbit(d.Real) {
+		return d
+	}
+	return Scale(-1, d)
+}
@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jet
+
+import "math"
+
+// PowReal returns d**p, the base-d exponential of p.
+func PowReal(d Number, p float64) Number {
+	const tol = 1e-15
+
+	r := d.Real
+	if math.Abs(r) < tol {
+		if r >= 0 {
+			r = tol
+		}
+		if r < 0 {
+			r = -tol
+		}
+	}
+	deriv := p * math.Pow(r, p-1)
+	deriv2 := p * (p - 1) * math.Pow(r, p-2)
+	return chain(d, math.Pow(d.Real, p), deriv, deriv2)
+}
+
+// Pow returns d**p, the base-d exponential of p.
+func Pow(d, p Number) Number {
+	return Exp(Mul(p, Log(d)))
+}
+
+// Sqrt returns the square root of d.
+//
+// Special cases are:
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0 with infinite derivatives
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+func Sqrt(d Number) Number {
+	v := math.Sqrt(d.Real)
+	return chain(d, v, 0.5/v, -0.25/(d.Real*v))
+}
+
+// Exp returns e**d, the base-e exponential of d.
+//
+// Special cases are:
+//	Exp(+Inf) = +Inf
+//	Exp(NaN) = NaN
+// Very large values overflow to 0 or +Inf.
+// Very small values underflow to 1.
+func Exp(d Number) Number {
+	v := math.Exp(d.Real)
+	return chain(d, v, v, v)
+}
+
+// Log returns the natural logarithm of d.
+//
+// Special cases are:
+//	Log(+Inf) = +Inf
+//	Log(0) = -Inf
+//	Log(x < 0) = NaN
+//	Log(NaN) = NaN
+func Log(d Number) Number {
+	inv := 1 / d.Real
+	return chain(d, math.Log(d.Real), inv, -inv*inv)
+}
+
+// Sin returns the sine of d.
+func Sin(d Number) Number {
+	s, c := math.Sincos(d.Real)
+	return chain(d, s, c, -s)
+}
+
+// Cos returns the cosine of d.
+func Cos(d Number) Number {
+	s, c := math.Sincos(d.Real)
+	return chain(d, c, -s, -c)
+}
+
+// Tan returns the tangent of d.
+func Tan(d Number) Number {
+	t := math.Tan(d.Real)
+	deriv := 1 + t*t
+	return chain(d, t, deriv, 2*t*deriv)
+}
+
+// Asin returns the inverse sine of d.
+func Asin(d Number) Number {
+	x := d.Real
+	s := 1 / (1 - x*x)
+	deriv := math.Sqrt(s)
+	return chain(d, math.Asin(x), deriv, x*s*deriv)
+}
+
+// Acos returns the inverse cosine of d.
+func Acos(d Number) Number {
+	x := d.Real
+	s := 1 / (1 - x*x)
+	deriv := math.Sqrt(s)
+	return chain(d, math.Acos(x), -deriv, -x*s*deriv)
+}
+
+// Atan returns the inverse tangent of d.
+func Atan(d Number) Number {
+	x := d.Real
+	deriv := 1 / (1 + x*x)
+	return chain(d, math.Atan(x), deriv, -2*x*deriv*deriv)
+}
@@ -0,0 +1,29 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jet_test
+
+import (
+	"fmt"
+
+	"github.com/jingcheng-WU/gonum/num/jet"
+)
+
+func ExampleVars() {
+	// Compute the gradient and Hessian of
+	//  f(x, y) = x^2 * y + sin(y)
+	// at (3, 0) in a single evaluation.
+	v := jet.Vars([]float64{3, 0}, true)
+	x, y := v[0], v[1]
+	f := jet.Add(jet.Mul(jet.Mul(x, x), y), jet.Sin(y))
+
+	fmt.Printf("f(3, 0) = %v\n", f.Real)
+	fmt.Printf("∇f(3, 0) = %v\n", f.Grad)
+	fmt.Printf("∇²f(3, 0) = %v\n", f.Hess)
+
+	// Output:
+	// f(3, 0) = 0
+	// ∇f(3, 0) = [0 10]
+	// ∇²f(3, 0) = [0 6 6 0]
+}
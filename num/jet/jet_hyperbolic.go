@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jet
+
+import "math"
+
+// Sinh returns the hyperbolic sine of d.
+func Sinh(d Number) Number {
+	s := math.Sinh(d.Real)
+	return chain(d, s, math.Cosh(d.Real), s)
+}
+
+// Cosh returns the hyperbolic cosine of d.
+func Cosh(d Number) Number {
+	c := math.Cosh(d.Real)
+	return chain(d, c, math.Sinh(d.Real), c)
+}
+
+// Tanh returns the hyperbolic tangent of d.
+func Tanh(d Number) Number {
+	t := math.Tanh(d.Real)
+	deriv := 1 - t*t
+	return chain(d, t, deriv, -2*t*deriv)
+}
+
+// Asinh returns the inverse hyperbolic sine of d.
+func Asinh(d Number) Number {
+	x := d.Real
+	s := 1 / (x*x + 1)
+	deriv := math.Sqrt(s)
+	return chain(d, math.Asinh(x), deriv, -x*s*deriv)
+}
+
+// Acosh returns the inverse hyperbolic cosine of d.
+func Acosh(d Number) Number {
+	x := d.Real
+	s := 1 / (x*x - 1)
+	deriv := math.Sqrt(s)
+	return chain(d, math.Acosh(x), deriv, -x*s*deriv)
+}
+
+// Atanh returns the inverse hyperbolic tangent of d.
+func Atanh(d Number) Number {
+	x := d.Real
+	deriv := 1 / (1 - x*x)
+	return chain(d, math.Atanh(x), deriv, 2*x*deriv*deriv)
+}
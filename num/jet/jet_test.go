@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package jet
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/diff/fd"
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/num/hyperdual"
+)
+
+var unaryTests = []struct {
+	name string
+	x    []float64
+	jet  func(Number) Number
+	hd   func(hyperdual.Number) hyperdual.Number
+}{
+	{name: "Inv", x: []float64{-2, -0.5, 0.5, 3}, jet: Inv, hd: hyperdual.Inv},
+	{name: "Abs", x: []float64{-2, 0.5, 3}, jet: Abs, hd: hyperdual.Abs},
+	{
+		name: "PowReal",
+		x:    []float64{0.5, 1, 2.5},
+		jet:  func(d Number) Number { return PowReal(d, 2.5) },
+		hd:   func(d hyperdual.Number) hyperdual.Number { return hyperdual.PowReal(d, 2.5) },
+	},
+	{name: "Sqrt", x: []float64{0.25, 1, 4}, jet: Sqrt, hd: hyperdual.Sqrt},
+	{name: "Exp", x: []float64{-2, 0, 1.5}, jet: Exp, hd: hyperdual.Exp},
+	{name: "Log", x: []float64{0.25, 1, 4}, jet: Log, hd: hyperdual.Log},
+	{name: "Sin", x: []float64{-2, 0, 1.5}, jet: Sin, hd: hyperdual.Sin},
+	{name: "Cos", x: []float64{-2, 0, 1.5}, jet: Cos, hd: hyperdual.Cos},
+	{name: "Tan", x: []float64{-1, 0, 1.2}, jet: Tan, hd: hyperdual.Tan},
+	{name: "Asin", x: []float64{-0.5, 0, 0.9}, jet: Asin, hd: hyperdual.Asin},
+	{name: "Acos", x: []float64{-0.5, 0, 0.9}, jet: Acos, hd: hyperdual.Acos},
+	{name: "Atan", x: []float64{-2, 0, 1.5}, jet: Atan, hd: hyperdual.Atan},
+	{name: "Sinh", x: []float64{-2, 0, 1.5}, jet: Sinh, hd: hyperdual.Sinh},
+	{name: "Cosh", x: []float64{-2, 0, 1.5}, jet: Cosh, hd: hyperdual.Cosh},
+	{name: "Tanh", x: []float64{-2, 0, 1.5}, jet: Tanh, hd: hyperdual.Tanh},
+	{name: "Asinh", x: []float64{-2, 0, 1.5}, jet: Asinh, hd: hyperdual.Asinh},
+	{name: "Acosh", x: []float64{1.5, 2, 4}, jet: Acosh, hd: hyperdual.Acosh},
+	{name: "Atanh", x: []float64{-0.5, 0, 0.9}, jet: Atanh, hd: hyperdual.Atanh},
+}
+
+func TestUnary(t *testing.T) {
+	t.Parallel()
+	const tol = 1e-14
+	for _, test := range unaryTests {
+		for _, x := range test.x {
+			got := test.jet(Vars([]float64{x}, true)[0])
+			want := test.hd(hyperdual.Number{Real: x, E1mag: 1, E2mag: 1})
+			if !scalar.EqualWithinAbsOrRel(got.Real, want.Real, tol, tol) {
+				t.Errorf("unexpected %s(%v) value: got %v, want %v", test.name, x, got.Real, want.Real)
+			}
+			if !scalar.EqualWithinAbsOrRel(got.Grad[0], want.E1mag, tol, tol) {
+				t.Errorf("unexpected %s(%v) derivative: got %v, want %v", test.name, x, got.Grad[0], want.E1mag)
+			}
+			if !scalar.EqualWithinAbsOrRel(got.Hess[0], want.E1E2mag, tol, tol) {
+				t.Errorf("unexpected %s(%v) second derivative: got %v, want %v", test.name, x, got.Hess[0], want.E1E2mag)
+			}
+
+			// Constants remain constant.
+			c := test.jet(Const(x))
+			if c.Grad != nil || c.Hess != nil {
+				t.Errorf("%s(%v) of a constant has derivatives", test.name, x)
+			}
+			if c.Real != got.Real && !(math.IsNaN(c.Real) && math.IsNaN(got.Real)) {
+				t.Errorf("unexpected %s(%v) constant value: got %v, want %v", test.name, x, c.Real, got.Real)
+			}
+		}
+	}
+}
+
+// multiTests are functions of several variables using all operations.
+var multiTests = []struct {
+	name string
+	x    []float64
+	f    func(x []Number) Number
+}{
+	{
+		name: "Rosenbrock",
+		x:    []float64{-1.2, 1, 0.5},
+		f: func(x []Number) Number {
+			var sum Number
+			for i := 0; i < len(x)-1; i++ {
+				a := Sub(x[i+1], Mul(x[i], x[i]))
+				b := Sub(Const(1), x[i])
+				sum = Add(sum, Add(Scale(100, Mul(a, a)), Mul(b, b)))
+			}
+			return sum
+		},
+	},
+	{
+		name: "Elementary",
+		x:    []float64{0.3, 0.7, 1.4},
+		f: func(x []Number) Number {
+			a, b, c := x[0], x[1], x[2]
+			terms := []Number{
+				Mul(Mul(a, b), Inv(Add(c, Const(2)))),
+				Pow(c, Mul(a, b)),
+				PowReal(Add(a, c), 1.5),
+				Mul(Sqrt(b), Exp(Sub(a, c))),
+				Log(Add(Mul(a, a), Mul(b, c))),
+				Mul(Sin(a), Cos(Mul(b, c))),
+				Tan(Scale(0.5, Sub(c, a))),
+				Add(Asin(Mul(a, b)), Acos(Scale(0.5, b))),
+				Mul(Atan(c), Abs(Sub(a, b))),
+				Mul(Sinh(a), Cosh(b)),
+				Tanh(Mul(b, c)),
+				Add(Asinh(Mul(a, c)), Acosh(Add(c, b))),
+				Atanh(Mul(a, b)),
+			}
+			var sum Number
+			for _, v := range terms {
+				sum = Add(sum, v)
+			}
+			return sum
+		},
+	},
+}
+
+func TestMulti(t *testing.T) {
+	t.Parallel()
+	for _, test := range multiTests {
+		n := len(test.x)
+		plain := func(x []float64) float64 {
+			xs := make([]Number, len(x))
+			for i, v := range x {
+				xs[i] = Const(v)
+			}
+			return test.f(xs).Real
+		}
+		wantGrad := fd.Gradient(nil, plain, test.x, &fd.Settings{Formula: fd.Central})
+		// The Hessian is compared with the finite-difference Jacobian
+		// of the first derivatives.
+		wantHess := mat.NewDense(n, n, nil)
+		fd.Jacobian(wantHess, func(y, x []float64) {
+			copy(y, test.f(Vars(x, false)).Grad)
+		}, test.x, &fd.JacobianSettings{Formula: fd.Central})
+
+		for _, hess := range []bool{false, true} {
+			got := test.f(Vars(test.x, hess))
+			if v := plain(test.x); got.Real != v {
+				t.Errorf("%s: unexpected value: got %v, want %v", test.name, got.Real, v)
+			}
+			if !floats.EqualApprox(got.Grad, wantGrad, 1e-6) {
+				t.Errorf("%s: unexpected gradient:\ngot  %v\nwant %v", test.name, got.Grad, wantGrad)
+			}
+			if !hess {
+				if got.Hess != nil {
+					t.Errorf("%s: unexpected Hessian without second derivatives", test.name)
+				}
+				continue
+			}
+			h := mat.NewDense(n, n, got.Hess)
+			if !mat.EqualApprox(h, h.T(), 1e-14) {
+				t.Errorf("%s: Hessian not symmetric:\n%v", test.name, mat.Formatted(h))
+			}
+			if !mat.EqualApprox(h, wantHess, 1e-6) {
+				t.Errorf("%s: unexpected Hessian:\ngot  %v\nwant %v", test.name, mat.Formatted(h), mat.Formatted(wantHess))
+			}
+		}
+	}
+}
+
+func TestMismatchedLength(t *testing.T) {
+	t.Parallel()
+	x := Vars([]float64{1, 2}, false)
+	y := Vars([]float64{1, 2, 3}, false)
+	panicked := func() (panicked bool) {
+		defer func() {
+			panicked = recover() != nil
+		}()
+		Add(x[0], y[0])
+		return false
+	}()
+	if !panicked {
+		t.Error("expected panic for mismatched number of variables")
+	}
+}
+
+func TestDerivatives(t *testing.T) {
+	t.Parallel()
+	for _, test := range multiTests {
+		n := len(test.x)
+		v := test.f(Vars(test.x, true))
+		grad := Gradient(nil, test.f, test.x)
+		if !floats.Equal(grad, v.Grad) {
+			t.Errorf("%s: unexpected gradient: got %v, want %v", test.name, grad, v.Grad)
+		}
+		var hess mat.SymDense
+		Hessian(&hess, test.f, test.x)
+		if !mat.Equal(&hess, mat.NewDense(n, n, v.Hess)) {
+			t.Errorf("%s: unexpected Hessian:\ngot  %v\nwant %v", test.name, mat.Formatted(&hess), v.Hess)
+		}
+	}
+
+	// Constant functions have zero derivatives.
+	constant := func(x []Number) Number { return Const(2) }
+	grad := Gradient([]float64{1, 1}, constant, []float64{3, 4})
+	if !floats.Equal(grad, []float64{0, 0}) {
+		t.Errorf("unexpected gradient of constant: got %v", grad)
+	}
+	hess := mat.NewSymDense(2, []float64{1, 1, 1, 1})
+	Hessian(hess, constant, []float64{3, 4})
+	if !mat.Equal(hess, mat.NewSymDense(2, nil)) {
+		t.Errorf("unexpected Hessian of constant:\n%v", mat.Formatted(hess))
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+var (
+	_ Method       = (*AdaGrad)(nil)
+	_ Statuser     = (*AdaGrad)(nil)
+	_ lossAverager = (*AdaGrad)(nil)
+)
+
+// AdaGrad implements the AdaGrad stochastic gradient method, which scales the
+// steps of the variables by the accumulated squares of their gradients. In
+// every iteration, the gradient g of the objective function is evaluated on a
+// minibatch, and the location is updated by
+//  s = s + g²
+//  x = x - rate * g / (√s + Epsilon)
+// so that the effective learning rate decreases fastest for the variables
+// with the largest gradients.
+//
+// See SGD for the handling of minibatches and the reported function values.
+//
+// The method is described in
+//  Duchi, J., Hazan, E., Singer, Y.: Adaptive subgradient methods for online
+//  learning and stochastic optimization. Journal of Machine Learning Research
+//  12, 2121–2159 (2011)
+type AdaGrad struct {
+	// LearningRate is the learning-rate schedule. If LearningRate is nil,
+	// a constant rate of 0.01 is used.
+	LearningRate Schedule
+	// Epsilon is added to the denominator of the update for numerical
+	// stability. If Epsilon is 0, it is defaulted to 1e-8.
+	Epsilon float64
+	// BatchSize is the number of terms in a minibatch. If BatchSize is 0,
+	// it is defaulted to 32.
+	BatchSize int
+	// LossWindow is the number of iterations of the moving average of the
+	// function values. If LossWindow is 0, it is defaulted to 100.
+	LossWindow int
+	// Src is the source of random numbers for drawing the minibatches. If
+	// Src is nil, the global source of golang.org/x/exp/rand is used.
+	Src rand.Source
+
+	s   stochastic
+	eps float64
+	sum []float64
+}
+
+// Status returns the status of the method.
+func (a *AdaGrad) Status() (Status, error) {
+	return a.s.status, a.s.err
+}
+
+func (a *AdaGrad) averageLoss() float64 {
+	return a.s.loss
+}
+
+// Uses returns the functions of the Problem that are used by AdaGrad, which
+// are BatchFunc and BatchGrad if available, and Grad otherwise.
+func (a *AdaGrad) Uses(has Available) (uses Available, err error) {
+	return a.s.uses(has)
+}
+
+func (a *AdaGrad) Init(dim, tasks int) int {
+	a.eps = defaultEpsilon(a.Epsilon, "adagrad: negative epsilon")
+	a.s.init(dim, tasks, a.LearningRate, 0.01, a.BatchSize, a.LossWindow, a.Src)
+	return 1
+}
+
+func (a *AdaGrad) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	a.s.run(a, operations, results, tasks)
+}
+
+func (a *AdaGrad) init(dim int) {
+	a.sum = resize(a.sum, dim)
+	for i := range a.sum {
+		a.sum[i] = 0
+	}
+}
+
+func (a *AdaGrad) step(x, grad []float64, t int, rate float64) {
+	for i, g := range grad {
+		a.sum[i] += g * g
+		x[i] -= rate * g / (math.Sqrt(a.sum[i]) + a.eps)
+	}
+}
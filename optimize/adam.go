@@ -0,0 +1,143 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+var (
+	_ Method       = (*Adam)(nil)
+	_ Statuser     = (*Adam)(nil)
+	_ lossAverager = (*Adam)(nil)
+)
+
+// Adam implements the Adam stochastic gradient method, which scales the steps
+// by estimates of the first and second moments of the gradient. In every
+// iteration t, the gradient g of the objective function is evaluated on a
+// minibatch, and the location is updated by
+//  m = Beta1*m + (1-Beta1)*g
+//  v = Beta2*v + (1-Beta2)*g²
+//  x = x - rate * m̂ / (√v̂ + Epsilon)
+// where m̂ = m/(1-Beta1^t) and v̂ = v/(1-Beta2^t) are the moments corrected
+// for the bias of their zero initial values. If AMSGrad is true, the maximum
+// of the second moments over all iterations is used instead of v̂, which
+// ensures that the effective learning rate does not increase.
+//
+// See SGD for the handling of minibatches and the reported function values.
+//
+// The method is described in
+//  Kingma, D. P., Ba, J.: Adam: A method for stochastic optimization. In:
+//  International Conference on Learning Representations (2015)
+// and the AMSGrad variant in
+//  Reddi, S. J., Kale, S., Kumar, S.: On the convergence of Adam and beyond.
+//  In: International Conference on Learning Representations (2018)
+type Adam struct {
+	// LearningRate is the learning-rate schedule. If LearningRate is nil,
+	// a constant rate of 0.001 is used.
+	LearningRate Schedule
+	// Beta1 and Beta2 are the decay rates of the moment estimates in
+	// [0, 1). If Beta1 is 0, it is defaulted to 0.9, and if Beta2 is 0, it
+	// is defaulted to 0.999.
+	Beta1, Beta2 float64
+	// Epsilon is added to the denominator of the update for numerical
+	// stability. If Epsilon is 0, it is defaulted to 1e-8.
+	Epsilon float64
+	// AMSGrad specifies whether the AMSGrad variant is used.
+	AMSGrad bool
+	// BatchSize is the number of terms in a minibatch. If BatchSize is 0,
+	// it is defaulted to 32.
+	BatchSize int
+	// LossWindow is the number of iterations of the moving average of the
+	// function values. If LossWindow is 0, it is defaulted to 100.
+	LossWindow int
+	// Src is the source of random numbers for drawing the minibatches. If
+	// Src is nil, the global source of golang.org/x/exp/rand is used.
+	Src rand.Source
+
+	s            stochastic
+	beta1, beta2 float64
+	eps          float64
+	m, v, vmax   []float64
+}
+
+// Status returns the status of the method.
+func (a *Adam) Status() (Status, error) {
+	return a.s.status, a.s.err
+}
+
+func (a *Adam) averageLoss() float64 {
+	return a.s.loss
+}
+
+// Uses returns the functions of the Problem that are used by Adam, which are
+// BatchFunc and BatchGrad if available, and Grad otherwise.
+func (a *Adam) Uses(has Available) (uses Available, err error) {
+	return a.s.uses(has)
+}
+
+func (a *Adam) Init(dim, tasks int) int {
+	a.beta1 = defaultDecay(a.Beta1, 0.9, "adam: Beta1 out of range")
+	a.beta2 = defaultDecay(a.Beta2, 0.999, "adam: Beta2 out of range")
+	a.eps = defaultEpsilon(a.Epsilon, "adam: negative epsilon")
+	a.s.init(dim, tasks, a.LearningRate, 0.001, a.BatchSize, a.LossWindow, a.Src)
+	return 1
+}
+
+func (a *Adam) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	a.s.run(a, operations, results, tasks)
+}
+
+func (a *Adam) init(dim int) {
+	a.m = resize(a.m, dim)
+	a.v = resize(a.v, dim)
+	a.vmax = resize(a.vmax, dim)
+	for i := range a.m {
+		a.m[i] = 0
+		a.v[i] = 0
+		a.vmax[i] = 0
+	}
+}
+
+func (a *Adam) step(x, grad []float64, t int, rate float64) {
+	c1 := 1 - math.Pow(a.beta1, float64(t+1))
+	c2 := 1 - math.Pow(a.beta2, float64(t+1))
+	for i, g := range grad {
+		a.m[i] = a.beta1*a.m[i] + (1-a.beta1)*g
+		a.v[i] = a.beta2*a.v[i] + (1-a.beta2)*g*g
+		v := a.v[i] / c2
+		if a.AMSGrad {
+			a.vmax[i] = math.Max(a.vmax[i], v)
+			v = a.vmax[i]
+		}
+		x[i] -= rate * (a.m[i] / c1) / (math.Sqrt(v) + a.eps)
+	}
+}
+
+// defaultDecay returns the decay rate beta, or def if beta is zero. It panics
+// with msg if beta is not in [0, 1).
+func defaultDecay(beta, def float64, msg string) float64 {
+	if beta < 0 || 1 <= beta {
+		panic(msg)
+	}
+	if beta == 0 {
+		return def
+	}
+	return beta
+}
+
+// defaultEpsilon returns eps, or 1e-8 if eps is zero. It panics with msg if
+// eps is negative.
+func defaultEpsilon(eps float64, msg string) float64 {
+	if eps < 0 {
+		panic(msg)
+	}
+	if eps == 0 {
+		return 1e-8
+	}
+	return eps
+}
@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var (
+	_ Statuser = (*SimulatedAnnealing)(nil)
+	_ Method   = (*SimulatedAnnealing)(nil)
+)
+
+// SimulatedAnnealing implements a simulated annealing global optimization
+// method with a geometric cooling schedule. In every iteration, candidate
+// locations are generated by adding normally distributed steps to the current
+// location. The best candidate is accepted as the new current location with
+// the Metropolis probability
+//  min(1, exp(-(f_candidate - f_current)/T))
+// where T is the current temperature. After every iteration the temperature
+// is multiplied by the cooling factor, and the standard deviation of the
+// steps is scaled by sqrt(T/T_0).
+//
+// Evaluating several candidates in every iteration allows the method to use
+// concurrent evaluations. The sequence of iterations does not depend on the
+// number of concurrent evaluations.
+//
+// Candidate locations are projected onto the bounds given by Lower and Upper.
+type SimulatedAnnealing struct {
+	// Lower and Upper specify the bounds on the variables. If Lower (Upper)
+	// is nil, the variables have no lower (upper) bound. If they are not nil,
+	// they must have length equal to the problem dimension.
+	Lower, Upper []float64
+	// Candidates sets the number of candidate locations generated in every
+	// iteration. If Candidates is 0, a default value of 1 is used. Candidates
+	// must not be negative.
+	Candidates int
+	// InitTemperature is the initial temperature T_0. If InitTemperature is 0,
+	// a default value of 1 is used. InitTemperature must not be negative.
+	InitTemperature float64
+	// Cooling is the factor by which the temperature is multiplied after every
+	// iteration. If Cooling is 0, a default value of 0.99 is used. Cooling
+	// must be in (0, 1).
+	Cooling float64
+	// StopTemperature sets the threshold for stopping the optimization when
+	// the temperature falls below StopTemperature. If StopTemperature is 0,
+	// a default value of 1e-8 times the initial temperature is used. If
+	// StopTemperature is NaN, the stopping criterion is not used.
+	StopTemperature float64
+	// StepSize is the initial standard deviation of the steps. If StepSize is
+	// 0, a default value of 1 is used. StepSize must not be negative.
+	StepSize float64
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/exp/rand is used.
+	Src rand.Source
+
+	dim      int
+	n        int
+	t0, temp float64
+	cooling  float64
+	stop     float64
+	step     float64
+	rnd      randFuncs
+
+	init   bool // whether the initial location is being evaluated
+	x      []float64
+	f      float64
+	status Status
+
+	runner batchRunner
+}
+
+func (*SimulatedAnnealing) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+// Status returns the status of the method.
+func (sa *SimulatedAnnealing) Status() (Status, error) {
+	return sa.status, nil
+}
+
+func (sa *SimulatedAnnealing) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(sa.Lower, sa.Upper, dim)
+
+	sa.dim = dim
+	sa.n = sa.Candidates
+	switch {
+	case sa.n == 0:
+		sa.n = 1
+	case sa.n < 0:
+		panic("simulated annealing: negative number of candidates")
+	}
+	sa.t0 = sa.InitTemperature
+	switch {
+	case sa.t0 == 0:
+		sa.t0 = 1
+	case sa.t0 < 0:
+		panic("simulated annealing: negative initial temperature")
+	}
+	sa.cooling = sa.Cooling
+	switch {
+	case sa.cooling == 0:
+		sa.cooling = 0.99
+	case sa.cooling < 0 || 1 <= sa.cooling:
+		panic("simulated annealing: cooling factor out of range")
+	}
+	sa.stop = sa.StopTemperature
+	if sa.stop == 0 {
+		sa.stop = 1e-8 * sa.t0
+	}
+	sa.step = sa.StepSize
+	switch {
+	case sa.step == 0:
+		sa.step = 1
+	case sa.step < 0:
+		panic("simulated annealing: negative step size")
+	}
+	sa.rnd = newRandFuncs(sa.Src)
+
+	sa.temp = sa.t0
+	sa.init = true
+	sa.x = resize(sa.x, dim)
+	sa.f = math.NaN()
+	sa.status = NotTerminated
+
+	sa.runner.init(dim, sa.n)
+	return min(tasks, sa.n)
+}
+
+func (sa *SimulatedAnnealing) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	copy(sa.x, tasks[0].X)
+	clip(sa.x, sa.Lower, sa.Upper)
+	if tasks[0].Op&FuncEvaluation != 0 && floats.Equal(sa.x, tasks[0].X) {
+		// The function value at the initial location is known.
+		sa.f = tasks[0].F
+		sa.init = false
+		sa.runner.setBest(sa.x, sa.f)
+	}
+	sa.runner.run(sa, operations, results, tasks)
+}
+
+func (sa *SimulatedAnnealing) batchSize() int {
+	if sa.init {
+		return 1
+	}
+	return sa.n
+}
+
+func (sa *SimulatedAnnealing) sample(i int, x []float64) {
+	if sa.init {
+		copy(x, sa.x)
+		return
+	}
+	scale := sa.step * math.Sqrt(sa.temp/sa.t0)
+	for j, v := range sa.x {
+		x[j] = v + scale*sa.rnd.normFloat64()
+	}
+	clip(x, sa.Lower, sa.Upper)
+}
+
+func (sa *SimulatedAnnealing) update(xs *mat.Dense, fs []float64) bool {
+	if sa.init {
+		sa.f = fs[0]
+		sa.init = false
+		return false
+	}
+
+	// Find the best candidate.
+	best := -1
+	for i, f := range fs {
+		if !math.IsNaN(f) && (best == -1 || f < fs[best]) {
+			best = i
+		}
+	}
+	if best != -1 {
+		f := fs[best]
+		if f <= sa.f || math.IsNaN(sa.f) || sa.rnd.float64() < math.Exp(-(f-sa.f)/sa.temp) {
+			sa.f = f
+			copy(sa.x, xs.RawRowView(best))
+		}
+	}
+
+	sa.temp *= sa.cooling
+	if sa.temp < sa.stop {
+		sa.status = MethodConverge
+		return true
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff
+
+import (
+	"github.com/jingcheng-WU/gonum/diff/fd"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/num/dual"
+	"github.com/jingcheng-WU/gonum/num/hyperdual"
+	"github.com/jingcheng-WU/gonum/optimize"
+)
+
+const badLength = "autodiff: slice length mismatch"
+
+// Func is an objective function of the Numbers in x. All elements of x are
+// of the same kind, and a Func must only combine them with constants created
+// by their Const method. The control flow of a Func may depend on the Real
+// values of Numbers, so that piecewise defined functions have the derivatives
+// of the piece selected at x. A Func must not retain or modify x.
+type Func func(x []Number) Number
+
+// Value returns the value of f at x.
+func Value(f Func, x []float64) float64 {
+	v := make([]Number, len(x))
+	for i, xi := range x {
+		v[i] = realNumber(xi)
+	}
+	return f(v).Real()
+}
+
+// Gradient computes the gradient of f at x using dual numbers, which
+// requires len(x) evaluations of f. If dst is not nil, the result is stored
+// in-place into dst and returned, otherwise a new slice is allocated first.
+// Gradient panics if the lengths of dst and x differ.
+func Gradient(dst []float64, f Func, x []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(x))
+	}
+	if len(dst) != len(x) {
+		panic(badLength)
+	}
+	v := make([]Number, len(x))
+	for i, xi := range x {
+		v[i] = dualNumber{dual.Number{Real: xi}}
+	}
+	for i, xi := range x {
+		v[i] = dualNumber{dual.Number{Real: xi, Emag: 1}}
+		dst[i] = f(v).(dualNumber).v.Emag
+		v[i] = dualNumber{dual.Number{Real: xi}}
+	}
+	return dst
+}
+
+// Hessian computes the Hessian of f at x using hyperdual numbers, which
+// requires len(x)*(len(x)+1)/2 evaluations of f, and stores the result into
+// dst. If dst is empty, it is resized to the correct dimensions, otherwise
+// Hessian panics if the dimensions of dst do not match the length of x.
+func Hessian(dst *mat.SymDense, f Func, x []float64) {
+	n := len(x)
+	if dst.IsEmpty() {
+		dst.ReuseAsSym(n)
+	} else if dst.Symmetric() != n {
+		panic(badLength)
+	}
+	v := make([]Number, n)
+	for i, xi := range x {
+		v[i] = hyperdualNumber{hyperdual.Number{Real: xi}}
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			// Seed x_i in the first and x_j in the second infinitesimal
+			// part, so that the mixed part of f is ∂²f/∂x_i∂x_j.
+			if i == j {
+				v[i] = hyperdualNumber{hyperdual.Number{Real: x[i], E1mag: 1, E2mag: 1}}
+			} else {
+				v[i] = hyperdualNumber{hyperdual.Number{Real: x[i], E1mag: 1}}
+				v[j] = hyperdualNumber{hyperdual.Number{Real: x[j], E2mag: 1}}
+			}
+			dst.SetSym(i, j, f(v).(hyperdualNumber).v.E1E2mag)
+			v[i] = hyperdualNumber{hyperdual.Number{Real: x[i]}}
+			v[j] = hyperdualNumber{hyperdual.Number{Real: x[j]}}
+		}
+	}
+}
+
+// NewProblem returns an optimize.Problem with the function value, the
+// gradient and the Hessian of f.
+func NewProblem(f Func) optimize.Problem {
+	return Complete(optimize.Problem{}, f, nil)
+}
+
+// Complete returns a copy of p with the nil fields of p among Func, Grad and
+// Hess filled. If f is not nil, the fields are filled with the value,
+// gradient and Hessian of f computed by Value, Gradient and Hessian.
+// Otherwise, p.Func must not be nil and Grad and Hess are filled with the
+// finite-difference approximations computed by fd.Gradient and fd.Hessian
+// with the given settings.
+func Complete(p optimize.Problem, f Func, settings *fd.Settings) optimize.Problem {
+	if f != nil {
+		if p.Func == nil {
+			p.Func = func(x []float64) float64 {
+				return Value(f, x)
+			}
+		}
+		if p.Grad == nil {
+			p.Grad = func(grad, x []float64) {
+				Gradient(grad, f, x)
+			}
+		}
+		if p.Hess == nil {
+			p.Hess = func(hess *mat.SymDense, x []float64) {
+				Hessian(hess, f, x)
+			}
+		}
+		return p
+	}
+
+	if p.Func == nil {
+		panic("autodiff: nil objective function")
+	}
+	fn := p.Func
+	if p.Grad == nil {
+		p.Grad = func(grad, x []float64) {
+			fd.Gradient(grad, fn, x, settings)
+		}
+	}
+	if p.Hess == nil {
+		p.Hess = func(hess *mat.SymDense, x []float64) {
+			fd.Hessian(hess, fn, x, settings)
+		}
+	}
+	return p
+}
@@ -0,0 +1,212 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/diff/fd"
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize"
+	"github.com/jingcheng-WU/gonum/optimize/functions"
+)
+
+// rosenbrock is the extended Rosenbrock function written over Numbers.
+func rosenbrock(x []Number) Number {
+	sum := x[0].Const(0)
+	for i := 0; i < len(x)-1; i++ {
+		a := x[i].Const(1).Sub(x[i])
+		b := x[i+1].Sub(x[i].Mul(x[i]))
+		sum = sum.Add(a.Mul(a)).Add(b.Mul(b).Scale(100))
+	}
+	return sum
+}
+
+// wood is the Wood function written over Numbers.
+func wood(x []Number) Number {
+	one := x[0].Const(1)
+	f1 := x[1].Sub(x[0].Mul(x[0]))
+	f2 := one.Sub(x[0])
+	f3 := x[3].Sub(x[2].Mul(x[2]))
+	f4 := one.Sub(x[2])
+	f5 := x[1].Add(x[3]).Sub(x[0].Const(2))
+	f6 := x[1].Sub(x[3])
+	return f1.Mul(f1).Scale(100).Add(f2.Mul(f2)).Add(f3.Mul(f3).Scale(90)).
+		Add(f4.Mul(f4)).Add(f5.Mul(f5).Scale(10)).Add(f6.Mul(f6).Scale(0.1))
+}
+
+// elementary uses all operations of Number at locations in (0, 1).
+func elementary(x []Number) Number {
+	a, b, c := x[0], x[1], x[2]
+	two := a.Const(2)
+	terms := []Number{
+		a.Mul(b).Div(c.Add(two)),
+		a.Sub(b).Neg().Abs(),
+		a.Pow(b).Add(c.PowReal(2.5)),
+		b.Inv().Sqrt(),
+		a.Mul(c).Exp().Log().Scale(3),
+		a.Sin().Mul(b.Cos()).Add(c.Tan()),
+		a.Asin().Add(b.Acos()).Mul(c.Atan()),
+		a.Sinh().Mul(b.Cosh()).Sub(c.Tanh()),
+		a.Add(two).Asinh().Mul(b.Add(two).Acosh()).Add(c.Atanh()),
+	}
+	sum := a.Const(0)
+	for _, t := range terms {
+		sum = sum.Add(t)
+	}
+	return sum
+}
+
+func TestDerivatives(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name  string
+		f     Func
+		x     []float64
+		grad  func(grad, x []float64)
+		hess  func(hess *mat.SymDense, x []float64)
+		tol   float64
+		fdTol float64
+	}{
+		{
+			name:  "Rosenbrock",
+			f:     rosenbrock,
+			x:     []float64{-1.2, 1, 0.3, 2},
+			grad:  functions.ExtendedRosenbrock{}.Grad,
+			tol:   1e-12,
+			fdTol: 1e-5,
+		},
+		{
+			name:  "Wood",
+			f:     wood,
+			x:     []float64{-3, -1, -3, -1},
+			grad:  functions.Wood{}.Grad,
+			hess:  functions.Wood{}.Hess,
+			tol:   1e-10,
+			fdTol: 1e-5,
+		},
+		{
+			name:  "Elementary",
+			f:     elementary,
+			x:     []float64{0.3, 0.6, 0.2},
+			tol:   1e-12,
+			fdTol: 1e-6,
+		},
+	} {
+		n := len(test.x)
+		plain := func(x []float64) float64 { return Value(test.f, x) }
+
+		grad := Gradient(nil, test.f, test.x)
+		if test.grad != nil {
+			want := make([]float64, n)
+			test.grad(want, test.x)
+			if !floats.EqualApprox(grad, want, test.tol) {
+				t.Errorf("%s: unexpected gradient: got %v, want %v", test.name, grad, want)
+			}
+		}
+		settings := &fd.Settings{Formula: fd.Central}
+		want := fd.Gradient(nil, plain, test.x, settings)
+		if !floats.EqualApprox(grad, want, test.fdTol) {
+			t.Errorf("%s: gradient does not match finite differences: got %v, want %v", test.name, grad, want)
+		}
+
+		var hess mat.SymDense
+		Hessian(&hess, test.f, test.x)
+		if test.hess != nil {
+			want := mat.NewSymDense(n, nil)
+			test.hess(want, test.x)
+			if !mat.EqualApprox(&hess, want, test.tol) {
+				t.Errorf("%s: unexpected Hessian: got %v, want %v", test.name, mat.Formatted(&hess), mat.Formatted(want))
+			}
+		}
+		// The Hessian is compared with central differences of the exact
+		// gradient.
+		for j := 0; j < n; j++ {
+			col := fd.Gradient(nil, func(x []float64) float64 {
+				return Gradient(nil, test.f, x)[j]
+			}, test.x, settings)
+			for i, v := range col {
+				if math.Abs(v-hess.At(i, j)) > test.fdTol*math.Max(1, math.Abs(v)) {
+					t.Errorf("%s: Hessian element (%d,%d) does not match finite differences: got %v, want %v", test.name, i, j, hess.At(i, j), v)
+				}
+			}
+		}
+	}
+}
+
+func TestComplete(t *testing.T) {
+	t.Parallel()
+	x := []float64{-3, -1, -3, -1}
+	w := functions.Wood{}
+
+	p := NewProblem(wood)
+	if got, want := p.Func(x), w.Func(x); math.Abs(got-want) > 1e-9 {
+		t.Errorf("unexpected function value: got %v, want %v", got, want)
+	}
+
+	// Existing fields are kept.
+	var called bool
+	p = Complete(optimize.Problem{
+		Grad: func(grad, x []float64) {
+			called = true
+			w.Grad(grad, x)
+		},
+	}, wood, nil)
+	p.Grad(make([]float64, len(x)), x)
+	if !called {
+		t.Errorf("provided gradient not used")
+	}
+
+	// Without a Func the derivatives are approximated by finite differences.
+	p = Complete(optimize.Problem{Func: w.Func}, nil, &fd.Settings{Formula: fd.Central})
+	grad := make([]float64, len(x))
+	p.Grad(grad, x)
+	want := make([]float64, len(x))
+	w.Grad(want, x)
+	if !floats.EqualApprox(grad, want, 1e-5) {
+		t.Errorf("unexpected finite-difference gradient: got %v, want %v", grad, want)
+	}
+	hess := mat.NewSymDense(len(x), nil)
+	p.Hess(hess, x)
+	wantHess := mat.NewSymDense(len(x), nil)
+	w.Hess(wantHess, x)
+	if !mat.EqualApprox(hess, wantHess, 1e-3) {
+		t.Errorf("unexpected finite-difference Hessian: got %v, want %v", mat.Formatted(hess), mat.Formatted(wantHess))
+	}
+
+	// Exact derivatives allow Newton's method to converge.
+	result, err := optimize.Minimize(NewProblem(rosenbrock), []float64{-1.2, 1, -1.2, 1}, nil, &optimize.Newton{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(result.X, []float64{1, 1, 1, 1}, 1e-8) {
+		t.Errorf("minimum not found: got %v", result.X)
+	}
+}
+
+func TestMixedKinds(t *testing.T) {
+	t.Parallel()
+	f := func(x []Number) Number {
+		return x[0].Add(realNumber(1))
+	}
+	for name, fn := range map[string]func(){
+		"Gradient": func() { Gradient(nil, f, []float64{1}) },
+		"Hessian":  func() { Hessian(&mat.SymDense{}, f, []float64{1}) },
+	} {
+		if !panics(fn) {
+			t.Errorf("%s: expected panic for mixed kinds of numbers", name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	fn()
+	return false
+}
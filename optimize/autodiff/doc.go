@@ -0,0 +1,22 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package autodiff provides exact gradients and Hessians of objective
+// functions for optimize.Problem by forward-mode automatic differentiation.
+//
+// An objective function is written as a Func over Numbers instead of
+// float64 values, for example the Rosenbrock function
+//  f := func(x []autodiff.Number) autodiff.Number {
+//  	a := x[0].Const(1).Sub(x[0])
+//  	b := x[1].Sub(x[0].Mul(x[0]))
+//  	return a.Mul(a).Add(b.Mul(b).Scale(100))
+//  }
+// The function is evaluated on float64 values for the function value, on
+// dual numbers of the num/dual package for the gradient, and on hyperdual
+// numbers of the num/hyperdual package for the Hessian. The derivatives are
+// exact up to floating-point rounding, unlike finite-difference
+// approximations. For objective functions that cannot be written over
+// Numbers, Complete falls back to the finite-difference approximations of
+// the diff/fd package.
+package autodiff // import "github.com/jingcheng-WU/gonum/optimize/autodiff"
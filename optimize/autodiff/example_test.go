@@ -0,0 +1,38 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jingcheng-WU/gonum/optimize"
+	"github.com/jingcheng-WU/gonum/optimize/autodiff"
+)
+
+func ExampleNewProblem() {
+	// The Rosenbrock function written over Numbers.
+	f := func(x []autodiff.Number) autodiff.Number {
+		a := x[0].Const(1).Sub(x[0])
+		b := x[1].Sub(x[0].Mul(x[0]))
+		return a.Mul(a).Add(b.Mul(b).Scale(100))
+	}
+
+	x := []float64{-1.2, 1}
+	grad := autodiff.Gradient(nil, f, x)
+	fmt.Printf("gradient at %v: %.4g\n", x, grad)
+
+	// Newton's method uses the exact gradient and Hessian.
+	p := autodiff.NewProblem(f)
+	result, err := optimize.Minimize(p, x, nil, &optimize.Newton{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("minimum at %.4f\n", result.X)
+
+	// Output:
+	// gradient at [-1.2 1]: [-215.6 -88]
+	// minimum at [1.0000 1.0000]
+}
@@ -0,0 +1,211 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package autodiff
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/num/dual"
+	"github.com/jingcheng-WU/gonum/num/hyperdual"
+)
+
+const mixedKinds = "autodiff: mixed kinds of numbers"
+
+// Number is a scalar value in a Func. Depending on the evaluation, a Number
+// holds a float64 value, a dual number or a hyperdual number. Numbers of
+// different kinds cannot be combined, so constants in a Func must be created
+// with the Const method of a Number passed to the Func. Operations on Numbers
+// of different kinds panic.
+type Number interface {
+	// Real returns the value of the number without its derivative parts.
+	// Real can be used for comparisons that select the branch of a
+	// piecewise defined function.
+	Real() float64
+
+	// Const returns a constant number of the same kind as the receiver
+	// with value v.
+	Const(v float64) Number
+
+	// Add returns the sum of the receiver and y.
+	Add(y Number) Number
+	// Sub returns the difference of the receiver and y.
+	Sub(y Number) Number
+	// Mul returns the product of the receiver and y.
+	Mul(y Number) Number
+	// Div returns the quotient of the receiver and y.
+	Div(y Number) Number
+	// Neg returns the negation of the receiver.
+	Neg() Number
+	// Scale returns the receiver scaled by f.
+	Scale(f float64) Number
+	// Inv returns the reciprocal of the receiver.
+	Inv() Number
+	// Pow returns the receiver raised to the power p.
+	Pow(p Number) Number
+	// PowReal returns the receiver raised to the real power p.
+	PowReal(p float64) Number
+
+	// Abs returns the absolute value of the receiver.
+	Abs() Number
+	// Sqrt returns the square root of the receiver.
+	Sqrt() Number
+	// Exp returns e**r, the base-e exponential of the receiver r.
+	Exp() Number
+	// Log returns the natural logarithm of the receiver.
+	Log() Number
+	// Sin returns the sine of the receiver.
+	Sin() Number
+	// Cos returns the cosine of the receiver.
+	Cos() Number
+	// Tan returns the tangent of the receiver.
+	Tan() Number
+	// Asin returns the inverse sine of the receiver.
+	Asin() Number
+	// Acos returns the inverse cosine of the receiver.
+	Acos() Number
+	// Atan returns the inverse tangent of the receiver.
+	Atan() Number
+	// Sinh returns the hyperbolic sine of the receiver.
+	Sinh() Number
+	// Cosh returns the hyperbolic cosine of the receiver.
+	Cosh() Number
+	// Tanh returns the hyperbolic tangent of the receiver.
+	Tanh() Number
+	// Asinh returns the inverse hyperbolic sine of the receiver.
+	Asinh() Number
+	// Acosh returns the inverse hyperbolic cosine of the receiver.
+	Acosh() Number
+	// Atanh returns the inverse hyperbolic tangent of the receiver.
+	Atanh() Number
+}
+
+var (
+	_ Number = realNumber(0)
+	_ Number = dualNumber{}
+	_ Number = hyperdualNumber{}
+)
+
+// realNumber is a Number holding a float64 value.
+type realNumber float64
+
+func (r realNumber) other(y Number) float64 {
+	v, ok := y.(realNumber)
+	if !ok {
+		panic(mixedKinds)
+	}
+	return float64(v)
+}
+
+func (r realNumber) Real() float64            { return float64(r) }
+func (r realNumber) Const(v float64) Number   { return realNumber(v) }
+func (r realNumber) Add(y Number) Number      { return r + realNumber(r.other(y)) }
+func (r realNumber) Sub(y Number) Number      { return r - realNumber(r.other(y)) }
+func (r realNumber) Mul(y Number) Number      { return r * realNumber(r.other(y)) }
+func (r realNumber) Div(y Number) Number      { return r / realNumber(r.other(y)) }
+func (r realNumber) Neg() Number              { return -r }
+func (r realNumber) Scale(f float64) Number   { return realNumber(f) * r }
+func (r realNumber) Inv() Number              { return 1 / r }
+func (r realNumber) Pow(p Number) Number      { return realNumber(math.Pow(float64(r), r.other(p))) }
+func (r realNumber) PowReal(p float64) Number { return realNumber(math.Pow(float64(r), p)) }
+func (r realNumber) Abs() Number              { return realNumber(math.Abs(float64(r))) }
+func (r realNumber) Sqrt() Number             { return realNumber(math.Sqrt(float64(r))) }
+func (r realNumber) Exp() Number              { return realNumber(math.Exp(float64(r))) }
+func (r realNumber) Log() Number              { return realNumber(math.Log(float64(r))) }
+func (r realNumber) Sin() Number              { return realNumber(math.Sin(float64(r))) }
+func (r realNumber) Cos() Number              { return realNumber(math.Cos(float64(r))) }
+func (r realNumber) Tan() Number              { return realNumber(math.Tan(float64(r))) }
+func (r realNumber) Asin() Number             { return realNumber(math.Asin(float64(r))) }
+func (r realNumber) Acos() Number             { return realNumber(math.Acos(float64(r))) }
+func (r realNumber) Atan() Number             { return realNumber(math.Atan(float64(r))) }
+func (r realNumber) Sinh() Number             { return realNumber(math.Sinh(float64(r))) }
+func (r realNumber) Cosh() Number             { return realNumber(math.Cosh(float64(r))) }
+func (r realNumber) Tanh() Number             { return realNumber(math.Tanh(float64(r))) }
+func (r realNumber) Asinh() Number            { return realNumber(math.Asinh(float64(r))) }
+func (r realNumber) Acosh() Number            { return realNumber(math.Acosh(float64(r))) }
+func (r realNumber) Atanh() Number            { return realNumber(math.Atanh(float64(r))) }
+
+// dualNumber is a Number holding a dual number.
+type dualNumber struct {
+	v dual.Number
+}
+
+func (d dualNumber) other(y Number) dual.Number {
+	v, ok := y.(dualNumber)
+	if !ok {
+		panic(mixedKinds)
+	}
+	return v.v
+}
+
+func (d dualNumber) Real() float64            { return d.v.Real }
+func (d dualNumber) Const(v float64) Number   { return dualNumber{dual.Number{Real: v}} }
+func (d dualNumber) Add(y Number) Number      { return dualNumber{dual.Add(d.v, d.other(y))} }
+func (d dualNumber) Sub(y Number) Number      { return dualNumber{dual.Sub(d.v, d.other(y))} }
+func (d dualNumber) Mul(y Number) Number      { return dualNumber{dual.Mul(d.v, d.other(y))} }
+func (d dualNumber) Div(y Number) Number      { return dualNumber{dual.Mul(d.v, dual.Inv(d.other(y)))} }
+func (d dualNumber) Neg() Number              { return dualNumber{dual.Scale(-1, d.v)} }
+func (d dualNumber) Scale(f float64) Number   { return dualNumber{dual.Scale(f, d.v)} }
+func (d dualNumber) Inv() Number              { return dualNumber{dual.Inv(d.v)} }
+func (d dualNumber) Pow(p Number) Number      { return dualNumber{dual.Pow(d.v, d.other(p))} }
+func (d dualNumber) PowReal(p float64) Number { return dualNumber{dual.PowReal(d.v, p)} }
+func (d dualNumber) Abs() Number              { return dualNumber{dual.Abs(d.v)} }
+func (d dualNumber) Sqrt() Number             { return dualNumber{dual.Sqrt(d.v)} }
+func (d dualNumber) Exp() Number              { return dualNumber{dual.Exp(d.v)} }
+func (d dualNumber) Log() Number              { return dualNumber{dual.Log(d.v)} }
+func (d dualNumber) Sin() Number              { return dualNumber{dual.Sin(d.v)} }
+func (d dualNumber) Cos() Number              { return dualNumber{dual.Cos(d.v)} }
+func (d dualNumber) Tan() Number              { return dualNumber{dual.Tan(d.v)} }
+func (d dualNumber) Asin() Number             { return dualNumber{dual.Asin(d.v)} }
+func (d dualNumber) Acos() Number             { return dualNumber{dual.Acos(d.v)} }
+func (d dualNumber) Atan() Number             { return dualNumber{dual.Atan(d.v)} }
+func (d dualNumber) Sinh() Number             { return dualNumber{dual.Sinh(d.v)} }
+func (d dualNumber) Cosh() Number             { return dualNumber{dual.Cosh(d.v)} }
+func (d dualNumber) Tanh() Number             { return dualNumber{dual.Tanh(d.v)} }
+func (d dualNumber) Asinh() Number            { return dualNumber{dual.Asinh(d.v)} }
+func (d dualNumber) Acosh() Number            { return dualNumber{dual.Acosh(d.v)} }
+func (d dualNumber) Atanh() Number            { return dualNumber{dual.Atanh(d.v)} }
+
+// hyperdualNumber is a Number holding a hyperdual number.
+type hyperdualNumber struct {
+	v hyperdual.Number
+}
+
+func (d hyperdualNumber) other(y Number) hyperdual.Number {
+	v, ok := y.(hyperdualNumber)
+	if !ok {
+		panic(mixedKinds)
+	}
+	return v.v
+}
+
+func (d hyperdualNumber) Real() float64          { return d.v.Real }
+func (d hyperdualNumber) Const(v float64) Number { return hyperdualNumber{hyperdual.Number{Real: v}} }
+func (d hyperdualNumber) Add(y Number) Number    { return hyperdualNumber{hyperdual.Add(d.v, d.other(y))} }
+func (d hyperdualNumber) Sub(y Number) Number    { return hyperdualNumber{hyperdual.Sub(d.v, d.other(y))} }
+func (d hyperdualNumber) Mul(y Number) Number    { return hyperdualNumber{hyperdual.Mul(d.v, d.other(y))} }
+func (d hyperdualNumber) Div(y Number) Number {
+	return hyperdualNumber{hyperdual.Mul(d.v, hyperdual.Inv(d.other(y)))}
+}
+func (d hyperdualNumber) Neg() Number              { return hyperdualNumber{hyperdual.Scale(-1, d.v)} }
+func (d hyperdualNumber) Scale(f float64) Number   { return hyperdualNumber{hyperdual.Scale(f, d.v)} }
+func (d hyperdualNumber) Inv() Number              { return hyperdualNumber{hyperdual.Inv(d.v)} }
+func (d hyperdualNumber) Pow(p Number) Number      { return hyperdualNumber{hyperdual.Pow(d.v, d.other(p))} }
+func (d hyperdualNumber) PowReal(p float64) Number { return hyperdualNumber{hyperdual.PowReal(d.v, p)} }
+func (d hyperdualNumber) Abs() Number              { return hyperdualNumber{hyperdual.Abs(d.v)} }
+func (d hyperdualNumber) Sqrt() Number             { return hyperdualNumber{hyperdual.Sqrt(d.v)} }
+func (d hyperdualNumber) Exp() Number              { return hyperdualNumber{hyperdual.Exp(d.v)} }
+func (d hyperdualNumber) Log() Number              { return hyperdualNumber{hyperdual.Log(d.v)} }
+func (d hyperdualNumber) Sin() Number              { return hyperdualNumber{hyperdual.Sin(d.v)} }
+func (d hyperdualNumber) Cos() Number              { return hyperdualNumber{hyperdual.Cos(d.v)} }
+func (d hyperdualNumber) Tan() Number              { return hyperdualNumber{hyperdual.Tan(d.v)} }
+func (d hyperdualNumber) Asin() Number             { return hyperdualNumber{hyperdual.Asin(d.v)} }
+func (d hyperdualNumber) Acos() Number             { return hyperdualNumber{hyperdual.Acos(d.v)} }
+func (d hyperdualNumber) Atan() Number             { return hyperdualNumber{hyperdual.Atan(d.v)} }
+func (d hyperdualNumber) Sinh() Number             { return hyperdualNumber{hyperdual.Sinh(d.v)} }
+func (d hyperdualNumber) Cosh() Number             { return hyperdualNumber{hyperdual.Cosh(d.v)} }
+func (d hyperdualNumber) Tanh() Number             { return hyperdualNumber{hyperdual.Tanh(d.v)} }
+func (d hyperdualNumber) Asinh() Number            { return hyperdualNumber{hyperdual.Asinh(d.v)} }
+func (d hyperdualNumber) Acosh() Number            { return hyperdualNumber{hyperdual.Acosh(d.v)} }
+func (d hyperdualNumber) Atanh() Number            { return hyperdualNumber{hyperdual.Atanh(d.v)} }
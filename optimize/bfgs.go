@@ -12,6 +12,7 @@ import (
 
 var (
 	_ Method          = (*BFGS)(nil)
+	_ Checkpointer    = (*BFGS)(nil)
 	_ localMethod     = (*BFGS)(nil)
 	_ NextDirectioner = (*BFGS)(nil)
 )
@@ -46,6 +47,9 @@ type BFGS struct {
 	invHess *mat.SymDense
 
 	first bool // Indicator of the first iteration.
+
+	started bool // Indicates that initLocal has been called in the current run.
+	resume  bool // Indicates that the run is resumed from a saved state.
 }
 
 func (b *BFGS) Status() (Status, error) {
@@ -59,15 +63,25 @@ func (*BFGS) Uses(has Available) (uses Available, err error) {
 func (b *BFGS) Init(dim, tasks int) int {
 	b.status = NotTerminated
 	b.err = nil
+	b.started = false
+	b.resume = false
 	return 1
 }
 
 func (b *BFGS) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
-	b.status, b.err = localOptimizer{}.run(b, b.GradStopThreshold, operation, result, tasks)
+	b.status, b.err = localOptimizer{resume: b.resume, started: b.started}.run(b, b.GradStopThreshold, operation, result, tasks)
 	close(operation)
 }
 
 func (b *BFGS) initLocal(loc *Location) (Operation, error) {
+	b.initLinesearch()
+	b.started = true
+	return b.ls.Init(loc)
+}
+
+// initLinesearch sets the default Linesearcher and prepares the
+// LinesearchMethod.
+func (b *BFGS) initLinesearch() {
 	if b.Linesearcher == nil {
 		b.Linesearcher = &Bisection{}
 	}
@@ -76,8 +90,60 @@ func (b *BFGS) initLocal(loc *Location) (Operation, error) {
 	}
 	b.ls.Linesearcher = b.Linesearcher
 	b.ls.NextDirectioner = b
+}
 
-	return b.ls.Init(loc)
+// bfgsState is the saved state of a BFGS optimization run.
+type bfgsState struct {
+	Started bool
+	X       []float64
+	Grad    []float64
+	InvHess []float64
+	First   bool
+}
+
+// SaveState returns the state of the optimization run, which consists of the
+// location and gradient at the last major iteration and the inverse Hessian
+// approximation.
+func (b *BFGS) SaveState() ([]byte, error) {
+	if !b.started {
+		return encodeState(bfgsState{})
+	}
+	return encodeState(bfgsState{
+		Started: true,
+		X:       b.x.RawVector().Data,
+		Grad:    b.grad.RawVector().Data,
+		InvHess: b.invHess.RawSymmetric().Data,
+		First:   b.first,
+	})
+}
+
+// LoadState restores the state of an optimization run saved by SaveState.
+func (b *BFGS) LoadState(state []byte) error {
+	var st bfgsState
+	err := decodeState(state, &st)
+	if err != nil {
+		return err
+	}
+	b.resume = true
+	b.started = st.Started
+	if !st.Started {
+		return nil
+	}
+	dim := len(st.X)
+	if len(st.Grad) != dim || len(st.InvHess) != dim*dim {
+		return ErrCheckpointMismatch
+	}
+	b.initLinesearch()
+	b.dim = dim
+	b.x.CloneFromVec(mat.NewVecDense(dim, st.X))
+	b.grad.CloneFromVec(mat.NewVecDense(dim, st.Grad))
+	b.y.Reset()
+	b.s.Reset()
+	b.tmp.Reset()
+	b.invHess = mat.NewSymDense(dim, st.InvHess)
+	b.first = st.First
+	b.ls.resume(dim)
+	return nil
 }
 
 func (b *BFGS) iterateLocal(loc *Location) (Operation, error) {
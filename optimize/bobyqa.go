@@ -0,0 +1,640 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var (
+	_ Statuser = (*BOBYQA)(nil)
+	_ Method   = (*BOBYQA)(nil)
+)
+
+// BOBYQA implements Powell's derivative-free trust-region method for bound
+// constrained minimization. The objective function is approximated by a
+// quadratic model that interpolates the function at a set of points. When a
+// point in the set is replaced, the model is updated such that the Frobenius
+// norm of the change in its Hessian is least, so that only O(dim) points are
+// needed to build useful quadratic models. The minimizer of the model within
+// a trust region and the bounds is computed by a truncated conjugate gradient
+// method. The trust-region radius is bounded from below by a second radius ρ
+// that is decreased from InitRadius to StopRadius, and the method converges
+// with MethodConverge when ρ cannot be decreased further.
+//
+// BOBYQA evaluates the objective function at only one location at a time and
+// is intended for problems where evaluations are expensive. Without bounds,
+// it is a variant of Powell's NEWUOA method for unconstrained minimization.
+//
+// The method is described in
+//  Powell, M. J. D.: The BOBYQA algorithm for bound constrained optimization
+//  without derivatives. Technical Report DAMTP 2009/NA06, University of
+//  Cambridge (2009)
+// The implementation computes the model updates by solving the interpolation
+// equations directly instead of updating their inverse as in Powell's code.
+type BOBYQA struct {
+	// Lower and Upper specify the bounds on the variables. If Lower (Upper)
+	// is nil, the variables have no lower (upper) bound. If they are not nil,
+	// they must have length equal to the problem dimension, and the
+	// difference between the bounds must be positive for all variables.
+	// The objective function is only evaluated within the bounds.
+	Lower, Upper []float64
+	// InterpolationPoints is the number of interpolation points. If
+	// InterpolationPoints is 0, it is defaulted to 2*dim+1. Otherwise it
+	// must be in [dim+2, 2*dim+1].
+	InterpolationPoints int
+	// InitRadius is the initial trust-region radius, which should be about
+	// one tenth of the greatest expected change of a variable. If
+	// InitRadius is 0, it is defaulted to 0.5. InitRadius must not be
+	// negative, and it is decreased to half of the smallest difference
+	// between the bounds if necessary.
+	InitRadius float64
+	// StopRadius is the final value of the lower bound on the trust-region
+	// radius, which determines the accuracy of the solution. If StopRadius
+	// is 0, it is defaulted to 1e-6 times the initial radius. StopRadius
+	// must not be negative.
+	StopRadius float64
+
+	status Status
+	err    error
+
+	dim, npt   int
+	rho, delta float64
+
+	pts  *mat.Dense // interpolation points by row
+	fs   []float64  // function values at the points
+	kopt int        // index of the best point
+
+	// Quadratic model
+	//  Q(x) = c + gᵀ(x-xopt) + 1/2 (x-xopt)ᵀ H (x-xopt)
+	// around the best point.
+	c float64
+	g []float64
+	h *mat.SymDense
+
+	// Interpolation system of the minimum Frobenius norm update with
+	// points scaled as (x_k - xopt)/scale.
+	s     *mat.Dense
+	scale float64
+	kkt   *mat.Dense
+	lu    mat.LU
+
+	xopt, d, xnew, lag []float64
+	gd, r, p, hp       []float64
+	fixed              []bool
+}
+
+func (*BOBYQA) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+// Status returns the status of the method.
+func (b *BOBYQA) Status() (Status, error) {
+	return b.status, b.err
+}
+
+func (b *BOBYQA) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(b.Lower, b.Upper, dim)
+	b.dim = dim
+	b.npt = b.InterpolationPoints
+	switch {
+	case b.npt == 0:
+		b.npt = 2*dim + 1
+	case b.npt < dim+2 || 2*dim+1 < b.npt:
+		panic("bobyqa: number of interpolation points out of range")
+	}
+	b.rho = b.InitRadius
+	switch {
+	case b.rho == 0:
+		b.rho = 0.5
+	case b.rho < 0:
+		panic("bobyqa: negative initial radius")
+	}
+	for i := 0; i < dim; i++ {
+		l, u := bounds(b.Lower, b.Upper, i)
+		if !(l < u) {
+			panic("bobyqa: empty range of variable")
+		}
+		b.rho = math.Min(b.rho, (u-l)/2)
+	}
+	if b.StopRadius < 0 {
+		panic("bobyqa: negative stop radius")
+	}
+
+	m := b.npt
+	b.pts = mat.NewDense(m, dim, nil)
+	b.fs = resize(b.fs, m)
+	b.g = resize(b.g, dim)
+	b.h = mat.NewSymDense(dim, nil)
+	b.s = mat.NewDense(m, dim, nil)
+	b.kkt = mat.NewDense(m+dim+1, m+dim+1, nil)
+	b.xopt = resize(b.xopt, dim)
+	b.d = resize(b.d, dim)
+	b.xnew = resize(b.xnew, dim)
+	b.lag = resize(b.lag, m+dim+1)
+	b.gd = resize(b.gd, dim)
+	b.r = resize(b.r, dim)
+	b.p = resize(b.p, dim)
+	b.hp = resize(b.hp, dim)
+	if cap(b.fixed) < dim {
+		b.fixed = make([]bool, dim)
+	}
+	b.fixed = b.fixed[:dim]
+
+	b.status = NotTerminated
+	b.err = nil
+	return 1
+}
+
+func (b *BOBYQA) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	r := newSerialRunner(operations, results, tasks[0])
+	b.status, b.err = b.run(r)
+	r.finish()
+}
+
+func (b *BOBYQA) run(r *serialRunner) (Status, error) {
+	n := b.dim
+	rhoEnd := b.StopRadius
+	if rhoEnd == 0 {
+		rhoEnd = 1e-6 * b.rho
+	}
+	rhoEnd = math.Min(rhoEnd, b.rho)
+	rho := b.rho
+	delta := rho
+
+	// Move the initial location into the bounds such that it is either on a
+	// bound or at least rho away from it, and build the initial points.
+	x0 := b.xnew
+	copy(x0, r.task.X)
+	moved := false
+	for i, v := range x0 {
+		l, u := bounds(b.Lower, b.Upper, i)
+		switch {
+		case v-l < rho:
+			if v-l < rho/2 {
+				x0[i] = l
+			} else {
+				x0[i] = l + rho
+			}
+		case u-v < rho:
+			if u-v < rho/2 {
+				x0[i] = u
+			} else {
+				x0[i] = u - rho
+			}
+		}
+		moved = moved || x0[i] != v
+	}
+	for k := 0; k < b.npt; k++ {
+		b.pts.SetRow(k, x0)
+	}
+	for i := 0; i < n; i++ {
+		l, u := bounds(b.Lower, b.Upper, i)
+		step := rho
+		if x0[i]+rho > u {
+			step = -rho
+		}
+		b.pts.Set(i+1, i, x0[i]+step)
+		if k := n + 1 + i; k < b.npt {
+			if x0[i]-step < l || u < x0[i]-step {
+				b.pts.Set(k, i, x0[i]+2*step)
+			} else {
+				b.pts.Set(k, i, x0[i]-step)
+			}
+		}
+	}
+
+	// Evaluate the function at the initial points.
+	for k := range b.fs {
+		var f float64
+		var ok bool
+		if k == 0 && !moved {
+			f, ok = r.initial()
+		} else {
+			f, ok = r.eval(b.pts.RawRowView(k))
+		}
+		if !ok {
+			return NotTerminated, nil
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			if k == 0 {
+				return Failure, ErrFunc(f)
+			}
+			return Failure, ErrNonFiniteFunc
+		}
+		b.fs[k] = f
+	}
+	b.kopt = floats.MinIdx(b.fs)
+	copy(b.xopt, b.pts.RawRowView(b.kopt))
+	if !r.major(b.xopt, b.fs[b.kopt]) {
+		return NotTerminated, nil
+	}
+	b.c = 0
+	for i := range b.g {
+		b.g[i] = 0
+	}
+	b.h.Zero()
+	b.updateModel(rho)
+
+	for {
+		b.step(delta)
+		dnorm := floats.Norm(b.d, 2)
+		ratio := -1.0
+		if dnorm < rho/2 {
+			// The step is too short to be useful.
+			delta /= 2
+			if delta <= 1.5*rho {
+				delta = rho
+			}
+		} else {
+			floats.AddTo(b.xnew, b.xopt, b.d)
+			clip(b.xnew, b.Lower, b.Upper)
+			fopt := b.fs[b.kopt]
+			pred := -b.modelChange(b.d)
+			f, err := b.evaluate(r, b.xnew, -1, delta, rho)
+			if err != nil {
+				return Failure, err
+			}
+			if r.stopped {
+				return NotTerminated, nil
+			}
+			if pred > 0 {
+				ratio = (fopt - f) / pred
+			}
+			switch {
+			case ratio <= 0.1:
+				delta = math.Min(delta/2, dnorm)
+			case ratio <= 0.7:
+				delta = math.Max(delta/2, dnorm)
+			default:
+				delta = math.Max(delta/2, 2*dnorm)
+			}
+			if delta <= 1.5*rho {
+				delta = rho
+			}
+			if ratio >= 0.1 {
+				continue
+			}
+		}
+
+		// Improve the geometry of the interpolation set if a point is far
+		// from the best point.
+		kfar := -1
+		var far float64
+		for k := 0; k < b.npt; k++ {
+			dist := floats.Distance(b.pts.RawRowView(k), b.xopt, 2)
+			if dist > far {
+				kfar, far = k, dist
+			}
+		}
+		if far > 2*delta {
+			b.geometryStep(kfar, math.Max(math.Min(far/10, delta), rho))
+			floats.AddTo(b.xnew, b.xopt, b.d)
+			clip(b.xnew, b.Lower, b.Upper)
+			_, err := b.evaluate(r, b.xnew, kfar, delta, rho)
+			if err != nil {
+				return Failure, err
+			}
+			if r.stopped {
+				return NotTerminated, nil
+			}
+			continue
+		}
+		if ratio > 0 || math.Max(delta, dnorm) > rho {
+			continue
+		}
+
+		// Decrease the lower bound on the trust-region radius.
+		if rho <= rhoEnd {
+			return MethodConverge, nil
+		}
+		rhoOld := rho
+		switch q := rho / rhoEnd; {
+		case q <= 16:
+			rho = rhoEnd
+		case q <= 250:
+			rho = math.Sqrt(q) * rhoEnd
+		default:
+			rho /= 10
+		}
+		delta = math.Max(rhoOld/2, rho)
+	}
+}
+
+// evaluate evaluates the function at x and replaces the interpolation point
+// with index k by x, or the point chosen by replacement if k is negative.
+// The model is updated, and a MajorIteration is sent if x is the new best
+// point. The caller must check whether the optimization has been stopped.
+func (b *BOBYQA) evaluate(r *serialRunner, x []float64, k int, delta, rho float64) (float64, error) {
+	f, ok := r.eval(x)
+	if !ok {
+		return f, nil
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return f, ErrNonFiniteFunc
+	}
+	better := f < b.fs[b.kopt]
+	if k < 0 {
+		k = b.replacement(x, better, delta)
+	}
+	b.pts.SetRow(k, x)
+	b.fs[k] = f
+	if better {
+		b.kopt = k
+		copy(b.xopt, x)
+	}
+	b.updateModel(rho)
+	if better {
+		r.major(b.xopt, f)
+	}
+	return f, nil
+}
+
+// modelChange returns Q(xopt + d) - Q(xopt).
+func (b *BOBYQA) modelChange(d []float64) float64 {
+	dv := mat.NewVecDense(len(d), d)
+	return floats.Dot(b.g, d) + 0.5*mat.Inner(dv, b.h, dv)
+}
+
+// modelValue returns the value of the model at x.
+func (b *BOBYQA) modelValue(x []float64) float64 {
+	floats.SubTo(b.r, x, b.xopt)
+	return b.c + b.modelChange(b.r)
+}
+
+// updateModel updates the model after a change in the interpolation set, such
+// that it interpolates the function values and the Frobenius norm of the
+// change in its Hessian is least. The model is moved to the new best point.
+func (b *BOBYQA) updateModel(rho float64) {
+	n, m := b.dim, b.npt
+	xopt := b.pts.RawRowView(b.kopt)
+
+	// Compute the residuals of the interpolation conditions at the points
+	// and move the model to the new best point.
+	rhs := b.lag
+	for k := 0; k < m; k++ {
+		rhs[k] = b.fs[k] - b.modelValue(b.pts.RawRowView(k))
+	}
+	for i := m; i < len(rhs); i++ {
+		rhs[i] = 0
+	}
+	floats.SubTo(b.r, xopt, b.xopt)
+	b.c += b.modelChange(b.r)
+	hp := mat.NewVecDense(n, b.hp)
+	hp.MulVec(b.h, mat.NewVecDense(n, b.r))
+	floats.Add(b.g, b.hp)
+	copy(b.xopt, xopt)
+
+	// Build the interpolation system
+	//  [ A  Yᵀ ] [ λ ]   [ r ]
+	//  [ Y  0  ] [ μ ] = [ 0 ]
+	// with A_ij = 1/2 (s_iᵀ s_j)^2 and the columns of Y equal to [1 s_i].
+	b.scale = rho
+	for k := 0; k < m; k++ {
+		s := b.s.RawRowView(k)
+		floats.SubTo(s, b.pts.RawRowView(k), xopt)
+		floats.Scale(1/rho, s)
+	}
+	b.kkt.Zero()
+	for i := 0; i < m; i++ {
+		si := b.s.RawRowView(i)
+		for j := i; j < m; j++ {
+			v := floats.Dot(si, b.s.RawRowView(j))
+			b.kkt.Set(i, j, v*v/2)
+			b.kkt.Set(j, i, v*v/2)
+		}
+		b.kkt.Set(i, m, 1)
+		b.kkt.Set(m, i, 1)
+		for l, v := range si {
+			b.kkt.Set(i, m+1+l, v)
+			b.kkt.Set(m+1+l, i, v)
+		}
+	}
+	b.lu.Factorize(b.kkt)
+	sol := mat.NewVecDense(len(rhs), rhs)
+	// Ill-conditioning of the system is reduced by the geometry steps, so
+	// the condition error is not checked.
+	_ = b.lu.SolveVecTo(sol, false, sol)
+
+	// Add the correction
+	//  c' + g'ᵀ s + 1/2 Σ_k λ_k (s_kᵀ s)^2
+	// with s = (x - xopt)/rho to the model.
+	b.c += rhs[m]
+	floats.AddScaled(b.g, 1/rho, rhs[m+1:m+1+n])
+	for k := 0; k < m; k++ {
+		b.h.SymRankOne(b.h, rhs[k]/(rho*rho), mat.NewVecDense(n, b.s.RawRowView(k)))
+	}
+}
+
+// lagrange stores in b.lag the values of the Lagrange functions of the
+// interpolation at x in the first npt elements.
+func (b *BOBYQA) lagrange(x []float64) {
+	m := b.npt
+	floats.SubTo(b.r, x, b.xopt)
+	floats.Scale(1/b.scale, b.r)
+	w := b.lag
+	for k := 0; k < m; k++ {
+		v := floats.Dot(b.s.RawRowView(k), b.r)
+		w[k] = v * v / 2
+	}
+	w[m] = 1
+	copy(w[m+1:], b.r)
+	v := mat.NewVecDense(len(w), w)
+	_ = b.lu.SolveVecTo(v, false, v)
+}
+
+// replacement returns the index of the interpolation point to be replaced by
+// x. Points far from the best point and points whose Lagrange function has
+// a large magnitude at x are preferred. The best point is not replaced
+// unless x is better.
+func (b *BOBYQA) replacement(x []float64, better bool, delta float64) int {
+	b.lagrange(x)
+	k := -1
+	var max float64
+	for i := 0; i < b.npt; i++ {
+		if i == b.kopt && !better {
+			continue
+		}
+		dist := floats.Distance(b.pts.RawRowView(i), b.xopt, 2) / delta
+		score := math.Abs(b.lag[i]) * math.Max(1, dist*dist)
+		if k == -1 || score > max {
+			k, max = i, score
+		}
+	}
+	return k
+}
+
+// geometryStep stores in b.d a step from the best point within the given
+// radius and the bounds at which the Lagrange function of point k has a large
+// magnitude, so that replacing point k improves the interpolation set.
+func (b *BOBYQA) geometryStep(k int, radius float64) {
+	n, m := b.dim, b.npt
+
+	// Compute the coefficients of the Lagrange function of point k.
+	coef := make([]float64, m+n+1)
+	coef[k] = 1
+	v := mat.NewVecDense(len(coef), coef)
+	_ = b.lu.SolveVecTo(v, false, v)
+	lk := func(d []float64) float64 {
+		var l float64
+		for j := 0; j < m; j++ {
+			t := floats.Dot(b.s.RawRowView(j), d) / b.scale
+			l += coef[j] * t * t / 2
+		}
+		return l + coef[m] + floats.Dot(coef[m+1:], d)/b.scale
+	}
+
+	// Try the steps along the lines through the other points and along the
+	// gradient of the Lagrange function.
+	cand := b.r
+	var best float64
+	try := func(dir []float64) {
+		norm := floats.Norm(dir, 2)
+		if norm == 0 {
+			return
+		}
+		for _, sign := range []float64{1, -1} {
+			alpha := sign * radius / norm
+			// Shorten the step to stay within the bounds.
+			for i, v := range dir {
+				l, u := bounds(b.Lower, b.Upper, i)
+				switch t := alpha * v; {
+				case b.xopt[i]+t > u:
+					alpha *= (u - b.xopt[i]) / t
+				case b.xopt[i]+t < l:
+					alpha *= (l - b.xopt[i]) / t
+				}
+			}
+			floats.ScaleTo(cand, alpha, dir)
+			if l := math.Abs(lk(cand)); l > best {
+				best = l
+				copy(b.d, cand)
+			}
+		}
+	}
+	for i := range b.d {
+		b.d[i] = 0
+	}
+	dir := make([]float64, n)
+	for j := 0; j < m; j++ {
+		if j == b.kopt {
+			continue
+		}
+		floats.SubTo(dir, b.pts.RawRowView(j), b.xopt)
+		try(dir)
+	}
+	try(coef[m+1:])
+}
+
+// step stores in b.d an approximate minimizer of the model within the trust
+// region of radius delta and the bounds, computed by the truncated conjugate
+// gradient method. Variables are fixed when they reach a bound, and the
+// iterations are restarted for the remaining free variables.
+func (b *BOBYQA) step(delta float64) {
+	n := b.dim
+	d := b.d
+	for i := range d {
+		d[i] = 0
+	}
+	copy(b.gd, b.g)
+	for i := range b.fixed {
+		l, u := bounds(b.Lower, b.Upper, i)
+		b.fixed[i] = (b.xopt[i] <= l && b.g[i] >= 0) || (b.xopt[i] >= u && b.g[i] <= 0)
+	}
+	pv := mat.NewVecDense(n, b.p)
+	hpv := mat.NewVecDense(n, b.hp)
+	for restart := 0; restart <= n; restart++ {
+		var rr float64
+		for i, g := range b.gd {
+			b.r[i] = 0
+			if !b.fixed[i] {
+				b.r[i] = -g
+				rr += g * g
+			}
+		}
+		if rr == 0 {
+			return
+		}
+		rr0 := rr
+		copy(b.p, b.r)
+		bound := -1
+		for iter := 0; iter < n; iter++ {
+			hpv.MulVec(b.h, pv)
+			curv := floats.Dot(b.p, b.hp)
+
+			// Find the step to the boundary of the trust region.
+			pd := floats.Dot(b.p, d)
+			pp := floats.Dot(b.p, b.p)
+			dd := floats.Dot(d, d)
+			tau := (-pd + math.Sqrt(math.Max(0, pd*pd+pp*(delta*delta-dd)))) / pp
+			boundary := true
+			if curv > 0 && rr/curv < tau {
+				tau = rr / curv
+				boundary = false
+			}
+			// Shorten the step to stay within the bounds.
+			for i, v := range b.p {
+				if b.fixed[i] || v == 0 {
+					continue
+				}
+				l, u := bounds(b.Lower, b.Upper, i)
+				lim := (u - b.xopt[i] - d[i]) / v
+				if v < 0 {
+					lim = (l - b.xopt[i] - d[i]) / v
+				}
+				if lim < tau {
+					tau = math.Max(lim, 0)
+					bound = i
+					boundary = false
+				}
+			}
+
+			floats.AddScaled(d, tau, b.p)
+			floats.AddScaled(b.gd, tau, b.hp)
+			if boundary {
+				return
+			}
+			if bound >= 0 {
+				l, u := bounds(b.Lower, b.Upper, bound)
+				if b.p[bound] > 0 {
+					d[bound] = u - b.xopt[bound]
+				} else {
+					d[bound] = l - b.xopt[bound]
+				}
+				b.fixed[bound] = true
+				break
+			}
+
+			var rrNew float64
+			for i, g := range b.gd {
+				b.r[i] = 0
+				if !b.fixed[i] {
+					b.r[i] = -g
+					rrNew += g * g
+				}
+			}
+			if rrNew <= 1e-12*rr0 {
+				return
+			}
+			beta := rrNew / rr
+			for i, v := range b.r {
+				b.p[i] = v + beta*b.p[i]
+			}
+			rr = rrNew
+		}
+		if bound < 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,281 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"time"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// ErrCheckpointMismatch signifies that a saved state cannot be restored
+// because it does not match the configuration of the receiver.
+var ErrCheckpointMismatch = errors.New("optimize: checkpoint does not match configuration")
+
+// StateSaver is a type whose state during an optimization run can be saved
+// and restored. A Converger that keeps state across major iterations should
+// implement StateSaver so that an optimization run resumed from a Checkpoint
+// converges as the original run would have.
+type StateSaver interface {
+	// SaveState returns the encoded state of the receiver.
+	SaveState() ([]byte, error)
+	// LoadState restores a state returned by SaveState. The receiver must
+	// be configured in the same way as the receiver of SaveState.
+	LoadState(state []byte) error
+}
+
+// Checkpointer is a Method that can save the state of an optimization run
+// at a major iteration and resume the run from the saved state.
+//
+// Minimize calls SaveState only while the Method waits for the result of a
+// MajorIteration, and the saved state must allow the run to continue after
+// that MajorIteration. When resuming a run, Minimize calls LoadState after
+// Init, and the following call to Run must continue the run after the saved
+// MajorIteration. The Location of the MajorIteration is passed to Run in the
+// first task with Op set to MajorIteration.
+type Checkpointer interface {
+	Method
+	StateSaver
+}
+
+// Checkpoint is the state of an optimization run at a major iteration. A run
+// can be resumed from a Checkpoint by setting the Resume field of Settings.
+// For deterministic problems and methods, the resumed run gives the same
+// result as the original run.
+type Checkpoint struct {
+	// Location is the location of the major iteration.
+	Location Location
+	// Stats are the statistics of the run at the major iteration.
+	Stats Stats
+	// Method is the saved state of the Method.
+	Method []byte
+	// Converger is the saved state of the Converger, or nil if the
+	// Converger does not implement StateSaver.
+	Converger []byte
+}
+
+// newCheckpoint stores a checkpoint of an optimization run at the major
+// iteration at loc in dst, reusing the memory of dst.Location.
+func newCheckpoint(dst *Checkpoint, loc *Location, stats *Stats, method Checkpointer, converger Converger) error {
+	copyLocation(&dst.Location, loc)
+	dst.Stats = *stats
+	var err error
+	dst.Method, err = method.SaveState()
+	if err != nil {
+		return err
+	}
+	dst.Converger = nil
+	if s, ok := converger.(StateSaver); ok {
+		dst.Converger, err = s.SaveState()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyLocation copies the fields of src into dst. The memory of the fields
+// of dst is reused if it is large enough, and nil fields of src are set to
+// nil in dst.
+func copyLocation(dst, src *Location) {
+	dst.X = copyFloats(dst.X, src.X)
+	dst.F = src.F
+	dst.Gradient = copyFloats(dst.Gradient, src.Gradient)
+	if src.Hessian == nil {
+		dst.Hessian = nil
+	} else {
+		dst.Hessian = resizeSymDense(dst.Hessian, src.Hessian.Symmetric())
+		dst.Hessian.CopySym(src.Hessian)
+	}
+	dst.HessVecDir = copyFloats(dst.HessVecDir, src.HessVecDir)
+	dst.HessVec = copyFloats(dst.HessVec, src.HessVec)
+	if src.Batch == nil {
+		dst.Batch = nil
+	} else {
+		dst.Batch = append(dst.Batch[:0], src.Batch...)
+	}
+}
+
+// copyFloats copies src into dst, resized to the length of src, and returns
+// the result. It returns nil if src is nil.
+func copyFloats(dst, src []float64) []float64 {
+	if src == nil {
+		return nil
+	}
+	dst = resize(dst, len(src))
+	copy(dst, src)
+	return dst
+}
+
+// checkpointData is the encoded form of a Checkpoint.
+type checkpointData struct {
+	X          []float64
+	F          float64
+	Gradient   []float64
+	Hessian    []float64
+	HessVecDir []float64
+	HessVec    []float64
+	Batch      []int
+
+	Stats     Stats
+	Method    []byte
+	Converger []byte
+}
+
+// MarshalBinary encodes the checkpoint so that it can be stored, for example
+// in a file, and restored with UnmarshalBinary.
+func (c *Checkpoint) MarshalBinary() ([]byte, error) {
+	loc := &c.Location
+	d := checkpointData{
+		X:          loc.X,
+		F:          loc.F,
+		Gradient:   loc.Gradient,
+		HessVecDir: loc.HessVecDir,
+		HessVec:    loc.HessVec,
+		Batch:      loc.Batch,
+		Stats:      c.Stats,
+		Method:     c.Method,
+		Converger:  c.Converger,
+	}
+	if loc.Hessian != nil {
+		n := loc.Hessian.Symmetric()
+		d.Hessian = make([]float64, n*n)
+		mat.NewSymDense(n, d.Hessian).CopySym(loc.Hessian)
+	}
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(d)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary restores a checkpoint encoded by MarshalBinary into the
+// receiver.
+func (c *Checkpoint) UnmarshalBinary(data []byte) error {
+	var d checkpointData
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&d)
+	if err != nil {
+		return err
+	}
+	n := len(d.X)
+	if (d.Gradient != nil && len(d.Gradient) != n) || (d.Hessian != nil && len(d.Hessian) != n*n) {
+		return errors.New("optimize: invalid checkpoint data")
+	}
+	*c = Checkpoint{
+		Location: Location{
+			X:          d.X,
+			F:          d.F,
+			Gradient:   d.Gradient,
+			HessVecDir: d.HessVecDir,
+			HessVec:    d.HessVec,
+			Batch:      d.Batch,
+		},
+		Stats:     d.Stats,
+		Method:    d.Method,
+		Converger: d.Converger,
+	}
+	if d.Hessian != nil {
+		c.Location.Hessian = mat.NewSymDense(n, d.Hessian)
+	}
+	return nil
+}
+
+// encodeState returns the gob encoding of the state v of a method.
+func encodeState(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeState decodes a state encoded by encodeState into v.
+func decodeState(state []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(state)).Decode(v)
+}
+
+// CheckpointRecorder is a Recorder that can save checkpoints of an
+// optimization run.
+type CheckpointRecorder interface {
+	Recorder
+
+	// RecordCheckpoint is called by Minimize after Record at every
+	// MajorIteration of a Method that implements Checkpointer. The checkpoint
+	// function returns a Checkpoint of the run at the MajorIteration and must
+	// not be called after RecordCheckpoint returns. The memory of the
+	// Checkpoint is reused by Minimize at later major iterations, so the
+	// Checkpoint must not be retained after RecordCheckpoint returns. A
+	// Checkpoint can be kept by encoding it with MarshalBinary.
+	RecordCheckpoint(checkpoint func() (*Checkpoint, error)) error
+}
+
+var _ CheckpointRecorder = (*CheckpointWriter)(nil)
+
+// CheckpointWriter is a CheckpointRecorder that periodically saves checkpoints
+// of an optimization run, for example to resume a long run after it has been
+// interrupted.
+type CheckpointWriter struct {
+	// Write is called with every saved checkpoint. The checkpoint is
+	// only valid until Write returns.
+	Write func(*Checkpoint) error
+
+	// Iterations is the number of major iterations between checkpoints, and
+	// Interval is the time between checkpoints. A checkpoint is saved when
+	// either limit is reached. A zero value disables the respective limit,
+	// and if both are zero, a checkpoint is saved at every major iteration.
+	Iterations int
+	Interval   time.Duration
+
+	// Recorder, if not nil, also records the progress of the optimization.
+	Recorder Recorder
+
+	iter int
+	last time.Time
+}
+
+func (w *CheckpointWriter) Init() error {
+	if w.Write == nil {
+		panic("optimize: nil checkpoint Write function")
+	}
+	w.iter = 0
+	w.last = time.Now()
+	if w.Recorder != nil {
+		return w.Recorder.Init()
+	}
+	return nil
+}
+
+func (w *CheckpointWriter) Record(loc *Location, op Operation, stats *Stats) error {
+	if w.Recorder != nil {
+		return w.Recorder.Record(loc, op, stats)
+	}
+	return nil
+}
+
+func (w *CheckpointWriter) RecordCheckpoint(checkpoint func() (*Checkpoint, error)) error {
+	w.iter++
+	due := w.Iterations == 0 && w.Interval == 0
+	if w.Iterations > 0 && w.iter >= w.Iterations {
+		due = true
+	}
+	if w.Interval > 0 && time.Since(w.last) >= w.Interval {
+		due = true
+	}
+	if !due {
+		return nil
+	}
+	c, err := checkpoint()
+	if err != nil {
+		return err
+	}
+	w.iter = 0
+	w.last = time.Now()
+	return w.Write(c)
+}
@@ -0,0 +1,268 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/optimize/functions"
+)
+
+// splitMix is a random source whose state can be saved.
+type splitMix struct {
+	state uint64
+}
+
+func (s *splitMix) Seed(seed uint64) { s.state = seed }
+
+func (s *splitMix) Uint64() uint64 {
+	s.state += 0x9e3779b97f4a7c15
+	z := s.state
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+func (s *splitMix) MarshalBinary() ([]byte, error) {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, s.state)
+	return b, nil
+}
+
+func (s *splitMix) UnmarshalBinary(b []byte) error {
+	if len(b) != 8 {
+		return errors.New("splitmix: bad state length")
+	}
+	s.state = binary.LittleEndian.Uint64(b)
+	return nil
+}
+
+func TestCheckpointResume(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name      string
+		problem   Problem
+		x         []float64
+		method    func() Method
+		converger func() Converger
+		settings  Settings
+	}{
+		{
+			name: "LBFGS",
+			problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+			},
+			x:      []float64{-1.2, 1, -1.2, 1, -1.2, 1},
+			method: func() Method { return &LBFGS{Store: 3} },
+		},
+		{
+			name: "BFGS",
+			problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+				Grad: functions.ExtendedRosenbrock{}.Grad,
+			},
+			x:      []float64{-1.2, 1, -1.2, 1},
+			method: func() Method { return &BFGS{Linesearcher: &MoreThuente{}} },
+		},
+		{
+			name: "CmaEsChol",
+			problem: Problem{
+				Func: functions.ExtendedRosenbrock{}.Func,
+			},
+			x:         []float64{-1.2, 1},
+			method:    func() Method { return &CmaEsChol{Src: &splitMix{state: 1}} },
+			converger: func() Converger { return NeverTerminate{} },
+			settings:  Settings{MajorIterations: 300},
+		},
+	} {
+		newSettings := func() *Settings {
+			settings := test.settings
+			if test.converger != nil {
+				settings.Converger = test.converger()
+			}
+			return &settings
+		}
+		want, err := Minimize(test.problem, test.x, newSettings(), test.method())
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+
+		var saved [][]byte
+		settings := newSettings()
+		settings.Recorder = &CheckpointWriter{
+			Iterations: 5,
+			Write: func(c *Checkpoint) error {
+				if c.Stats.MajorIterations%5 != 0 {
+					t.Errorf("%s: unexpected checkpoint at major iteration %d", test.name, c.Stats.MajorIterations)
+				}
+				b, err := c.MarshalBinary()
+				saved = append(saved, b)
+				return err
+			},
+		}
+		got, err := Minimize(test.problem, test.x, settings, test.method())
+		if err != nil {
+			t.Errorf("%s: unexpected error with checkpoints: %v", test.name, err)
+			continue
+		}
+		checkSameResult(t, test.name+" with checkpoints", got, want)
+		if len(saved) < 3 {
+			t.Errorf("%s: too few checkpoints: %d", test.name, len(saved))
+			continue
+		}
+
+		for _, i := range []int{0, len(saved) / 2, len(saved) - 1} {
+			var c Checkpoint
+			err := c.UnmarshalBinary(saved[i])
+			if err != nil {
+				t.Errorf("%s: unexpected error decoding checkpoint %d: %v", test.name, i, err)
+				continue
+			}
+			settings := newSettings()
+			settings.Resume = &c
+			got, err := Minimize(test.problem, make([]float64, len(test.x)), settings, test.method())
+			if err != nil {
+				t.Errorf("%s: unexpected error resuming from checkpoint %d: %v", test.name, i, err)
+				continue
+			}
+			checkSameResult(t, test.name+" resumed", got, want)
+		}
+	}
+}
+
+func checkSameResult(t *testing.T, name string, got, want *Result) {
+	t.Helper()
+	if got.Status != want.Status {
+		t.Errorf("%s: status mismatch: got %v, want %v", name, got.Status, want.Status)
+	}
+	if got.F != want.F || !floats.Equal(got.X, want.X) {
+		t.Errorf("%s: location mismatch: got %v at %v, want %v at %v", name, got.F, got.X, want.F, want.X)
+	}
+	gotStats, wantStats := got.Stats, want.Stats
+	gotStats.Runtime, wantStats.Runtime = 0, 0
+	if gotStats != wantStats {
+		t.Errorf("%s: stats mismatch: got %+v, want %+v", name, gotStats, wantStats)
+	}
+}
+
+func TestCheckpointInitialIteration(t *testing.T) {
+	t.Parallel()
+	// A checkpoint at the initial location is saved before the local method
+	// is initialized.
+	problem := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	x := []float64{-1.2, 1}
+	want, err := Minimize(problem, x, nil, &LBFGS{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var first *Checkpoint
+	settings := &Settings{
+		Recorder: &CheckpointWriter{
+			Write: func(c *Checkpoint) error {
+				if first == nil {
+					first = cloneCheckpoint(t, c)
+				}
+				return nil
+			},
+		},
+	}
+	_, err = Minimize(problem, x, settings, &LBFGS{})
+	if err != nil {
+		t.Fatalf("unexpected error with checkpoints: %v", err)
+	}
+	if first.Stats.MajorIterations != 1 || !floats.Equal(first.Location.X, x) {
+		t.Fatalf("unexpected first checkpoint at %v after %d major iterations", first.Location.X, first.Stats.MajorIterations)
+	}
+	got, err := Minimize(problem, x, &Settings{Resume: first}, &LBFGS{})
+	if err != nil {
+		t.Fatalf("unexpected error resuming: %v", err)
+	}
+	checkSameResult(t, "initial checkpoint", got, want)
+}
+
+func TestCheckpointMismatch(t *testing.T) {
+	t.Parallel()
+	problem := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	var last *Checkpoint
+	settings := &Settings{
+		MajorIterations: 10,
+		Recorder: &CheckpointWriter{
+			Write: func(c *Checkpoint) error {
+				last = cloneCheckpoint(t, c)
+				return nil
+			},
+		},
+	}
+	_, err := Minimize(problem, []float64{-1.2, 1}, settings, &LBFGS{Store: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = Minimize(problem, []float64{-1.2, 1}, &Settings{Resume: last}, &LBFGS{Store: 6})
+	if err != ErrCheckpointMismatch {
+		t.Errorf("unexpected error for mismatched Store: got %v, want %v", err, ErrCheckpointMismatch)
+	}
+}
+
+func TestCheckpointReuse(t *testing.T) {
+	t.Parallel()
+	// The memory of the checkpoint is reused between major iterations.
+	problem := Problem{
+		Func: functions.ExtendedRosenbrock{}.Func,
+		Grad: functions.ExtendedRosenbrock{}.Grad,
+	}
+	var (
+		first *Checkpoint
+		x     *float64
+		n     int
+	)
+	settings := &Settings{
+		MajorIterations: 10,
+		Recorder: &CheckpointWriter{
+			Write: func(c *Checkpoint) error {
+				if first == nil {
+					first, x = c, &c.Location.X[0]
+				}
+				if c != first || &c.Location.X[0] != x {
+					t.Errorf("checkpoint not reused at major iteration %d", c.Stats.MajorIterations)
+				}
+				n++
+				return nil
+			},
+		},
+	}
+	_, err := Minimize(problem, []float64{-1.2, 1}, settings, &LBFGS{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if n < 2 {
+		t.Errorf("too few checkpoints: %d", n)
+	}
+}
+
+// cloneCheckpoint returns a copy of c that does not share memory with c.
+func cloneCheckpoint(t *testing.T, c *Checkpoint) *Checkpoint {
+	t.Helper()
+	b, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error encoding checkpoint: %v", err)
+	}
+	var clone Checkpoint
+	err = clone.UnmarshalBinary(b)
+	if err != nil {
+		t.Fatalf("unexpected error decoding checkpoint: %v", err)
+	}
+	return &clone
+}
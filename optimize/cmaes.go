@@ -5,6 +5,7 @@
 package optimize
 
 import (
+	"encoding"
 	"math"
 	"sort"
 
@@ -111,11 +112,14 @@ type CmaEsChol struct {
 	receivedIdx int
 	operation   chan<- Task
 	updateErr   error
+
+	resume bool // Indicates that the run is resumed from a saved state.
 }
 
 var (
-	_ Statuser = (*CmaEsChol)(nil)
-	_ Method   = (*CmaEsChol)(nil)
+	_ Statuser     = (*CmaEsChol)(nil)
+	_ Method       = (*CmaEsChol)(nil)
+	_ Checkpointer = (*CmaEsChol)(nil)
 )
 
 func (cma *CmaEsChol) methodConverged() Status {
@@ -231,6 +235,7 @@ func (cma *CmaEsChol) Init(dim, tasks int) int {
 	cma.receivedIdx = 0
 	cma.operation = nil
 	cma.updateErr = nil
+	cma.resume = false
 	t := min(tasks, cma.pop)
 	return t
 }
@@ -296,7 +301,9 @@ func (cma *CmaEsChol) findBestAndUpdateTask(task Task) Task {
 }
 
 func (cma *CmaEsChol) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
-	copy(cma.mean, tasks[0].X)
+	if !cma.resume {
+		copy(cma.mean, tasks[0].X)
+	}
 	cma.operation = operations
 	// Send the initial tasks. We know there are at most as many tasks as elements
 	// of the population.
@@ -386,6 +393,75 @@ Loop:
 	close(operations)
 }
 
+// cmaEsCholState is the saved state of a CmaEsChol optimization run.
+type cmaEsCholState struct {
+	Mean     []float64
+	PC, PS   []float64
+	InvSigma float64
+	CholU    []float64
+	BestX    []float64
+	BestF    float64
+	Src      []byte
+}
+
+// SaveState returns the state of the optimization run, which consists of the
+// parameters of the sampling distribution and the best location found. The
+// state of Src is saved only if it implements encoding.BinaryMarshaler, so
+// that a resumed run generates the same samples as the original run only if
+// Src implements both encoding.BinaryMarshaler and
+// encoding.BinaryUnmarshaler.
+func (cma *CmaEsChol) SaveState() ([]byte, error) {
+	var u mat.TriDense
+	cma.chol.UTo(&u)
+	st := cmaEsCholState{
+		Mean:     cma.mean,
+		PC:       cma.pc,
+		PS:       cma.ps,
+		InvSigma: cma.invSigma,
+		CholU:    u.RawTriangular().Data,
+		BestX:    cma.bestX,
+		BestF:    cma.bestF,
+	}
+	if m, ok := cma.Src.(encoding.BinaryMarshaler); ok {
+		var err error
+		st.Src, err = m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return encodeState(st)
+}
+
+// LoadState restores the state of an optimization run saved by SaveState.
+func (cma *CmaEsChol) LoadState(state []byte) error {
+	var st cmaEsCholState
+	err := decodeState(state, &st)
+	if err != nil {
+		return err
+	}
+	dim := cma.dim
+	if len(st.Mean) != dim || len(st.PC) != dim || len(st.PS) != dim || len(st.BestX) != dim || len(st.CholU) != dim*dim {
+		return ErrCheckpointMismatch
+	}
+	if st.Src != nil {
+		if u, ok := cma.Src.(encoding.BinaryUnmarshaler); ok {
+			err = u.UnmarshalBinary(st.Src)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	copy(cma.mean, st.Mean)
+	copy(cma.pc, st.PC)
+	copy(cma.ps, st.PS)
+	cma.invSigma = st.InvSigma
+	cma.chol.SetFromU(mat.NewTriDense(dim, mat.Upper, st.CholU))
+	copy(cma.bestX, st.BestX)
+	cma.bestF = st.BestF
+	cma.resume = true
+	return nil
+}
+
 // update computes the new parameters (mean, cholesky, etc.). Does not update
 // any of the synchronization parameters (taskIdx).
 func (cma *CmaEsChol) update() error {
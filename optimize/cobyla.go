@@ -0,0 +1,585 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+)
+
+var (
+	_ Statuser = (*COBYLA)(nil)
+	_ Method   = (*COBYLA)(nil)
+)
+
+// COBYLA implements Powell's derivative-free trust-region method for
+// nonlinearly constrained minimization. The objective function and the
+// constraints are approximated by linear models that interpolate them at the
+// vertices of a simplex. The step from the best vertex minimizes the linear
+// model of the objective function subject to the linear models of the
+// constraints within a trust region, or minimizes the greatest violation of
+// the linearized constraints if they cannot be satisfied. Vertices are
+// compared by the merit function
+//  f(x) + μ max(0, -c_i(x))
+// where the penalty parameter μ is increased as needed. The trust-region
+// radius ρ is decreased from InitRadius to StopRadius, and the method
+// converges with MethodConverge when ρ cannot be decreased further.
+//
+// COBYLA evaluates the objective function at only one location at a time and
+// is intended for problems where evaluations are expensive. The location
+// reported at a MajorIteration is the best vertex according to the merit
+// function, which may violate the constraints slightly.
+//
+// The method is described in
+//  Powell, M. J. D.: A direct search optimization method that models the
+//  objective and constraint functions by linear interpolation. In: Advances
+//  in Optimization and Numerical Analysis, pp. 51–67. Springer (1994)
+// The implementation uses a trust region in the maximum norm, so that the
+// trust-region subproblems are linear programs that are solved by
+// lp.Simplex.
+type COBYLA struct {
+	// Constraints are the constraint functions. A location x is feasible
+	// if c(x) >= 0 for all the constraint functions c. The constraint
+	// functions are evaluated serially at the locations where the objective
+	// function is evaluated, and they must not modify x.
+	Constraints []func(x []float64) float64
+	// InitRadius is the initial trust-region radius, which should be about
+	// one tenth of the greatest expected change of a variable. If
+	// InitRadius is 0, it is defaulted to 0.5. InitRadius must not be
+	// negative.
+	InitRadius float64
+	// StopRadius is the final value of the trust-region radius, which
+	// determines the accuracy of the solution. If StopRadius is 0, it is
+	// defaulted to 1e-6 times the initial radius. StopRadius must not be
+	// negative.
+	StopRadius float64
+
+	status Status
+	err    error
+
+	dim int
+	rho float64
+	mu  float64
+
+	pts  *mat.Dense // simplex vertices by row
+	fs   []float64  // function values at the vertices
+	cs   *mat.Dense // constraint values at the vertices by row
+	viol []float64  // greatest constraint violations at the vertices
+	kopt int        // index of the best vertex
+
+	// Linear models around the best vertex. The rows of the matrix dirs
+	// are the differences between the other vertices and the best vertex,
+	// in the order given by others.
+	dirs   *mat.Dense
+	others []int
+	inv    *mat.Dense
+	g      []float64  // gradient of the objective function model
+	gc     *mat.Dense // gradients of the constraint models by row
+
+	xnew, cnew, d, lam []float64
+}
+
+func (*COBYLA) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+// Status returns the status of the method.
+func (c *COBYLA) Status() (Status, error) {
+	return c.status, c.err
+}
+
+func (c *COBYLA) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	c.dim = dim
+	c.rho = c.InitRadius
+	switch {
+	case c.rho == 0:
+		c.rho = 0.5
+	case c.rho < 0:
+		panic("cobyla: negative initial radius")
+	}
+	if c.StopRadius < 0 {
+		panic("cobyla: negative stop radius")
+	}
+
+	m := len(c.Constraints)
+	c.pts = mat.NewDense(dim+1, dim, nil)
+	c.fs = resize(c.fs, dim+1)
+	c.viol = resize(c.viol, dim+1)
+	c.cs = nil
+	c.gc = nil
+	if m > 0 {
+		c.cs = mat.NewDense(dim+1, m, nil)
+		c.gc = mat.NewDense(m, dim, nil)
+	}
+	c.dirs = mat.NewDense(dim, dim, nil)
+	c.inv = mat.NewDense(dim, dim, nil)
+	if cap(c.others) < dim {
+		c.others = make([]int, dim)
+	}
+	c.others = c.others[:dim]
+	c.g = resize(c.g, dim)
+	c.xnew = resize(c.xnew, dim)
+	c.cnew = resize(c.cnew, m)
+	c.d = resize(c.d, dim)
+	c.lam = resize(c.lam, dim)
+	c.mu = 0
+
+	c.status = NotTerminated
+	c.err = nil
+	return 1
+}
+
+func (c *COBYLA) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	r := newSerialRunner(operations, results, tasks[0])
+	c.status, c.err = c.run(r)
+	r.finish()
+}
+
+// Parameters of the acceptability of the simplex and of the geometry steps,
+// relative to the trust-region radius, as in Powell's implementation.
+const (
+	cobylaAlpha = 0.25 // least distance of a vertex from the opposite face
+	cobylaBeta  = 2.1  // greatest distance of a vertex from the best vertex
+	cobylaGamma = 0.5  // length of a geometry step
+	cobylaDelta = 1.1  // distance of vertices that are preferably replaced
+)
+
+func (c *COBYLA) run(r *serialRunner) (Status, error) {
+	n := c.dim
+	rhoEnd := c.StopRadius
+	if rhoEnd == 0 {
+		rhoEnd = 1e-6 * c.rho
+	}
+	rhoEnd = math.Min(rhoEnd, c.rho)
+	rho := c.rho
+
+	// Evaluate the functions at the initial simplex.
+	for k := 0; k <= n; k++ {
+		c.pts.SetRow(k, r.task.X)
+		if k > 0 {
+			c.pts.Set(k, k-1, c.pts.At(k, k-1)+rho)
+		}
+		var f float64
+		var ok bool
+		if k == 0 {
+			f, ok = r.initial()
+		} else {
+			f, ok = r.eval(c.pts.RawRowView(k))
+		}
+		if !ok {
+			return NotTerminated, nil
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			if k == 0 {
+				return Failure, ErrFunc(f)
+			}
+			return Failure, ErrNonFiniteFunc
+		}
+		viol, err := c.constrain(c.cnew, c.pts.RawRowView(k))
+		if err != nil {
+			return Failure, err
+		}
+		c.fs[k] = f
+		c.viol[k] = viol
+		if c.cs != nil {
+			c.cs.SetRow(k, c.cnew)
+		}
+	}
+	c.kopt = -1
+
+	reduce := false
+	for {
+		if changed := c.selectBest(); changed {
+			if !r.major(c.pts.RawRowView(c.kopt), c.fs[c.kopt]) {
+				return NotTerminated, nil
+			}
+		}
+		if !c.updateModels() {
+			return Failure, ErrDegenerateModel
+		}
+		xopt := c.pts.RawRowView(c.kopt)
+
+		// Improve the geometry of the simplex if it is not acceptable.
+		if j := c.unacceptable(rho); j >= 0 {
+			w := c.lam
+			for i := range w {
+				w[i] = c.inv.At(i, j)
+			}
+			floats.Scale(cobylaGamma*rho/floats.Norm(w, 2), w)
+			if c.meritChange(w) > 0 {
+				floats.Scale(-1, w)
+			}
+			floats.AddTo(c.xnew, xopt, w)
+			ok, err := c.evaluate(r, c.xnew, c.others[j])
+			if err != nil {
+				return Failure, err
+			}
+			if !ok {
+				return NotTerminated, nil
+			}
+			continue
+		}
+
+		if reduce {
+			// Decrease the trust-region radius.
+			if rho <= rhoEnd {
+				return MethodConverge, nil
+			}
+			rho /= 2
+			if rho <= 1.5*rhoEnd {
+				rho = rhoEnd
+			}
+			reduce = false
+			continue
+		}
+
+		c.step(rho)
+		if floats.Norm(c.d, 2) < rho/2 {
+			reduce = true
+			continue
+		}
+
+		// Increase the penalty parameter if the step reduces the
+		// predicted constraint violation.
+		v0 := c.viol[c.kopt]
+		v1 := c.predictedViolation(c.d)
+		df := floats.Dot(c.g, c.d)
+		if v1 < v0 {
+			barmu := df / (v0 - v1)
+			if c.mu < 1.5*barmu {
+				c.mu = 2 * barmu
+				if c.selectBest() {
+					if !r.major(c.pts.RawRowView(c.kopt), c.fs[c.kopt]) {
+						return NotTerminated, nil
+					}
+					continue
+				}
+			}
+		}
+		pred := c.mu*(v0-v1) - df
+
+		// Evaluate the functions at the trial location.
+		phiOpt := c.fs[c.kopt] + c.mu*v0
+		fOpt := c.fs[c.kopt]
+		floats.AddTo(c.xnew, xopt, c.d)
+		f, ok := r.eval(c.xnew)
+		if !ok {
+			return NotTerminated, nil
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return Failure, ErrNonFiniteFunc
+		}
+		viol, err := c.constrain(c.cnew, c.xnew)
+		if err != nil {
+			return Failure, err
+		}
+		actual := phiOpt - (f + c.mu*viol)
+		if c.mu == 0 && f == fOpt {
+			actual = v0 - viol
+		}
+
+		// Replace a vertex of the simplex by the trial location.
+		if j := c.replacement(c.xnew, actual > 0, rho); j >= 0 {
+			k := c.others[j]
+			c.pts.SetRow(k, c.xnew)
+			c.fs[k] = f
+			c.viol[k] = viol
+			if c.cs != nil {
+				c.cs.SetRow(k, c.cnew)
+			}
+		}
+		if pred <= 0 || actual <= 0.1*pred {
+			reduce = true
+		}
+	}
+}
+
+// constrain evaluates the constraints at x into dst and returns the greatest
+// constraint violation.
+func (c *COBYLA) constrain(dst, x []float64) (float64, error) {
+	var viol float64
+	for i, fn := range c.Constraints {
+		v := fn(x)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, ErrNonFiniteFunc
+		}
+		dst[i] = v
+		viol = math.Max(viol, -v)
+	}
+	return viol, nil
+}
+
+// evaluate evaluates the functions at x and replaces the vertex with index k
+// by x. ok is false if the optimization has been stopped by the caller.
+func (c *COBYLA) evaluate(r *serialRunner, x []float64, k int) (ok bool, err error) {
+	f, ok := r.eval(x)
+	if !ok {
+		return false, nil
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return true, ErrNonFiniteFunc
+	}
+	viol, err := c.constrain(c.cnew, x)
+	if err != nil {
+		return true, err
+	}
+	c.pts.SetRow(k, x)
+	c.fs[k] = f
+	c.viol[k] = viol
+	if c.cs != nil {
+		c.cs.SetRow(k, c.cnew)
+	}
+	return true, nil
+}
+
+// selectBest sets the best vertex to the vertex with the least merit function
+// value, preferring smaller constraint violations on ties, and reports
+// whether the best vertex has changed.
+func (c *COBYLA) selectBest() (changed bool) {
+	best := c.kopt
+	if best < 0 {
+		best = 0
+	}
+	phiBest := c.fs[best] + c.mu*c.viol[best]
+	for k := range c.fs {
+		phi := c.fs[k] + c.mu*c.viol[k]
+		if phi < phiBest || (phi == phiBest && c.viol[k] < c.viol[best]) {
+			best, phiBest = k, phi
+		}
+	}
+	changed = best != c.kopt
+	c.kopt = best
+	return changed
+}
+
+// updateModels computes the linear models of the objective and constraint
+// functions around the best vertex. It returns false if the simplex is
+// degenerate.
+func (c *COBYLA) updateModels() bool {
+	n := c.dim
+	xopt := c.pts.RawRowView(c.kopt)
+	var j int
+	for k := 0; k <= n; k++ {
+		if k == c.kopt {
+			continue
+		}
+		c.others[j] = k
+		row := c.dirs.RawRowView(j)
+		floats.SubTo(row, c.pts.RawRowView(k), xopt)
+		j++
+	}
+	if err := c.inv.Inverse(c.dirs); err != nil {
+		if cond, ok := err.(mat.Condition); !ok || math.IsInf(float64(cond), 1) {
+			return false
+		}
+	}
+
+	// The gradient g of a linear model of h satisfies
+	//  (x_k - xopt)ᵀ g = h_k - hopt
+	// for all vertices k.
+	diff := c.lam
+	for j, k := range c.others {
+		diff[j] = c.fs[k] - c.fs[c.kopt]
+	}
+	gv := mat.NewVecDense(n, c.g)
+	gv.MulVec(c.inv, mat.NewVecDense(n, diff))
+	for i := range c.Constraints {
+		for j, k := range c.others {
+			diff[j] = c.cs.At(k, i) - c.cs.At(c.kopt, i)
+		}
+		gv := mat.NewVecDense(n, c.gc.RawRowView(i))
+		gv.MulVec(c.inv, mat.NewVecDense(n, diff))
+	}
+	return true
+}
+
+// unacceptable returns the index into others of a vertex that should be moved
+// to improve the geometry of the simplex, or -1 if the simplex is acceptable.
+// A vertex should be moved if it is far from the best vertex or close to the
+// face of the simplex that is opposite to it.
+func (c *COBYLA) unacceptable(rho float64) int {
+	xopt := c.pts.RawRowView(c.kopt)
+	jfar, far := -1, cobylaBeta*rho
+	for j, k := range c.others {
+		dist := floats.Distance(c.pts.RawRowView(k), xopt, 2)
+		if dist > far {
+			jfar, far = j, dist
+		}
+	}
+	if jfar >= 0 {
+		return jfar
+	}
+	jflat, flat := -1, cobylaAlpha*rho
+	for j := range c.others {
+		// The distance of the vertex from the opposite face is the
+		// reciprocal of the norm of the gradient of its barycentric
+		// coordinate, which is a column of the inverse of dirs.
+		sigma := 1 / mat.Norm(c.inv.ColView(j), 2)
+		if sigma < flat {
+			jflat, flat = j, sigma
+		}
+	}
+	return jflat
+}
+
+// predictedViolation returns the greatest violation of the linear models of
+// the constraints at the step d from the best vertex.
+func (c *COBYLA) predictedViolation(d []float64) float64 {
+	var viol float64
+	for i := range c.Constraints {
+		v := c.cs.At(c.kopt, i) + floats.Dot(c.gc.RawRowView(i), d)
+		viol = math.Max(viol, -v)
+	}
+	return viol
+}
+
+// meritChange returns the change of the linear model of the merit function
+// for the step d from the best vertex.
+func (c *COBYLA) meritChange(d []float64) float64 {
+	return floats.Dot(c.g, d) + c.mu*(c.predictedViolation(d)-c.viol[c.kopt])
+}
+
+// replacement returns the index into others of the vertex that is replaced by
+// the trial location x, or -1 if no vertex should be replaced. better
+// indicates whether x reduces the merit function.
+func (c *COBYLA) replacement(x []float64, better bool, rho float64) int {
+	n := c.dim
+	xopt := c.pts.RawRowView(c.kopt)
+
+	// Compute the barycentric coordinates of x with respect to the
+	// vertices other than the best vertex.
+	floats.SubTo(c.d, x, xopt)
+	lam := mat.NewVecDense(n, c.lam)
+	lam.MulVec(c.inv.T(), mat.NewVecDense(n, c.d))
+
+	// Prefer the vertex whose replacement increases the volume of the
+	// simplex most. If x does not reduce the merit function, the volume
+	// must increase.
+	jdrop := -1
+	largest := 0.0
+	if !better {
+		largest = 1
+	}
+	for j := range c.others {
+		if v := math.Abs(c.lam[j]); v > largest {
+			jdrop, largest = j, v
+		}
+	}
+
+	// Replace a vertex that is far from the best location instead if the
+	// geometry of the simplex remains acceptable.
+	far := cobylaDelta * rho
+	best := xopt
+	if better {
+		best = x
+	}
+	for j, k := range c.others {
+		v := math.Abs(c.lam[j])
+		sigma := 1 / mat.Norm(c.inv.ColView(j), 2)
+		if v*sigma < cobylaAlpha*rho && v < 1 {
+			continue
+		}
+		if dist := floats.Distance(c.pts.RawRowView(k), best, 2); dist > far {
+			jdrop, far = j, dist
+		}
+	}
+	return jdrop
+}
+
+// step computes the trust-region step from the best vertex into d. The step
+// minimizes the linear model of the objective function subject to the linear
+// models of the constraints within the box of half-width rho. If the linear
+// constraints cannot be satisfied within the box, their greatest violation is
+// minimized first, and the step then minimizes the linear model of the
+// objective function without increasing the greatest violation.
+func (c *COBYLA) step(rho float64) {
+	n := c.dim
+	m := len(c.Constraints)
+	for i := range c.d {
+		c.d[i] = 0
+	}
+
+	// The steps are computed in the scaled variables u = d/rho, and the
+	// box constraints are -1 <= u_i <= 1.
+	var tol float64
+	if c.viol[c.kopt] > 0 {
+		// Minimize the greatest violation t of the linearized constraints
+		//  c_i + rho gc_iᵀ u >= -t
+		// with the variables [u, t].
+		obj := make([]float64, n+1)
+		obj[n] = 1
+		g := mat.NewDense(m+2*n+1, n+1, nil)
+		h := make([]float64, m+2*n+1)
+		for i := 0; i < m; i++ {
+			for j, v := range c.gc.RawRowView(i) {
+				g.Set(i, j, -rho*v)
+			}
+			g.Set(i, n, -1)
+			h[i] = c.cs.At(c.kopt, i)
+		}
+		boxConstraints(g.Slice(m, m+2*n, 0, n).(*mat.Dense), h[m:m+2*n])
+		g.Set(m+2*n, n, -1)
+		cNew, aNew, bNew := lp.Convert(obj, g, h, nil, nil)
+		t, x, err := lp.Simplex(cNew, aNew, bNew, 1e-12, nil)
+		if err != nil {
+			return
+		}
+		for j := range c.d {
+			c.d[j] = rho * (x[j] - x[n+1+j])
+		}
+		if t > 0 {
+			tol = t + 1e-10*math.Max(t, c.viol[c.kopt])
+		}
+	}
+
+	// Minimize the linear model of the objective function subject to the
+	// linearized constraints, which are relaxed by the least greatest
+	// violation tol. The objective is normalized so that the reduced costs
+	// are not negligible compared with the tolerance of the simplex method.
+	gnorm := floats.Norm(c.g, 2)
+	if gnorm == 0 {
+		return
+	}
+	obj := make([]float64, n)
+	floats.ScaleTo(obj, 1/gnorm, c.g)
+	g := mat.NewDense(m+2*n, n, nil)
+	h := make([]float64, m+2*n)
+	for i := 0; i < m; i++ {
+		for j, v := range c.gc.RawRowView(i) {
+			g.Set(i, j, -rho*v)
+		}
+		h[i] = c.cs.At(c.kopt, i) + tol
+	}
+	boxConstraints(g.Slice(m, m+2*n, 0, n).(*mat.Dense), h[m:])
+	cNew, aNew, bNew := lp.Convert(obj, g, h, nil, nil)
+	_, x, err := lp.Simplex(cNew, aNew, bNew, 1e-12, nil)
+	if err != nil {
+		return
+	}
+	for j := range c.d {
+		c.d[j] = rho * (x[j] - x[n+j])
+	}
+}
+
+// boxConstraints sets the rows of g and the elements of h to the constraints
+// -1 <= u_i <= 1 in the form g u <= h.
+func boxConstraints(g *mat.Dense, h []float64) {
+	n := len(h) / 2
+	for i := 0; i < n; i++ {
+		g.Set(2*i, i, 1)
+		g.Set(2*i+1, i, -1)
+		h[2*i] = 1
+		h[2*i+1] = 1
+	}
+}
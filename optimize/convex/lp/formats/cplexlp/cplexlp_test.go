@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cplexlp
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp/formats/mps"
+)
+
+var readTests = []struct {
+	path string
+	// mps is the path of the equivalent problem in the mps testdata.
+	mps       string
+	mpsFormat mps.Format
+}{
+	{
+		path:      "testprob.lp",
+		mps:       "testprob.mps",
+		mpsFormat: mps.Fixed,
+	},
+	{
+		path:      "mixed.lp",
+		mps:       "mixed.mps",
+		mpsFormat: mps.Free,
+	},
+	{
+		path:      "afiro.lp",
+		mps:       "afiro.mps",
+		mpsFormat: mps.Fixed,
+	},
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+	for _, test := range readTests {
+		got, err := readFile(filepath.Join("testdata", test.path), func(f *os.File) (*lp.Model, error) {
+			return Read(f)
+		})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.path, err)
+			continue
+		}
+		want, err := readFile(filepath.Join("..", "mps", "testdata", test.mps), func(f *os.File) (*lp.Model, error) {
+			return mps.Read(f, test.mpsFormat)
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error reading MPS file: %v", test.mps, err)
+		}
+		// Variables are ordered by their first appearance in an LP file,
+		// which need not be the order of the columns of the MPS file.
+		want = reorderVars(want, got.VarNames)
+		if !equalModels(got, want) {
+			t.Errorf("%s: model does not match MPS model:\ngot: %+v\nwant:%+v", test.path, got, want)
+		}
+	}
+}
+
+// reorderVars returns a copy of m with the variables in the order given by
+// names. If names is not a permutation of the variable names of m, m is
+// returned unchanged.
+func reorderVars(m *lp.Model, names []string) *lp.Model {
+	if len(names) != len(m.VarNames) {
+		return m
+	}
+	idx := make(map[string]int, len(m.VarNames))
+	for j, name := range m.VarNames {
+		idx[name] = j
+	}
+	perm := make([]int, len(names))
+	for j, name := range names {
+		k, ok := idx[name]
+		if !ok {
+			return m
+		}
+		perm[j] = k
+	}
+
+	r := *m
+	r.VarNames = make([]string, len(names))
+	copy(r.VarNames, names)
+	r.C = make([]float64, len(perm))
+	r.Lower = make([]float64, len(perm))
+	r.Upper = make([]float64, len(perm))
+	if m.Kind != nil {
+		r.Kind = make([]lp.VarKind, len(perm))
+	}
+	for j, k := range perm {
+		r.C[j] = m.C[k]
+		r.Lower[j] = m.Lower[k]
+		r.Upper[j] = m.Upper[k]
+		if m.Kind != nil {
+			r.Kind[j] = m.Kind[k]
+		}
+	}
+	if m.A != nil {
+		rows, _ := m.A.Dims()
+		r.A = mat.NewDense(rows, len(perm), nil)
+		for j, k := range perm {
+			r.A.SetCol(j, mat.Col(nil, k, m.A))
+		}
+	}
+	return &r
+}
+
+func readFile(path string, read func(*os.File) (*lp.Model, error)) (*lp.Model, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return read(f)
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	models := []*lp.Model{
+		{
+			Name:     "bounds",
+			ObjName:  "cost",
+			C:        []float64{1, -2, 3, 1e-20, 5, 6, 7},
+			Offset:   -1.5,
+			VarNames: []string{"x", "minus", "fixed", "lower", "upper", "b1", "y.z"},
+			Lower:    []float64{-inf, -inf, 2, 0.5, 0, 0, -3},
+			Upper:    []float64{inf, 3, 2, inf, 4, 1, -1},
+			Kind:     []lp.VarKind{lp.Continuous, lp.Continuous, lp.Integer, lp.Continuous, lp.Integer, lp.Binary, lp.Integer},
+			RowNames: []string{"r1", "r2", "r3"},
+			A: mat.NewDense(3, 7, []float64{
+				1, 1, 1, 1, 1, 1, 1,
+				0, 0, 0, 0, 0, 0, 0,
+				-1e6, 0, 0, 2.5e-7, 0, 0, 0,
+			}),
+			RowLower: []float64{-1, 0, -inf},
+			RowUpper: []float64{1, 0, 3},
+		},
+		{
+			Maximize: true,
+			ObjName:  "obj",
+			C:        []float64{0, 0},
+			VarNames: []string{"a", "b"},
+			Lower:    []float64{0, 0},
+			Upper:    []float64{inf, inf},
+			RowNames: []string{"R1"},
+			A:        mat.NewDense(1, 2, []float64{1, 1}),
+			RowLower: []float64{2},
+			RowUpper: []float64{inf},
+		},
+	}
+	for _, test := range readTests {
+		m, err := readFile(filepath.Join("testdata", test.path), func(f *os.File) (*lp.Model, error) {
+			return Read(f)
+		})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.path, err)
+		}
+		models = append(models, m)
+	}
+
+	for i, want := range models {
+		var buf bytes.Buffer
+		err := Write(&buf, want)
+		if err != nil {
+			t.Errorf("model %d: unexpected error writing: %v", i, err)
+			continue
+		}
+		got, err := Read(&buf)
+		if err != nil {
+			t.Errorf("model %d: unexpected error reading: %v\n%s", i, err, buf.Bytes())
+			continue
+		}
+		if !equalModels(got, want) {
+			t.Errorf("model %d: round trip does not match:\ngot: %+v\nwant:%+v", i, got, want)
+		}
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []string{
+		"x + y\n",
+		"Minimize\n obj: x + [ x ^ 2 ]\nEnd\n",
+		"Minimize\n obj: x\nSubject To\n c1: x + 1 <= 2\nEnd\n",
+		"Minimize\n obj: x\nSubject To\n c1: x y <= 2\nEnd\n",
+		"Minimize\n obj: x\nSubject To\n c1: x + y\nEnd\n",
+		"Minimize\n obj: x\nSubject To\n c1: x + y <= z\nEnd\n",
+		"Minimize\n obj: x\nBounds\n 0 <= x >= 1\nEnd\n",
+		"Minimize\n obj: x\nSemi-continuous\n x\nEnd\n",
+		"Minimize\n obj: x\nGeneral\n 1\nEnd\n",
+	} {
+		_, err := Read(strings.NewReader(test))
+		if err == nil {
+			t.Errorf("expected error reading:\n%s", test)
+		}
+	}
+}
+
+// equalModels returns whether the models a and b are equal.
+func equalModels(a, b *lp.Model) bool {
+	if a.Name != b.Name || a.Maximize != b.Maximize || a.Offset != b.Offset || a.ObjName != b.ObjName {
+		return false
+	}
+	if !reflect.DeepEqual(a.VarNames, b.VarNames) || !reflect.DeepEqual(a.RowNames, b.RowNames) {
+		return false
+	}
+	if !reflect.DeepEqual(a.Kind, b.Kind) {
+		return false
+	}
+	if !floats.Same(a.C, b.C) || !floats.Same(a.Lower, b.Lower) || !floats.Same(a.Upper, b.Upper) {
+		return false
+	}
+	if !floats.Same(a.RowLower, b.RowLower) || !floats.Same(a.RowUpper, b.RowUpper) {
+		return false
+	}
+	if (a.A == nil) != (b.A == nil) {
+		return false
+	}
+	return a.A == nil || mat.Equal(a.A, b.A)
+}
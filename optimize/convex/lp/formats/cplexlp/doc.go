@@ -0,0 +1,10 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package cplexlp implements reading and writing of linear programs in the
+// CPLEX LP format.
+//
+// A description of the CPLEX LP format can be found at
+//  http://lpsolve.sourceforge.net/5.5/CPLEX-format.htm
+package cplexlp // import "github.com/jingcheng-WU/gonum/optimize/convex/lp/formats/cplexlp"
@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cplexlp
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	name tokenKind = iota
+	number
+	signTok
+	op
+	colon
+	bracket
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+// nameChars holds the characters other than letters and digits that may
+// appear in names.
+const nameChars = "!\"#$%&()/,.;?@_`'{}|~"
+
+// tokenize splits a line of an LP file into tokens. Comparison operators
+// are normalized to "<=", ">=" and "=".
+func tokenize(s string) ([]token, error) {
+	var toks []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == ':':
+			toks = append(toks, token{kind: colon, text: ":"})
+			i++
+		case c == '+' || c == '-':
+			toks = append(toks, token{kind: signTok, text: string(c)})
+			i++
+		case c == '[' || c == ']' || c == '^' || c == '*':
+			toks = append(toks, token{kind: bracket, text: string(c)})
+			i++
+		case c == '<' || c == '>' || c == '=':
+			j := i + 1
+			if j < len(s) && (s[j] == '<' || s[j] == '>' || s[j] == '=') {
+				j++
+			}
+			var o string
+			switch s[i:j] {
+			case "<", "<=", "=<":
+				o = "<="
+			case ">", ">=", "=>":
+				o = ">="
+			case "=", "==":
+				o = "="
+			default:
+				return nil, fmt.Errorf("invalid operator %q", s[i:j])
+			}
+			toks = append(toks, token{kind: op, text: o})
+			i = j
+		case isDigit(c) || (c == '.' && i+1 < len(s) && isDigit(s[i+1])):
+			j := i
+			for j < len(s) && (isDigit(s[j]) || s[j] == '.') {
+				j++
+			}
+			if j < len(s) && (s[j] == 'e' || s[j] == 'E') {
+				k := j + 1
+				if k < len(s) && (s[k] == '+' || s[k] == '-') {
+					k++
+				}
+				if k < len(s) && isDigit(s[k]) {
+					for k < len(s) && isDigit(s[k]) {
+						k++
+					}
+					j = k
+				}
+			}
+			toks = append(toks, token{kind: number, text: s[i:j]})
+			i = j
+		case isNameChar(c):
+			j := i
+			for j < len(s) && (isNameChar(s[j]) || isDigit(s[j])) {
+				j++
+			}
+			toks = append(toks, token{kind: name, text: s[i:j]})
+			i = j
+		default:
+			return nil, fmt.Errorf("invalid character %q", c)
+		}
+	}
+	return toks, nil
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isNameChar(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || c >= 0x80 || strings.IndexByte(nameChars, c) >= 0
+}
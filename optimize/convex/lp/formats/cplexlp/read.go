@@ -0,0 +1,491 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cplexlp
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+)
+
+// Read reads a linear program in CPLEX LP format from r.
+//
+// The objective, Subject To, Bounds, General, Binary and End sections are
+// supported, along with ranged constraints of the form
+//  name: lower <= expression <= upper.
+// Quadratic terms, semi-continuous variables and special ordered sets are not
+// supported. Variables have a default lower bound of zero and no upper bound.
+// Unnamed constraints are given names of the form R<i>.
+func Read(r io.Reader) (*lp.Model, error) {
+	p := parser{
+		varIdx: make(map[string]int),
+		model:  &lp.Model{},
+	}
+	sc := bufio.NewScanner(r)
+	var line int
+	for sc.Scan() {
+		line++
+		text := sc.Text()
+		if i := strings.IndexByte(text, '\\'); i >= 0 {
+			comment := strings.TrimSpace(text[i+1:])
+			if line == 1 && strings.HasPrefix(strings.ToLower(comment), problemName) {
+				p.model.Name = strings.TrimSpace(comment[len(problemName):])
+			}
+			text = text[:i]
+		}
+		err := p.parseLine(text)
+		if err == errEnd {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cplexlp: line %d: %v", line, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	err := p.flush()
+	if err != nil {
+		return nil, fmt.Errorf("cplexlp: %v", err)
+	}
+	return p.build(), nil
+}
+
+var errEnd = errors.New("cplexlp: end of data")
+
+// problemName is the prefix of a comment on the first line holding the name
+// of the problem.
+const problemName = "problem name:"
+
+type section int
+
+const (
+	none section = iota
+	objective
+	constraints
+	bounds
+	general
+	binary
+)
+
+// term is a linear term of an expression.
+type term struct {
+	coef float64
+	v    int
+}
+
+// constraint is a parsed constraint.
+type constraint struct {
+	name   string
+	terms  []term
+	lo, up float64
+}
+
+type parser struct {
+	sec    section
+	tokens []token // Tokens of the current section.
+	seen   bool    // Whether a section header has been seen.
+
+	model  *lp.Model
+	varIdx map[string]int
+
+	obj  []term
+	cons []constraint
+}
+
+// sections maps lower case keywords to sections. Keywords consisting of two
+// words are handled in parseLine.
+var sections = map[string]section{
+	"minimize": objective,
+	"minimise": objective,
+	"minimum":  objective,
+	"min":      objective,
+	"maximize": objective,
+	"maximise": objective,
+	"maximum":  objective,
+	"max":      objective,
+	"st":       constraints,
+	"s.t.":     constraints,
+	"st.":      constraints,
+	"bounds":   bounds,
+	"bound":    bounds,
+	"general":  general,
+	"generals": general,
+	"gen":      general,
+	"integer":  general,
+	"integers": general,
+	"binary":   binary,
+	"binaries": binary,
+	"bin":      binary,
+}
+
+func (p *parser) parseLine(text string) error {
+	fields := strings.Fields(text)
+	if len(fields) != 0 {
+		key := strings.ToLower(fields[0])
+		sec, ok := sections[key]
+		if !ok && len(fields) >= 2 {
+			switch key + " " + strings.ToLower(fields[1]) {
+			case "subject to", "such that":
+				sec, ok = constraints, true
+				text = text[strings.Index(strings.ToLower(text), strings.ToLower(fields[1]))+len(fields[1]):]
+			}
+		} else if ok {
+			text = text[strings.Index(text, fields[0])+len(fields[0]):]
+		}
+		if !ok {
+			switch key {
+			case "end":
+				return errEnd
+			case "semi-continuous", "semis", "semi", "sos":
+				return fmt.Errorf("unsupported section %q", fields[0])
+			}
+		}
+		if ok {
+			// Sections may not start in the middle of a statement, so
+			// the collected tokens can be parsed.
+			err := p.flush()
+			if err != nil {
+				return err
+			}
+			p.sec = sec
+			p.seen = true
+			if sec == objective {
+				p.model.Maximize = strings.HasPrefix(key, "max")
+			}
+		}
+	}
+	if !p.seen && strings.TrimSpace(text) != "" {
+		return errors.New("data before first section")
+	}
+	toks, err := tokenize(text)
+	if err != nil {
+		return err
+	}
+	p.tokens = append(p.tokens, toks...)
+	return nil
+}
+
+// flush parses the tokens collected for the current section.
+func (p *parser) flush() error {
+	toks := p.tokens
+	p.tokens = nil
+	if len(toks) == 0 {
+		return nil
+	}
+	switch p.sec {
+	case objective:
+		return p.parseObjective(toks)
+	case constraints:
+		return p.parseConstraints(toks)
+	case bounds:
+		return p.parseBounds(toks)
+	case general, binary:
+		kind := lp.Integer
+		if p.sec == binary {
+			kind = lp.Binary
+		}
+		for _, t := range toks {
+			if t.kind != name {
+				return fmt.Errorf("unexpected %q in variable list", t.text)
+			}
+			j := p.variable(t.text)
+			p.model.Kind[j] = kind
+			if kind == lp.Binary {
+				p.model.Lower[j] = 0
+				p.model.Upper[j] = 1
+			}
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseObjective(toks []token) error {
+	if len(toks) >= 2 && toks[0].kind == name && toks[1].kind == colon {
+		p.model.ObjName = toks[0].text
+		toks = toks[2:]
+	}
+	terms, offset, rest, err := p.parseExpr(toks, true)
+	if err != nil {
+		return err
+	}
+	if len(rest) != 0 {
+		return fmt.Errorf("unexpected %q in objective", rest[0].text)
+	}
+	p.obj = append(p.obj, terms...)
+	p.model.Offset += offset
+	return nil
+}
+
+func (p *parser) parseConstraints(toks []token) error {
+	for len(toks) != 0 {
+		var c constraint
+		if len(toks) >= 2 && toks[0].kind == name && toks[1].kind == colon {
+			c.name = toks[0].text
+			toks = toks[2:]
+		}
+		c.lo = math.Inf(-1)
+		c.up = math.Inf(1)
+
+		// Handle the lower bound of a ranged constraint.
+		if v, rest, ok := parseNumber(toks); ok && len(rest) != 0 && rest[0].kind == op {
+			switch rest[0].text {
+			case "<=":
+				c.lo = v
+			case ">=":
+				c.up = v
+			default:
+				return fmt.Errorf("invalid operator %q in ranged constraint", rest[0].text)
+			}
+			toks = rest[1:]
+		}
+
+		terms, _, rest, err := p.parseExpr(toks, false)
+		if err != nil {
+			return err
+		}
+		if len(rest) == 0 || rest[0].kind != op {
+			return errors.New("constraint without operator")
+		}
+		opText := rest[0].text
+		v, rest, ok := parseNumber(rest[1:])
+		if !ok {
+			return errors.New("constraint without right-hand side")
+		}
+		switch opText {
+		case "<=":
+			c.up = v
+		case ">=":
+			c.lo = v
+		case "=":
+			c.lo, c.up = v, v
+		}
+		c.terms = terms
+		p.cons = append(p.cons, c)
+		toks = rest
+	}
+	return nil
+}
+
+func (p *parser) parseBounds(toks []token) error {
+	for len(toks) != 0 {
+		m := p.model
+		if v, rest, ok := parseNumber(toks); ok {
+			// lower <= x [<= upper] or upper >= x [>= lower].
+			if len(rest) < 2 || rest[0].kind != op || rest[1].kind != name {
+				return errors.New("malformed bound")
+			}
+			j := p.variable(rest[1].text)
+			setBound(m, j, flip(rest[0].text), v)
+			toks = rest[2:]
+			if len(toks) >= 2 && toks[0].kind == op {
+				if toks[0].text != rest[0].text {
+					return errors.New("inconsistent operators in bound")
+				}
+				v, rest, ok := parseNumber(toks[1:])
+				if !ok {
+					return errors.New("malformed bound")
+				}
+				setBound(m, j, toks[0].text, v)
+				toks = rest
+			}
+			continue
+		}
+		if toks[0].kind != name {
+			return fmt.Errorf("unexpected %q in bounds", toks[0].text)
+		}
+		j := p.variable(toks[0].text)
+		if len(toks) >= 2 && toks[1].kind == name && strings.ToLower(toks[1].text) == "free" {
+			m.Lower[j] = math.Inf(-1)
+			m.Upper[j] = math.Inf(1)
+			toks = toks[2:]
+			continue
+		}
+		if len(toks) < 2 || toks[1].kind != op {
+			return errors.New("malformed bound")
+		}
+		v, rest, ok := parseNumber(toks[2:])
+		if !ok {
+			return errors.New("malformed bound")
+		}
+		setBound(m, j, toks[1].text, v)
+		toks = rest
+	}
+	return nil
+}
+
+// flip returns the operator that is equivalent to op with its operands
+// exchanged.
+func flip(op string) string {
+	switch op {
+	case "<=":
+		return ">="
+	case ">=":
+		return "<="
+	}
+	return op
+}
+
+// setBound sets the bound on variable j given by x op v.
+func setBound(m *lp.Model, j int, op string, v float64) {
+	switch op {
+	case "<=":
+		m.Upper[j] = v
+	case ">=":
+		m.Lower[j] = v
+	case "=":
+		m.Lower[j] = v
+		m.Upper[j] = v
+	}
+}
+
+// parseExpr parses a linear expression at the start of toks and returns the
+// terms, the sum of any constant terms and the remaining tokens. Constant terms
+// are an error unless constant is true.
+func (p *parser) parseExpr(toks []token, constant bool) (terms []term, offset float64, rest []token, err error) {
+	for len(toks) != 0 {
+		sign := 1.0
+		var hasSign bool
+		for len(toks) != 0 && toks[0].kind == signTok {
+			if toks[0].text == "-" {
+				sign = -sign
+			}
+			hasSign = true
+			toks = toks[1:]
+		}
+		if len(toks) == 0 {
+			return nil, 0, nil, errors.New("expression ends with sign")
+		}
+		if len(terms) != 0 || offset != 0 {
+			if !hasSign && (toks[0].kind == name || toks[0].kind == number) {
+				return nil, 0, nil, fmt.Errorf("missing operator before %q", toks[0].text)
+			}
+		}
+		switch toks[0].kind {
+		case number:
+			v, err := strconv.ParseFloat(toks[0].text, 64)
+			if err != nil {
+				return nil, 0, nil, fmt.Errorf("invalid number %q", toks[0].text)
+			}
+			toks = toks[1:]
+			if len(toks) != 0 && toks[0].kind == name && !isInf(toks[0].text) {
+				terms = append(terms, term{coef: sign * v, v: p.variable(toks[0].text)})
+				toks = toks[1:]
+				continue
+			}
+			if !constant {
+				return nil, 0, nil, errors.New("constant term in constraint")
+			}
+			offset += sign * v
+		case name:
+			terms = append(terms, term{coef: sign, v: p.variable(toks[0].text)})
+			toks = toks[1:]
+		case bracket:
+			return nil, 0, nil, errors.New("quadratic terms are not supported")
+		default:
+			if hasSign {
+				return nil, 0, nil, fmt.Errorf("unexpected %q after sign", toks[0].text)
+			}
+			return terms, offset, toks, nil
+		}
+	}
+	return terms, offset, nil, nil
+}
+
+// parseNumber parses a possibly signed number or infinity at the start of
+// toks and returns its value and the remaining tokens.
+func parseNumber(toks []token) (float64, []token, bool) {
+	sign := 1.0
+	i := 0
+	for i < len(toks) && toks[i].kind == signTok {
+		if toks[i].text == "-" {
+			sign = -sign
+		}
+		i++
+	}
+	if i >= len(toks) {
+		return 0, toks, false
+	}
+	t := toks[i]
+	switch {
+	case t.kind == number:
+		v, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return 0, toks, false
+		}
+		return sign * v, toks[i+1:], true
+	case t.kind == name && isInf(t.text):
+		return sign * math.Inf(1), toks[i+1:], true
+	}
+	return 0, toks, false
+}
+
+func isInf(s string) bool {
+	s = strings.ToLower(s)
+	return s == "inf" || s == "infinity"
+}
+
+// variable returns the index of the named variable, adding it to the model if
+// necessary.
+func (p *parser) variable(s string) int {
+	j, ok := p.varIdx[s]
+	if ok {
+		return j
+	}
+	m := p.model
+	j = len(m.VarNames)
+	p.varIdx[s] = j
+	m.VarNames = append(m.VarNames, s)
+	m.Lower = append(m.Lower, 0)
+	m.Upper = append(m.Upper, math.Inf(1))
+	m.Kind = append(m.Kind, lp.Continuous)
+	return j
+}
+
+// build constructs the model from the parsed data.
+func (p *parser) build() *lp.Model {
+	m := p.model
+	nVar := len(m.VarNames)
+	m.C = make([]float64, nVar)
+	for _, t := range p.obj {
+		m.C[t.v] += t.coef
+	}
+	nCon := len(p.cons)
+	if nCon != 0 && nVar != 0 {
+		m.A = mat.NewDense(nCon, nVar, nil)
+	}
+	m.RowNames = make([]string, nCon)
+	m.RowLower = make([]float64, nCon)
+	m.RowUpper = make([]float64, nCon)
+	for i, c := range p.cons {
+		m.RowNames[i] = c.name
+		if c.name == "" {
+			m.RowNames[i] = "R" + strconv.Itoa(i+1)
+		}
+		m.RowLower[i] = c.lo
+		m.RowUpper[i] = c.up
+		for _, t := range c.terms {
+			m.A.Set(i, t.v, m.A.At(i, t.v)+t.coef)
+		}
+	}
+	var isInt bool
+	for _, k := range m.Kind {
+		if k != lp.Continuous {
+			isInt = true
+			break
+		}
+	}
+	if !isInt {
+		m.Kind = nil
+	}
+	return m
+}
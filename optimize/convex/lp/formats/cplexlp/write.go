@@ -0,0 +1,252 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package cplexlp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+)
+
+// lineLength is the length after which expressions are wrapped.
+const lineLength = 78
+
+// Write writes the linear program m to w in CPLEX LP format.
+//
+// Free constraints are not written. Unnamed rows and variables are given names
+// of the form R<i> and x<j>, and an unnamed objective is given the name obj.
+func Write(w io.Writer, m *lp.Model) error {
+	nRow, nVar := m.Dims()
+	if len(m.Lower) != nVar || len(m.Upper) != nVar || len(m.RowUpper) != nRow || len(m.RowNames) > nRow || len(m.VarNames) > nVar {
+		panic("cplexlp: size mismatch")
+	}
+	varNames := make([]string, nVar)
+	for j := range varNames {
+		if j < len(m.VarNames) && m.VarNames[j] != "" {
+			varNames[j] = m.VarNames[j]
+		} else {
+			varNames[j] = "x" + strconv.Itoa(j+1)
+		}
+		if err := checkName(varNames[j]); err != nil {
+			return err
+		}
+	}
+	objName := m.ObjName
+	if objName == "" {
+		objName = "obj"
+	}
+	if err := checkName(objName); err != nil {
+		return err
+	}
+
+	wr := writer{w: bufio.NewWriter(w)}
+	if m.Name != "" {
+		wr.printf("\\Problem name: %s\n\n", m.Name)
+	}
+	if m.Maximize {
+		wr.printf("Maximize\n")
+	} else {
+		wr.printf("Minimize\n")
+	}
+	row := make([]float64, nVar)
+	copy(row, m.C)
+	wr.expr(objName, row, varNames, m.Offset, nil)
+	wr.printf("\n")
+
+	wr.printf("Subject To\n")
+	for i := 0; i < nRow; i++ {
+		lo, up := m.RowLower[i], m.RowUpper[i]
+		if math.IsInf(lo, -1) && math.IsInf(up, 1) {
+			continue
+		}
+		rowName := "R" + strconv.Itoa(i+1)
+		if i < len(m.RowNames) && m.RowNames[i] != "" {
+			rowName = m.RowNames[i]
+		}
+		if err := checkName(rowName); err != nil {
+			return err
+		}
+		for j := range row {
+			row[j] = m.A.At(i, j)
+		}
+		switch {
+		case lo == up:
+			wr.expr(rowName, row, varNames, 0, nil)
+			wr.printf(" = %s\n", formatNumber(up))
+		case math.IsInf(lo, -1):
+			wr.expr(rowName, row, varNames, 0, nil)
+			wr.printf(" <= %s\n", formatNumber(up))
+		case math.IsInf(up, 1):
+			wr.expr(rowName, row, varNames, 0, nil)
+			wr.printf(" >= %s\n", formatNumber(lo))
+		default:
+			wr.expr(rowName, row, varNames, 0, &lo)
+			wr.printf(" <= %s\n", formatNumber(up))
+		}
+	}
+
+	wr.printf("Bounds\n")
+	for j, name := range varNames {
+		lo, up := m.Lower[j], m.Upper[j]
+		if m.Kind != nil && m.Kind[j] == lp.Binary && lo == 0 && up == 1 {
+			continue
+		}
+		switch {
+		case lo == up:
+			wr.printf(" %s = %s\n", name, formatNumber(lo))
+		case math.IsInf(lo, -1) && math.IsInf(up, 1):
+			wr.printf(" %s free\n", name)
+		case lo == 0 && math.IsInf(up, 1):
+		case lo == 0:
+			wr.printf(" %s <= %s\n", name, formatNumber(up))
+		case math.IsInf(up, 1):
+			wr.printf(" %s >= %s\n", name, formatNumber(lo))
+		default:
+			wr.printf(" %s <= %s <= %s\n", formatNumber(lo), name, formatNumber(up))
+		}
+	}
+
+	// Binary variables with bounds other than [0, 1] are written as
+	// general integers so that the bounds are retained.
+	var generals, binaries []string
+	for j, k := range m.Kind {
+		switch {
+		case k == lp.Binary && m.Lower[j] == 0 && m.Upper[j] == 1:
+			binaries = append(binaries, varNames[j])
+		case k != lp.Continuous:
+			generals = append(generals, varNames[j])
+		}
+	}
+	if len(generals) != 0 {
+		wr.printf("General\n")
+		wr.list(generals)
+	}
+	if len(binaries) != 0 {
+		wr.printf("Binary\n")
+		wr.list(binaries)
+	}
+	wr.printf("End\n")
+
+	if wr.err != nil {
+		return wr.err
+	}
+	return wr.w.Flush()
+}
+
+// checkName returns an error if s is not a valid name in LP format.
+func checkName(s string) error {
+	if s == "" || isDigit(s[0]) || s[0] == '.' || (s[0]|0x20) == 'e' && len(s) > 1 && (isDigit(s[1]) || s[1] == '+' || s[1] == '-') {
+		return fmt.Errorf("cplexlp: invalid name %q", s)
+	}
+	for i := 0; i < len(s); i++ {
+		if !isNameChar(s[i]) && !isDigit(s[i]) {
+			return fmt.Errorf("cplexlp: invalid name %q", s)
+		}
+	}
+	lower := strings.ToLower(s)
+	if _, ok := sections[lower]; ok || isInf(s) || lower == "free" || lower == "end" {
+		return fmt.Errorf("cplexlp: invalid name %q", s)
+	}
+	return nil
+}
+
+type writer struct {
+	w   *bufio.Writer
+	col int
+	err error
+}
+
+func (w *writer) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	s := fmt.Sprintf(format, args...)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		w.col = len(s) - i - 1
+	} else {
+		w.col += len(s)
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+// term writes s, wrapping the line if it would become too long.
+func (w *writer) term(s string) {
+	if w.col+len(s) > lineLength {
+		w.printf("\n   ")
+	}
+	w.printf("%s", s)
+}
+
+// expr writes a named linear expression with the given coefficients and
+// constant offset. If lo is not nil, the expression is written as the start of
+// a ranged constraint with lower bound *lo.
+func (w *writer) expr(name string, coef []float64, names []string, offset float64, lo *float64) {
+	w.printf(" %s:", name)
+	if lo != nil {
+		w.printf(" %s <=", formatNumber(*lo))
+	}
+	var written bool
+	for j, v := range coef {
+		if v == 0 {
+			continue
+		}
+		w.term(termString(v, names[j], written))
+		written = true
+	}
+	if offset != 0 {
+		w.term(termString(offset, "", written))
+		written = true
+	}
+	if !written && len(names) != 0 {
+		// Write an empty expression as a term with a zero coefficient.
+		w.term(termString(0, names[0], false))
+	}
+}
+
+// list writes a list of names.
+func (w *writer) list(names []string) {
+	for _, name := range names {
+		w.term(" " + name)
+	}
+	w.printf("\n")
+}
+
+// termString returns the string representation of the term v*name. If name is
+// empty, the term is a constant.
+func termString(v float64, name string, sign bool) string {
+	var s string
+	switch {
+	case v < 0:
+		s = " - "
+		v = -v
+	case sign:
+		s = " + "
+	default:
+		s = " "
+	}
+	if name == "" {
+		return s + formatNumber(v)
+	}
+	if v == 1 {
+		return s + name
+	}
+	return s + formatNumber(v) + " " + name
+}
+
+// formatNumber returns the LP format representation of v.
+func formatNumber(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+inf"
+	case math.IsInf(v, -1):
+		return "-inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,10 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package mps implements reading and writing of linear programs in the free
+// and fixed MPS formats.
+//
+// A description of the MPS format can be found at
+//  http://lpsolve.sourceforge.net/5.5/mps-format.htm
+package mps // import "github.com/jingcheng-WU/gonum/optimize/convex/lp/formats/mps"
@@ -0,0 +1,548 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mps
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+)
+
+// Format specifies the layout of the fields of an MPS file.
+type Format int
+
+const (
+	// Free is the free MPS format where fields are separated by white
+	// space. Names may not contain spaces.
+	Free Format = iota
+	// Fixed is the fixed MPS format where fields are located in fixed
+	// columns. Names are limited to eight characters and may contain
+	// spaces.
+	Fixed
+)
+
+// fixedFields holds the column ranges of the six fields of a fixed MPS data
+// line. The ranges are zero-based and half-open.
+var fixedFields = [6][2]int{{1, 3}, {4, 12}, {14, 22}, {24, 36}, {39, 47}, {49, 61}}
+
+// Read reads a linear program in MPS format from r.
+//
+// The NAME, OBJSENSE, ROWS, COLUMNS, RHS, RANGES, BOUNDS and ENDATA sections
+// are supported, as are integer markers in the COLUMNS section and the
+// UP, LO, FX, FR, MI, PL, BV, LI and UI bound types. The first N row is used
+// as the objective and other N rows are ignored. Only the first RHS, RANGES
+// and BOUNDS vectors are used. A right-hand side given for the objective row
+// is the negated constant offset of the objective. Integer variables without
+// bounds have a lower bound of zero and no upper bound. An UP bound with a
+// negative value on a variable with a lower bound of zero sets the lower bound
+// to -Inf.
+func Read(r io.Reader, format Format) (*lp.Model, error) {
+	p := parser{
+		format:   format,
+		rowIdx:   make(map[string]int),
+		varIdx:   make(map[string]int),
+		objIdx:   -1,
+		entries:  make(map[[2]int]float64),
+		model:    &lp.Model{},
+		ignored:  make(map[string]bool),
+		rhsSet:   noSet,
+		rangeSet: noSet,
+		boundSet: noSet,
+	}
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		p.line++
+		err := p.parseLine(sc.Text())
+		if err == errEnd {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("mps: line %d: %v", p.line, err)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return p.build()
+}
+
+// noSet indicates that no RHS, RANGES or BOUNDS vector name has been seen.
+const noSet = "\x00"
+
+var errEnd = errors.New("mps: end of data")
+
+type section int
+
+const (
+	none section = iota
+	name
+	objSense
+	rows
+	columns
+	rhs
+	ranges
+	bounds
+)
+
+type rowType byte
+
+const (
+	rowN rowType = 'N'
+	rowE rowType = 'E'
+	rowL rowType = 'L'
+	rowG rowType = 'G'
+)
+
+type parser struct {
+	format Format
+	line   int
+	sec    section
+
+	model *lp.Model
+
+	rowIdx   map[string]int
+	rowTypes []rowType
+	rhs      []float64
+	rangeVal []float64
+	hasRange []bool
+	objIdx   int
+	ignored  map[string]bool // Names of N rows other than the objective.
+
+	varIdx  map[string]int
+	entries map[[2]int]float64
+	integer bool // Whether the parser is between integer markers.
+
+	rhsSet, rangeSet, boundSet string
+}
+
+func (p *parser) parseLine(line string) error {
+	if strings.TrimSpace(line) == "" || line[0] == '*' {
+		return nil
+	}
+	if line[0] != ' ' && line[0] != '\t' {
+		return p.parseHeader(line)
+	}
+
+	var f []string
+	if p.format == Fixed && !strings.Contains(line, "'MARKER'") {
+		f = fixedSplit(line)
+	} else {
+		f = strings.Fields(line)
+	}
+	if len(f) == 0 {
+		return nil
+	}
+	switch p.sec {
+	case objSense:
+		return p.parseObjSense(f[0])
+	case rows:
+		return p.parseRow(f)
+	case columns:
+		return p.parseColumn(f)
+	case rhs:
+		return p.parseRHS(f)
+	case ranges:
+		return p.parseRange(f)
+	case bounds:
+		return p.parseBound(f)
+	}
+	return fmt.Errorf("unexpected data line in section")
+}
+
+func (p *parser) parseHeader(line string) error {
+	f := strings.Fields(line)
+	switch strings.ToUpper(f[0]) {
+	case "NAME":
+		p.sec = name
+		if p.format == Fixed && len(line) > 14 {
+			p.model.Name = strings.TrimSpace(line[14:])
+		} else if len(f) > 1 {
+			p.model.Name = f[1]
+		}
+	case "OBJSENSE":
+		p.sec = objSense
+		if len(f) > 1 {
+			return p.parseObjSense(f[1])
+		}
+	case "ROWS":
+		p.sec = rows
+	case "COLUMNS":
+		p.sec = columns
+	case "RHS":
+		p.sec = rhs
+	case "RANGES":
+		p.sec = ranges
+	case "BOUNDS":
+		p.sec = bounds
+	case "ENDATA":
+		return errEnd
+	default:
+		return fmt.Errorf("unknown section %q", f[0])
+	}
+	return nil
+}
+
+func (p *parser) parseObjSense(s string) error {
+	switch strings.ToUpper(s) {
+	case "MIN", "MINIMIZE":
+		p.model.Maximize = false
+	case "MAX", "MAXIMIZE":
+		p.model.Maximize = true
+	default:
+		return fmt.Errorf("unknown objective sense %q", s)
+	}
+	return nil
+}
+
+func (p *parser) parseRow(f []string) error {
+	if len(f) != 2 {
+		return errors.New("malformed row")
+	}
+	if len(f[0]) != 1 {
+		return fmt.Errorf("unknown row type %q", f[0])
+	}
+	typ := rowType(strings.ToUpper(f[0])[0])
+	switch typ {
+	case rowN:
+		if p.objIdx >= 0 {
+			p.ignored[f[1]] = true
+			return nil
+		}
+		p.model.ObjName = f[1]
+	case rowE, rowL, rowG:
+	default:
+		return fmt.Errorf("unknown row type %q", f[0])
+	}
+	if _, ok := p.rowIdx[f[1]]; ok {
+		return fmt.Errorf("duplicate row %q", f[1])
+	}
+	p.rowIdx[f[1]] = len(p.rowTypes)
+	if typ == rowN {
+		p.objIdx = len(p.rowTypes)
+	}
+	p.rowTypes = append(p.rowTypes, typ)
+	p.rhs = append(p.rhs, 0)
+	p.rangeVal = append(p.rangeVal, 0)
+	p.hasRange = append(p.hasRange, false)
+	return nil
+}
+
+func (p *parser) parseColumn(f []string) error {
+	if len(f) >= 2 && strings.Trim(f[1], "'") == "MARKER" {
+		for _, s := range f[2:] {
+			switch strings.Trim(s, "'") {
+			case "INTORG":
+				p.integer = true
+				return nil
+			case "INTEND":
+				p.integer = false
+				return nil
+			}
+		}
+		return errors.New("malformed marker")
+	}
+	if len(f) != 3 && len(f) != 5 {
+		return errors.New("malformed column")
+	}
+	j, ok := p.varIdx[f[0]]
+	if !ok {
+		j = len(p.model.VarNames)
+		p.varIdx[f[0]] = j
+		p.model.VarNames = append(p.model.VarNames, f[0])
+		p.model.Lower = append(p.model.Lower, 0)
+		p.model.Upper = append(p.model.Upper, math.Inf(1))
+		kind := lp.Continuous
+		if p.integer {
+			kind = lp.Integer
+		}
+		p.model.Kind = append(p.model.Kind, kind)
+	}
+	for k := 1; k < len(f); k += 2 {
+		if p.ignored[f[k]] {
+			continue
+		}
+		i, ok := p.rowIdx[f[k]]
+		if !ok {
+			return fmt.Errorf("unknown row %q", f[k])
+		}
+		v, err := parseFloat(f[k+1])
+		if err != nil {
+			return err
+		}
+		p.entries[[2]int{i, j}] += v
+	}
+	return nil
+}
+
+// setPairs returns the name/value pairs of a RHS or RANGES line and whether
+// the line belongs to the first vector in the section.
+func setPairs(f []string, set *string) ([]string, bool, error) {
+	var setName string
+	if len(f)%2 == 1 {
+		setName = f[0]
+		f = f[1:]
+	}
+	if len(f) != 2 && len(f) != 4 {
+		return nil, false, errors.New("malformed line")
+	}
+	if *set == noSet {
+		*set = setName
+	}
+	return f, *set == setName, nil
+}
+
+func (p *parser) parseRHS(f []string) error {
+	f, ok, err := setPairs(f, &p.rhsSet)
+	if !ok || err != nil {
+		return err
+	}
+	for k := 0; k < len(f); k += 2 {
+		if p.ignored[f[k]] {
+			continue
+		}
+		i, ok := p.rowIdx[f[k]]
+		if !ok {
+			return fmt.Errorf("unknown row %q", f[k])
+		}
+		v, err := parseFloat(f[k+1])
+		if err != nil {
+			return err
+		}
+		p.rhs[i] = v
+	}
+	return nil
+}
+
+func (p *parser) parseRange(f []string) error {
+	f, ok, err := setPairs(f, &p.rangeSet)
+	if !ok || err != nil {
+		return err
+	}
+	for k := 0; k < len(f); k += 2 {
+		if p.ignored[f[k]] {
+			continue
+		}
+		i, ok := p.rowIdx[f[k]]
+		if !ok {
+			return fmt.Errorf("unknown row %q", f[k])
+		}
+		if i == p.objIdx {
+			return errors.New("range on objective row")
+		}
+		v, err := parseFloat(f[k+1])
+		if err != nil {
+			return err
+		}
+		p.rangeVal[i] = v
+		p.hasRange[i] = true
+	}
+	return nil
+}
+
+func (p *parser) parseBound(f []string) error {
+	if len(f) < 2 {
+		return errors.New("malformed bound")
+	}
+	typ := strings.ToUpper(f[0])
+	f = f[1:]
+	var hasValue bool
+	switch typ {
+	case "UP", "LO", "FX", "LI", "UI":
+		hasValue = true
+	case "FR", "MI", "PL":
+	case "BV":
+		// The value of a BV bound is optional.
+		hasValue = len(f) == 3 || (len(f) == 2 && p.isVar(f[0]) && !p.isVar(f[1]))
+	case "SC":
+		return errors.New("semi-continuous variables are not supported")
+	default:
+		return fmt.Errorf("unknown bound type %q", typ)
+	}
+	want := 1
+	if hasValue {
+		want = 2
+	}
+	var setName string
+	switch len(f) {
+	case want:
+	case want + 1:
+		setName = f[0]
+		f = f[1:]
+	default:
+		return errors.New("malformed bound")
+	}
+	if p.boundSet == noSet {
+		p.boundSet = setName
+	}
+	if p.boundSet != setName {
+		return nil
+	}
+
+	j, ok := p.varIdx[f[0]]
+	if !ok {
+		return fmt.Errorf("unknown column %q", f[0])
+	}
+	var v float64
+	if hasValue {
+		var err error
+		v, err = parseFloat(f[1])
+		if err != nil {
+			return err
+		}
+	}
+	m := p.model
+	switch typ {
+	case "UP", "UI":
+		if v < 0 && m.Lower[j] == 0 {
+			m.Lower[j] = math.Inf(-1)
+		}
+		m.Upper[j] = v
+	case "LO", "LI":
+		m.Lower[j] = v
+	case "FX":
+		m.Lower[j] = v
+		m.Upper[j] = v
+	case "FR":
+		m.Lower[j] = math.Inf(-1)
+		m.Upper[j] = math.Inf(1)
+	case "MI":
+		m.Lower[j] = math.Inf(-1)
+	case "PL":
+		m.Upper[j] = math.Inf(1)
+	case "BV":
+		m.Lower[j] = 0
+		m.Upper[j] = 1
+		m.Kind[j] = lp.Binary
+	}
+	if typ == "LI" || typ == "UI" {
+		m.Kind[j] = lp.Integer
+	}
+	return nil
+}
+
+func (p *parser) isVar(s string) bool {
+	_, ok := p.varIdx[s]
+	return ok
+}
+
+// build constructs the model from the parsed data.
+func (p *parser) build() (*lp.Model, error) {
+	if p.objIdx < 0 {
+		return nil, errors.New("mps: no objective row")
+	}
+	m := p.model
+	nVar := len(m.VarNames)
+	m.C = make([]float64, nVar)
+	m.Offset = -p.rhs[p.objIdx]
+
+	// Map the parsed row indices to constraint indices.
+	con := make([]int, len(p.rowTypes))
+	var nCon int
+	for i := range p.rowTypes {
+		if i == p.objIdx {
+			con[i] = -1
+			continue
+		}
+		con[i] = nCon
+		nCon++
+	}
+	m.RowNames = make([]string, nCon)
+	for s, i := range p.rowIdx {
+		if con[i] >= 0 {
+			m.RowNames[con[i]] = s
+		}
+	}
+	if nCon != 0 && nVar != 0 {
+		m.A = mat.NewDense(nCon, nVar, nil)
+	}
+	for ij, v := range p.entries {
+		i, j := ij[0], ij[1]
+		if i == p.objIdx {
+			m.C[j] = v
+			continue
+		}
+		m.A.Set(con[i], j, v)
+	}
+
+	m.RowLower = make([]float64, nCon)
+	m.RowUpper = make([]float64, nCon)
+	for i, typ := range p.rowTypes {
+		k := con[i]
+		if k < 0 {
+			continue
+		}
+		b := p.rhs[i]
+		r := math.Abs(p.rangeVal[i])
+		lo, up := b, b
+		switch typ {
+		case rowE:
+			if p.hasRange[i] {
+				if p.rangeVal[i] >= 0 {
+					up = b + r
+				} else {
+					lo = b - r
+				}
+			}
+		case rowL:
+			lo = math.Inf(-1)
+			if p.hasRange[i] {
+				lo = b - r
+			}
+		case rowG:
+			up = math.Inf(1)
+			if p.hasRange[i] {
+				up = b + r
+			}
+		}
+		m.RowLower[k] = lo
+		m.RowUpper[k] = up
+	}
+
+	var isInt bool
+	for _, k := range m.Kind {
+		if k != lp.Continuous {
+			isInt = true
+			break
+		}
+	}
+	if !isInt {
+		m.Kind = nil
+	}
+	return m, nil
+}
+
+// fixedSplit returns the non-empty fields of a fixed format MPS data line.
+func fixedSplit(line string) []string {
+	var f []string
+	for _, r := range fixedFields {
+		if r[0] >= len(line) {
+			break
+		}
+		end := r[1]
+		if end > len(line) {
+			end = len(line)
+		}
+		s := strings.TrimSpace(line[r[0]:end])
+		if s != "" {
+			f = append(f, s)
+		}
+	}
+	return f
+}
+
+func parseFloat(s string) (float64, error) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", s)
+	}
+	return v, nil
+}
@@ -0,0 +1,332 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mps
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+)
+
+var readTests = []struct {
+	path   string
+	format Format
+
+	name     string
+	rows     int
+	vars     int
+	maximize bool
+	offset   float64
+	integer  bool
+
+	// opt and x are the optimal objective value of the model,
+	// including the offset, and the optimal location. The location
+	// is not checked if x is nil.
+	opt float64
+	x   []float64
+}{
+	{
+		path:   "testprob.mps",
+		format: Fixed,
+		name:   "TESTPROB",
+		rows:   3,
+		vars:   3,
+		opt:    16,
+		x:      []float64{0, -1, 6},
+	},
+	{
+		path:   "testprob.mps",
+		format: Free,
+		name:   "TESTPROB",
+		rows:   3,
+		vars:   3,
+		opt:    16,
+		x:      []float64{0, -1, 6},
+	},
+	{
+		path:     "mixed.mps",
+		format:   Free,
+		name:     "mixed",
+		rows:     3,
+		vars:     3,
+		maximize: true,
+		offset:   10,
+		integer:  true,
+		opt:      32.5,
+		x:        []float64{5, 3, 1.5},
+	},
+	{
+		// AFIRO from the Netlib LP test set.
+		path:   "afiro.mps",
+		format: Fixed,
+		name:   "AFIRO",
+		rows:   27,
+		vars:   32,
+		// The published optimal value is -4.6475314286e+02. The
+		// optimal location is not unique.
+		opt: -464.753142857142857,
+	},
+}
+
+func TestRead(t *testing.T) {
+	t.Parallel()
+	for _, test := range readTests {
+		f, err := os.Open(filepath.Join("testdata", test.path))
+		if err != nil {
+			t.Fatalf("failed to open test file: %v", err)
+		}
+		m, err := Read(f, test.format)
+		f.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.path, err)
+			continue
+		}
+		if m.Name != test.name {
+			t.Errorf("%s: unexpected name: got %q, want %q", test.path, m.Name, test.name)
+		}
+		rows, vars := m.Dims()
+		if rows != test.rows || vars != test.vars {
+			t.Errorf("%s: unexpected dimensions: got %d×%d, want %d×%d", test.path, rows, vars, test.rows, test.vars)
+		}
+		if m.Maximize != test.maximize {
+			t.Errorf("%s: unexpected objective sense", test.path)
+		}
+		if m.Offset != test.offset {
+			t.Errorf("%s: unexpected offset: got %v, want %v", test.path, m.Offset, test.offset)
+		}
+		if (m.Kind != nil) != test.integer {
+			t.Errorf("%s: unexpected integer variables: %v", test.path, m.Kind)
+		}
+
+		opt, x, err := solve(m)
+		if err != nil {
+			t.Errorf("%s: unexpected error solving model: %v", test.path, err)
+			continue
+		}
+		if !scalar.EqualWithinAbsOrRel(opt, test.opt, 1e-10, 1e-10) {
+			t.Errorf("%s: unexpected optimum: got %v, want %v", test.path, opt, test.opt)
+		}
+		if test.x != nil && !floats.EqualApprox(x, test.x, 1e-10) {
+			t.Errorf("%s: unexpected location: got %v, want %v", test.path, x, test.x)
+		}
+	}
+}
+
+// solve returns the optimal objective value and location of m.
+func solve(m *lp.Model) (float64, []float64, error) {
+	sign := 1.0
+	if m.Maximize {
+		sign = -1
+	}
+	p, shift, err := m.MILP()
+	if err == lp.ErrNegativeVar {
+		if m.Kind != nil {
+			return 0, nil, err
+		}
+		c, g, h, a, b := m.General()
+		cNew, aNew, bNew := lp.Convert(c, g, h, a, b)
+		f, xNew, err := lp.Simplex(cNew, aNew, bNew, 0, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		n := len(c)
+		x := make([]float64, n)
+		floats.SubTo(x, xNew[:n], xNew[n:2*n])
+		return sign*f + m.Offset, x, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		f float64
+		x []float64
+	)
+	if m.Kind != nil {
+		res, err := lp.BranchAndBound(p, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		f, x = res.F, res.X
+	} else {
+		// The variables of p are non-negative, so the standard form LP is
+		// found by adding a slack variable for each inequality instead of
+		// splitting the variables with Convert, which doubles the size of
+		// the problem and makes it highly degenerate.
+		c, a, b := standardForm(p)
+		var xStd []float64
+		f, xStd, err = lp.Simplex(c, a, b, 0, nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		x = xStd[:len(p.C)]
+	}
+	if shift != nil {
+		f += floats.Dot(p.C, shift)
+		floats.Add(x, shift)
+	}
+	return sign*f + m.Offset, x, nil
+}
+
+// standardForm returns the standard form LP of the relaxation of p, with a
+// slack variable for each inequality constraint.
+func standardForm(p *lp.MILP) (c []float64, a *mat.Dense, b []float64) {
+	n := len(p.C)
+	var nIneq, nEq int
+	if p.G != nil {
+		nIneq, _ = p.G.Dims()
+	}
+	if p.A != nil {
+		nEq, _ = p.A.Dims()
+	}
+	c = make([]float64, n+nIneq)
+	copy(c, p.C)
+	a = mat.NewDense(nIneq+nEq, n+nIneq, nil)
+	b = make([]float64, 0, nIneq+nEq)
+	for i := 0; i < nIneq; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(i, j, p.G.At(i, j))
+		}
+		a.Set(i, n+i, 1)
+	}
+	b = append(b, p.H...)
+	for i := 0; i < nEq; i++ {
+		for j := 0; j < n; j++ {
+			a.Set(nIneq+i, j, p.A.At(i, j))
+		}
+	}
+	b = append(b, p.B...)
+	return c, a, b
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+	for _, test := range readTests {
+		f, err := os.Open(filepath.Join("testdata", test.path))
+		if err != nil {
+			t.Fatalf("failed to open test file: %v", err)
+		}
+		want, err := Read(f, test.format)
+		f.Close()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.path, err)
+			continue
+		}
+		for _, format := range []Format{Free, Fixed} {
+			var buf bytes.Buffer
+			err = Write(&buf, want, format)
+			if err != nil {
+				t.Errorf("%s: unexpected error writing format %d: %v", test.path, format, err)
+				continue
+			}
+			got, err := Read(&buf, format)
+			if err != nil {
+				t.Errorf("%s: unexpected error reading format %d: %v\n%s", test.path, format, err, buf.Bytes())
+				continue
+			}
+			if !equalModels(got, want) {
+				t.Errorf("%s: round trip in format %d does not match:\ngot: %+v\nwant:%+v", test.path, format, got, want)
+			}
+		}
+	}
+}
+
+func TestWriteBounds(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	want := &lp.Model{
+		Name:     "bounds",
+		C:        []float64{1, -2, 3, 1e-20, 5, 6, 7},
+		VarNames: []string{"free", "minus", "fixed", "lower", "upper", "binary", "negup"},
+		Lower:    []float64{-inf, -inf, 2, 0.5, 0, 0, 0},
+		Upper:    []float64{inf, 3, 2, inf, 4, 1, -1},
+		Kind:     []lp.VarKind{lp.Continuous, lp.Continuous, lp.Integer, lp.Continuous, lp.Integer, lp.Binary, lp.Continuous},
+		RowNames: []string{"r1", "r2"},
+		A:        mat.NewDense(2, 7, []float64{1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0}),
+		RowLower: []float64{-1, -inf},
+		RowUpper: []float64{1, inf},
+	}
+	for _, format := range []Format{Free, Fixed} {
+		var buf bytes.Buffer
+		err := Write(&buf, want, format)
+		if err != nil {
+			t.Errorf("unexpected error writing format %d: %v", format, err)
+			continue
+		}
+		got, err := Read(&buf, format)
+		if err != nil {
+			t.Errorf("unexpected error reading format %d: %v", format, err)
+			continue
+		}
+		// The free row is written as an N row, which is ignored when
+		// reading.
+		free := *want
+		free.RowNames = want.RowNames[:1]
+		free.A = mat.NewDense(1, 7, want.A.RawRowView(0))
+		free.RowLower = want.RowLower[:1]
+		free.RowUpper = want.RowUpper[:1]
+		if !equalModels(got, &free) {
+			t.Errorf("round trip in format %d does not match:\ngot: %+v\nwant:%+v", format, got, &free)
+		}
+	}
+
+	long := *want
+	long.VarNames = append([]string{"longer_than_eight"}, want.VarNames[1:]...)
+	err := Write(&bytes.Buffer{}, &long, Fixed)
+	if err == nil {
+		t.Errorf("expected error for long name in fixed format")
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	t.Parallel()
+	for _, test := range []string{
+		"NAME\nROWS\n L c1\nCOLUMNS\n x c1 1\nENDATA\n",
+		"NAME\nROWS\n N obj\n X c1\nENDATA\n",
+		"NAME\nROWS\n N obj\nCOLUMNS\n x c1 1\nENDATA\n",
+		"NAME\nROWS\n N obj\nCOLUMNS\n x obj one\nENDATA\n",
+		"NAME\nROWS\n N obj\nCOLUMNS\n x obj 1\nBOUNDS\n XX bnd x 1\nENDATA\n",
+		"NAME\nROWS\n N obj\nCOLUMNS\n x obj 1\nBOUNDS\n SC bnd x 1\nENDATA\n",
+		"NAME\nROWS\n N obj\nCOLUMNS\n x obj 1\nBOUNDS\n UP bnd y 1\nENDATA\n",
+		"NAME\nSECTION\nENDATA\n",
+	} {
+		_, err := Read(strings.NewReader(test), Free)
+		if err == nil {
+			t.Errorf("expected error reading:\n%s", test)
+		}
+	}
+}
+
+// equalModels returns whether the models a and b are equal.
+func equalModels(a, b *lp.Model) bool {
+	if a.Name != b.Name || a.Maximize != b.Maximize || a.Offset != b.Offset {
+		return false
+	}
+	if !reflect.DeepEqual(a.VarNames, b.VarNames) || !reflect.DeepEqual(a.RowNames, b.RowNames) {
+		return false
+	}
+	if !reflect.DeepEqual(a.Kind, b.Kind) {
+		return false
+	}
+	if !floats.Same(a.C, b.C) || !floats.Same(a.Lower, b.Lower) || !floats.Same(a.Upper, b.Upper) {
+		return false
+	}
+	if !floats.Same(a.RowLower, b.RowLower) || !floats.Same(a.RowUpper, b.RowUpper) {
+		return false
+	}
+	if (a.A == nil) != (b.A == nil) {
+		return false
+	}
+	return a.A == nil || mat.Equal(a.A, b.A)
+}
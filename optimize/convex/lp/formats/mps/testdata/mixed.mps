@@ -0,0 +1,31 @@
+* A maximization problem in free MPS format using ranges, integer markers,
+* an objective offset and a second ignored RHS vector.
+* The optimal objective value is 32.5 at x=5, y=3, z=1.5.
+NAME mixed
+OBJSENSE
+    MAX
+ROWS
+ N obj
+ L c1
+ E c2
+ G c3
+ N unused
+COLUMNS
+    MARKER 'MARKER' 'INTORG'
+    x obj 3 c1 1
+    x c2 1 c3 1
+    y obj 2 c1 1
+    y c2 -1 unused 5
+    MARKER 'MARKER' 'INTEND'
+    z obj 1 c1 1
+    z c3 2
+RHS
+    rhs obj -10 c1 10
+    rhs c2 -2 c3 3
+    other c1 100
+RANGES
+    rng c2 4 c3 5
+BOUNDS
+ UP bnd y 3
+ UP bnd z 2.5
+ENDATA
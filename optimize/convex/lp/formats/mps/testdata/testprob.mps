@@ -0,0 +1,24 @@
+* The example problem from the description of the MPS format at
+* https://en.wikipedia.org/wiki/MPS_(format), in fixed MPS format.
+* The optimal objective value is 16 at XONE=0, YTWO=-1, ZTHREE=6.
+NAME          TESTPROB
+ROWS
+ N  COST
+ L  LIM1
+ G  LIM2
+ E  MYEQN
+COLUMNS
+    XONE      COST                 1   LIM1                 1
+    XONE      LIM2                 1
+    YTWO      COST                 2   LIM1                 1
+    YTWO      MYEQN               -1
+    ZTHREE    COST                 3   LIM2                 1
+    ZTHREE    MYEQN                1
+RHS
+    RHS1      LIM1                 4   LIM2                 1
+    RHS1      MYEQN                7
+BOUNDS
+ UP BND1      XONE                 4
+ LO BND1      YTWO                -1
+ UP BND1      YTWO                 1
+ENDATA
@@ -0,0 +1,288 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mps
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+)
+
+// Write writes the linear program m to w in MPS format.
+//
+// Constraints with two finite distinct bounds are written as G rows with a
+// range, and free constraints are written as additional N rows. An OBJSENSE
+// section is written if the model is maximized, and the constant offset of the
+// objective is written as the negated right-hand side of the objective row.
+// Unnamed rows and variables are given names of the form R<i> and C<j>, and
+// an unnamed objective is given the name OBJ.
+//
+// In Fixed format, Write returns an error if a name is longer than eight
+// characters, and numbers are written with at most twelve characters, which
+// may lose precision.
+func Write(w io.Writer, m *lp.Model, format Format) error {
+	nRow, nVar := m.Dims()
+	if len(m.Lower) != nVar || len(m.Upper) != nVar || len(m.RowUpper) != nRow || len(m.RowNames) > nRow || len(m.VarNames) > nVar {
+		panic("mps: size mismatch")
+	}
+	wr := writer{w: bufio.NewWriter(w), format: format}
+
+	objName := m.ObjName
+	if objName == "" {
+		objName = "OBJ"
+	}
+	rowNames := make([]string, nRow)
+	for i := range rowNames {
+		if i < len(m.RowNames) && m.RowNames[i] != "" {
+			rowNames[i] = m.RowNames[i]
+		} else {
+			rowNames[i] = "R" + strconv.Itoa(i+1)
+		}
+	}
+	varNames := make([]string, nVar)
+	for j := range varNames {
+		if j < len(m.VarNames) && m.VarNames[j] != "" {
+			varNames[j] = m.VarNames[j]
+		} else {
+			varNames[j] = "C" + strconv.Itoa(j+1)
+		}
+	}
+	if format == Fixed {
+		for _, s := range append(append([]string{objName, m.Name}, rowNames...), varNames...) {
+			if len(s) > 8 && s != m.Name {
+				return fmt.Errorf("mps: name %q too long for fixed format", s)
+			}
+		}
+	}
+	if format == Free {
+		for _, s := range append(append([]string{objName}, rowNames...), varNames...) {
+			if strings.ContainsAny(s, " \t") {
+				return fmt.Errorf("mps: name %q contains white space", s)
+			}
+		}
+	}
+
+	if format == Fixed {
+		wr.printf("NAME          %s\n", m.Name)
+	} else {
+		wr.printf("NAME %s\n", m.Name)
+	}
+	if m.Maximize {
+		wr.printf("OBJSENSE\n    MAX\n")
+	}
+
+	wr.printf("ROWS\n")
+	wr.line("N", objName)
+	for i, name := range rowNames {
+		wr.line(string(modelRowType(m, i)), name)
+	}
+
+	wr.printf("COLUMNS\n")
+	var integer bool
+	var markers int
+	for j, name := range varNames {
+		isInt := m.Kind != nil && m.Kind[j] != lp.Continuous
+		if isInt != integer {
+			marker := "'INTEND'"
+			if isInt {
+				marker = "'INTORG'"
+			}
+			wr.line("", "MARKER"+strconv.Itoa(markers), "'MARKER'", "", marker)
+			markers++
+			integer = isInt
+		}
+		var pairs []string
+		if m.C[j] != 0 {
+			pairs = append(pairs, objName, wr.number(m.C[j]))
+		}
+		for i, row := range rowNames {
+			v := m.A.At(i, j)
+			if v == 0 {
+				continue
+			}
+			pairs = append(pairs, row, wr.number(v))
+		}
+		if len(pairs) == 0 {
+			// Make sure that the column is defined.
+			pairs = append(pairs, objName, "0")
+		}
+		for k := 0; k < len(pairs); k += 4 {
+			wr.line("", append([]string{name}, pairs[k:min(k+4, len(pairs))]...)...)
+		}
+	}
+	if integer {
+		wr.line("", "MARKER"+strconv.Itoa(markers), "'MARKER'", "", "'INTEND'")
+	}
+
+	wr.printf("RHS\n")
+	if m.Offset != 0 {
+		wr.line("", "RHS", objName, wr.number(-m.Offset))
+	}
+	for i, row := range rowNames {
+		b := rowRHS(m, i)
+		if b != 0 {
+			wr.line("", "RHS", row, wr.number(b))
+		}
+	}
+
+	var hasRanges bool
+	for i := range rowNames {
+		if isRanged(m, i) {
+			if !hasRanges {
+				wr.printf("RANGES\n")
+				hasRanges = true
+			}
+			wr.line("", "RNG", rowNames[i], wr.number(m.RowUpper[i]-m.RowLower[i]))
+		}
+	}
+
+	var hasBounds bool
+	bound := func(typ, name string, v ...string) {
+		if !hasBounds {
+			wr.printf("BOUNDS\n")
+			hasBounds = true
+		}
+		wr.line(typ, append([]string{"BND", name}, v...)...)
+	}
+	for j, name := range varNames {
+		lo, up := m.Lower[j], m.Upper[j]
+		if m.Kind != nil && m.Kind[j] == lp.Binary && lo == 0 && up == 1 {
+			bound("BV", name)
+			continue
+		}
+		switch {
+		case lo == up:
+			bound("FX", name, wr.number(lo))
+			continue
+		case math.IsInf(lo, -1) && math.IsInf(up, 1):
+			bound("FR", name)
+			continue
+		case math.IsInf(lo, -1):
+			bound("MI", name)
+		}
+		if !math.IsInf(up, 1) {
+			bound("UP", name, wr.number(up))
+		}
+		if lo != 0 && !math.IsInf(lo, -1) || lo == 0 && up < 0 {
+			bound("LO", name, wr.number(lo))
+		}
+	}
+	wr.printf("ENDATA\n")
+
+	if wr.err != nil {
+		return wr.err
+	}
+	return wr.w.Flush()
+}
+
+// modelRowType returns the MPS row type of the ith constraint of m.
+func modelRowType(m *lp.Model, i int) rowType {
+	lo, up := m.RowLower[i], m.RowUpper[i]
+	switch {
+	case lo == up:
+		return rowE
+	case math.IsInf(lo, -1) && math.IsInf(up, 1):
+		return rowN
+	case math.IsInf(lo, -1):
+		return rowL
+	}
+	return rowG
+}
+
+// rowRHS returns the MPS right-hand side of the ith constraint of m.
+func rowRHS(m *lp.Model, i int) float64 {
+	switch modelRowType(m, i) {
+	case rowE, rowG:
+		return m.RowLower[i]
+	case rowL:
+		return m.RowUpper[i]
+	}
+	return 0
+}
+
+// isRanged returns whether the ith constraint of m has two distinct finite
+// bounds.
+func isRanged(m *lp.Model, i int) bool {
+	lo, up := m.RowLower[i], m.RowUpper[i]
+	return lo != up && !math.IsInf(lo, -1) && !math.IsInf(up, 1)
+}
+
+type writer struct {
+	w      *bufio.Writer
+	format Format
+	err    error
+}
+
+func (w *writer) printf(format string, args ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = fmt.Fprintf(w.w, format, args...)
+}
+
+// line writes a data line with the given type code and fields.
+func (w *writer) line(typ string, fields ...string) {
+	if w.format == Free {
+		var sb strings.Builder
+		sb.WriteByte(' ')
+		if typ != "" {
+			sb.WriteString(typ)
+			sb.WriteByte(' ')
+		} else {
+			sb.WriteString("   ")
+		}
+		for k, f := range fields {
+			if f == "" {
+				continue
+			}
+			if k != 0 {
+				sb.WriteByte(' ')
+			}
+			sb.WriteString(f)
+		}
+		w.printf("%s\n", strings.TrimRight(sb.String(), " "))
+		return
+	}
+
+	b := []byte(strings.Repeat(" ", 61))
+	copy(b[fixedFields[0][0]:], typ)
+	for k, f := range fields {
+		r := fixedFields[k+1]
+		if k == 2 || k == 4 {
+			// Numeric fields are right-aligned.
+			copy(b[r[1]-len(f):], f)
+		} else {
+			copy(b[r[0]:], f)
+		}
+	}
+	w.printf("%s\n", strings.TrimRight(string(b), " "))
+}
+
+// number formats v for the output format.
+func (w *writer) number(v float64) string {
+	s := strconv.FormatFloat(v, 'g', -1, 64)
+	if w.format == Free || len(s) <= 12 {
+		return s
+	}
+	for prec := 12; prec > 0; prec-- {
+		s = strconv.FormatFloat(v, 'g', prec, 64)
+		if len(s) <= 12 {
+			break
+		}
+	}
+	return s
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,642 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"container/heap"
+	"math"
+	"time"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+const (
+	// defaultIntTol is the default tolerance for deciding that a relaxed
+	// variable takes an integer value.
+	defaultIntTol = 1e-6
+	// defaultGap is the default relative optimality gap.
+	defaultGap = 1e-9
+	// gomoryFracTol is the minimum distance of a basic variable from an
+	// integer for it to be used to generate a Gomory cut.
+	gomoryFracTol = 1e-2
+	// gomoryZeroTol is the tolerance below which cut coefficients are treated
+	// as zero.
+	gomoryZeroTol = 1e-12
+)
+
+// VarKind specifies the domain of a variable in a mixed-integer linear program.
+type VarKind int
+
+const (
+	// Continuous variables may take any non-negative value.
+	Continuous VarKind = iota
+	// Integer variables may take any non-negative integer value.
+	Integer
+	// Binary variables may take the values 0 and 1.
+	Binary
+)
+
+// isInteger returns whether the variable must take integer values.
+func (k VarKind) isInteger() bool {
+	return k == Integer || k == Binary
+}
+
+// NodeSelection specifies the order in which branch-and-bound nodes are
+// explored.
+type NodeSelection int
+
+const (
+	// BestBound explores the open node with the lowest relaxation bound
+	// first.
+	BestBound NodeSelection = iota
+	// DepthFirst explores the most recently created node first.
+	DepthFirst
+)
+
+// Status specifies the termination status of BranchAndBound.
+type Status int
+
+const (
+	// Optimal indicates that the search proved optimality of the
+	// incumbent to within the requested gap.
+	Optimal Status = iota
+	// NodeLimit indicates that the node limit was reached.
+	NodeLimit
+	// TimeLimit indicates that the time limit was reached.
+	TimeLimit
+	// Stopped indicates that the search was stopped by the incumbent
+	// callback.
+	Stopped
+)
+
+func (s Status) String() string {
+	switch s {
+	case Optimal:
+		return "Optimal"
+	case NodeLimit:
+		return "NodeLimit"
+	case TimeLimit:
+		return "TimeLimit"
+	case Stopped:
+		return "Stopped"
+	}
+	return "Unknown"
+}
+
+// MILP is a mixed-integer linear program of the form
+//  minimize cᵀ * x
+//  s.t      G * x <= h
+//           A * x = b
+//           x >= 0
+//           x_i integer if Kind[i] is Integer or Binary
+//           x_i <= 1 if Kind[i] is Binary.
+// If there are no constraints of a given type, the corresponding fields may
+// be nil. If Kind is nil, all variables are continuous.
+//
+// The standard form of the relaxed problem, with a slack variable for each
+// inequality, is solved with Simplex, and so has the same restrictions. In
+// particular every variable must appear in at least one constraint.
+type MILP struct {
+	C    []float64
+	G    mat.Matrix
+	H    []float64
+	A    mat.Matrix
+	B    []float64
+	Kind []VarKind
+}
+
+// MILPSettings holds settings for BranchAndBound.
+type MILPSettings struct {
+	// Tol is the tolerance passed to Simplex when solving the relaxations.
+	Tol float64
+	// IntTol is the tolerance for deciding that a variable takes an
+	// integer value. If IntTol is zero, a default value of 1e-6 is used.
+	IntTol float64
+	// Gap is the relative optimality gap. A node is pruned if its bound is
+	// not lower than the incumbent by more than Gap*max(1, |incumbent|).
+	// If Gap is zero, a default value of 1e-9 is used.
+	Gap float64
+
+	// NodeSelection specifies the order in which nodes are explored.
+	NodeSelection NodeSelection
+
+	// GomoryRounds is the number of rounds of Gomory mixed-integer cuts
+	// that are added to the root relaxation before branching.
+	GomoryRounds int
+
+	// NodeLimit is the maximum number of nodes to explore. If NodeLimit
+	// is zero, the number of nodes is not limited.
+	NodeLimit int
+	// TimeLimit is the maximum run time of the search. If TimeLimit is
+	// zero, the run time is not limited.
+	TimeLimit time.Duration
+
+	// Incumbent, if not nil, is called every time a new best integer
+	// solution is found with the objective value and location of the
+	// solution. The search is stopped if Incumbent returns true. The
+	// slice x must not be retained.
+	Incumbent func(f float64, x []float64) (stop bool)
+}
+
+// MILPResult holds the result of BranchAndBound.
+type MILPResult struct {
+	// F and X are the objective value and location of the best integer
+	// solution found.
+	F float64
+	X []float64
+	// Bound is a lower bound on the optimal objective value.
+	Bound float64
+	// Status is the termination status of the search.
+	Status Status
+	// Nodes is the number of nodes that were explored.
+	Nodes int
+	// Cuts is the number of Gomory cuts added to the root relaxation.
+	Cuts int
+}
+
+// BranchAndBound solves a mixed-integer linear program using the
+// branch-and-bound method. At each node the linear programming relaxation of
+// the problem is solved with the Simplex algorithm, warm started from the
+// basis of the parent node, and the node is split on the most fractional
+// integer variable. Optionally, rounds of Gomory mixed-integer cuts are added
+// to the root relaxation before branching.
+//
+// If settings is nil, the zero value of MILPSettings is used.
+//
+// ErrInfeasible is returned if the problem has no integer feasible solution
+// and ErrUnbounded is returned if the relaxation is unbounded. If the search
+// is terminated before an integer feasible solution is found, the result is
+// returned along with ErrNoIncumbent.
+//
+// A description of branch-and-bound and Gomory cuts can be found in
+//  Wolsey, Laurence A. "Integer Programming." Wiley, New York (1998).
+func BranchAndBound(p *MILP, settings *MILPSettings) (*MILPResult, error) {
+	if settings == nil {
+		settings = &MILPSettings{}
+	}
+	s := newMILPSolver(p, settings)
+	start := time.Now()
+
+	res := &MILPResult{
+		F:     math.Inf(1),
+		Bound: math.Inf(-1),
+	}
+
+	// Solve the root relaxation.
+	f, x, basis, err := s.relax(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < settings.GomoryRounds; i++ {
+		nCuts := s.addGomoryCuts(x, basis)
+		if nCuts == 0 {
+			break
+		}
+		res.Cuts += nCuts
+		// Each added cut has a basic slack variable.
+		for k := 0; k < nCuts; k++ {
+			basis = append(basis, s.n+len(s.h)-nCuts+k)
+		}
+		f, x, basis, err = s.relax(nil, basis)
+		if err != nil {
+			return nil, err
+		}
+	}
+	res.Bound = f
+
+	var open nodeQueue
+	if settings.NodeSelection == DepthFirst {
+		open = &nodeStack{}
+	} else {
+		open = &nodeHeap{}
+	}
+	open.push(&node{basis: basis, bound: f})
+
+	res.Status = Optimal
+	for open.len() > 0 {
+		if settings.NodeLimit > 0 && res.Nodes >= settings.NodeLimit {
+			res.Status = NodeLimit
+			break
+		}
+		if settings.TimeLimit > 0 && time.Since(start) > settings.TimeLimit {
+			res.Status = TimeLimit
+			break
+		}
+		nd := open.pop()
+		if s.prune(nd.bound, res.F) {
+			continue
+		}
+		res.Nodes++
+
+		f, x, basis, err := s.relax(nd.branches, nd.basis)
+		if err != nil {
+			if err == ErrInfeasible {
+				continue
+			}
+			return nil, err
+		}
+		if s.prune(f, res.F) {
+			continue
+		}
+
+		j := s.branchVar(x)
+		if j < 0 {
+			// The relaxed solution is integer feasible.
+			for i, v := range x {
+				if s.kind(i).isInteger() {
+					x[i] = math.Round(v)
+				}
+			}
+			res.F = f
+			res.X = x
+			if settings.Incumbent != nil && settings.Incumbent(f, x) {
+				res.Status = Stopped
+				break
+			}
+			continue
+		}
+
+		down := &node{
+			branches: appendBranch(nd.branches, branch{idx: j, upper: true, val: math.Floor(x[j])}),
+			basis:    basis,
+			bound:    f,
+			depth:    nd.depth + 1,
+		}
+		up := &node{
+			branches: appendBranch(nd.branches, branch{idx: j, upper: false, val: math.Ceil(x[j])}),
+			basis:    basis,
+			bound:    f,
+			depth:    nd.depth + 1,
+		}
+		// Explore the branch nearest to the relaxed value first when
+		// searching depth first.
+		if x[j]-math.Floor(x[j]) < 0.5 {
+			open.push(up)
+			open.push(down)
+		} else {
+			open.push(down)
+			open.push(up)
+		}
+	}
+
+	if res.Status == Optimal {
+		res.Bound = res.F
+	} else {
+		bound := res.F
+		for _, nd := range open.nodes() {
+			bound = math.Min(bound, nd.bound)
+		}
+		res.Bound = bound
+	}
+	if res.X == nil {
+		if res.Status == Optimal {
+			return nil, ErrInfeasible
+		}
+		return res, ErrNoIncumbent
+	}
+	return res, nil
+}
+
+// branch is a bound on a single variable added when branching.
+type branch struct {
+	idx   int     // The index of the bounded variable.
+	upper bool    // Whether the bound is x[idx] <= val or x[idx] >= val.
+	val   float64 // The value of the bound.
+}
+
+// appendBranch returns a new slice containing the branches followed by b.
+func appendBranch(branches []branch, b branch) []branch {
+	dst := make([]branch, len(branches)+1)
+	copy(dst, branches)
+	dst[len(branches)] = b
+	return dst
+}
+
+// node is a subproblem of the branch-and-bound search.
+type node struct {
+	branches []branch
+	basis    []int   // The optimal basis of the parent relaxation.
+	bound    float64 // The optimal value of the parent relaxation.
+	depth    int
+}
+
+// milpSolver holds the state of the branch-and-bound search.
+type milpSolver struct {
+	p      *MILP
+	n      int
+	tol    float64
+	intTol float64
+	gap    float64
+
+	// g and h are the rows of the inequality constraints at the root,
+	// including bounds on binary variables and Gomory cuts.
+	g [][]float64
+	h []float64
+}
+
+func newMILPSolver(p *MILP, settings *MILPSettings) *milpSolver {
+	n := len(p.C)
+	if p.Kind != nil && len(p.Kind) != n {
+		panic(badShape)
+	}
+	nIneq := len(p.H)
+	if p.G == nil {
+		if nIneq != 0 {
+			panic(badShape)
+		}
+	} else {
+		r, c := p.G.Dims()
+		if r != nIneq || c != n {
+			panic(badShape)
+		}
+	}
+	if p.A == nil {
+		if len(p.B) != 0 {
+			panic(badShape)
+		}
+	} else {
+		r, c := p.A.Dims()
+		if r != len(p.B) || c != n {
+			panic(badShape)
+		}
+	}
+
+	s := &milpSolver{
+		p:      p,
+		n:      n,
+		tol:    settings.Tol,
+		intTol: settings.IntTol,
+		gap:    settings.Gap,
+	}
+	if s.intTol == 0 {
+		s.intTol = defaultIntTol
+	}
+	if s.gap == 0 {
+		s.gap = defaultGap
+	}
+	for i := 0; i < nIneq; i++ {
+		s.g = append(s.g, mat.Row(nil, i, p.G))
+		s.h = append(s.h, p.H[i])
+	}
+	for i := 0; i < n; i++ {
+		if s.kind(i) == Binary {
+			row := make([]float64, n)
+			row[i] = 1
+			s.g = append(s.g, row)
+			s.h = append(s.h, 1)
+		}
+	}
+	return s
+}
+
+func (s *milpSolver) kind(i int) VarKind {
+	if s.p.Kind == nil {
+		return Continuous
+	}
+	return s.p.Kind[i]
+}
+
+// prune returns whether a node with the given bound cannot improve on the
+// incumbent value.
+func (s *milpSolver) prune(bound, incumbent float64) bool {
+	if math.IsInf(incumbent, 1) {
+		return false
+	}
+	return bound >= incumbent-s.gap*math.Max(1, math.Abs(incumbent))
+}
+
+// branchVar returns the index of the most fractional integer variable in x,
+// or -1 if all integer variables take integer values.
+func (s *milpSolver) branchVar(x []float64) int {
+	idx := -1
+	best := s.intTol
+	for i, v := range x {
+		if !s.kind(i).isInteger() {
+			continue
+		}
+		frac := math.Abs(v - math.Round(v))
+		if frac > best {
+			idx = i
+			best = frac
+		}
+	}
+	return idx
+}
+
+// standardForm returns the standard form of the relaxation with the given
+// branches. The variables of the standard form LP are x followed by a slack
+// variable for each root inequality and each branch in order, so the basis
+// of a parent relaxation is a valid set of indices for its children.
+func (s *milpSolver) standardForm(branches []branch) (c []float64, a *mat.Dense, b []float64) {
+	n := s.n
+	nIneq := len(s.h) + len(branches)
+	nEq := len(s.p.B)
+
+	c = make([]float64, n+nIneq)
+	copy(c, s.p.C)
+
+	b = make([]float64, nIneq+nEq)
+	copy(b, s.h)
+
+	a = mat.NewDense(nIneq+nEq, n+nIneq, nil)
+	for i, row := range s.g {
+		a.SetRow(i, append(row[:n:n], make([]float64, nIneq)...))
+		a.Set(i, n+i, 1)
+	}
+	for k, br := range branches {
+		i := len(s.h) + k
+		if br.upper {
+			a.Set(i, br.idx, 1)
+			b[i] = br.val
+		} else {
+			a.Set(i, br.idx, -1)
+			b[i] = -br.val
+		}
+		a.Set(i, n+i, 1)
+	}
+	if nEq != 0 {
+		a.Slice(nIneq, nIneq+nEq, 0, n).(*mat.Dense).Copy(s.p.A)
+		copy(b[nIneq:], s.p.B)
+	}
+	return c, a, b
+}
+
+// relax solves the relaxation with the given branches, starting from the
+// parent basis if it is not nil. The returned basis includes the slack
+// variable of the most recent branch.
+func (s *milpSolver) relax(branches []branch, parent []int) (f float64, x []float64, basis []int, err error) {
+	c, a, b := s.standardForm(branches)
+	var guess []int
+	if parent != nil {
+		m, _ := a.Dims()
+		guess = make([]int, len(parent), m)
+		copy(guess, parent)
+		// The slack variables of the new branches complete the basis.
+		for k := len(guess); k < m; k++ {
+			guess = append(guess, s.n+len(s.h)+len(branches)-(m-k))
+		}
+	}
+	f, xStd, basis, err := warmSimplex(guess, c, a, b, s.tol)
+	if err != nil {
+		return f, nil, nil, err
+	}
+	return f, xStd[:s.n], basis, nil
+}
+
+// addGomoryCuts adds a Gomory mixed-integer cut to the root inequalities
+// for each integer variable that takes a fractional value in the optimal
+// basis of the root relaxation, and returns the number of cuts added.
+func (s *milpSolver) addGomoryCuts(x []float64, basis []int) int {
+	if basis == nil {
+		return 0
+	}
+	n := s.n
+	nIneq := len(s.h)
+	_, a, _ := s.standardForm(nil)
+	m, nStd := a.Dims()
+
+	ab := mat.NewDense(m, m, nil)
+	extractColumns(ab, a, basis)
+	var lu mat.LU
+	lu.Factorize(ab)
+	if lu.Det() == 0 {
+		return 0
+	}
+
+	isBasic := make([]bool, nStd)
+	for _, v := range basis {
+		isBasic[v] = true
+	}
+
+	var cuts [][]float64
+	var rhs []float64
+	e := mat.NewVecDense(m, nil)
+	var y mat.VecDense
+	row := make([]float64, nStd)
+	for i, v := range basis {
+		if v >= n || !s.kind(v).isInteger() {
+			continue
+		}
+		f0 := x[v] - math.Floor(x[v])
+		if f0 < gomoryFracTol || f0 > 1-gomoryFracTol {
+			continue
+		}
+
+		// Compute the row of the simplex tableau for the basic variable,
+		// row = e_iᵀ * ab^-1 * a.
+		e.Zero()
+		e.SetVec(i, 1)
+		err := lu.SolveVecTo(&y, true, e)
+		if err != nil {
+			continue
+		}
+		for k := range row {
+			row[k] = 0
+			if isBasic[k] {
+				continue
+			}
+			row[k] = mat.Dot(&y, a.ColView(k))
+		}
+
+		// Construct the cut Σ alpha_k * xt_k >= 1 over the non-basic
+		// variables of the standard form.
+		alpha := make([]float64, nStd)
+		for k, ak := range row {
+			if isBasic[k] || math.Abs(ak) < gomoryZeroTol {
+				continue
+			}
+			if k < n && s.kind(k).isInteger() {
+				fk := ak - math.Floor(ak)
+				if fk <= f0 {
+					alpha[k] = fk / f0
+				} else {
+					alpha[k] = (1 - fk) / (1 - f0)
+				}
+				continue
+			}
+			if ak >= 0 {
+				alpha[k] = ak / f0
+			} else {
+				alpha[k] = -ak / (1 - f0)
+			}
+		}
+
+		// Substitute the slack variables, s = h - G * x, to express the
+		// cut in the original variables as cut * x <= r.
+		cut := make([]float64, n)
+		floats.ScaleTo(cut, -1, alpha[:n])
+		r := -1.0
+		for k := 0; k < nIneq; k++ {
+			beta := alpha[n+k]
+			if beta == 0 {
+				continue
+			}
+			floats.AddScaled(cut, beta, s.g[k])
+			r += beta * s.h[k]
+		}
+		var nonZero bool
+		for k, v := range cut {
+			if math.Abs(v) < gomoryZeroTol {
+				cut[k] = 0
+				continue
+			}
+			nonZero = true
+		}
+		if !nonZero {
+			continue
+		}
+		cuts = append(cuts, cut)
+		rhs = append(rhs, r)
+	}
+	s.g = append(s.g, cuts...)
+	s.h = append(s.h, rhs...)
+	return len(cuts)
+}
+
+// nodeQueue is a collection of open branch-and-bound nodes.
+type nodeQueue interface {
+	push(*node)
+	pop() *node
+	len() int
+	nodes() []*node
+}
+
+// nodeStack is a nodeQueue that implements depth-first node selection.
+type nodeStack []*node
+
+func (s *nodeStack) push(n *node) { *s = append(*s, n) }
+func (s *nodeStack) pop() *node {
+	n := (*s)[len(*s)-1]
+	*s = (*s)[:len(*s)-1]
+	return n
+}
+func (s *nodeStack) len() int       { return len(*s) }
+func (s *nodeStack) nodes() []*node { return *s }
+
+// nodeHeap is a nodeQueue that implements best-bound node selection.
+// Ties are broken in favor of deeper nodes.
+type nodeHeap []*node
+
+func (h *nodeHeap) push(n *node)   { heap.Push(h, n) }
+func (h *nodeHeap) pop() *node     { return heap.Pop(h).(*node) }
+func (h *nodeHeap) len() int       { return len(*h) }
+func (h *nodeHeap) nodes() []*node { return *h }
+
+func (h nodeHeap) Len() int { return len(h) }
+func (h nodeHeap) Less(i, j int) bool {
+	if h[i].bound == h[j].bound {
+		return h[i].depth > h[j].depth
+	}
+	return h[i].bound < h[j].bound
+}
+func (h nodeHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *nodeHeap) Push(x interface{}) { *h = append(*h, x.(*node)) }
+func (h *nodeHeap) Pop() interface{} {
+	old := *h
+	n := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return n
+}
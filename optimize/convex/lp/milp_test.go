@@ -0,0 +1,239 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func TestBranchAndBound(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		p    MILP
+		f    float64
+		x    []float64
+	}{
+		{
+			// maximize 5x + 4y s.t. 6x + 4y <= 24, x + 2y <= 6.
+			// The relaxed optimum is (3, 1.5).
+			name: "Wolsey",
+			p: MILP{
+				C:    []float64{-5, -4},
+				G:    mat.NewDense(2, 2, []float64{6, 4, 1, 2}),
+				H:    []float64{24, 6},
+				Kind: []VarKind{Integer, Integer},
+			},
+			f: -20,
+			x: []float64{4, 0},
+		},
+		{
+			// maximize x + y s.t. -x + y <= 1, 3x + 2y <= 12, 2x + 3y <= 12.
+			name: "Fractional",
+			p: MILP{
+				C:    []float64{-1, -1},
+				G:    mat.NewDense(3, 2, []float64{-1, 1, 3, 2, 2, 3}),
+				H:    []float64{1, 12, 12},
+				Kind: []VarKind{Integer, Integer},
+			},
+			f: -4,
+		},
+		{
+			// Knapsack with weights 12, 2, 1, 1, 4 and values 4, 2, 1, 2, 10.
+			name: "Knapsack",
+			p: MILP{
+				C:    []float64{-4, -2, -1, -2, -10},
+				G:    mat.NewDense(1, 5, []float64{12, 2, 1, 1, 4}),
+				H:    []float64{15},
+				Kind: []VarKind{Binary, Binary, Binary, Binary, Binary},
+			},
+			f: -15,
+			x: []float64{0, 1, 1, 1, 1},
+		},
+		{
+			// Mixed problem with an equality constraint.
+			// minimize -x - 2y - z s.t. x + y + z = 3.5, y <= 1.5, x <= 2.
+			name: "Mixed",
+			p: MILP{
+				C:    []float64{-1, -2, -1},
+				G:    mat.NewDense(2, 3, []float64{0, 1, 0, 1, 0, 0}),
+				H:    []float64{1.5, 2},
+				A:    mat.NewDense(1, 3, []float64{1, 1, 1}),
+				B:    []float64{3.5},
+				Kind: []VarKind{Continuous, Integer, Continuous},
+			},
+			f: -4.5,
+		},
+	} {
+		for _, sel := range []NodeSelection{BestBound, DepthFirst} {
+			for _, rounds := range []int{0, 3} {
+				res, err := BranchAndBound(&test.p, &MILPSettings{NodeSelection: sel, GomoryRounds: rounds})
+				if err != nil {
+					t.Errorf("%s (selection %d, rounds %d): unexpected error: %v", test.name, sel, rounds, err)
+					continue
+				}
+				if res.Status != Optimal {
+					t.Errorf("%s (selection %d, rounds %d): unexpected status: %v", test.name, sel, rounds, res.Status)
+				}
+				if !scalar.EqualWithinAbsOrRel(res.F, test.f, 1e-10, 1e-10) {
+					t.Errorf("%s (selection %d, rounds %d): unexpected optimum: got %v, want %v", test.name, sel, rounds, res.F, test.f)
+				}
+				if test.x != nil && !floats.EqualApprox(res.X, test.x, 1e-10) {
+					t.Errorf("%s (selection %d, rounds %d): unexpected location: got %v, want %v", test.name, sel, rounds, res.X, test.x)
+				}
+				if !scalar.EqualWithinAbsOrRel(res.F, floats.Dot(res.X, test.p.C), 1e-10, 1e-10) {
+					t.Errorf("%s (selection %d, rounds %d): objective does not match location", test.name, sel, rounds)
+				}
+			}
+		}
+	}
+}
+
+func TestBranchAndBoundInfeasible(t *testing.T) {
+	t.Parallel()
+	// 2x = 1 has no integer solution.
+	p := &MILP{
+		C:    []float64{1},
+		A:    mat.NewDense(1, 1, []float64{2}),
+		B:    []float64{1},
+		Kind: []VarKind{Integer},
+	}
+	_, err := BranchAndBound(p, nil)
+	if err != ErrInfeasible {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrInfeasible)
+	}
+
+	// 2x + 2y = 3 has no integer solution but a feasible relaxation.
+	p = &MILP{
+		C:    []float64{1, 1},
+		A:    mat.NewDense(1, 2, []float64{2, 2}),
+		B:    []float64{3},
+		Kind: []VarKind{Integer, Integer},
+	}
+	_, err = BranchAndBound(p, nil)
+	if err != ErrInfeasible {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrInfeasible)
+	}
+}
+
+func TestBranchAndBoundTermination(t *testing.T) {
+	t.Parallel()
+	p := &MILP{
+		C:    []float64{-4, -2, -1, -2, -10},
+		G:    mat.NewDense(1, 5, []float64{12, 2, 1, 1, 4}),
+		H:    []float64{15},
+		Kind: []VarKind{Binary, Binary, Binary, Binary, Binary},
+	}
+
+	res, err := BranchAndBound(p, &MILPSettings{NodeLimit: 1})
+	if err != ErrNoIncumbent {
+		t.Errorf("unexpected error with node limit: got %v, want %v", err, ErrNoIncumbent)
+	}
+	if res == nil || res.Status != NodeLimit {
+		t.Errorf("unexpected result with node limit: %+v", res)
+	} else if res.Bound > -15 {
+		t.Errorf("bound %v is not a lower bound on the optimum", res.Bound)
+	}
+
+	var calls int
+	last := math.Inf(1)
+	res, err = BranchAndBound(p, &MILPSettings{
+		NodeSelection: DepthFirst,
+		Incumbent: func(f float64, x []float64) bool {
+			calls++
+			if f >= last {
+				t.Errorf("incumbent did not improve: %v >= %v", f, last)
+			}
+			last = f
+			return true
+		},
+	})
+	if err != nil {
+		t.Errorf("unexpected error with stopping callback: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("unexpected number of callback calls: got %d, want 1", calls)
+	}
+	if res.Status != Stopped {
+		t.Errorf("unexpected status: got %v, want %v", res.Status, Stopped)
+	}
+	if res.Bound > res.F {
+		t.Errorf("bound %v is greater than the incumbent %v", res.Bound, res.F)
+	}
+}
+
+func TestBranchAndBoundRandom(t *testing.T) {
+	t.Parallel()
+	const (
+		n    = 3
+		m    = 3
+		maxX = 4
+	)
+	rnd := rand.New(rand.NewSource(1))
+	for test := 0; test < 50; test++ {
+		// Bound each variable by maxX and add random constraints that
+		// are satisfied at the origin.
+		g := mat.NewDense(n+m, n, nil)
+		h := make([]float64, n+m)
+		for i := 0; i < n; i++ {
+			g.Set(i, i, 1)
+			h[i] = maxX
+		}
+		for i := n; i < n+m; i++ {
+			for j := 0; j < n; j++ {
+				g.Set(i, j, math.Round(10*rnd.NormFloat64())/10)
+			}
+			h[i] = rnd.Float64() * 5
+		}
+		c := make([]float64, n)
+		for i := range c {
+			c[i] = math.Round(10*rnd.NormFloat64()) / 10
+		}
+		p := &MILP{C: c, G: g, H: h, Kind: []VarKind{Integer, Integer, Integer}}
+
+		// Find the optimum by enumeration.
+		want := math.Inf(1)
+		x := make([]float64, n)
+		gx := make([]float64, n+m)
+		for i0 := 0; i0 <= maxX; i0++ {
+			for i1 := 0; i1 <= maxX; i1++ {
+				for i2 := 0; i2 <= maxX; i2++ {
+					x[0], x[1], x[2] = float64(i0), float64(i1), float64(i2)
+					mat.NewVecDense(n+m, gx).MulVec(g, mat.NewVecDense(n, x))
+					feasible := true
+					for i, v := range gx {
+						if v > h[i]+1e-12 {
+							feasible = false
+							break
+						}
+					}
+					if feasible {
+						want = math.Min(want, floats.Dot(c, x))
+					}
+				}
+			}
+		}
+
+		for _, sel := range []NodeSelection{BestBound, DepthFirst} {
+			for _, rounds := range []int{0, 2} {
+				res, err := BranchAndBound(p, &MILPSettings{NodeSelection: sel, GomoryRounds: rounds})
+				if err != nil {
+					t.Errorf("test %d (selection %d, rounds %d): unexpected error: %v", test, sel, rounds, err)
+					continue
+				}
+				if !scalar.EqualWithinAbsOrRel(res.F, want, 1e-8, 1e-8) {
+					t.Errorf("test %d (selection %d, rounds %d): unexpected optimum: got %v, want %v", test, sel, rounds, res.F, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp_test
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize/convex/lp"
+)
+
+func ExampleBranchAndBound() {
+	// Maximize 5x + 4y subject to 6x + 4y <= 24 and x + 2y <= 6 for
+	// non-negative integer x and y.
+	p := &lp.MILP{
+		C:    []float64{-5, -4},
+		G:    mat.NewDense(2, 2, []float64{6, 4, 1, 2}),
+		H:    []float64{24, 6},
+		Kind: []lp.VarKind{lp.Integer, lp.Integer},
+	}
+
+	res, err := lp.BranchAndBound(p, &lp.MILPSettings{GomoryRounds: 2})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("opt: %v\n", res.F)
+	fmt.Printf("x: %v\n", res.X)
+	// Output:
+	// opt: -20
+	// x: [4 0]
+}
@@ -0,0 +1,263 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Model is a linear program with bounds on the constraints and the variables.
+// The problem described by a Model is
+//  minimize   cᵀ * x + offset
+//  s.t.       rowLower <= A * x <= rowUpper
+//             lower <= x <= upper
+//             x_i integer if Kind[i] is Integer or Binary
+// or the equivalent maximization problem if Maximize is true. Infinite bounds
+// are represented by ±Inf. A constraint with equal lower and upper bounds is
+// an equality constraint, and a constraint with both bounds infinite is a
+// free row that does not constrain the problem.
+//
+// Model is the representation of linear programs used when reading and
+// writing files in the formats of the optimize/convex/lp/formats packages.
+type Model struct {
+	// Name is the name of the problem.
+	Name string
+
+	// Maximize specifies whether the objective is maximized rather than
+	// minimized.
+	Maximize bool
+	// ObjName is the name of the objective.
+	ObjName string
+	// C holds the objective coefficients and Offset the constant term
+	// of the objective.
+	C      []float64
+	Offset float64
+
+	// VarNames holds the names of the variables.
+	VarNames []string
+	// Lower and Upper hold the bounds on the variables.
+	Lower, Upper []float64
+	// Kind holds the domain of each variable. If Kind is nil, all
+	// variables are continuous.
+	Kind []VarKind
+
+	// RowNames holds the names of the constraints.
+	RowNames []string
+	// A is the constraint matrix. A may be nil if there are no
+	// constraints.
+	A *mat.Dense
+	// RowLower and RowUpper hold the bounds on the constraints.
+	RowLower, RowUpper []float64
+}
+
+// Dims returns the number of constraints and variables of the model.
+func (m *Model) Dims() (rows, vars int) {
+	return len(m.RowLower), len(m.C)
+}
+
+// General returns the model as a general-form LP that can be passed to
+// Convert,
+//  minimize cᵀ * x
+//  s.t      G * x <= h
+//           A * x = b.
+// Finite bounds on the variables and ranged constraints are represented by
+// inequality constraints, and free rows are omitted. The objective is negated
+// if the model is maximized, and the constant offset is not included. If
+// there are no constraints of a given type, the corresponding returned values
+// are nil. Kind is ignored.
+func (m *Model) General() (c []float64, g mat.Matrix, h []float64, a mat.Matrix, b []float64) {
+	_, nVar := m.Dims()
+	m.checkDims()
+
+	c = make([]float64, nVar)
+	copy(c, m.C)
+	if m.Maximize {
+		for i, v := range c {
+			c[i] = -v
+		}
+	}
+
+	gRows, h, aRows, b := m.constraintRows()
+	for j := 0; j < nVar; j++ {
+		lo, up := m.Lower[j], m.Upper[j]
+		if !math.IsInf(up, 1) {
+			r := make([]float64, nVar)
+			r[j] = 1
+			gRows = append(gRows, r)
+			h = append(h, up)
+		}
+		if !math.IsInf(lo, -1) {
+			r := make([]float64, nVar)
+			r[j] = -1
+			gRows = append(gRows, r)
+			h = append(h, -lo)
+		}
+	}
+	if len(gRows) != 0 {
+		g = denseFromRows(gRows, nVar)
+	}
+	if len(aRows) != 0 {
+		a = denseFromRows(aRows, nVar)
+	}
+	return c, g, h, a, b
+}
+
+// MILP returns the model as a mixed-integer linear program that can be passed
+// to BranchAndBound. Non-zero lower bounds, finite upper bounds other than
+// those of binary variables, and ranged constraints are represented by
+// inequality constraints, and free rows are omitted. The objective is negated
+// if the model is maximized, and the constant offset is not included.
+//
+// The variables of a MILP are non-negative, so a variable with a negative
+// lower bound is shifted by the bound, rounded up to an integer for integer
+// variables. The variables of the model are x = y + shift, where y are the
+// variables of the returned MILP, and the objective of the model is
+// cᵀ * y + cᵀ * shift + offset. If no variable is shifted, shift is nil.
+//
+// MILP returns ErrNegativeVar if a variable other than a binary variable has
+// no lower bound.
+func (m *Model) MILP() (p *MILP, shift []float64, err error) {
+	_, nVar := m.Dims()
+	m.checkDims()
+
+	p = &MILP{
+		C:    make([]float64, nVar),
+		Kind: make([]VarKind, nVar),
+	}
+	copy(p.C, m.C)
+	if m.Maximize {
+		for i, v := range p.C {
+			p.C[i] = -v
+		}
+	}
+	copy(p.Kind, m.Kind)
+
+	for j, lo := range m.Lower {
+		if lo >= 0 || p.Kind[j] == Binary {
+			continue
+		}
+		if math.IsInf(lo, -1) {
+			return nil, nil, ErrNegativeVar
+		}
+		if shift == nil {
+			shift = make([]float64, nVar)
+		}
+		if p.Kind[j] == Integer {
+			lo = math.Ceil(lo)
+		}
+		shift[j] = lo
+	}
+
+	var gRows, aRows [][]float64
+	gRows, p.H, aRows, p.B = m.constraintRows()
+	if shift != nil {
+		for i, r := range gRows {
+			p.H[i] -= floats.Dot(r, shift)
+		}
+		for i, r := range aRows {
+			p.B[i] -= floats.Dot(r, shift)
+		}
+	}
+	for j := 0; j < nVar; j++ {
+		lo, up := m.Lower[j], m.Upper[j]
+		if shift != nil {
+			lo -= shift[j]
+			up -= shift[j]
+		}
+		if p.Kind[j] == Binary {
+			// The bounds 0 <= x <= 1 are added by BranchAndBound.
+			lo = math.Max(lo, 0)
+			if up >= 1 {
+				up = math.Inf(1)
+			}
+		}
+		if p.Kind[j] == Integer && lo == 0 && up == 1 {
+			p.Kind[j] = Binary
+			up = math.Inf(1)
+		}
+		if !math.IsInf(up, 1) {
+			r := make([]float64, nVar)
+			r[j] = 1
+			gRows = append(gRows, r)
+			p.H = append(p.H, up)
+		}
+		if lo > 0 {
+			r := make([]float64, nVar)
+			r[j] = -1
+			gRows = append(gRows, r)
+			p.H = append(p.H, -lo)
+		}
+	}
+	if len(gRows) != 0 {
+		p.G = denseFromRows(gRows, nVar)
+	}
+	if len(aRows) != 0 {
+		p.A = denseFromRows(aRows, nVar)
+	}
+	return p, shift, nil
+}
+
+// constraintRows returns the rows of the inequality and equality constraints
+// of the general-form LP corresponding to the constraints of the model.
+func (m *Model) constraintRows() (gRows [][]float64, h []float64, aRows [][]float64, b []float64) {
+	nRow, nVar := m.Dims()
+	for i := 0; i < nRow; i++ {
+		lo, up := m.RowLower[i], m.RowUpper[i]
+		row := mat.Row(nil, i, m.A)
+		if lo == up {
+			aRows = append(aRows, row)
+			b = append(b, up)
+			continue
+		}
+		if !math.IsInf(up, 1) {
+			gRows = append(gRows, row)
+			h = append(h, up)
+		}
+		if !math.IsInf(lo, -1) {
+			neg := make([]float64, nVar)
+			for j, v := range row {
+				neg[j] = -v
+			}
+			gRows = append(gRows, neg)
+			h = append(h, -lo)
+		}
+	}
+	return gRows, h, aRows, b
+}
+
+func (m *Model) checkDims() {
+	nRow, nVar := m.Dims()
+	if len(m.Lower) != nVar || len(m.Upper) != nVar {
+		panic(badShape)
+	}
+	if m.Kind != nil && len(m.Kind) != nVar {
+		panic(badShape)
+	}
+	if len(m.RowUpper) != nRow {
+		panic(badShape)
+	}
+	if nRow == 0 {
+		return
+	}
+	if m.A == nil {
+		panic(badShape)
+	}
+	r, c := m.A.Dims()
+	if r != nRow || c != nVar {
+		panic(badShape)
+	}
+}
+
+// denseFromRows returns a dense matrix with the given rows.
+func denseFromRows(rows [][]float64, n int) *mat.Dense {
+	d := mat.NewDense(len(rows), n, nil)
+	for i, r := range rows {
+		d.SetRow(i, r)
+	}
+	return d
+}
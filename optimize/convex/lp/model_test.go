@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lp
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func TestModelMILPShift(t *testing.T) {
+	t.Parallel()
+	inf := math.Inf(1)
+	// maximize x + 2y + z
+	// s.t.     x + y + z <= 1
+	//          x - y = 1
+	//          -2.5 <= x <= 3, y >= -3.5 integer, z binary.
+	// Eliminating x gives maximize 3y + z + 1 s.t. 2y + z <= 0, so the
+	// optimum is 1 at x = 1, y = 0, z = 0.
+	m := &Model{
+		Maximize: true,
+		C:        []float64{1, 2, 1},
+		Lower:    []float64{-2.5, -3.5, -1},
+		Upper:    []float64{3, inf, 1},
+		Kind:     []VarKind{Continuous, Integer, Binary},
+		A:        mat.NewDense(2, 3, []float64{1, 1, 1, 1, -1, 0}),
+		RowLower: []float64{-inf, 1},
+		RowUpper: []float64{1, 1},
+	}
+	p, shift, err := m.MILP()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []float64{-2.5, -3, 0}; !floats.Equal(shift, want) {
+		t.Errorf("unexpected shift: got:%v want:%v", shift, want)
+	}
+	res, err := BranchAndBound(p, nil)
+	if err != nil {
+		t.Fatalf("unexpected error solving: %v", err)
+	}
+	x := make([]float64, len(shift))
+	floats.AddTo(x, res.X, shift)
+	if f := -(res.F + floats.Dot(p.C, shift)); !scalar.EqualWithinAbs(f, 1, 1e-10) {
+		t.Errorf("unexpected optimum: got:%v want:1", f)
+	}
+	if want := []float64{1, 0, 0}; !floats.EqualApprox(x, want, 1e-10) {
+		t.Errorf("unexpected location: got:%v want:%v", x, want)
+	}
+
+	free := *m
+	free.Lower = []float64{-inf, 0, 0}
+	_, _, err = free.MILP()
+	if !errors.Is(err, ErrNegativeVar) {
+		t.Errorf("unexpected error for free variable: got:%v want:%v", err, ErrNegativeVar)
+	}
+}
@@ -24,13 +24,15 @@ import (
 // number is not inf and the equation solved "well", should keep moving.
 
 var (
-	ErrBland      = errors.New("lp: bland: all replacements are negative or cause ill-conditioned ab")
-	ErrInfeasible = errors.New("lp: problem is infeasible")
-	ErrLinSolve   = errors.New("lp: linear solve failure")
-	ErrUnbounded  = errors.New("lp: problem is unbounded")
-	ErrSingular   = errors.New("lp: A is singular")
-	ErrZeroColumn = errors.New("lp: A has a column of all zeros")
-	ErrZeroRow    = errors.New("lp: A has a row of all zeros")
+	ErrBland       = errors.New("lp: bland: all replacements are negative or cause ill-conditioned ab")
+	ErrInfeasible  = errors.New("lp: problem is infeasible")
+	ErrLinSolve    = errors.New("lp: linear solve failure")
+	ErrNegativeVar = errors.New("lp: model variable has no lower bound")
+	ErrNoIncumbent = errors.New("lp: no integer feasible solution found before termination")
+	ErrUnbounded   = errors.New("lp: problem is unbounded")
+	ErrSingular    = errors.New("lp: A is singular")
+	ErrZeroColumn  = errors.New("lp: A has a column of all zeros")
+	ErrZeroRow     = errors.New("lp: A has a row of all zeros")
 )
 
 const badShape = "lp: size mismatch"
@@ -299,6 +301,41 @@ func simplex(initialBasic []int, c []float64, A mat.Matrix, b []float64, tol flo
 	return opt, xopt, basicIdxs, err
 }
 
+// warmSimplex solves the standard form LP as simplex does, but uses guess as
+// the starting set of basic indices. If guess is not a feasible basis it is
+// used to initialize the Phase I problem instead of a set of linearly
+// independent columns found from scratch. If guess is unsuitable, warmSimplex
+// falls back to solving the LP without a guess.
+func warmSimplex(guess []int, c []float64, A mat.Matrix, b []float64, tol float64) (float64, []float64, []int, error) {
+	m, n := A.Dims()
+	if len(guess) != m || m == n {
+		return simplex(nil, c, A, b, tol)
+	}
+	err := verifyInputs(nil, c, A, b)
+	if err != nil {
+		return simplex(nil, c, A, b, tol)
+	}
+	ab := mat.NewDense(m, m, nil)
+	extractColumns(ab, A, guess)
+	if mat.Cond(ab, 1) > 1e12 {
+		return simplex(nil, c, A, b, tol)
+	}
+	basicIdxs := make([]int, m)
+	copy(basicIdxs, guess)
+	basicIdxs, ab, _, err = findInitialBasicFrom(A, b, basicIdxs)
+	if err != nil {
+		if err == ErrInfeasible {
+			return math.NaN(), nil, nil, ErrInfeasible
+		}
+		return simplex(nil, c, A, b, tol)
+	}
+	// Make sure that the basis passes the feasibility check in simplex.
+	if initializeFromBasic(make([]float64, m), ab, b) != nil {
+		return simplex(nil, c, A, b, tol)
+	}
+	return simplex(basicIdxs, c, A, b, tol)
+}
+
 // computeMove computes how far can be moved replacing each index. The results
 // are stored into move.
 func computeMove(move []float64, minIdx int, A mat.Matrix, ab *mat.Dense, xb []float64, nonBasicIdx []int) error {
@@ -491,11 +528,19 @@ func extractColumns(dst *mat.Dense, A mat.Matrix, cols []int) {
 // findInitialBasic finds an initial basic solution, and returns the basic
 // indices, ab, and xb.
 func findInitialBasic(A mat.Matrix, b []float64) ([]int, *mat.Dense, []float64, error) {
-	m, n := A.Dims()
+	m, _ := A.Dims()
 	basicIdxs := findLinearlyIndependent(A)
 	if len(basicIdxs) != m {
 		return nil, nil, nil, ErrSingular
 	}
+	return findInitialBasicFrom(A, b, basicIdxs)
+}
+
+// findInitialBasicFrom finds an initial basic solution starting from the
+// linearly independent set of columns in basicIdxs, and returns the basic
+// indices, ab, and xb. The contents of basicIdxs may be modified.
+func findInitialBasicFrom(A mat.Matrix, b []float64, basicIdxs []int) ([]int, *mat.Dense, []float64, error) {
+	m, n := A.Dims()
 
 	// It may be that this linearly independent basis is also a feasible set. If
 	// so, the Phase I problem can be avoided.
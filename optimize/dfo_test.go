@@ -0,0 +1,227 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/optimize/functions"
+)
+
+func TestBOBYQA(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		f      func([]float64) float64
+		x      []float64
+		method *BOBYQA
+		want   []float64
+		tol    float64
+	}{
+		{
+			name:   "Beale",
+			f:      functions.Beale{}.Func,
+			x:      []float64{1, 1},
+			method: &BOBYQA{},
+			want:   []float64{3, 0.5},
+			tol:    1e-5,
+		},
+		{
+			name:   "Rosenbrock",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      []float64{-1.2, 1, -1.2, 1},
+			method: &BOBYQA{},
+			want:   []float64{1, 1, 1, 1},
+			tol:    1e-5,
+		},
+		{
+			name:   "RosenbrockMinimalPoints",
+			f:      functions.ExtendedRosenbrock{}.Func,
+			x:      []float64{-1.2, 1},
+			method: &BOBYQA{InterpolationPoints: 4},
+			want:   []float64{1, 1},
+			tol:    1e-5,
+		},
+		{
+			name:   "Wood",
+			f:      functions.Wood{}.Func,
+			x:      []float64{-3, -1, -3, -1},
+			method: &BOBYQA{},
+			want:   []float64{1, 1, 1, 1},
+			tol:    1e-5,
+		},
+		{
+			// The unconstrained minimum of the Rosenbrock function at
+			// [1 1] is outside the bounds, so the constrained minimum
+			// is on the boundary.
+			name: "RosenbrockBounds",
+			f:    functions.ExtendedRosenbrock{}.Func,
+			x:    []float64{-1, 1},
+			method: &BOBYQA{
+				Lower: []float64{-2, -2},
+				Upper: []float64{0.5, 2},
+			},
+			want: []float64{0.5, 0.25},
+			tol:  1e-5,
+		},
+		{
+			// The initial location is outside the bounds.
+			name: "QuadraticBounds",
+			f: func(x []float64) float64 {
+				return (x[0]-1)*(x[0]-1) + 10*(x[1]+2)*(x[1]+2) + 3*x[0]*x[1]
+			},
+			x: []float64{5, 5},
+			method: &BOBYQA{
+				Lower: []float64{-1, -1},
+				Upper: []float64{1, 1},
+			},
+			want: []float64{1, -1},
+			tol:  1e-6,
+		},
+	} {
+		var outside bool
+		problem := Problem{
+			Func: func(x []float64) float64 {
+				for i, v := range x {
+					l, u := bounds(test.method.Lower, test.method.Upper, i)
+					if v < l || u < v {
+						outside = true
+					}
+				}
+				return test.f(x)
+			},
+		}
+		settings := &Settings{Converger: NeverTerminate{}}
+		result, err := Minimize(problem, test.x, settings, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Status != MethodConverge {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, MethodConverge)
+		}
+		if outside {
+			t.Errorf("%s: function evaluated outside the bounds", test.name)
+		}
+		if !floats.EqualApprox(result.X, test.want, test.tol) {
+			t.Errorf("%s: minimum not found: got %v, want %v", test.name, result.X, test.want)
+		}
+		if result.F != test.f(result.X) {
+			t.Errorf("%s: function value mismatch: got %v, want %v", test.name, result.F, test.f(result.X))
+		}
+	}
+}
+
+func TestCOBYLA(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name        string
+		f           func([]float64) float64
+		constraints []func([]float64) float64
+		x           []float64
+		want        []float64
+		tol         float64
+	}{
+		{
+			name: "HalfPlane",
+			f:    func(x []float64) float64 { return x[0]*x[0] + x[1]*x[1] },
+			constraints: []func([]float64) float64{
+				func(x []float64) float64 { return x[0] + x[1] - 1 },
+			},
+			x:    []float64{3, -2},
+			want: []float64{0.5, 0.5},
+			tol:  1e-5,
+		},
+		{
+			name: "Disk",
+			f:    func(x []float64) float64 { return -x[0] * x[1] },
+			constraints: []func([]float64) float64{
+				func(x []float64) float64 { return 1 - x[0]*x[0] - x[1]*x[1] },
+			},
+			x:    []float64{1, 1},
+			want: []float64{math.Sqrt2 / 2, math.Sqrt2 / 2},
+			tol:  1e-5,
+		},
+		{
+			name: "Simplex",
+			f: func(x []float64) float64 {
+				return (x[0]-1)*(x[0]-1) + (x[1]-1)*(x[1]-1) + (x[2]+1)*(x[2]+1)
+			},
+			constraints: []func([]float64) float64{
+				func(x []float64) float64 { return x[0] },
+				func(x []float64) float64 { return x[1] },
+				func(x []float64) float64 { return x[2] },
+				func(x []float64) float64 { return 1 - x[0] - x[1] - x[2] },
+			},
+			x:    []float64{0, 0, 0},
+			want: []float64{0.5, 0.5, 0},
+			tol:  1e-5,
+		},
+		{
+			name: "Unconstrained",
+			f: func(x []float64) float64 {
+				return (x[0]-1)*(x[0]-1) + 10*(x[1]+2)*(x[1]+2) + x[0]*x[1]
+			},
+			x:    []float64{0, 0},
+			want: []float64{80.0 / 39, -82.0 / 39},
+			tol:  1e-4,
+		},
+	} {
+		method := &COBYLA{Constraints: test.constraints}
+		settings := &Settings{Converger: NeverTerminate{}}
+		result, err := Minimize(Problem{Func: test.f}, test.x, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if result.Status != MethodConverge {
+			t.Errorf("%s: unexpected status: got %v, want %v", test.name, result.Status, MethodConverge)
+		}
+		if !floats.EqualApprox(result.X, test.want, test.tol) {
+			t.Errorf("%s: minimum not found: got %v, want %v", test.name, result.X, test.want)
+		}
+		for i, c := range test.constraints {
+			if v := c(result.X); v < -1e-8 {
+				t.Errorf("%s: constraint %d violated: got %v", test.name, i, v)
+			}
+		}
+	}
+}
+
+func TestDerivativeFreeInitValues(t *testing.T) {
+	t.Parallel()
+	// A known initial function value must not be evaluated again.
+	for name, method := range map[string]Method{
+		"BOBYQA": &BOBYQA{},
+		"COBYLA": &COBYLA{},
+	} {
+		initX := []float64{-1.2, 1}
+		problem := Problem{Func: functions.ExtendedRosenbrock{}.Func}
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			FuncEvaluations: 100,
+		}
+		want, err := Minimize(problem, initX, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		settings.InitValues = &Location{F: problem.Func(initX)}
+		settings.FuncEvaluations = 99
+		got, err := Minimize(problem, initX, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error with initial values: %v", name, err)
+			continue
+		}
+		if got.FuncEvaluations != want.FuncEvaluations-1 {
+			t.Errorf("%s: unexpected number of evaluations: got %d, want %d", name, got.FuncEvaluations, want.FuncEvaluations-1)
+		}
+		if !floats.Equal(got.X, want.X) {
+			t.Errorf("%s: location mismatch with initial values: got %v, want %v", name, got.X, want.X)
+		}
+	}
+}
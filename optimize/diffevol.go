@@ -0,0 +1,241 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var (
+	_ Statuser = (*DifferentialEvolution)(nil)
+	_ Method   = (*DifferentialEvolution)(nil)
+)
+
+// DifferentialEvolution implements the differential evolution global
+// optimization method described in
+//  Storn, R., and Price, K. "Differential evolution - a simple and efficient
+//  heuristic for global optimization over continuous spaces." Journal of
+//  Global Optimization 11.4 (1997): 341-359.
+// Differential evolution maintains a population of candidate locations. In
+// every generation, a trial location is created for each member of the
+// population by adding the scaled difference of two other members to a base
+// member and crossing the result over with the member. The trial location
+// replaces the member if its function value is not worse.
+//
+// All of the trial locations of a generation are evaluated before the
+// population is updated, so the sequence of generations does not depend on
+// the number of concurrent evaluations.
+//
+// Trial locations are projected onto the bounds given by Lower and Upper.
+// The initial population consists of the initial location and random samples
+// which are uniformly distributed for variables with finite lower and upper
+// bounds, and normally distributed around the initial location otherwise.
+type DifferentialEvolution struct {
+	// Lower and Upper specify the bounds on the variables. If Lower (Upper)
+	// is nil, the variables have no lower (upper) bound. If they are not nil,
+	// they must have length equal to the problem dimension.
+	Lower, Upper []float64
+	// Population sets the population size. If Population is 0, a default
+	// value of max(10*dim, 20) is used. Population must not be negative or
+	// less than 4, otherwise DifferentialEvolution will panic.
+	Population int
+	// Mutation is the scale factor of the difference vector. If Mutation is
+	// 0, a default value of 0.8 is used. Mutation must not be negative.
+	Mutation float64
+	// Crossover is the crossover probability. If Crossover is 0, a default
+	// value of 0.9 is used. Crossover must be in [0, 1].
+	Crossover float64
+	// MutateBest, when true, uses the best member of the population as the
+	// base member (DE/best/1/bin) instead of a random member (DE/rand/1/bin).
+	MutateBest bool
+	// InitStepSize is the standard deviation of the initial population for
+	// variables without finite lower and upper bounds. If InitStepSize is 0,
+	// a default value of 1 is used. InitStepSize must not be negative.
+	InitStepSize float64
+	// StopSpread sets the threshold for stopping the optimization when the
+	// difference between the largest and smallest function values in the
+	// population is less than StopSpread. If StopSpread is 0, a default value
+	// of 1e-12 is used. If StopSpread is NaN, the stopping criterion is not
+	// used.
+	StopSpread float64
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/exp/rand is used.
+	Src rand.Source
+
+	dim       int
+	pop       int
+	mutation  float64
+	crossover float64
+	rnd       randFuncs
+
+	x0     []float64
+	init   bool // whether the initial population is being evaluated
+	xs     *mat.Dense
+	fs     []float64
+	best   int
+	status Status
+
+	runner batchRunner
+}
+
+func (*DifferentialEvolution) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+// Status returns the status of the method.
+func (de *DifferentialEvolution) Status() (Status, error) {
+	return de.status, nil
+}
+
+func (de *DifferentialEvolution) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(de.Lower, de.Upper, dim)
+
+	de.dim = dim
+	de.pop = de.Population
+	switch {
+	case de.pop == 0:
+		de.pop = 10 * dim
+		if de.pop < 20 {
+			de.pop = 20
+		}
+	case de.pop < 4:
+		panic("differential evolution: population too small")
+	}
+	de.mutation = de.Mutation
+	switch {
+	case de.mutation == 0:
+		de.mutation = 0.8
+	case de.mutation < 0:
+		panic("differential evolution: negative mutation")
+	}
+	de.crossover = de.Crossover
+	switch {
+	case de.crossover == 0:
+		de.crossover = 0.9
+	case de.crossover < 0 || 1 < de.crossover:
+		panic("differential evolution: crossover probability out of range")
+	}
+	if de.InitStepSize < 0 {
+		panic("differential evolution: negative initial step size")
+	}
+	de.rnd = newRandFuncs(de.Src)
+
+	de.x0 = resize(de.x0, dim)
+	de.init = true
+	de.xs = mat.NewDense(de.pop, dim, nil)
+	de.fs = resize(de.fs, de.pop)
+	de.best = 0
+	de.status = NotTerminated
+
+	de.runner.init(dim, de.pop)
+	return min(tasks, de.pop)
+}
+
+func (de *DifferentialEvolution) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	copy(de.x0, tasks[0].X)
+	de.runner.run(de, operations, results, tasks)
+}
+
+func (de *DifferentialEvolution) batchSize() int {
+	return de.pop
+}
+
+func (de *DifferentialEvolution) sample(i int, x []float64) {
+	if de.init {
+		if i == 0 {
+			copy(x, de.x0)
+			clip(x, de.Lower, de.Upper)
+			return
+		}
+		step := de.InitStepSize
+		if step == 0 {
+			step = 1
+		}
+		sampleInitial(x, de.x0, de.Lower, de.Upper, step, de.rnd)
+		return
+	}
+
+	// Choose the base member and two distinct other members.
+	base := de.best
+	if !de.MutateBest {
+		base = de.distinct(i, -1, -1)
+	}
+	r1 := de.distinct(i, base, -1)
+	r2 := de.distinct(i, base, r1)
+
+	xi := de.xs.RawRowView(i)
+	xb := de.xs.RawRowView(base)
+	x1 := de.xs.RawRowView(r1)
+	x2 := de.xs.RawRowView(r2)
+	jrand := de.rnd.intn(de.dim)
+	for j := range x {
+		if j == jrand || de.rnd.float64() < de.crossover {
+			x[j] = xb[j] + de.mutation*(x1[j]-x2[j])
+		} else {
+			x[j] = xi[j]
+		}
+	}
+	clip(x, de.Lower, de.Upper)
+}
+
+// distinct returns a random member index that is different from a, b and c.
+func (de *DifferentialEvolution) distinct(a, b, c int) int {
+	for {
+		r := de.rnd.intn(de.pop)
+		if r != a && r != b && r != c {
+			return r
+		}
+	}
+}
+
+func (de *DifferentialEvolution) update(xs *mat.Dense, fs []float64) bool {
+	if de.init {
+		de.xs.Copy(xs)
+		copy(de.fs, fs)
+		de.init = false
+	} else {
+		for i, f := range fs {
+			// Trial locations replace members with NaN function values.
+			if f <= de.fs[i] || math.IsNaN(de.fs[i]) {
+				de.fs[i] = f
+				copy(de.xs.RawRowView(i), xs.RawRowView(i))
+			}
+		}
+	}
+
+	lo := math.Inf(1)
+	hi := math.Inf(-1)
+	de.best = 0
+	for i, f := range de.fs {
+		if f < de.fs[de.best] || math.IsNaN(de.fs[de.best]) {
+			de.best = i
+		}
+		lo = math.Min(lo, f)
+		hi = math.Max(hi, f)
+	}
+
+	stop := de.StopSpread
+	switch {
+	case math.IsNaN(stop):
+		return false
+	case stop == 0:
+		stop = 1e-12
+	}
+	if hi-lo < stop {
+		de.status = MethodConverge
+		return true
+	}
+	return false
+}
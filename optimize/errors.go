@@ -32,6 +32,22 @@ var (
 	// lies out of allowed bounds.
 	ErrLinesearcherBound = errors.New("linesearch: step out of bounds")
 
+	// ErrTrustRegionRadius signifies that a trust-region method cannot make
+	// further progress because the trust-region radius has become too small
+	// to change the location due to floating-point arithmetic.
+	ErrTrustRegionRadius = errors.New("optimize: trust-region radius too small")
+
+	// ErrNonFiniteFunc signifies that a method based on models of the
+	// objective function received a function value that is NaN or infinite
+	// and cannot be used in the model.
+	ErrNonFiniteFunc = errors.New("optimize: non-finite function value")
+
+	// ErrDegenerateModel signifies that a method based on interpolation
+	// models of the objective function cannot continue because the
+	// interpolation points have become degenerate due to floating-point
+	// arithmetic.
+	ErrDegenerateModel = errors.New("optimize: degenerate interpolation points")
+
 	// ErrMissingGrad signifies that a Method requires a Gradient function that
 	// is not supplied by Problem.
 	ErrMissingGrad = errors.New("optimize: problem does not provide needed Grad function")
@@ -39,6 +55,10 @@ var (
 	// ErrMissingHess signifies that a Method requires a Hessian function that
 	// is not supplied by Problem.
 	ErrMissingHess = errors.New("optimize: problem does not provide needed Hess function")
+
+	// ErrMissingHessVec signifies that a Method requires a HessVec function
+	// that is not supplied by Problem.
+	ErrMissingHessVec = errors.New("optimize: problem does not provide needed HessVec function")
 )
 
 // ErrFunc is returned when an initial function value is invalid. The error
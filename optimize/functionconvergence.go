@@ -17,8 +17,9 @@ type Converger interface {
 }
 
 var (
-	_ Converger = NeverTerminate{}
-	_ Converger = (*FunctionConverge)(nil)
+	_ Converger  = NeverTerminate{}
+	_ Converger  = (*FunctionConverge)(nil)
+	_ StateSaver = (*FunctionConverge)(nil)
 )
 
 // NeverTerminate implements Converger, always reporting NotTerminated.
@@ -79,3 +80,33 @@ func (fc *FunctionConverge) Converged(l *Location) Status {
 	}
 	return FunctionConvergence
 }
+
+// functionConvergeState is the saved state of a FunctionConverge.
+type functionConvergeState struct {
+	First bool
+	Best  float64
+	Iter  int
+}
+
+// SaveState returns the best function value and the number of iterations
+// since its last significant decrease.
+func (fc *FunctionConverge) SaveState() ([]byte, error) {
+	return encodeState(functionConvergeState{
+		First: fc.first,
+		Best:  fc.best,
+		Iter:  fc.iter,
+	})
+}
+
+// LoadState restores a state saved by SaveState.
+func (fc *FunctionConverge) LoadState(state []byte) error {
+	var st functionConvergeState
+	err := decodeState(state, &st)
+	if err != nil {
+		return err
+	}
+	fc.first = st.First
+	fc.best = st.Best
+	fc.iter = st.Iter
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 
 var (
 	_ Method          = (*LBFGS)(nil)
+	_ Checkpointer    = (*LBFGS)(nil)
 	_ localMethod     = (*LBFGS)(nil)
 	_ NextDirectioner = (*LBFGS)(nil)
 )
@@ -42,6 +43,9 @@ type LBFGS struct {
 
 	ls *LinesearchMethod
 
+	started bool // Indicates that initLocal has been called in the current run.
+	resume  bool // Indicates that the run is resumed from a saved state.
+
 	dim  int       // Dimension of the problem
 	x    []float64 // Location at the last major iteration
 	grad []float64 // Gradient at the last major iteration
@@ -65,15 +69,25 @@ func (*LBFGS) Uses(has Available) (uses Available, err error) {
 func (l *LBFGS) Init(dim, tasks int) int {
 	l.status = NotTerminated
 	l.err = nil
+	l.started = false
+	l.resume = false
 	return 1
 }
 
 func (l *LBFGS) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
-	l.status, l.err = localOptimizer{}.run(l, l.GradStopThreshold, operation, result, tasks)
+	l.status, l.err = localOptimizer{resume: l.resume, started: l.started}.run(l, l.GradStopThreshold, operation, result, tasks)
 	close(operation)
 }
 
 func (l *LBFGS) initLocal(loc *Location) (Operation, error) {
+	l.initLinesearch()
+	l.started = true
+	return l.ls.Init(loc)
+}
+
+// initLinesearch sets the default values of the settings and prepares the
+// LinesearchMethod.
+func (l *LBFGS) initLinesearch() {
 	if l.Linesearcher == nil {
 		l.Linesearcher = &Bisection{}
 	}
@@ -86,8 +100,64 @@ func (l *LBFGS) initLocal(loc *Location) (Operation, error) {
 	}
 	l.ls.Linesearcher = l.Linesearcher
 	l.ls.NextDirectioner = l
+}
 
-	return l.ls.Init(loc)
+// lbfgsState is the saved state of an LBFGS optimization run.
+type lbfgsState struct {
+	Started bool
+	X       []float64
+	Grad    []float64
+	Oldest  int
+	Y, S    [][]float64
+	Rho     []float64
+}
+
+// SaveState returns the state of the optimization run, which consists of the
+// location and gradient at the last major iteration and the history of the
+// updates of the inverse Hessian approximation.
+func (l *LBFGS) SaveState() ([]byte, error) {
+	if !l.started {
+		return encodeState(lbfgsState{})
+	}
+	return encodeState(lbfgsState{
+		Started: true,
+		X:       l.x,
+		Grad:    l.grad,
+		Oldest:  l.oldest,
+		Y:       l.y,
+		S:       l.s,
+		Rho:     l.rho,
+	})
+}
+
+// LoadState restores the state of an optimization run saved by SaveState.
+// LoadState returns ErrCheckpointMismatch if Store differs from the saved
+// run.
+func (l *LBFGS) LoadState(state []byte) error {
+	var st lbfgsState
+	err := decodeState(state, &st)
+	if err != nil {
+		return err
+	}
+	l.resume = true
+	l.started = st.Started
+	if !st.Started {
+		return nil
+	}
+	l.initLinesearch()
+	if len(st.Y) != l.Store || len(st.S) != l.Store || len(st.Rho) != l.Store || len(st.Grad) != len(st.X) {
+		return ErrCheckpointMismatch
+	}
+	l.dim = len(st.X)
+	l.x = st.X
+	l.grad = st.Grad
+	l.oldest = st.Oldest
+	l.y = st.Y
+	l.s = st.S
+	l.rho = st.Rho
+	l.a = resize(l.a, l.Store)
+	l.ls.resume(l.dim)
+	return nil
 }
 
 func (l *LBFGS) iterateLocal(loc *Location) (Operation, error) {
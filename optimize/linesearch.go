@@ -53,6 +53,18 @@ func (ls *LinesearchMethod) Init(loc *Location) (Operation, error) {
 	return ls.initNextLinesearch(loc)
 }
 
+// resume prepares ls to continue an optimization run of dimension dim after
+// a MajorIteration, with the state of the NextDirectioner already restored.
+func (ls *LinesearchMethod) resume(dim int) {
+	ls.x = resize(ls.x, dim)
+	ls.dir = resize(ls.dir, dim)
+	ls.first = false
+	ls.nextMajor = false
+	ls.eval = NoOperation
+	ls.lastStep = math.NaN()
+	ls.lastOp = MajorIteration
+}
+
 func (ls *LinesearchMethod) Iterate(loc *Location) (Operation, error) {
 	switch ls.lastOp {
 	case NoOperation:
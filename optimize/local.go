@@ -11,33 +11,44 @@ import (
 )
 
 // localOptimizer is a helper type for running an optimization using a LocalMethod.
-type localOptimizer struct{}
+type localOptimizer struct {
+	// resume indicates that the optimization run is resumed at the
+	// MajorIteration in the first task, and started indicates that the
+	// method was initialized by initLocal before that MajorIteration.
+	resume, started bool
+}
 
 // run controls the optimization run for a localMethod. The calling method
 // must close the operation channel at the conclusion of the optimization. This
 // provides a happens before relationship between the return of status and the
 // closure of operation, and thus a call to method.Status (if necessary).
 func (l localOptimizer) run(method localMethod, gradThresh float64, operation chan<- Task, result <-chan Task, tasks []Task) (Status, error) {
-	// Local methods start with a fully-specified initial location.
 	task := tasks[0]
-	task = l.initialLocation(operation, result, task, method)
-	if task.Op == PostIteration {
-		l.finish(operation, result)
-		return NotTerminated, nil
-	}
-	status, err := l.checkStartingLocation(task, gradThresh)
-	if err != nil {
-		l.finishMethodDone(operation, result, task)
-		return status, err
+	if l.resume && l.started {
+		// Continue the run after the MajorIteration in task.
+		return l.iterate(method, gradThresh, operation, result, task)
 	}
+	if !l.resume {
+		// Local methods start with a fully-specified initial location.
+		task = l.initialLocation(operation, result, task, method)
+		if task.Op == PostIteration {
+			l.finish(operation, result)
+			return NotTerminated, nil
+		}
+		status, err := l.checkStartingLocation(task, gradThresh)
+		if err != nil {
+			l.finishMethodDone(operation, result, task)
+			return status, err
+		}
 
-	// Send a major iteration with the starting location.
-	task.Op = MajorIteration
-	operation <- task
-	task = <-result
-	if task.Op == PostIteration {
-		l.finish(operation, result)
-		return NotTerminated, nil
+		// Send a major iteration with the starting location.
+		task.Op = MajorIteration
+		operation <- task
+		task = <-result
+		if task.Op == PostIteration {
+			l.finish(operation, result)
+			return NotTerminated, nil
+		}
 	}
 	op, err := method.initLocal(task.Location)
 	if err != nil {
@@ -46,17 +57,21 @@ func (l localOptimizer) run(method localMethod, gradThresh float64, operation ch
 	}
 	task.Op = op
 	operation <- task
-Loop:
+	return l.iterate(method, gradThresh, operation, result, <-result)
+}
+
+// iterate iterates the localMethod starting with the result r.
+func (l localOptimizer) iterate(method localMethod, gradThresh float64, operation chan<- Task, result <-chan Task, r Task) (Status, error) {
 	for {
-		r := <-result
 		switch r.Op {
 		case PostIteration:
-			break Loop
+			l.finish(operation, result)
+			return NotTerminated, nil
 		case MajorIteration:
 			// The last operation was a MajorIteration. Check if the gradient
 			// is below the threshold.
 			if status := l.checkGradientConvergence(r.Gradient, gradThresh); status != NotTerminated {
-				l.finishMethodDone(operation, result, task)
+				l.finishMethodDone(operation, result, r)
 				return GradientThreshold, nil
 			}
 			fallthrough
@@ -69,9 +84,8 @@ Loop:
 			r.Op = op
 			operation <- r
 		}
+		r = <-result
 	}
-	l.finish(operation, result)
-	return NotTerminated, nil
 }
 
 // initialOperation returns the Operation needed to fill the initial location
@@ -52,6 +52,18 @@ type Location struct {
 	// a new mat.SymDense will be allocated, if it is empty
 	// it will be resized to match the length of X.
 	Hessian *mat.SymDense
+	// HessVecDir is the vector multiplied by the Hessian
+	// at X in a HessVecEvaluation. It is set by the Method
+	// and its length must match the length of X.
+	HessVecDir []float64
+	// HessVec holds the product of the Hessian at X with
+	// HessVecDir. The length of HessVec must match the
+	// length of X or be zero.
+	HessVec []float64
+	// Batch holds the indices of the terms of the objective
+	// function that are evaluated in a BatchEvaluation. It is
+	// set by the Method.
+	Batch []int
 }
 
 // Method is a type which can search for an optimum of an objective function.
@@ -122,10 +134,11 @@ type Method interface {
 // The first argument represents the problem to be minimized. Its fields are
 // routines that evaluate the objective function, gradient, and other
 // quantities related to the problem. The objective function, p.Func, must not
-// be nil. The optimization method used may require other fields to be non-nil
-// as specified by method.Needs. Minimize will panic if these are not met. The
-// method can be determined automatically from the supplied problem which is
-// described below.
+// be nil unless the method only evaluates the objective function on
+// minibatches with p.BatchFunc and p.BatchGrad. The optimization method used
+// may require other fields to be non-nil as specified by method.Needs.
+// Minimize will panic if these are not met. The method can be determined
+// automatically from the supplied problem which is described below.
 //
 // If p.Status is not nil, it is called before every evaluation. If the
 // returned Status is other than NotTerminated or if the error is not nil, the
@@ -151,6 +164,11 @@ type Method interface {
 // Minimize returns a Result struct and any error that occurred. See the
 // documentation of Result for more information.
 //
+// An optimization run with a Method that implements Checkpointer can be
+// saved at major iterations by a Recorder that implements CheckpointRecorder,
+// for example CheckpointWriter, and resumed later from the saved Checkpoint
+// by setting Settings.Resume.
+//
 // See the documentation for Method for the details on implementing a method.
 //
 // Be aware that the default settings of Minimize are to accurately find the
@@ -175,14 +193,32 @@ func Minimize(p Problem, initX []float64, settings *Settings, method Method) (*R
 	optLoc := newLocation(dim) // This must have an allocated X field.
 	optLoc.F = math.Inf(1)
 
-	initOp, initLoc := getInitLocation(dim, initX, settings.InitValues)
-
 	converger := settings.Converger
 	if converger == nil {
 		converger = defaultFunctionConverge()
 	}
 	converger.Init(dim)
 
+	var (
+		initOp  Operation
+		initLoc *Location
+	)
+	if settings.Resume != nil {
+		initLoc, err = resumeCheckpoint(settings.Resume, dim, settings.InitValues, converger)
+		if err != nil {
+			return nil, err
+		}
+		initOp = MajorIteration
+		*stats = settings.Resume.Stats
+		// Account for the runtime of the original run.
+		startTime = startTime.Add(-stats.Runtime)
+		optLoc.F = initLoc.F
+		copy(optLoc.X, initLoc.X)
+		optLoc.Gradient = copyFloats(optLoc.Gradient, initLoc.Gradient)
+	} else {
+		initOp, initLoc = getInitLocation(dim, initX, settings.InitValues)
+	}
+
 	stats.Runtime = time.Since(startTime)
 
 	// Send initial location to Recorder
@@ -225,15 +261,28 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 		nTasks = 1
 	}
 	has := availFromProblem(*prob)
-	_, initErr := method.Uses(has)
+	uses, initErr := method.Uses(has)
 	if initErr != nil {
 		panic(fmt.Sprintf("optimize: specified method inconsistent with Problem: %v", initErr))
 	}
+	if prob.Func == nil && !uses.Batch {
+		panic(badProblem)
+	}
 	newNTasks := method.Init(dim, nTasks)
 	if newNTasks > nTasks {
 		panic("optimize: too many tasks returned by Method")
 	}
 	nTasks = newNTasks
+	if settings.Resume != nil {
+		checkpointer, ok := method.(Checkpointer)
+		if !ok {
+			panic("optimize: method cannot resume from a checkpoint")
+		}
+		err := checkpointer.LoadState(settings.Resume.Method)
+		if err != nil {
+			return Failure, err
+		}
+	}
 
 	// Launch the method. The method communicates tasks using the operations
 	// channel, and results is used to return the evaluated results.
@@ -318,8 +367,9 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	// Evaluate the Problem concurrently.
 	worker := func() {
 		x := make([]float64, dim)
+		v := make([]float64, dim)
 		for task := range workerChan {
-			evaluate(prob, task.Location, task.Op, x)
+			evaluate(prob, task.Location, task.Op, x, v)
 			statsChan <- task
 		}
 		// Signal successful worker completion.
@@ -337,6 +387,21 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 		finalError  error
 	)
 
+	// Checkpoints are only saved if both the Recorder and the Method
+	// support them, and the same Checkpoint is reused at every major
+	// iteration.
+	var (
+		checkpointRecorder CheckpointRecorder
+		checkpointer       Checkpointer
+		checkpoint         *Checkpoint
+	)
+	if r, ok := settings.Recorder.(CheckpointRecorder); ok {
+		if m, ok := method.(Checkpointer); ok {
+			checkpointRecorder, checkpointer = r, m
+			checkpoint = &Checkpoint{}
+		}
+	}
+
 	// Update optimization statistics and check convergence.
 	var methodDone bool
 	for task := range statsChan {
@@ -356,7 +421,7 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 		case NoOperation:
 			// Just send the task back.
 		case MajorIteration:
-			status = performMajorIteration(optLoc, task.Location, stats, converger, startTime, settings)
+			status = performMajorIteration(optLoc, task.Location, stats, method, converger, startTime, settings)
 		case MethodDone:
 			methodDone = true
 			status = MethodConverge
@@ -365,6 +430,9 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 			stats.Runtime = time.Since(startTime)
 			// Allow err to be overloaded if the Recorder fails.
 			err = settings.Recorder.Record(task.Location, task.Op, stats)
+			if err == nil && task.Op == MajorIteration && checkpoint != nil {
+				err = recordCheckpoint(checkpointRecorder, checkpointer, converger, checkpoint, task.Location, stats, done)
+			}
 			if err != nil {
 				status = Failure
 			}
@@ -404,6 +472,50 @@ func minimize(prob *Problem, method Method, settings *Settings, converger Conver
 	return finalStatus, finalError
 }
 
+// recordCheckpoint passes a checkpoint of the optimization run at the major
+// iteration at loc to recorder. The checkpoint is stored in dst.
+func recordCheckpoint(recorder CheckpointRecorder, method Checkpointer, converger Converger, dst *Checkpoint, loc *Location, stats *Stats, done <-chan struct{}) error {
+	select {
+	case <-done:
+		// The method may be collecting the final results, so its state
+		// does not correspond to the major iteration.
+		return nil
+	default:
+	}
+	return recorder.RecordCheckpoint(func() (*Checkpoint, error) {
+		err := newCheckpoint(dst, loc, stats, method, converger)
+		if err != nil {
+			return nil, err
+		}
+		return dst, nil
+	})
+}
+
+// resumeCheckpoint checks that the checkpoint c matches the problem dimension,
+// restores the state of the converger and returns a copy of the location of
+// the checkpoint.
+func resumeCheckpoint(c *Checkpoint, dim int, initValues *Location, converger Converger) (*Location, error) {
+	if initValues != nil {
+		panic("optimize: InitValues specified when resuming from a checkpoint")
+	}
+	if len(c.Location.X) != dim {
+		panic("optimize: checkpoint does not match problem dimension")
+	}
+	if c.Converger != nil {
+		s, ok := converger.(StateSaver)
+		if !ok {
+			panic("optimize: converger cannot resume from a checkpoint")
+		}
+		err := s.LoadState(c.Converger)
+		if err != nil {
+			return nil, err
+		}
+	}
+	loc := &Location{}
+	copyLocation(loc, &c.Location)
+	return loc, nil
+}
+
 func defaultFunctionConverge() *FunctionConverge {
 	return &FunctionConverge{
 		Absolute:   1e-10,
@@ -457,9 +569,12 @@ func getInitLocation(dim int, initX []float64, initValues *Location) (Operation,
 }
 
 func checkOptimization(p Problem, dim int, recorder Recorder) error {
-	if p.Func == nil {
+	if p.Func == nil && !availFromProblem(p).Batch {
 		panic(badProblem)
 	}
+	if (p.BatchFunc != nil || p.BatchGrad != nil) && p.Terms <= 0 {
+		panic("optimize: non-positive number of terms")
+	}
 	if dim <= 0 {
 		panic("optimize: impossible problem dimension")
 	}
@@ -479,15 +594,23 @@ func checkOptimization(p Problem, dim int, recorder Recorder) error {
 }
 
 // evaluate evaluates the routines specified by the Operation at loc.X, and stores
-// the answer into loc. loc.X is copied into x before evaluating in order to
-// prevent the routines from modifying it.
-func evaluate(p *Problem, loc *Location, op Operation, x []float64) {
+// the answer into loc. loc.X is copied into x (and loc.HessVecDir into v) before
+// evaluating in order to prevent the routines from modifying it.
+func evaluate(p *Problem, loc *Location, op Operation, x, v []float64) {
 	if !op.isEvaluation() {
 		panic(fmt.Sprintf("optimize: invalid evaluation %v", op))
 	}
 	copy(x, loc.X)
+	batch := op&BatchEvaluation != 0
+	if batch && op&(FuncEvaluation|GradEvaluation) == 0 {
+		panic(fmt.Sprintf("optimize: invalid evaluation %v", op))
+	}
 	if op&FuncEvaluation != 0 {
-		loc.F = p.Func(x)
+		if batch {
+			loc.F = p.BatchFunc(x, loc.Batch)
+		} else {
+			loc.F = p.Func(x)
+		}
 	}
 	if op&GradEvaluation != 0 {
 		// Make sure we have a destination in which to place the gradient.
@@ -498,7 +621,11 @@ func evaluate(p *Problem, loc *Location, op Operation, x []float64) {
 				loc.Gradient = loc.Gradient[:len(x)]
 			}
 		}
-		p.Grad(loc.Gradient, x)
+		if batch {
+			p.BatchGrad(loc.Gradient, x, loc.Batch)
+		} else {
+			p.Grad(loc.Gradient, x)
+		}
 	}
 	if op&HessEvaluation != 0 {
 		// Make sure we have a destination in which to place the Hessian.
@@ -510,6 +637,20 @@ func evaluate(p *Problem, loc *Location, op Operation, x []float64) {
 		}
 		p.Hess(loc.Hessian, x)
 	}
+	if op&HessVecEvaluation != 0 {
+		if len(loc.HessVecDir) != len(x) {
+			panic("optimize: HessVecDir does not match problem dimension")
+		}
+		copy(v, loc.HessVecDir)
+		if len(loc.HessVec) == 0 {
+			if cap(loc.HessVec) < len(x) {
+				loc.HessVec = make([]float64, len(x))
+			} else {
+				loc.HessVec = loc.HessVec[:len(x)]
+			}
+		}
+		p.HessVec(loc.HessVec, x, v)
+	}
 }
 
 // updateEvaluationStats updates the statistics based on the operation.
@@ -523,6 +664,9 @@ func updateEvaluationStats(stats *Stats, op Operation) {
 	if op&HessEvaluation != 0 {
 		stats.HessEvaluations++
 	}
+	if op&HessVecEvaluation != 0 {
+		stats.HessVecEvaluations++
+	}
 }
 
 // checkLocationConvergence checks if the current optimal location satisfies
@@ -564,6 +708,9 @@ func checkEvaluationLimits(p *Problem, stats *Stats, settings *Settings) (Status
 	if settings.HessEvaluations > 0 && stats.HessEvaluations >= settings.HessEvaluations {
 		return HessianEvaluationLimit, nil
 	}
+	if settings.HessVecEvaluations > 0 && stats.HessVecEvaluations >= settings.HessVecEvaluations {
+		return HessianVectorEvaluationLimit, nil
+	}
 	return NotTerminated, nil
 }
 
@@ -580,8 +727,9 @@ func checkIterationLimits(loc *Location, stats *Stats, settings *Settings) Statu
 
 // performMajorIteration does all of the steps needed to perform a MajorIteration.
 // It increments the iteration count, updates the optimal location, and checks
-// the necessary convergence criteria.
-func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Converger, startTime time.Time, settings *Settings) Status {
+// the necessary convergence criteria. If method is a lossAverager, its average
+// loss is used in place of the function value for the convergence criteria.
+func performMajorIteration(optLoc, loc *Location, stats *Stats, method Method, converger Converger, startTime time.Time, settings *Settings) Status {
 	optLoc.F = loc.F
 	copy(optLoc.X, loc.X)
 	if loc.Gradient == nil {
@@ -594,7 +742,13 @@ func performMajorIteration(optLoc, loc *Location, stats *Stats, converger Conver
 	}
 	stats.MajorIterations++
 	stats.Runtime = time.Since(startTime)
-	status := checkLocationConvergence(optLoc, settings, converger)
+	convLoc := optLoc
+	if a, ok := method.(lossAverager); ok {
+		avg := *optLoc
+		avg.F = a.averageLoss()
+		convLoc = &avg
+	}
+	status := checkLocationConvergence(convLoc, settings, converger)
 	if status != NotTerminated {
 		return status
 	}
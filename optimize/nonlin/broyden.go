@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Broyden implements Broyden's quasi-Newton method. The inverse of the
+// Jacobian matrix is evaluated at the initial location and then updated in
+// every iteration using Broyden's rank-one formula
+//  H += (s - H y) sᵀ H / (sᵀ H y),
+// where s is the step and y is the change in the residual. The quasi-Newton
+// step is globalized by a backtracking line search on the residual norm. When
+// the line search fails, the Jacobian matrix is evaluated again at the
+// current location.
+//
+// The method is described in
+//  Broyden, C. G.: A class of methods for solving nonlinear simultaneous
+//  equations. Mathematics of Computation 19.92 (1965): 577-593.
+type Broyden struct{}
+
+func (Broyden) solve(s *solver) (Status, error) {
+	n := s.dim()
+	var (
+		jac  = mat.NewDense(n, n, nil)
+		inv  mat.Dense
+		p    = mat.NewVecDense(n, nil)
+		y    = mat.NewVecDense(n, nil)
+		hy   = mat.NewVecDense(n, nil)
+		sh   = mat.NewVecDense(n, nil)
+		xNew = make([]float64, n)
+		fNew = make([]float64, n)
+	)
+	// fresh indicates whether the inverse is exact at the current location.
+	var fresh bool
+	needJac := true
+	for {
+		if needJac {
+			s.jacobian(jac)
+			err := inv.Inverse(jac)
+			if err != nil {
+				if _, ok := err.(mat.Condition); !ok {
+					return Failure, ErrSingular
+				}
+			}
+			needJac = false
+			fresh = true
+		}
+
+		// The quasi-Newton step is -H F.
+		p.MulVec(&inv, mat.NewVecDense(n, s.f))
+		p.ScaleVec(-1, p)
+		t, normNew, ok := s.lineSearch(xNew, fNew, p.RawVector().Data, 0)
+		if !ok {
+			if fresh {
+				return Failure, ErrNoProgress
+			}
+			needJac = true
+			continue
+		}
+
+		// Update the inverse with the step s = t p and y = F(x+s) - F(x).
+		p.ScaleVec(t, p)
+		floats.SubTo(y.RawVector().Data, fNew, s.f)
+		hy.MulVec(&inv, y)
+		sh.MulVec(inv.T(), p)
+		denom := mat.Dot(p, hy)
+		if denom != 0 && !math.IsInf(denom, 0) && !math.IsNaN(denom) {
+			hy.SubVec(p, hy)
+			inv.RankOne(&inv, 1/denom, hy, sh)
+		} else {
+			needJac = true
+		}
+		fresh = false
+
+		status := s.accept(xNew, fNew, normNew, mat.Norm(p, 2))
+		if status != NotTerminated {
+			return status, nil
+		}
+	}
+}
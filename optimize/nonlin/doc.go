@@ -0,0 +1,15 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package nonlin provides methods for solving systems of nonlinear equations
+//  F(x) = 0,
+// where F maps an n-dimensional vector to an n-dimensional vector.
+//
+// The system is specified by a Problem and solved from an initial location
+// by Solve using one of the methods Hybrid, Broyden or NewtonKrylov. Hybrid
+// is a robust general purpose method. Broyden needs fewer function
+// evaluations per iteration for problems close to linear, and NewtonKrylov
+// never forms the Jacobian matrix, which makes it suitable for large
+// systems.
+package nonlin // import "github.com/jingcheng-WU/gonum/optimize/nonlin"
@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/jingcheng-WU/gonum/optimize/nonlin"
+)
+
+func ExampleSolve() {
+	// Find the operating point of a circuit with a 5 V source, a 1 kΩ
+	// resistor and a diode in series. The unknowns are the voltage across
+	// the diode in V and the current in mA.
+	const (
+		source     = 5     // V
+		resistance = 1     // kΩ
+		saturation = 1e-11 // mA
+		thermal    = 0.025 // V
+	)
+	p := nonlin.Problem{
+		Func: func(dst, x []float64) {
+			v, i := x[0], x[1]
+			// Diode equation.
+			dst[0] = saturation*(math.Exp(v/thermal)-1) - i
+			// Kirchhoff's voltage law.
+			dst[1] = source - resistance*i - v
+		},
+	}
+	res, err := nonlin.Solve(p, []float64{0.6, 1}, nil, &nonlin.Hybrid{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("status: %v\n", res.Status)
+	fmt.Printf("diode voltage: %.4f V, current: %.4f mA\n", res.X[0], res.X[1])
+
+	// Output:
+	// status: FuncConvergence
+	// diode voltage: 0.6699 V, current: 4.3301 mA
+}
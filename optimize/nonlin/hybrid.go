@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Hybrid implements Powell's hybrid method, a trust-region method that
+// minimizes the linear model
+//  |F(x) + J p|
+// of the residual norm over steps p within the trust region. The step is
+// computed using the dogleg path between the steepest descent step and the
+// Newton step. To save evaluations of the Jacobian matrix J, it is updated
+// using Broyden's rank-one formula after every step, and it is evaluated
+// again only after repeated steps that do not reduce the residual as
+// predicted by the model.
+//
+// The method is described in
+//  Powell, M. J. D.: A hybrid method for nonlinear equations. In Numerical
+//  Methods for Nonlinear Algebraic Equations, Gordon and Breach (1970)
+// and is the method used in MINPACK by the routines HYBRD and HYBRJ.
+type Hybrid struct {
+	// InitRadius is the initial trust-region radius. If InitRadius is 0,
+	// it is defaulted to 100 times the norm of the initial location, or to
+	// 100 if the initial location is zero. InitRadius must not be negative.
+	InitRadius float64
+}
+
+func (h *Hybrid) solve(s *solver) (Status, error) {
+	n := s.dim()
+	radius := h.InitRadius
+	switch {
+	case radius < 0:
+		panic("nonlin: negative InitRadius")
+	case radius == 0:
+		radius = 100 * floats.Norm(s.x, 2)
+		if radius == 0 {
+			radius = 100
+		}
+	}
+
+	jac := mat.NewDense(n, n, nil)
+	s.jacobian(jac)
+
+	var (
+		lu     mat.LU
+		newton = mat.NewVecDense(n, nil)
+		g      = mat.NewVecDense(n, nil)
+		jg     = mat.NewVecDense(n, nil)
+		sd     = make([]float64, n)
+		p      = make([]float64, n)
+		jp     = mat.NewVecDense(n, nil)
+		xNew   = make([]float64, n)
+		fNew   = make([]float64, n)
+		u      = make([]float64, n)
+	)
+	var fails int
+	for {
+		f := mat.NewVecDense(n, s.f)
+
+		// Compute the steepest descent step for |F + J p|^2, which is the
+		// minimizer of the model along the negative gradient -Jᵀ F.
+		g.MulVec(jac.T(), f)
+		gnorm := floats.Norm(g.RawVector().Data, 2)
+		if gnorm == 0 {
+			// The location is a stationary point of the residual norm.
+			return Failure, ErrNoProgress
+		}
+		jg.MulVec(jac, g)
+		jgnorm := floats.Norm(jg.RawVector().Data, 2)
+		floats.ScaleTo(sd, -gnorm*gnorm/(jgnorm*jgnorm), g.RawVector().Data)
+
+		// Compute the Newton step if the Jacobian is not singular.
+		lu.Factorize(jac)
+		haveNewton := lu.Cond() < 1/machEps
+		if haveNewton {
+			err := lu.SolveVecTo(newton, false, f)
+			haveNewton = err == nil
+			newton.ScaleVec(-1, newton)
+		}
+		dogleg(p, newton.RawVector().Data, haveNewton, sd, radius)
+		pnorm := floats.Norm(p, 2)
+
+		floats.AddTo(xNew, s.x, p)
+		normNew := s.eval(fNew, xNew)
+
+		// Compare the actual and the predicted reduction of the squared
+		// residual norm.
+		jp.MulVec(jac, mat.NewVecDense(n, p))
+		floats.AddTo(u, s.f, jp.RawVector().Data)
+		modelNorm := floats.Norm(u, 2)
+		predicted := (s.norm - modelNorm) * (s.norm + modelNorm)
+		actual := (s.norm - normNew) * (s.norm + normNew)
+		ratio := -1.0
+		if predicted > 0 && !math.IsInf(normNew, 1) {
+			ratio = actual / predicted
+		}
+
+		// Update the trust-region radius.
+		switch {
+		case ratio < 0.25:
+			radius = 0.5 * math.Min(radius, pnorm)
+		case ratio >= 0.75:
+			radius = math.Max(radius, 2*pnorm)
+		}
+
+		// Update the Jacobian with Broyden's formula
+		//  J += (F(x+p) - F(x) - J p) pᵀ / pᵀp.
+		if !math.IsInf(normNew, 1) {
+			for i := range u {
+				u[i] = (fNew[i] - u[i]) / (pnorm * pnorm)
+			}
+			jac.RankOne(jac, 1, mat.NewVecDense(n, u), mat.NewVecDense(n, p))
+		}
+
+		if ratio >= 1e-4 {
+			status := s.accept(xNew, fNew, normNew, pnorm)
+			if status != NotTerminated {
+				return status, nil
+			}
+		} else if s.smallStep(radius) {
+			return StepConvergence, nil
+		}
+
+		// Evaluate the Jacobian again if the updates do not give
+		// sufficient progress.
+		if ratio < 0.1 {
+			fails++
+		} else {
+			fails = 0
+		}
+		if fails == 2 {
+			s.jacobian(jac)
+			fails = 0
+		}
+	}
+}
+
+// dogleg stores in p the point on the dogleg path from 0 through the steepest
+// descent step sd to the Newton step that is at the given radius, or the
+// Newton step if it is inside the trust region. If haveNewton is false, the
+// path ends at sd.
+func dogleg(p, newton []float64, haveNewton bool, sd []float64, radius float64) {
+	if haveNewton && floats.Norm(newton, 2) <= radius {
+		copy(p, newton)
+		return
+	}
+	sdnorm := floats.Norm(sd, 2)
+	if sdnorm >= radius {
+		floats.ScaleTo(p, radius/sdnorm, sd)
+		return
+	}
+	if !haveNewton {
+		copy(p, sd)
+		return
+	}
+	// Find τ in (0, 1] such that |sd + τ (newton - sd)| = radius.
+	floats.SubTo(p, newton, sd)
+	a := floats.Dot(p, p)
+	b := floats.Dot(sd, p)
+	c := sdnorm*sdnorm - radius*radius
+	tau := (-b + math.Sqrt(b*b-a*c)) / a
+	floats.AddScaledTo(p, sd, tau, p)
+}
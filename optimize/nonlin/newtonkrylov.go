@@ -0,0 +1,239 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+)
+
+// NewtonKrylov implements a Jacobian-free inexact Newton method. The Newton
+// equation
+//  J p = -F(x)
+// is solved approximately by the restarted GMRES method, where the products
+// of the Jacobian matrix J with vectors are approximated by finite
+// differences of F, so the Jacobian matrix is never formed and Problem.Jac
+// is not used. The linear iterations are terminated when the relative
+// residual of the Newton equation is below the forcing term, and the step is
+// globalized by a backtracking line search on the residual norm.
+//
+// The method is described in
+//  Knoll, D. A., Keyes, D. E.: Jacobian-free Newton–Krylov methods: a survey
+//  of approaches and applications. Journal of Computational Physics 193.2
+//  (2004): 357-397.
+type NewtonKrylov struct {
+	// Restart is the dimension of the Krylov subspace after which GMRES is
+	// restarted. If Restart is 0, it is defaulted to the minimum of the
+	// problem dimension and 30. Restart must not be negative.
+	Restart int
+
+	// MaxLinearIterations is the maximum number of GMRES iterations in
+	// every Newton iteration. If MaxLinearIterations is 0, it is defaulted
+	// to twice the problem dimension. MaxLinearIterations must not be
+	// negative.
+	MaxLinearIterations int
+
+	// Forcing is the forcing term, the tolerance on the relative residual
+	// of the Newton equation. If Forcing is 0, the forcing term is chosen
+	// adaptively using choice 2 of Eisenstat and Walker, which gives fast
+	// local convergence without oversolving the Newton equation far from
+	// the solution. Otherwise Forcing must be in (0, 1).
+	Forcing float64
+}
+
+func (nk *NewtonKrylov) solve(s *solver) (Status, error) {
+	n := s.dim()
+	restart := nk.Restart
+	switch {
+	case restart < 0:
+		panic("nonlin: negative Restart")
+	case restart == 0:
+		restart = n
+		if restart > 30 {
+			restart = 30
+		}
+	}
+	maxLinear := nk.MaxLinearIterations
+	switch {
+	case maxLinear < 0:
+		panic("nonlin: negative MaxLinearIterations")
+	case maxLinear == 0:
+		maxLinear = 2 * n
+	}
+	if nk.Forcing < 0 || 1 <= nk.Forcing {
+		panic("nonlin: forcing term out of range")
+	}
+
+	const (
+		gamma     = 0.9
+		alpha     = 2
+		etaMax    = 0.9
+		etaInit   = 0.5
+		etaSafety = 0.1
+	)
+	eta := nk.Forcing
+	if eta == 0 {
+		eta = etaInit
+	}
+
+	var (
+		p    = make([]float64, n)
+		b    = make([]float64, n)
+		xh   = make([]float64, n)
+		fh   = make([]float64, n)
+		xNew = make([]float64, n)
+		fNew = make([]float64, n)
+		g    = newGMRES(n, restart)
+	)
+	// jv approximates the product of the Jacobian at the current location
+	// with v by a forward difference.
+	jv := func(dst, v []float64) {
+		vnorm := floats.Norm(v, 2)
+		if vnorm == 0 {
+			for i := range dst {
+				dst[i] = 0
+			}
+			return
+		}
+		h := math.Sqrt(machEps) * (1 + floats.Norm(s.x, 2)) / vnorm
+		floats.AddScaledTo(xh, s.x, h, v)
+		s.eval(fh, xh)
+		floats.SubTo(dst, fh, s.f)
+		floats.Scale(1/h, dst)
+	}
+	for {
+		floats.ScaleTo(b, -1, s.f)
+		g.solve(p, jv, b, eta*s.norm, maxLinear)
+
+		t, normNew, ok := s.lineSearch(xNew, fNew, p, eta)
+		if !ok {
+			return Failure, ErrNoProgress
+		}
+
+		if nk.Forcing == 0 {
+			// Eisenstat–Walker choice 2 with safeguards.
+			prev := eta
+			eta = gamma * math.Pow(normNew/s.norm, alpha)
+			if safe := gamma * math.Pow(prev, alpha); safe > etaSafety {
+				eta = math.Max(eta, safe)
+			}
+			eta = math.Min(eta, etaMax)
+			// Avoid oversolving close to the solution.
+			eta = math.Max(eta, 0.5*s.funcTol/normNew)
+			eta = math.Min(eta, etaMax)
+		}
+
+		status := s.accept(xNew, fNew, normNew, t*floats.Norm(p, 2))
+		if status != NotTerminated {
+			return status, nil
+		}
+	}
+}
+
+// gmres holds the workspace of the restarted GMRES method.
+type gmres struct {
+	v      [][]float64 // orthonormal basis of the Krylov subspace
+	h      [][]float64 // upper Hessenberg matrix, stored by columns
+	cs, sn []float64   // Givens rotations
+	g      []float64   // rotated right-hand side
+	y      []float64
+	r, w   []float64
+}
+
+func newGMRES(n, m int) *gmres {
+	g := &gmres{
+		v:  make([][]float64, m+1),
+		h:  make([][]float64, m),
+		cs: make([]float64, m),
+		sn: make([]float64, m),
+		g:  make([]float64, m+1),
+		y:  make([]float64, m),
+		r:  make([]float64, n),
+		w:  make([]float64, n),
+	}
+	for i := range g.v {
+		g.v[i] = make([]float64, n)
+	}
+	for i := range g.h {
+		g.h[i] = make([]float64, m+1)
+	}
+	return g
+}
+
+// solve approximately solves A x = b starting from x = 0, where A is given by
+// the matrix-vector product matvec, until the residual norm is at most tol or
+// maxIter iterations have been performed. The result is stored in x.
+func (g *gmres) solve(x []float64, matvec func(dst, v []float64), b []float64, tol float64, maxIter int) {
+	m := len(g.h)
+	for i := range x {
+		x[i] = 0
+	}
+	copy(g.r, b)
+	beta := floats.Norm(g.r, 2)
+	var iter int
+	for beta > tol && iter < maxIter {
+		floats.ScaleTo(g.v[0], 1/beta, g.r)
+		for i := range g.g {
+			g.g[i] = 0
+		}
+		g.g[0] = beta
+
+		var k int
+		for k < m && iter < maxIter {
+			h := g.h[k]
+			matvec(g.w, g.v[k])
+			iter++
+			// Modified Gram–Schmidt orthogonalization.
+			for i := 0; i <= k; i++ {
+				h[i] = floats.Dot(g.w, g.v[i])
+				floats.AddScaled(g.w, -h[i], g.v[i])
+			}
+			h[k+1] = floats.Norm(g.w, 2)
+			if h[k+1] != 0 {
+				floats.ScaleTo(g.v[k+1], 1/h[k+1], g.w)
+			}
+			// Apply the previous rotations to the new column and compute
+			// a rotation that eliminates h[k+1].
+			for i := 0; i < k; i++ {
+				h[i], h[i+1] = g.cs[i]*h[i]+g.sn[i]*h[i+1], -g.sn[i]*h[i]+g.cs[i]*h[i+1]
+			}
+			d := math.Hypot(h[k], h[k+1])
+			if d == 0 {
+				break
+			}
+			g.cs[k], g.sn[k] = h[k]/d, h[k+1]/d
+			h[k], h[k+1] = d, 0
+			g.g[k], g.g[k+1] = g.cs[k]*g.g[k], -g.sn[k]*g.g[k]
+			k++
+			if math.Abs(g.g[k]) <= tol {
+				break
+			}
+		}
+		if k == 0 {
+			return
+		}
+
+		// Solve the upper triangular system and update x.
+		for i := k - 1; i >= 0; i-- {
+			sum := g.g[i]
+			for j := i + 1; j < k; j++ {
+				sum -= g.h[j][i] * g.y[j]
+			}
+			g.y[i] = sum / g.h[i][i]
+		}
+		for i := 0; i < k; i++ {
+			floats.AddScaled(x, g.y[i], g.v[i])
+		}
+		if math.Abs(g.g[k]) <= tol || iter >= maxIter {
+			return
+		}
+
+		// Restart with the true residual.
+		matvec(g.w, x)
+		floats.SubTo(g.r, b, g.w)
+		beta = floats.Norm(g.r, 2)
+	}
+}
@@ -0,0 +1,315 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jingcheng-WU/gonum/diff/fd"
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+const (
+	defaultFuncTol       = 1e-10
+	defaultStepTol       = 1e-12
+	defaultMaxIterations = 200
+
+	machEps = 1.0 / (1 << 52)
+)
+
+var (
+	// ErrNoProgress is returned when a method cannot reduce the norm of
+	// the residual any further, for example close to a local minimum of
+	// the norm that is not a root of F.
+	ErrNoProgress = errors.New("nonlin: no progress")
+	// ErrSingular is returned when the Jacobian matrix is singular and no
+	// step can be computed.
+	ErrSingular = errors.New("nonlin: singular Jacobian")
+	// ErrNonFinite is returned when the residual at the initial location
+	// is not finite.
+	ErrNonFinite = errors.New("nonlin: non-finite residual at the initial location")
+)
+
+// Problem describes a system of nonlinear equations F(x) = 0.
+type Problem struct {
+	// Func evaluates F at x and stores the result in dst. dst and x have
+	// the same length. Func must not modify x. Func is required.
+	Func func(dst, x []float64)
+
+	// Jac evaluates the Jacobian matrix of F at x and stores the result in
+	// dst, an n×n matrix. Jac must not modify x. If Jac is nil, the Jacobian
+	// is approximated by forward differences using fd.Jacobian for the
+	// methods that need it.
+	Jac func(dst *mat.Dense, x []float64)
+}
+
+// Settings holds the termination settings of Solve. A nil *Settings is
+// equivalent to the zero value, for which the defaults are used.
+type Settings struct {
+	// FuncTol is the tolerance on the Euclidean norm of the residual F(x).
+	// The iterations terminate with FuncConvergence when the norm is at
+	// most FuncTol. If FuncTol is zero, it is defaulted to 1e-10. FuncTol
+	// must not be negative.
+	FuncTol float64
+
+	// StepTol is the relative tolerance on the step. The iterations
+	// terminate with StepConvergence when the norm of a step is at most
+	//  StepTol * (StepTol + |x|).
+	// If StepTol is zero, it is defaulted to 1e-12. StepTol must not be
+	// negative.
+	StepTol float64
+
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, it is defaulted to 200. MaxIterations must not be negative.
+	MaxIterations int
+}
+
+// Status represents the status of the solution.
+type Status int
+
+const (
+	NotTerminated Status = iota
+	// FuncConvergence indicates that the norm of the residual is within
+	// FuncTol.
+	FuncConvergence
+	// StepConvergence indicates that the step or the trust region became
+	// smaller than StepTol. The location may be a local minimum of the
+	// norm of the residual rather than a root, which can be checked using
+	// the norm in Result.
+	StepConvergence
+	// IterationLimit indicates that the maximum number of iterations was
+	// reached.
+	IterationLimit
+	// Failure indicates that the method failed. The error returned by
+	// Solve gives the reason.
+	Failure
+)
+
+var statusNames = [...]string{
+	NotTerminated:   "NotTerminated",
+	FuncConvergence: "FuncConvergence",
+	StepConvergence: "StepConvergence",
+	IterationLimit:  "IterationLimit",
+	Failure:         "Failure",
+}
+
+func (s Status) String() string {
+	if s < 0 || int(s) >= len(statusNames) {
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+	return statusNames[s]
+}
+
+// Result holds the result of Solve.
+type Result struct {
+	// X is the final location.
+	X []float64
+	// F is the residual F(X).
+	F []float64
+	// Norm is the Euclidean norm of F.
+	Norm float64
+
+	// Status is the reason for termination.
+	Status Status
+
+	// Iterations is the number of iterations performed.
+	Iterations int
+	// FuncEvaluations is the number of evaluations of F, including the
+	// evaluations used to approximate the Jacobian or its products with
+	// vectors.
+	FuncEvaluations int
+	// JacEvaluations is the number of evaluations or finite difference
+	// approximations of the Jacobian matrix.
+	JacEvaluations int
+
+	// ResidualNorms holds the norm of the residual at the initial location
+	// followed by the norm after every iteration.
+	ResidualNorms []float64
+}
+
+// Method is a method for solving systems of nonlinear equations. Method is
+// implemented by Hybrid, Broyden and NewtonKrylov.
+type Method interface {
+	solve(s *solver) (Status, error)
+}
+
+// Solve solves the system of nonlinear equations described by p starting
+// from the location x0 using the given method. If settings is nil, the
+// default settings are used, and if method is nil, Hybrid is used. x0 is
+// not modified.
+//
+// Solve returns an error if the method fails, together with the best
+// location found. If the iterations terminate because of StepConvergence,
+// the location is not necessarily a root and the residual norm in the
+// result should be checked.
+func Solve(p Problem, x0 []float64, settings *Settings, method Method) (*Result, error) {
+	if p.Func == nil {
+		panic("nonlin: nil Func")
+	}
+	if len(x0) == 0 {
+		panic("nonlin: zero dimension")
+	}
+	if method == nil {
+		method = &Hybrid{}
+	}
+	s := newSolver(p, x0, settings)
+	if math.IsInf(s.norm, 0) || math.IsNaN(s.norm) {
+		return s.result(Failure), ErrNonFinite
+	}
+	status := s.check(math.Inf(1))
+	var err error
+	if status == NotTerminated {
+		status, err = method.solve(s)
+	}
+	return s.result(status), err
+}
+
+// solver holds the state of a solution shared by the methods.
+type solver struct {
+	p Problem
+
+	funcTol, stepTol float64
+	maxIter          int
+
+	x, f  []float64 // current location and residual
+	norm  float64   // norm of f
+	norms []float64
+
+	iter, funcEvals, jacEvals int
+}
+
+func newSolver(p Problem, x0 []float64, settings *Settings) *solver {
+	s := &solver{
+		p:       p,
+		funcTol: defaultFuncTol,
+		stepTol: defaultStepTol,
+		maxIter: defaultMaxIterations,
+	}
+	if settings != nil {
+		if settings.FuncTol < 0 || settings.StepTol < 0 {
+			panic("nonlin: negative tolerance")
+		}
+		if settings.MaxIterations < 0 {
+			panic("nonlin: negative MaxIterations")
+		}
+		if settings.FuncTol != 0 {
+			s.funcTol = settings.FuncTol
+		}
+		if settings.StepTol != 0 {
+			s.stepTol = settings.StepTol
+		}
+		if settings.MaxIterations != 0 {
+			s.maxIter = settings.MaxIterations
+		}
+	}
+	n := len(x0)
+	s.x = make([]float64, n)
+	copy(s.x, x0)
+	s.f = make([]float64, n)
+	s.norm = s.eval(s.f, s.x)
+	s.norms = append(s.norms, s.norm)
+	return s
+}
+
+// dim returns the dimension of the system.
+func (s *solver) dim() int {
+	return len(s.x)
+}
+
+// eval evaluates F at x, stores the result in dst and returns its norm. The
+// norm is infinite if dst contains non-finite values.
+func (s *solver) eval(dst, x []float64) float64 {
+	s.funcEvals++
+	s.p.Func(dst, x)
+	norm := floats.Norm(dst, 2)
+	if math.IsNaN(norm) {
+		return math.Inf(1)
+	}
+	return norm
+}
+
+// jacobian stores the Jacobian matrix at the current location into dst.
+func (s *solver) jacobian(dst *mat.Dense) {
+	s.jacEvals++
+	if s.p.Jac != nil {
+		s.p.Jac(dst, s.x)
+		return
+	}
+	fd.Jacobian(dst, func(y, x []float64) {
+		s.funcEvals++
+		s.p.Func(y, x)
+	}, s.x, &fd.JacobianSettings{
+		OriginValue: s.f,
+		Step:        math.Sqrt(machEps) * math.Max(1, floats.Norm(s.x, math.Inf(1))),
+	})
+}
+
+// maxBacktracks is the maximum number of step halvings in lineSearch.
+const maxBacktracks = 30
+
+// lineSearch finds a step length t such that the residual norm at x + t p
+// decreases sufficiently, starting from t = 1 and halving t until
+//  |F(x + t p)| ≤ (1 - 1e-4 t (1 - eta)) |F(x)|.
+// The location and the residual are stored in xNew and fNew. ok is false if no
+// such step length was found.
+func (s *solver) lineSearch(xNew, fNew, p []float64, eta float64) (t, norm float64, ok bool) {
+	t = 1
+	for i := 0; i < maxBacktracks; i++ {
+		floats.AddScaledTo(xNew, s.x, t, p)
+		norm = s.eval(fNew, xNew)
+		if norm <= (1-1e-4*t*(1-eta))*s.norm {
+			return t, norm, true
+		}
+		t /= 2
+	}
+	return t, norm, false
+}
+
+// accept moves to the location x with residual f and norm, completing an
+// iteration, and returns the termination status. step is the norm of the
+// step from the previous location.
+func (s *solver) accept(x, f []float64, norm, step float64) Status {
+	copy(s.x, x)
+	copy(s.f, f)
+	s.norm = norm
+	s.iter++
+	s.norms = append(s.norms, norm)
+	return s.check(step)
+}
+
+// check returns the termination status after a step with the given norm.
+func (s *solver) check(step float64) Status {
+	switch {
+	case s.norm <= s.funcTol:
+		return FuncConvergence
+	case s.smallStep(step):
+		return StepConvergence
+	case s.iter >= s.maxIter:
+		return IterationLimit
+	}
+	return NotTerminated
+}
+
+// smallStep returns whether a step with the given norm is within the step
+// tolerance at the current location.
+func (s *solver) smallStep(step float64) bool {
+	return step <= s.stepTol*(s.stepTol+floats.Norm(s.x, 2))
+}
+
+func (s *solver) result(status Status) *Result {
+	return &Result{
+		X:               s.x,
+		F:               s.f,
+		Norm:            s.norm,
+		Status:          status,
+		Iterations:      s.iter,
+		FuncEvaluations: s.funcEvals,
+		JacEvaluations:  s.jacEvals,
+		ResidualNorms:   s.norms,
+	}
+}
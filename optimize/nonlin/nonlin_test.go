@@ -0,0 +1,249 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nonlin
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+type system struct {
+	name string
+	p    Problem
+	x0   []float64
+	root []float64 // nil if the root is not checked
+	hard bool      // whether only Hybrid is expected to solve the system
+}
+
+var systems = []system{
+	{
+		name: "Rosenbrock",
+		p: Problem{
+			Func: func(dst, x []float64) {
+				dst[0] = 10 * (x[1] - x[0]*x[0])
+				dst[1] = 1 - x[0]
+			},
+			Jac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, -20*x[0])
+				dst.Set(0, 1, 10)
+				dst.Set(1, 0, -1)
+				dst.Set(1, 1, 0)
+			},
+		},
+		x0:   []float64{-1.2, 1},
+		root: []float64{1, 1},
+	},
+	{
+		name: "Linear",
+		p: Problem{
+			Func: func(dst, x []float64) {
+				dst[0] = 4*x[0] + x[1] - 1
+				dst[1] = x[0] + 3*x[1] + x[2] - 2
+				dst[2] = x[1] + 2*x[2] - 3
+			},
+			Jac: func(dst *mat.Dense, x []float64) {
+				dst.Copy(mat.NewDense(3, 3, []float64{
+					4, 1, 0,
+					1, 3, 1,
+					0, 1, 2,
+				}))
+			},
+		},
+		x0:   []float64{10, -10, 10},
+		root: []float64{2.0 / 9, 1.0 / 9, 13.0 / 9},
+	},
+	{
+		name: "BroydenTridiagonal",
+		p: Problem{
+			Func: func(dst, x []float64) {
+				n := len(x)
+				for i, v := range x {
+					dst[i] = (3-2*v)*v + 1
+					if i > 0 {
+						dst[i] -= x[i-1]
+					}
+					if i < n-1 {
+						dst[i] -= 2 * x[i+1]
+					}
+				}
+			},
+			Jac: func(dst *mat.Dense, x []float64) {
+				n := len(x)
+				dst.Zero()
+				for i, v := range x {
+					dst.Set(i, i, 3-4*v)
+					if i > 0 {
+						dst.Set(i, i-1, -1)
+					}
+					if i < n-1 {
+						dst.Set(i, i+1, -2)
+					}
+				}
+			},
+		},
+		x0: []float64{-1, -1, -1, -1, -1, -1, -1, -1, -1, -1},
+	},
+	{
+		name: "PowellBadlyScaled",
+		p: Problem{
+			Func: func(dst, x []float64) {
+				dst[0] = 1e4*x[0]*x[1] - 1
+				dst[1] = math.Exp(-x[0]) + math.Exp(-x[1]) - 1.0001
+			},
+			Jac: func(dst *mat.Dense, x []float64) {
+				dst.Set(0, 0, 1e4*x[1])
+				dst.Set(0, 1, 1e4*x[0])
+				dst.Set(1, 0, -math.Exp(-x[0]))
+				dst.Set(1, 1, -math.Exp(-x[1]))
+			},
+		},
+		x0:   []float64{0, 1},
+		root: []float64{1.0981593296997e-05, 9.106146739867},
+		hard: true,
+	},
+}
+
+var methods = []struct {
+	name   string
+	method Method
+}{
+	{name: "Hybrid", method: &Hybrid{}},
+	{name: "Broyden", method: Broyden{}},
+	{name: "NewtonKrylov", method: &NewtonKrylov{}},
+	{name: "NewtonKrylovForcing", method: &NewtonKrylov{Forcing: 1e-3, Restart: 2}},
+}
+
+func TestSolve(t *testing.T) {
+	t.Parallel()
+	for _, m := range methods {
+		for _, test := range systems {
+			if test.hard && m.name != "Hybrid" {
+				continue
+			}
+			for _, jac := range []bool{true, false} {
+				p := test.p
+				if !jac {
+					p.Jac = nil
+				}
+				var evals int
+				f := p.Func
+				p.Func = func(dst, x []float64) {
+					evals++
+					f(dst, x)
+				}
+				x0 := make([]float64, len(test.x0))
+				copy(x0, test.x0)
+
+				res, err := Solve(p, x0, nil, m.method)
+				if err != nil {
+					t.Errorf("%s %s (Jac %t): unexpected error: %v", m.name, test.name, jac, err)
+					continue
+				}
+				if !floats.Equal(x0, test.x0) {
+					t.Errorf("%s %s (Jac %t): initial location modified", m.name, test.name, jac)
+				}
+				if res.Status != FuncConvergence {
+					t.Errorf("%s %s (Jac %t): unexpected status: got %v, want %v", m.name, test.name, jac, res.Status, FuncConvergence)
+				}
+				fx := make([]float64, len(x0))
+				f(fx, res.X)
+				if !floats.Equal(fx, res.F) || res.Norm != floats.Norm(fx, 2) || res.Norm > defaultFuncTol {
+					t.Errorf("%s %s (Jac %t): unexpected residual: got %v with norm %v", m.name, test.name, jac, res.F, res.Norm)
+				}
+				if test.root != nil && !floats.EqualApprox(res.X, test.root, 1e-8) {
+					t.Errorf("%s %s (Jac %t): unexpected root: got %v, want %v", m.name, test.name, jac, res.X, test.root)
+				}
+				if len(res.ResidualNorms) != res.Iterations+1 || res.ResidualNorms[res.Iterations] != res.Norm {
+					t.Errorf("%s %s (Jac %t): unexpected residual norms %v for %d iterations", m.name, test.name, jac, res.ResidualNorms, res.Iterations)
+				}
+				if res.FuncEvaluations != evals {
+					t.Errorf("%s %s (Jac %t): unexpected number of evaluations: got %d, want %d", m.name, test.name, jac, res.FuncEvaluations, evals)
+				}
+				_, isNK := m.method.(*NewtonKrylov)
+				if isNK != (res.JacEvaluations == 0) {
+					t.Errorf("%s %s (Jac %t): unexpected number of Jacobian evaluations: %d", m.name, test.name, jac, res.JacEvaluations)
+				}
+			}
+		}
+	}
+}
+
+func TestSolveSettings(t *testing.T) {
+	t.Parallel()
+	p := systems[0].p
+	for _, m := range methods {
+		res, err := Solve(p, []float64{-1.2, 1}, &Settings{MaxIterations: 1}, m.method)
+		if err != nil || res.Status != IterationLimit || res.Iterations != 1 {
+			t.Errorf("%s: unexpected result with iteration limit: %v, %v", m.name, res.Status, err)
+		}
+
+		res, err = Solve(p, []float64{-1.2, 1}, &Settings{FuncTol: 1e-2}, m.method)
+		if err != nil || res.Status != FuncConvergence || res.Norm > 1e-2 {
+			t.Errorf("%s: unexpected result with function tolerance: %v, %v", m.name, res.Status, err)
+		}
+
+		res, err = Solve(p, []float64{1, 1}, nil, m.method)
+		if err != nil || res.Status != FuncConvergence || res.Iterations != 0 || res.FuncEvaluations != 1 {
+			t.Errorf("%s: unexpected result at the root: %v, %v", m.name, res.Status, err)
+		}
+	}
+
+	// The residual norm of x^2 + 1 has a local minimum at 0 that is not a
+	// root.
+	noRoot := Problem{
+		Func: func(dst, x []float64) { dst[0] = x[0]*x[0] + 1 },
+	}
+	for _, m := range methods {
+		res, err := Solve(noRoot, []float64{1}, nil, m.method)
+		if res.Status == FuncConvergence || res.Norm < 1 {
+			t.Errorf("%s: unexpected result without root: %v with norm %v", m.name, res.Status, res.Norm)
+		}
+		if (res.Status == Failure) != (err != nil) {
+			t.Errorf("%s: unexpected error for status %v: %v", m.name, res.Status, err)
+		}
+		if math.Abs(res.X[0]) > 1e-3 {
+			t.Errorf("%s: local minimum of residual not found: got %v", m.name, res.X)
+		}
+	}
+
+	nonFinite := Problem{
+		Func: func(dst, x []float64) { dst[0] = math.Log(x[0]) },
+	}
+	_, err := Solve(nonFinite, []float64{-1}, nil, nil)
+	if err != ErrNonFinite {
+		t.Errorf("unexpected error for non-finite residual: got %v, want %v", err, ErrNonFinite)
+	}
+	res, err := Solve(nonFinite, []float64{5}, nil, nil)
+	if err != nil || math.Abs(res.X[0]-1) > 1e-10 {
+		t.Errorf("unexpected result for logarithm: got %v, %v", res.X, err)
+	}
+}
+
+func TestGMRES(t *testing.T) {
+	t.Parallel()
+	a := mat.NewDense(4, 4, []float64{
+		4, 1, 0, 2,
+		1, 3, 1, 0,
+		0, 1, 2, 1,
+		3, 0, 1, 5,
+	})
+	want := []float64{1, -2, 3, -4}
+	b := mat.NewVecDense(4, nil)
+	b.MulVec(a, mat.NewVecDense(4, want))
+	matvec := func(dst, v []float64) {
+		mat.NewVecDense(4, dst).MulVec(a, mat.NewVecDense(4, v))
+	}
+	for _, restart := range []int{1, 2, 4} {
+		g := newGMRES(4, restart)
+		x := make([]float64, 4)
+		g.solve(x, matvec, b.RawVector().Data, 1e-12, 1000)
+		if !floats.EqualApprox(x, want, 1e-10) {
+			t.Errorf("unexpected solution with restart %d: got %v, want %v", restart, x, want)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package pareto provides methods for multi-objective optimization, which
+// approximate the set of Pareto-optimal locations of a vector-valued objective
+// function
+//  F(x) = [f_1(x), ..., f_m(x)],
+// and utilities for working with sets of objective vectors.
+//
+// A location is Pareto-optimal if no other location is at least as good in all
+// of the objectives and better in one of them. The problem is specified by a
+// Problem and solved by Minimize using NSGA2, which evolves a population of
+// locations with the crossover and mutation operators implementing Crossover
+// and Mutation.
+//
+// NonDominatedSort, CrowdingDistance and Hypervolume can be used to analyze
+// the resulting approximations of the Pareto front.
+package pareto // import "github.com/jingcheng-WU/gonum/optimize/pareto"
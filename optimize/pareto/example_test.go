@@ -0,0 +1,53 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pareto_test
+
+import (
+	"fmt"
+	"log"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/optimize/pareto"
+)
+
+func ExampleMinimize() {
+	// Schaffer's problem has the two objectives x² and (x-2)², which
+	// are traded off against each other for x in [0, 2].
+	p := pareto.Problem{
+		Func: func(dst, x []float64) {
+			dst[0] = x[0] * x[0]
+			dst[1] = (x[0] - 2) * (x[0] - 2)
+		},
+		Objectives: 2,
+		Lower:      []float64{-10},
+		Upper:      []float64{10},
+	}
+	settings := &pareto.Settings{
+		Generations: 100,
+		Concurrent:  4,
+	}
+	method := &pareto.NSGA2{
+		Population: 50,
+		Src:        rand.NewSource(1),
+	}
+	res, err := pareto.Minimize(p, settings, method)
+	if err != nil {
+		log.Fatal(err)
+	}
+	x := mat.Col(nil, 0, res.X)
+	fmt.Printf("non-dominated locations: %d\n", len(x))
+	fmt.Printf("range of x: [%.2f, %.2f]\n", floats.Min(x), floats.Max(x))
+
+	// The hypervolume of the Pareto front with respect to [4 4] is 40/3.
+	fmt.Printf("hypervolume: %.2f\n", pareto.Hypervolume(res.F, []float64{4, 4}))
+
+	// Output:
+	// non-dominated locations: 50
+	// range of x: [0.00, 2.00]
+	// hypervolume: 13.20
+}
@@ -0,0 +1,207 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pareto
+
+import (
+	"errors"
+	"math"
+	"sync"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+const defaultGenerations = 250
+
+// ErrNonFinite is returned when an objective function value is NaN or
+// infinite.
+var ErrNonFinite = errors.New("pareto: non-finite objective function value")
+
+// Problem describes a multi-objective minimization problem with bounded
+// variables.
+type Problem struct {
+	// Func evaluates the objective functions at x and stores the result in
+	// dst, which has length Objectives. Func must not modify x, and it must
+	// be safe for concurrent use if Settings.Concurrent is greater than 1.
+	// Func is required.
+	Func func(dst, x []float64)
+	// Objectives is the number of objective functions, which must be
+	// positive.
+	Objectives int
+	// Lower and Upper are the bounds of the variables. Their lengths are
+	// the dimension of the problem, and the bounds must be finite with
+	// Lower[i] <= Upper[i]. Func is only evaluated within the bounds.
+	Lower, Upper []float64
+}
+
+// Settings holds the settings of Minimize. A nil *Settings is equivalent to
+// the zero value, for which the defaults are used.
+type Settings struct {
+	// Generations is the number of generations of the population. If
+	// Generations is 0, it is defaulted to 250. Generations must not be
+	// negative.
+	Generations int
+	// FuncEvaluations is the maximum number of evaluations of Func. The
+	// optimization stops after the generation in which the limit is
+	// reached. If FuncEvaluations is 0, the number of evaluations is not
+	// limited.
+	FuncEvaluations int
+	// Concurrent is the number of concurrent evaluations of Func. If
+	// Concurrent is 0 or 1, Func is evaluated serially. The result of
+	// Minimize does not depend on Concurrent.
+	Concurrent int
+}
+
+// Result holds the result of Minimize.
+type Result struct {
+	// X holds the non-dominated locations of the final population by row,
+	// and F holds their objective function values.
+	X, F *mat.Dense
+	// Population holds the locations of the final population by row, and
+	// Values holds their objective function values.
+	Population, Values *mat.Dense
+	// Generations is the number of generations after the initial
+	// population.
+	Generations int
+	// FuncEvaluations is the number of evaluations of Func.
+	FuncEvaluations int
+}
+
+// Method is a method for multi-objective minimization. Method is implemented
+// by NSGA2.
+type Method interface {
+	// run evolves the population until e reports that the optimization
+	// is done, and returns the final population with its objective
+	// function values.
+	run(p Problem, e *evaluator) (x, f *mat.Dense, err error)
+}
+
+// Minimize approximates the Pareto-optimal locations of the problem p using
+// the given method. If settings is nil, the default settings are used, and if
+// method is nil, NSGA2 with default settings is used.
+//
+// The non-dominated locations of the final population are returned in the
+// result together with the final population. If an objective function value
+// is not finite, Minimize returns ErrNonFinite and a nil result.
+func Minimize(p Problem, settings *Settings, method Method) (*Result, error) {
+	if p.Func == nil {
+		panic("pareto: nil Func")
+	}
+	if p.Objectives <= 0 {
+		panic("pareto: non-positive number of objectives")
+	}
+	if len(p.Lower) == 0 {
+		panic("pareto: zero dimension")
+	}
+	if len(p.Upper) != len(p.Lower) {
+		panic(badLength)
+	}
+	for i, l := range p.Lower {
+		u := p.Upper[i]
+		if math.IsInf(l, 0) || math.IsInf(u, 0) || !(l <= u) {
+			panic("pareto: invalid bounds")
+		}
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if settings.Generations < 0 {
+		panic("pareto: negative number of generations")
+	}
+	if method == nil {
+		method = &NSGA2{}
+	}
+
+	e := &evaluator{
+		fn:          p.Func,
+		generations: settings.Generations,
+		maxEvals:    settings.FuncEvaluations,
+		concurrent:  settings.Concurrent,
+	}
+	if e.generations == 0 {
+		e.generations = defaultGenerations
+	}
+	x, f, err := method.run(p, e)
+	if err != nil {
+		return nil, err
+	}
+	res := &Result{
+		Population:      x,
+		Values:          f,
+		Generations:     e.generation,
+		FuncEvaluations: e.evals,
+	}
+	front := NonDominatedSort(f)[0]
+	res.X = mat.NewDense(len(front), len(p.Lower), nil)
+	res.F = mat.NewDense(len(front), p.Objectives, nil)
+	for i, k := range front {
+		res.X.SetRow(i, x.RawRowView(k))
+		res.F.SetRow(i, f.RawRowView(k))
+	}
+	return res, nil
+}
+
+// evaluator evaluates the objective functions for the methods and keeps track
+// of the termination of the optimization.
+type evaluator struct {
+	fn          func(dst, x []float64)
+	generations int
+	maxEvals    int
+	concurrent  int
+
+	generation int
+	evals      int
+}
+
+// evaluate evaluates the objective functions at the rows of x and stores the
+// results in the rows of f.
+func (e *evaluator) evaluate(f, x *mat.Dense) error {
+	n, _ := x.Dims()
+	e.evals += n
+	workers := e.concurrent
+	if workers <= 1 {
+		for i := 0; i < n; i++ {
+			e.fn(f.RawRowView(i), x.RawRowView(i))
+		}
+	} else {
+		if workers > n {
+			workers = n
+		}
+		var wg sync.WaitGroup
+		next := make(chan int)
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range next {
+					e.fn(f.RawRowView(i), x.RawRowView(i))
+				}
+			}()
+		}
+		for i := 0; i < n; i++ {
+			next <- i
+		}
+		close(next)
+		wg.Wait()
+	}
+	for _, v := range f.RawMatrix().Data {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return ErrNonFinite
+		}
+	}
+	return nil
+}
+
+// next advances to the next generation and returns whether the optimization
+// should continue.
+func (e *evaluator) next() bool {
+	if e.generation >= e.generations {
+		return false
+	}
+	if e.maxEvals > 0 && e.evals >= e.maxEvals {
+		return false
+	}
+	e.generation++
+	return true
+}
@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pareto
+
+import (
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+const defaultPopulation = 100
+
+// NSGA2 implements the non-dominated sorting genetic algorithm NSGA-II
+// described in
+//  Deb, K., Pratap, A., Agarwal, S., Meyarivan, T.: A fast and elitist
+//  multiobjective genetic algorithm: NSGA-II. IEEE Transactions on
+//  Evolutionary Computation 6(2), 182–197 (2002)
+// In every generation, parents are selected from the population by binary
+// tournaments, and offspring are created from them by crossover and mutation.
+// The next population is selected from the union of the population and the
+// offspring by non-dominated sorting, and ties in the last admitted front are
+// broken by preferring larger crowding distances, which maintains the
+// diversity of the population along the Pareto front.
+//
+// All of the offspring of a generation are evaluated together, possibly
+// concurrently, and all random numbers are drawn from Src in a fixed order,
+// so the result does not depend on the number of concurrent evaluations.
+type NSGA2 struct {
+	// Population is the size of the population. If Population is 0, it is
+	// defaulted to 100. Population must be at least 4.
+	Population int
+	// Crossover is the crossover operator. If Crossover is nil, SBX with
+	// default settings is used.
+	Crossover Crossover
+	// Mutation is the mutation operator. If Mutation is nil,
+	// PolynomialMutation with default settings is used.
+	Mutation Mutation
+	// Initial holds locations by row that are included in the initial
+	// population. The remaining members of the initial population are
+	// sampled uniformly within the bounds. If Initial is not nil, its
+	// number of columns must equal the dimension of the problem and its
+	// number of rows must not exceed the size of the population.
+	Initial mat.Matrix
+	// Src is the source of random numbers. If Src is nil, a source seeded
+	// from the global source of golang.org/x/exp/rand is used.
+	Src rand.Source
+}
+
+func (n *NSGA2) run(p Problem, e *evaluator) (x, f *mat.Dense, err error) {
+	size := n.Population
+	switch {
+	case size == 0:
+		size = defaultPopulation
+	case size < 4:
+		panic("nsga2: population too small")
+	}
+	cross := n.Crossover
+	if cross == nil {
+		cross = SBX{}
+	}
+	mutate := n.Mutation
+	if mutate == nil {
+		mutate = PolynomialMutation{}
+	}
+	src := n.Src
+	if src == nil {
+		src = rand.NewSource(rand.Uint64())
+	}
+	rnd := rand.New(src)
+	dim := len(p.Lower)
+	m := p.Objectives
+
+	// The population is stored in the first size rows and the offspring
+	// in the last size rows.
+	xs := mat.NewDense(2*size, dim, nil)
+	fs := mat.NewDense(2*size, m, nil)
+	var init int
+	if n.Initial != nil {
+		r, c := n.Initial.Dims()
+		if c != dim {
+			panic(badLength)
+		}
+		if r > size {
+			panic("nsga2: too many initial locations")
+		}
+		for i := 0; i < r; i++ {
+			row := xs.RawRowView(i)
+			mat.Row(row, i, n.Initial)
+			for j, v := range row {
+				if v < p.Lower[j] || p.Upper[j] < v {
+					panic("nsga2: initial location out of bounds")
+				}
+			}
+		}
+		init = r
+	}
+	for i := init; i < size; i++ {
+		row := xs.RawRowView(i)
+		for j := range row {
+			row[j] = p.Lower[j] + (p.Upper[j]-p.Lower[j])*rnd.Float64()
+		}
+	}
+	pop := xs.Slice(0, size, 0, dim).(*mat.Dense)
+	popF := fs.Slice(0, size, 0, m).(*mat.Dense)
+	if err := e.evaluate(popF, pop); err != nil {
+		return nil, nil, err
+	}
+	rank := make([]int, size)
+	crowd := make([]float64, size)
+	n.assign(rank, crowd, popF)
+
+	off := xs.Slice(size, 2*size, 0, dim).(*mat.Dense)
+	offF := fs.Slice(size, 2*size, 0, m).(*mat.Dense)
+	tmp := make([]float64, dim)
+	order := make([]int, 2*size)
+	nextX := mat.NewDense(size, dim, nil)
+	nextF := mat.NewDense(size, m, nil)
+	for e.next() {
+		// Create the offspring from parents selected by binary
+		// tournaments.
+		tournament := func() int {
+			a, b := rnd.Intn(size), rnd.Intn(size)
+			switch {
+			case rank[a] < rank[b]:
+				return a
+			case rank[b] < rank[a]:
+				return b
+			case crowd[a] > crowd[b]:
+				return a
+			case crowd[b] > crowd[a]:
+				return b
+			}
+			if rnd.Float64() < 0.5 {
+				return a
+			}
+			return b
+		}
+		for i := 0; i < size; i += 2 {
+			p1 := pop.RawRowView(tournament())
+			p2 := pop.RawRowView(tournament())
+			c1 := off.RawRowView(i)
+			c2 := tmp
+			if i+1 < size {
+				c2 = off.RawRowView(i + 1)
+			}
+			cross.Cross(c1, c2, p1, p2, p.Lower, p.Upper, rnd)
+			mutate.Mutate(c1, p.Lower, p.Upper, rnd)
+			if i+1 < size {
+				mutate.Mutate(c2, p.Lower, p.Upper, rnd)
+			}
+		}
+		if err := e.evaluate(offF, off); err != nil {
+			return nil, nil, err
+		}
+
+		// Select the next population from the population and the
+		// offspring.
+		fronts := NonDominatedSort(fs)
+		order = order[:0]
+		for _, front := range fronts {
+			if len(order)+len(front) <= size {
+				order = append(order, front...)
+				continue
+			}
+			dist := CrowdingDistance(nil, fs, front)
+			idx := make([]int, len(front))
+			for i := range idx {
+				idx[i] = i
+			}
+			sort.SliceStable(idx, func(a, b int) bool { return dist[idx[a]] > dist[idx[b]] })
+			for _, i := range idx[:size-len(order)] {
+				order = append(order, front[i])
+			}
+			break
+		}
+		for i, k := range order {
+			nextX.SetRow(i, xs.RawRowView(k))
+			nextF.SetRow(i, fs.RawRowView(k))
+		}
+		pop.Copy(nextX)
+		popF.Copy(nextF)
+		n.assign(rank, crowd, popF)
+	}
+	return mat.DenseCopyOf(pop), mat.DenseCopyOf(popF), nil
+}
+
+// assign computes the non-domination ranks and the crowding distances of the
+// members of the population with the objective function values f.
+func (n *NSGA2) assign(rank []int, crowd []float64, f *mat.Dense) {
+	fronts := NonDominatedSort(f)
+	for r, front := range fronts {
+		dist := CrowdingDistance(nil, f, front)
+		for i, k := range front {
+			rank[k] = r
+			crowd[k] = dist[i]
+		}
+	}
+}
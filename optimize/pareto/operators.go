@@ -0,0 +1,213 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pareto
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+// Crossover is a crossover operator for real vectors.
+type Crossover interface {
+	// Cross creates the two children c1 and c2 from the parents p1 and p2.
+	// The children must be within the bounds given by lower and upper, and
+	// all slices have the same length. Cross must use rnd as its only
+	// source of randomness.
+	Cross(c1, c2, p1, p2, lower, upper []float64, rnd *rand.Rand)
+}
+
+// Mutation is a mutation operator for real vectors.
+type Mutation interface {
+	// Mutate mutates x in place. x must remain within the bounds given by
+	// lower and upper, and all slices have the same length. Mutate must use
+	// rnd as its only source of randomness.
+	Mutate(x, lower, upper []float64, rnd *rand.Rand)
+}
+
+// SBX is the simulated binary crossover operator, which creates children
+// that are spread around the parents similarly to the children of single
+// point crossover of binary strings. The operator is described in
+//  Deb, K., Agrawal, R. B.: Simulated binary crossover for continuous search
+//  space. Complex Systems 9, 115–148 (1995)
+// The implementation uses the bounded variant of the operator.
+type SBX struct {
+	// Prob is the probability that a pair of parents is crossed over.
+	// If Prob is 0, it is defaulted to 0.9.
+	Prob float64
+	// VarProb is the probability that a variable is crossed over. If
+	// VarProb is 0, it is defaulted to 0.5.
+	VarProb float64
+	// Eta is the distribution index. Large values create children close to
+	// the parents. If Eta is 0, it is defaulted to 15.
+	Eta float64
+}
+
+// Cross creates two children from two parents.
+func (s SBX) Cross(c1, c2, p1, p2, lower, upper []float64, rnd *rand.Rand) {
+	checkOperands(lower, upper, c1, c2, p1, p2)
+	prob := defaultValue(s.Prob, 0.9)
+	varProb := defaultValue(s.VarProb, 0.5)
+	eta := defaultValue(s.Eta, 15)
+	copy(c1, p1)
+	copy(c2, p2)
+	if rnd.Float64() >= prob {
+		return
+	}
+	for i := range c1 {
+		if rnd.Float64() >= varProb {
+			continue
+		}
+		y1, y2 := p1[i], p2[i]
+		if math.Abs(y1-y2) < 1e-14 {
+			continue
+		}
+		if y1 > y2 {
+			y1, y2 = y2, y1
+		}
+		l, u := lower[i], upper[i]
+		r := rnd.Float64()
+		// The spread factors are drawn from distributions that are
+		// truncated at the bounds.
+		spread := func(beta float64) float64 {
+			alpha := 2 - math.Pow(beta, -(eta+1))
+			if r <= 1/alpha {
+				return math.Pow(r*alpha, 1/(eta+1))
+			}
+			return math.Pow(1/(2-r*alpha), 1/(eta+1))
+		}
+		d := y2 - y1
+		v1 := 0.5 * ((y1 + y2) - spread(1+2*(y1-l)/d)*d)
+		v2 := 0.5 * ((y1 + y2) + spread(1+2*(u-y2)/d)*d)
+		v1 = math.Max(l, math.Min(u, v1))
+		v2 = math.Max(l, math.Min(u, v2))
+		if rnd.Float64() < 0.5 {
+			v1, v2 = v2, v1
+		}
+		c1[i], c2[i] = v1, v2
+	}
+}
+
+// BLX is the blend crossover operator BLX-α, which samples each variable of
+// the children uniformly from the interval spanned by the parents extended by
+// Alpha times its length on both sides. The operator is described in
+//  Eshelman, L. J., Schaffer, J. D.: Real-coded genetic algorithms and
+//  interval-schemata. In: Foundations of Genetic Algorithms 2, pp. 187–202.
+//  Morgan Kaufmann (1993)
+type BLX struct {
+	// Prob is the probability that a pair of parents is crossed over.
+	// If Prob is 0, it is defaulted to 0.9.
+	Prob float64
+	// Alpha is the relative extension of the interval spanned by the
+	// parents. If Alpha is 0, it is defaulted to 0.5.
+	Alpha float64
+}
+
+// Cross creates two children from two parents.
+func (b BLX) Cross(c1, c2, p1, p2, lower, upper []float64, rnd *rand.Rand) {
+	checkOperands(lower, upper, c1, c2, p1, p2)
+	prob := defaultValue(b.Prob, 0.9)
+	alpha := defaultValue(b.Alpha, 0.5)
+	copy(c1, p1)
+	copy(c2, p2)
+	if rnd.Float64() >= prob {
+		return
+	}
+	for i := range c1 {
+		lo := math.Min(p1[i], p2[i])
+		hi := math.Max(p1[i], p2[i])
+		ext := alpha * (hi - lo)
+		lo = math.Max(lower[i], lo-ext)
+		hi = math.Min(upper[i], hi+ext)
+		c1[i] = lo + (hi-lo)*rnd.Float64()
+		c2[i] = lo + (hi-lo)*rnd.Float64()
+	}
+}
+
+// PolynomialMutation is the polynomial mutation operator, which perturbs
+// variables by amounts that follow a polynomial distribution scaled by the
+// distance to the bounds. The operator is described in
+//  Deb, K., Goyal, M.: A combined genetic adaptive search (GeneAS) for
+//  engineering design. Computer Science and Informatics 26, 30–45 (1996)
+type PolynomialMutation struct {
+	// Prob is the probability that a variable is mutated. If Prob is 0,
+	// it is defaulted to 1/dim.
+	Prob float64
+	// Eta is the distribution index. Large values create small
+	// perturbations. If Eta is 0, it is defaulted to 20.
+	Eta float64
+}
+
+// Mutate mutates x in place.
+func (p PolynomialMutation) Mutate(x, lower, upper []float64, rnd *rand.Rand) {
+	checkOperands(lower, upper, x)
+	prob := defaultValue(p.Prob, 1/float64(len(x)))
+	eta := defaultValue(p.Eta, 20)
+	for i, y := range x {
+		if rnd.Float64() >= prob {
+			continue
+		}
+		l, u := lower[i], upper[i]
+		if u == l {
+			continue
+		}
+		d1 := (y - l) / (u - l)
+		d2 := (u - y) / (u - l)
+		r := rnd.Float64()
+		var dq float64
+		if r < 0.5 {
+			v := 2*r + (1-2*r)*math.Pow(1-d1, eta+1)
+			dq = math.Pow(v, 1/(eta+1)) - 1
+		} else {
+			v := 2*(1-r) + 2*(r-0.5)*math.Pow(1-d2, eta+1)
+			dq = 1 - math.Pow(v, 1/(eta+1))
+		}
+		x[i] = math.Max(l, math.Min(u, y+dq*(u-l)))
+	}
+}
+
+// GaussianMutation is a mutation operator that adds normally distributed
+// perturbations to variables and projects the result onto the bounds.
+type GaussianMutation struct {
+	// Prob is the probability that a variable is mutated. If Prob is 0,
+	// it is defaulted to 1/dim.
+	Prob float64
+	// Sigma is the standard deviation of the perturbations relative to the
+	// difference between the bounds. If Sigma is 0, it is defaulted to 0.1.
+	Sigma float64
+}
+
+// Mutate mutates x in place.
+func (g GaussianMutation) Mutate(x, lower, upper []float64, rnd *rand.Rand) {
+	checkOperands(lower, upper, x)
+	prob := defaultValue(g.Prob, 1/float64(len(x)))
+	sigma := defaultValue(g.Sigma, 0.1)
+	for i, y := range x {
+		if rnd.Float64() >= prob {
+			continue
+		}
+		l, u := lower[i], upper[i]
+		x[i] = math.Max(l, math.Min(u, y+sigma*(u-l)*rnd.NormFloat64()))
+	}
+}
+
+// defaultValue returns v, or def if v is zero.
+func defaultValue(v, def float64) float64 {
+	if v == 0 {
+		return def
+	}
+	return v
+}
+
+func checkOperands(lower, upper []float64, xs ...[]float64) {
+	if len(upper) != len(lower) {
+		panic(badLength)
+	}
+	for _, x := range xs {
+		if len(x) != len(lower) {
+			panic(badLength)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pareto
+
+import (
+	"math"
+	"sort"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// Dominates returns whether the objective vector a dominates the objective
+// vector b, that is, whether a is not greater than b in all of the objectives
+// and less than b in at least one of them. Dominates panics if the lengths of
+// a and b are not equal.
+func Dominates(a, b []float64) bool {
+	if len(a) != len(b) {
+		panic(badLength)
+	}
+	var less bool
+	for i, v := range a {
+		switch {
+		case v > b[i]:
+			return false
+		case v < b[i]:
+			less = true
+		}
+	}
+	return less
+}
+
+// NonDominatedSort sorts the objective vectors given by the rows of f into
+// fronts of non-dominated vectors and returns the row indices in each front.
+// The first front contains the vectors that are not dominated by any other
+// vector, and each subsequent front contains the vectors that are only
+// dominated by vectors in the preceding fronts. The indices within each front
+// are in increasing order.
+//
+// The fronts are computed by the fast non-dominated sorting algorithm of
+// NSGA-II, which needs O(m n²) operations for n vectors with m objectives.
+func NonDominatedSort(f mat.Matrix) [][]int {
+	n, _ := f.Dims()
+	rows := matrixRows(f)
+
+	// For every vector, count the vectors that dominate it, and record the
+	// vectors that it dominates.
+	count := make([]int, n)
+	dominated := make([][]int, n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			switch {
+			case Dominates(rows[i], rows[j]):
+				dominated[i] = append(dominated[i], j)
+				count[j]++
+			case Dominates(rows[j], rows[i]):
+				dominated[j] = append(dominated[j], i)
+				count[i]++
+			}
+		}
+	}
+
+	var fronts [][]int
+	var front []int
+	for i, c := range count {
+		if c == 0 {
+			front = append(front, i)
+		}
+	}
+	for len(front) > 0 {
+		fronts = append(fronts, front)
+		var next []int
+		for _, i := range front {
+			for _, j := range dominated[i] {
+				count[j]--
+				if count[j] == 0 {
+					next = append(next, j)
+				}
+			}
+		}
+		sort.Ints(next)
+		front = next
+	}
+	return fronts
+}
+
+// CrowdingDistance computes the crowding distances of the objective vectors
+// given by the rows of f with the indices in front, and stores them in dst.
+// The crowding distance of a vector is the sum over the objectives of the
+// distance between its neighbors in the front, normalized by the range of the
+// objective in the front. The vectors with the least or greatest value of an
+// objective have an infinite crowding distance. Vectors with larger crowding
+// distances are in less crowded regions of the front.
+//
+// If dst is nil, a new slice is allocated and returned. Otherwise the length
+// of dst must equal the length of front, and dst is returned.
+func CrowdingDistance(dst []float64, f mat.Matrix, front []int) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(front))
+	}
+	if len(dst) != len(front) {
+		panic(badLength)
+	}
+	for i := range dst {
+		dst[i] = 0
+	}
+	if len(front) <= 2 {
+		for i := range dst {
+			dst[i] = math.Inf(1)
+		}
+		return dst
+	}
+	_, m := f.Dims()
+	order := make([]int, len(front))
+	for k := 0; k < m; k++ {
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return f.At(front[order[a]], k) < f.At(front[order[b]], k)
+		})
+		lo := f.At(front[order[0]], k)
+		hi := f.At(front[order[len(order)-1]], k)
+		dst[order[0]] = math.Inf(1)
+		dst[order[len(order)-1]] = math.Inf(1)
+		if hi == lo {
+			continue
+		}
+		for i := 1; i < len(order)-1; i++ {
+			next := f.At(front[order[i+1]], k)
+			prev := f.At(front[order[i-1]], k)
+			dst[order[i]] += (next - prev) / (hi - lo)
+		}
+	}
+	return dst
+}
+
+// Hypervolume returns the hypervolume indicator of the objective vectors
+// given by the rows of f with respect to the reference point ref, which is the
+// volume of the region that is dominated by at least one of the vectors and
+// bounded by ref. Vectors that do not dominate ref in all of the objectives
+// do not contribute to the hypervolume. Larger values indicate better
+// approximations of the Pareto front of a minimization problem.
+//
+// The hypervolume is computed exactly by slicing along the objectives, which
+// needs O(n^(m-1) log n) operations for n vectors with m objectives, so it is
+// intended for problems with few objectives. Hypervolume panics if the length
+// of ref does not equal the number of columns of f.
+func Hypervolume(f mat.Matrix, ref []float64) float64 {
+	_, m := f.Dims()
+	if len(ref) != m {
+		panic(badLength)
+	}
+	var pts [][]float64
+	for _, row := range matrixRows(f) {
+		inside := true
+		for k, v := range row {
+			if !(v < ref[k]) {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			pts = append(pts, row)
+		}
+	}
+	return hypervolume(pts, ref, m)
+}
+
+// hypervolume returns the hypervolume of the points with respect to ref in
+// the first m objectives. All points must dominate ref in these objectives.
+func hypervolume(pts [][]float64, ref []float64, m int) float64 {
+	switch {
+	case len(pts) == 0:
+		return 0
+	case m == 1:
+		lo := ref[0]
+		for _, p := range pts {
+			lo = math.Min(lo, p[0])
+		}
+		return ref[0] - lo
+	case m == 2:
+		sorted := make([][]float64, len(pts))
+		copy(sorted, pts)
+		sort.Slice(sorted, func(i, j int) bool {
+			if sorted[i][0] != sorted[j][0] {
+				return sorted[i][0] < sorted[j][0]
+			}
+			return sorted[i][1] < sorted[j][1]
+		})
+		var vol float64
+		top := ref[1]
+		for _, p := range sorted {
+			if p[1] < top {
+				vol += (ref[0] - p[0]) * (top - p[1])
+				top = p[1]
+			}
+		}
+		return vol
+	}
+
+	// Sweep along the last objective, and add the volumes of the slabs
+	// between consecutive values, each of which is the hypervolume of the
+	// points below the slab in the remaining objectives.
+	sorted := make([][]float64, len(pts))
+	copy(sorted, pts)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i][m-1] < sorted[j][m-1] })
+	var vol float64
+	for i, p := range sorted {
+		top := ref[m-1]
+		if i+1 < len(sorted) {
+			top = sorted[i+1][m-1]
+		}
+		if top == p[m-1] {
+			continue
+		}
+		vol += (top - p[m-1]) * hypervolume(nonDominated(sorted[:i+1], m-1), ref, m-1)
+	}
+	return vol
+}
+
+// nonDominated returns the points that are not dominated by another point in
+// the first m objectives, keeping one of any identical points.
+func nonDominated(pts [][]float64, m int) [][]float64 {
+	var nd [][]float64
+	for i, p := range pts {
+		keep := true
+		for j, q := range pts {
+			if i == j {
+				continue
+			}
+			if Dominates(q[:m], p[:m]) || (j < i && equal(q[:m], p[:m])) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			nd = append(nd, p)
+		}
+	}
+	return nd
+}
+
+func equal(a, b []float64) bool {
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// matrixRows returns the rows of f as slices.
+func matrixRows(f mat.Matrix) [][]float64 {
+	r, c := f.Dims()
+	rows := make([][]float64, r)
+	if d, ok := f.(mat.RawMatrixer); ok {
+		raw := d.RawMatrix()
+		for i := range rows {
+			rows[i] = raw.Data[i*raw.Stride : i*raw.Stride+c]
+		}
+		return rows
+	}
+	for i := range rows {
+		rows[i] = make([]float64, c)
+		mat.Row(rows[i], i, f)
+	}
+	return rows
+}
+
+const badLength = "pareto: length mismatch"
@@ -0,0 +1,374 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package pareto
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func TestDominates(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		a, b []float64
+		want bool
+	}{
+		{a: []float64{1, 2}, b: []float64{2, 3}, want: true},
+		{a: []float64{1, 3}, b: []float64{2, 3}, want: true},
+		{a: []float64{1, 3}, b: []float64{1, 3}, want: false},
+		{a: []float64{1, 4}, b: []float64{2, 3}, want: false},
+		{a: []float64{2, 3}, b: []float64{1, 2}, want: false},
+	} {
+		if got := Dominates(test.a, test.b); got != test.want {
+			t.Errorf("unexpected result for %v and %v: got %t, want %t", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestNonDominatedSort(t *testing.T) {
+	t.Parallel()
+	f := mat.NewDense(7, 2, []float64{
+		1, 5,
+		2, 3,
+		4, 1,
+		3, 4,
+		5, 5,
+		2, 3,
+		4, 2,
+	})
+	got := NonDominatedSort(f)
+	want := [][]int{{0, 1, 2, 5}, {3, 6}, {4}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected fronts: got %v, want %v", got, want)
+	}
+
+	// Every vector must be dominated by a vector in the preceding front,
+	// and by no vector in its own or a later front.
+	rnd := rand.New(rand.NewSource(1))
+	f = mat.NewDense(60, 3, nil)
+	for i := 0; i < 60; i++ {
+		for j := 0; j < 3; j++ {
+			f.Set(i, j, float64(rnd.Intn(10)))
+		}
+	}
+	fronts := NonDominatedSort(f)
+	var count int
+	for r, front := range fronts {
+		count += len(front)
+		for _, i := range front {
+			for _, later := range fronts[r:] {
+				for _, j := range later {
+					if Dominates(f.RawRowView(j), f.RawRowView(i)) {
+						t.Errorf("row %d in front %d dominated by row %d", i, r, j)
+					}
+				}
+			}
+			if r == 0 {
+				continue
+			}
+			var dominated bool
+			for _, j := range fronts[r-1] {
+				dominated = dominated || Dominates(f.RawRowView(j), f.RawRowView(i))
+			}
+			if !dominated {
+				t.Errorf("row %d in front %d not dominated by the preceding front", i, r)
+			}
+		}
+	}
+	if count != 60 {
+		t.Errorf("unexpected number of sorted rows: got %d, want 60", count)
+	}
+}
+
+func TestCrowdingDistance(t *testing.T) {
+	t.Parallel()
+	f := mat.NewDense(5, 2, []float64{
+		0, 4,
+		9, 9,
+		1, 2,
+		2, 1,
+		4, 0,
+	})
+	got := CrowdingDistance(nil, f, []int{0, 2, 3, 4})
+	inf := math.Inf(1)
+	want := []float64{inf, 2.0/4 + 3.0/4, 3.0/4 + 2.0/4, inf}
+	if !floats.EqualApprox(got, want, 1e-14) {
+		t.Errorf("unexpected crowding distances: got %v, want %v", got, want)
+	}
+	got = CrowdingDistance(make([]float64, 2), f, []int{0, 2})
+	if !floats.Equal(got, []float64{inf, inf}) {
+		t.Errorf("unexpected crowding distances of two vectors: got %v", got)
+	}
+}
+
+func TestHypervolume(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		f    *mat.Dense
+		ref  []float64
+		want float64
+	}{
+		{
+			f:    mat.NewDense(1, 2, []float64{1, 1}),
+			ref:  []float64{3, 4},
+			want: 6,
+		},
+		{
+			f:    mat.NewDense(3, 2, []float64{1, 3, 2, 2, 3, 1}),
+			ref:  []float64{4, 4},
+			want: 6,
+		},
+		{
+			// Dominated vectors and vectors outside the reference box
+			// do not contribute.
+			f:    mat.NewDense(4, 2, []float64{1, 3, 2, 2, 3, 3, 0, 5}),
+			ref:  []float64{4, 4},
+			want: 5,
+		},
+		{
+			f:    mat.NewDense(2, 3, []float64{0, 0, 1, 1, 1, 0}),
+			ref:  []float64{2, 2, 2},
+			want: 5,
+		},
+	} {
+		if got := Hypervolume(test.f, test.ref); math.Abs(got-test.want) > 1e-14 {
+			t.Errorf("unexpected hypervolume of %v: got %v, want %v", mat.Formatted(test.f), got, test.want)
+		}
+	}
+
+	// Compare with the inclusion-exclusion formula for the volume of a
+	// union of boxes.
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{2, 3, 4} {
+		for trial := 0; trial < 10; trial++ {
+			const n = 7
+			f := mat.NewDense(n, m, nil)
+			for i := 0; i < n; i++ {
+				for j := 0; j < m; j++ {
+					f.Set(i, j, rnd.Float64())
+				}
+			}
+			ref := make([]float64, m)
+			for j := range ref {
+				ref[j] = 1
+			}
+			var want float64
+			for set := 1; set < 1<<n; set++ {
+				corner := make([]float64, m)
+				var size int
+				for i := 0; i < n; i++ {
+					if set&(1<<i) == 0 {
+						continue
+					}
+					size++
+					for j := range corner {
+						corner[j] = math.Max(corner[j], f.At(i, j))
+					}
+				}
+				vol := 1.0
+				for _, v := range corner {
+					vol *= 1 - v
+				}
+				if size%2 == 0 {
+					vol = -vol
+				}
+				want += vol
+			}
+			got := Hypervolume(f, ref)
+			if math.Abs(got-want) > 1e-12 {
+				t.Errorf("unexpected hypervolume with %d objectives: got %v, want %v", m, got, want)
+			}
+		}
+	}
+}
+
+// zdt1 is the ZDT1 test problem with a convex Pareto front
+//  f2 = 1 - √f1
+// for f1 in [0, 1].
+func zdt1(dim int) Problem {
+	lower := make([]float64, dim)
+	upper := make([]float64, dim)
+	for i := range upper {
+		upper[i] = 1
+	}
+	return Problem{
+		Func: func(dst, x []float64) {
+			g := 1 + 9*floats.Sum(x[1:])/float64(len(x)-1)
+			dst[0] = x[0]
+			dst[1] = g * (1 - math.Sqrt(x[0]/g))
+		},
+		Objectives: 2,
+		Lower:      lower,
+		Upper:      upper,
+	}
+}
+
+func TestNSGA2(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name   string
+		method *NSGA2
+	}{
+		{
+			name:   "SBX",
+			method: &NSGA2{Src: rand.NewSource(1)},
+		},
+		{
+			name: "BLX",
+			method: &NSGA2{
+				Crossover: BLX{},
+				Mutation:  GaussianMutation{},
+				Src:       rand.NewSource(1),
+			},
+		},
+	} {
+		p := zdt1(10)
+		res, err := Minimize(p, nil, test.method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if res.Generations != defaultGenerations {
+			t.Errorf("%s: unexpected number of generations: got %d, want %d", test.name, res.Generations, defaultGenerations)
+		}
+		if res.FuncEvaluations != (defaultGenerations+1)*defaultPopulation {
+			t.Errorf("%s: unexpected number of evaluations: got %d", test.name, res.FuncEvaluations)
+		}
+		// The hypervolume of the Pareto front with respect to [1 1] is 2/3.
+		hv := Hypervolume(res.F, []float64{1, 1})
+		if hv < 0.64 {
+			t.Errorf("%s: hypervolume too small: got %v, want close to 2/3", test.name, hv)
+		}
+		r, _ := res.F.Dims()
+		for i := 0; i < r; i++ {
+			f := res.F.RawRowView(i)
+			if f[1] < 1-math.Sqrt(f[0])-1e-12 {
+				t.Errorf("%s: objective vector %v beyond the Pareto front", test.name, f)
+			}
+		}
+	}
+}
+
+func TestNSGA2Concurrent(t *testing.T) {
+	t.Parallel()
+	// The result must not depend on the number of concurrent evaluations.
+	p := zdt1(5)
+	settings := &Settings{Generations: 20}
+	want, err := Minimize(p, settings, &NSGA2{Population: 20, Src: rand.NewSource(1)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, concurrent := range []int{2, 3, 8} {
+		settings.Concurrent = concurrent
+		got, err := Minimize(p, settings, &NSGA2{Population: 20, Src: rand.NewSource(1)})
+		if err != nil {
+			t.Errorf("unexpected error with %d concurrent: %v", concurrent, err)
+			continue
+		}
+		if !mat.Equal(got.Population, want.Population) || !mat.Equal(got.X, want.X) {
+			t.Errorf("result with %d concurrent differs from serial result", concurrent)
+		}
+	}
+}
+
+func TestNSGA2Settings(t *testing.T) {
+	t.Parallel()
+	p := zdt1(3)
+
+	// The evaluation limit stops the optimization after the generation in
+	// which it is reached, and the initial locations are included.
+	initial := mat.NewDense(2, 3, []float64{0, 0, 0, 1, 0, 0})
+	var evals int
+	fn := p.Func
+	p.Func = func(dst, x []float64) {
+		evals++
+		fn(dst, x)
+	}
+	method := &NSGA2{Population: 11, Initial: initial, Src: rand.NewSource(1)}
+	res, err := Minimize(p, &Settings{FuncEvaluations: 50}, method)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res.Generations != 4 || res.FuncEvaluations != 55 || evals != 55 {
+		t.Errorf("unexpected termination: got %d generations and %d evaluations (%d counted)", res.Generations, res.FuncEvaluations, evals)
+	}
+	if r, _ := res.Population.Dims(); r != 11 {
+		t.Errorf("unexpected population size: got %d, want 11", r)
+	}
+	// The initial locations are on the Pareto front and at its ends, so
+	// they must survive.
+	for i := 0; i < 2; i++ {
+		var found bool
+		r, _ := res.X.Dims()
+		for j := 0; j < r; j++ {
+			found = found || floats.Equal(res.X.RawRowView(j), initial.RawRowView(i))
+		}
+		if !found {
+			t.Errorf("initial location %v not in the final front", initial.RawRowView(i))
+		}
+	}
+
+	p.Func = func(dst, x []float64) {
+		dst[0] = math.NaN()
+	}
+	_, err = Minimize(p, nil, &NSGA2{Src: rand.NewSource(1)})
+	if err != ErrNonFinite {
+		t.Errorf("unexpected error for NaN objective: got %v, want %v", err, ErrNonFinite)
+	}
+}
+
+func TestOperators(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	lower := []float64{-1, 0, 2, 5}
+	upper := []float64{1, 10, 2, 6}
+	p1 := []float64{-1, 3, 2, 5.5}
+	p2 := []float64{0.5, 9, 2, 6}
+	c1 := make([]float64, 4)
+	c2 := make([]float64, 4)
+	inBounds := func(x []float64) bool {
+		for i, v := range x {
+			if v < lower[i] || upper[i] < v {
+				return false
+			}
+		}
+		return true
+	}
+	for _, cross := range []Crossover{SBX{}, SBX{Prob: 1, VarProb: 1, Eta: 2}, BLX{}, BLX{Prob: 1, Alpha: 2}} {
+		var changed bool
+		for i := 0; i < 1000; i++ {
+			cross.Cross(c1, c2, p1, p2, lower, upper, rnd)
+			if !inBounds(c1) || !inBounds(c2) {
+				t.Errorf("%T: children %v and %v out of bounds", cross, c1, c2)
+				break
+			}
+			changed = changed || !floats.Equal(c1, p1)
+		}
+		if !changed {
+			t.Errorf("%T: parents never changed", cross)
+		}
+	}
+	for _, mutate := range []Mutation{PolynomialMutation{}, PolynomialMutation{Prob: 1, Eta: 1}, GaussianMutation{}, GaussianMutation{Prob: 1, Sigma: 2}} {
+		var changed bool
+		for i := 0; i < 1000; i++ {
+			copy(c1, p1)
+			mutate.Mutate(c1, lower, upper, rnd)
+			if !inBounds(c1) {
+				t.Errorf("%T: mutation %v out of bounds", mutate, c1)
+				break
+			}
+			changed = changed || !floats.Equal(c1, p1)
+		}
+		if !changed {
+			t.Errorf("%T: location never changed", mutate)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// batcher is a method that evaluates the objective function at a batch of
+// locations in every major iteration.
+type batcher interface {
+	// batchSize returns the number of locations in the next batch.
+	batchSize() int
+
+	// sample stores the ith location of the next batch in x.
+	sample(i int, x []float64)
+
+	// update updates the state of the method after all locations in the
+	// batch have been evaluated and returns whether the method has converged.
+	update(xs *mat.Dense, fs []float64) (converged bool)
+}
+
+// batchRunner implements the Run protocol of Method for a batcher. Batches
+// are evaluated using as many concurrent tasks as are available, and a
+// MajorIteration with the best location found so far is sent after each
+// batch.
+type batchRunner struct {
+	dim int
+	max int // maximum batch size
+
+	// Current batch.
+	n        int
+	xs       *mat.Dense
+	fs       []float64
+	sent     int
+	received int
+
+	// Overall best.
+	bestX []float64
+	bestF float64
+}
+
+func (b *batchRunner) init(dim, max int) {
+	b.dim = dim
+	b.max = max
+	b.xs = mat.NewDense(max, dim, nil)
+	b.fs = resize(b.fs, max)
+	b.bestX = resize(b.bestX, dim)
+	b.bestF = math.Inf(1)
+}
+
+// setBest updates the best location if f is better than the best value
+// found so far.
+func (b *batchRunner) setBest(x []float64, f float64) {
+	if f < b.bestF {
+		b.bestF = f
+		copy(b.bestX, x)
+	}
+}
+
+// start begins a new batch, sending the initial evaluation tasks.
+func (b *batchRunner) start(m batcher, operations chan<- Task, tasks []Task) {
+	b.n = m.batchSize()
+	if b.n <= 0 || b.n > b.max {
+		panic("optimize: bad batch size")
+	}
+	for i := range b.fs[:b.n] {
+		b.fs[i] = math.NaN()
+	}
+	b.sent = 0
+	b.received = 0
+	for _, task := range tasks {
+		if b.sent == b.n {
+			break
+		}
+		b.send(m, operations, task)
+	}
+}
+
+func (b *batchRunner) send(m batcher, operations chan<- Task, task Task) {
+	x := b.xs.RawRowView(b.sent)
+	m.sample(b.sent, x)
+	copy(task.X, x)
+	task.ID = b.sent
+	task.Op = FuncEvaluation
+	b.sent++
+	operations <- task
+}
+
+// run runs the optimization protocol described in the documentation of
+// Method.Run. A MajorIteration is sent after every batch, and if the method
+// reports convergence, it is followed by a MethodDone.
+func (b *batchRunner) run(m batcher, operations chan<- Task, results <-chan Task, tasks []Task) {
+	var converged bool
+	b.start(m, operations, tasks)
+
+Loop:
+	for {
+		result := <-results
+		switch result.Op {
+		default:
+			panic("unknown operation")
+		case PostIteration:
+			break Loop
+		case MajorIteration:
+			if converged {
+				result.Op = MethodDone
+				operations <- result
+				continue
+			}
+			b.start(m, operations, tasks)
+		case FuncEvaluation:
+			b.fs[result.ID] = result.F
+			b.received++
+			switch {
+			case b.sent < b.n:
+				b.send(m, operations, result)
+			case b.received < b.n:
+				// Wait for the outstanding evaluations.
+			default:
+				xs := b.xs.Slice(0, b.n, 0, b.dim).(*mat.Dense)
+				for i, f := range b.fs[:b.n] {
+					b.setBest(xs.RawRowView(i), f)
+				}
+				converged = m.update(xs, b.fs[:b.n])
+				result.F = b.bestF
+				copy(result.X, b.bestX)
+				result.ID = -1
+				result.Op = MajorIteration
+				operations <- result
+			}
+		}
+	}
+
+	// Collect the outstanding evaluations and send a final MajorIteration if
+	// any of them improved on the best location.
+	bestF := b.bestF
+	for task := range results {
+		switch task.Op {
+		default:
+			panic("unknown operation")
+		case MajorIteration:
+		case FuncEvaluation:
+			b.setBest(b.xs.RawRowView(task.ID), task.F)
+		}
+	}
+	if b.bestF < bestF {
+		task := tasks[0]
+		task.F = b.bestF
+		copy(task.X, b.bestX)
+		task.ID = -1
+		task.Op = MajorIteration
+		operations <- task
+	}
+	close(operations)
+}
+
+// randFuncs holds the random number generating functions of a method.
+type randFuncs struct {
+	float64     func() float64
+	normFloat64 func() float64
+	intn        func(int) int
+}
+
+func newRandFuncs(src rand.Source) randFuncs {
+	if src == nil {
+		return randFuncs{
+			float64:     rand.Float64,
+			normFloat64: rand.NormFloat64,
+			intn:        rand.Intn,
+		}
+	}
+	rnd := rand.New(src)
+	return randFuncs{
+		float64:     rnd.Float64,
+		normFloat64: rnd.NormFloat64,
+		intn:        rnd.Intn,
+	}
+}
+
+// checkBounds panics if the lower and upper bounds are not valid for a problem
+// of dimension dim. Nil bounds are allowed and are treated as unbounded.
+func checkBounds(lower, upper []float64, dim int) {
+	if lower != nil && len(lower) != dim {
+		panic("optimize: lower bound length mismatch")
+	}
+	if upper != nil && len(upper) != dim {
+		panic("optimize: upper bound length mismatch")
+	}
+	if lower == nil || upper == nil {
+		return
+	}
+	for i, l := range lower {
+		if !(l <= upper[i]) {
+			panic("optimize: lower bound greater than upper bound")
+		}
+	}
+}
+
+// bounds returns the lower and upper bounds of the ith variable.
+func bounds(lower, upper []float64, i int) (l, u float64) {
+	l, u = math.Inf(-1), math.Inf(1)
+	if lower != nil {
+		l = lower[i]
+	}
+	if upper != nil {
+		u = upper[i]
+	}
+	return l, u
+}
+
+// clip projects x onto the box defined by lower and upper.
+func clip(x, lower, upper []float64) {
+	for i, v := range x {
+		l, u := bounds(lower, upper, i)
+		x[i] = math.Max(l, math.Min(u, v))
+	}
+}
+
+// sampleInitial stores a random location in x. Variables with finite lower and
+// upper bounds are sampled uniformly within the bounds. The other variables are
+// sampled from a normal distribution around x0 with standard deviation step
+// and projected onto the bounds.
+func sampleInitial(x, x0, lower, upper []float64, step float64, rnd randFuncs) {
+	for i := range x {
+		l, u := bounds(lower, upper, i)
+		if !math.IsInf(l, 0) && !math.IsInf(u, 0) {
+			x[i] = l + (u-l)*rnd.float64()
+			continue
+		}
+		x[i] = math.Max(l, math.Min(u, x0[i]+step*rnd.normFloat64()))
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/optimize/functions"
+)
+
+// newPopulationMethods returns the population-based methods with the given
+// bounds, each with a random source initialized with seed.
+func newPopulationMethods(lower, upper []float64, seed uint64) map[string]Method {
+	src := func() rand.Source { return rand.NewSource(seed) }
+	return map[string]Method{
+		"DifferentialEvolution": &DifferentialEvolution{Lower: lower, Upper: upper, Src: src()},
+		"DifferentialEvolutionBest": &DifferentialEvolution{
+			Lower:      lower,
+			Upper:      upper,
+			Population: 50,
+			MutateBest: true,
+			Src:        src(),
+		},
+		"ParticleSwarm": &ParticleSwarm{Lower: lower, Upper: upper, Population: 40, Src: src()},
+		"SimulatedAnnealing": &SimulatedAnnealing{
+			Lower:      lower,
+			Upper:      upper,
+			Candidates: 8,
+			Cooling:    0.995,
+			Src:        src(),
+		},
+	}
+}
+
+func TestPopulationMethodsRastrigin(t *testing.T) {
+	t.Parallel()
+	lower := []float64{-5.12, -5.12}
+	upper := []float64{5.12, 5.12}
+	for name, method := range newPopulationMethods(lower, upper, 1) {
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			FuncEvaluations: 100000,
+		}
+		initX := []float64{3.5, -2.5}
+		result, err := Minimize(Problem{Func: functions.Rastrigin{}.Func}, initX, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if result.Status != MethodConverge {
+			t.Errorf("%s: unexpected status: got %v, want %v", name, result.Status, MethodConverge)
+		}
+		if !floats.EqualApprox(result.X, []float64{0, 0}, 1e-4) {
+			t.Errorf("%s: global minimum not found: got %v", name, result.X)
+		}
+	}
+}
+
+func TestPopulationMethodsBounds(t *testing.T) {
+	t.Parallel()
+	// The unconstrained minimum of the Rosenbrock function at [1 1] is
+	// outside the bounds, so the constrained minimum is on the boundary.
+	lower := []float64{-2, -2}
+	upper := []float64{0.5, 2}
+	for name, method := range newPopulationMethods(lower, upper, 1) {
+		var outside bool
+		problem := Problem{
+			Func: func(x []float64) float64 {
+				for i, v := range x {
+					if v < lower[i] || upper[i] < v {
+						outside = true
+					}
+				}
+				return functions.ExtendedRosenbrock{}.Func(x)
+			},
+		}
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			FuncEvaluations: 50000,
+		}
+		result, err := Minimize(problem, []float64{-1, 1}, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if outside {
+			t.Errorf("%s: function evaluated outside the bounds", name)
+		}
+		if math.Abs(result.X[0]-0.5) > 1e-3 || math.Abs(result.X[1]-0.25) > 1e-2 {
+			t.Errorf("%s: constrained minimum not found: got %v, want [0.5 0.25]", name, result.X)
+		}
+	}
+}
+
+func TestPopulationMethodsConcurrent(t *testing.T) {
+	t.Parallel()
+	// The sequence of iterations must not depend on the number of
+	// concurrent evaluations.
+	const dim = 4
+	lower := []float64{-5, -5, -5, -5}
+	for name := range newPopulationMethods(nil, nil, 0) {
+		var want *Result
+		for _, concurrent := range []int{0, 1, 3, 8} {
+			method := newPopulationMethods(lower, nil, 1)[name]
+			settings := &Settings{
+				Converger:       NeverTerminate{},
+				MajorIterations: 50,
+				Concurrent:      concurrent,
+			}
+			result, err := Minimize(Problem{Func: functions.ExtendedRosenbrock{}.Func}, make([]float64, dim), settings, method)
+			if err != nil {
+				t.Errorf("%s: unexpected error with %d concurrent: %v", name, concurrent, err)
+				continue
+			}
+			if result.Status != IterationLimit {
+				t.Errorf("%s: unexpected status with %d concurrent: got %v, want %v", name, concurrent, result.Status, IterationLimit)
+			}
+			if want == nil {
+				want = result
+				continue
+			}
+			if result.F != want.F || !floats.Equal(result.X, want.X) || result.FuncEvaluations != want.FuncEvaluations {
+				t.Errorf("%s: result with %d concurrent differs: got %v at %v, want %v at %v",
+					name, concurrent, result.F, result.X, want.F, want.X)
+			}
+		}
+	}
+}
@@ -22,12 +22,14 @@ var printerHeadings = [...]string{
 	"GradEvals",
 	"|Gradient|∞",
 	"HessEvals",
+	"HessVecEvals",
 }
 
 const (
 	printerBaseTmpl = "%9v  %16v  %9v  %22v" // Base template for headings and values that are always printed.
 	printerGradTmpl = "  %9v  %22v"          // Appended to base template when loc.Gradient != nil.
 	printerHessTmpl = "  %9v"                // Appended to base template when loc.Hessian != nil.
+	printerHVecTmpl = "  %12v"               // Appended to base template when loc.HessVec != nil.
 )
 
 var _ Recorder = (*Printer)(nil)
@@ -85,6 +87,9 @@ func (p *Printer) Record(loc *Location, op Operation, stats *Stats) error {
 		if loc.Hessian != nil {
 			headings += fmt.Sprintf(printerHessTmpl, printerHeadings[6])
 		}
+		if loc.HessVec != nil {
+			headings += fmt.Sprintf(printerHVecTmpl, printerHeadings[7])
+		}
 		_, err := fmt.Fprintln(p.Writer, headings)
 		if err != nil {
 			return err
@@ -98,6 +103,9 @@ func (p *Printer) Record(loc *Location, op Operation, stats *Stats) error {
 	if loc.Hessian != nil {
 		values += fmt.Sprintf(printerHessTmpl, stats.HessEvaluations)
 	}
+	if loc.HessVec != nil {
+		values += fmt.Sprintf(printerHVecTmpl, stats.HessVecEvaluations)
+	}
 	_, err := fmt.Fprintln(p.Writer, values)
 	if err != nil {
 		return err
@@ -0,0 +1,241 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var (
+	_ Statuser = (*ParticleSwarm)(nil)
+	_ Method   = (*ParticleSwarm)(nil)
+)
+
+// ParticleSwarm implements the particle swarm optimization method with
+// a global-best topology and constant inertia weight, as described in
+//  Shi, Y., and Eberhart, R. "A modified particle swarm optimizer." IEEE
+//  International Conference on Evolutionary Computation (1998): 69-73.
+// Each particle of the swarm has a location and a velocity. In every
+// iteration, the velocity of a particle is updated by
+//  v = w*v + c_1*r_1*(p - x) + c_2*r_2*(g - x)
+// where p is the best location found by the particle, g is the best location
+// found by the swarm, and r_1 and r_2 are uniform random numbers in [0, 1)
+// drawn for every component. The particle then moves to x + v.
+//
+// The whole swarm is evaluated before the velocities are updated, so the
+// sequence of iterations does not depend on the number of concurrent
+// evaluations.
+//
+// Particles are projected onto the bounds given by Lower and Upper, and
+// the velocity components that would move a particle beyond a bound are
+// set to zero. The initial swarm consists of the initial location and random
+// samples which are uniformly distributed for variables with finite lower and
+// upper bounds, and normally distributed around the initial location otherwise.
+type ParticleSwarm struct {
+	// Lower and Upper specify the bounds on the variables. If Lower (Upper)
+	// is nil, the variables have no lower (upper) bound. If they are not nil,
+	// they must have length equal to the problem dimension.
+	Lower, Upper []float64
+	// Population sets the number of particles. If Population is 0, a default
+	// value of 10 + int(2*sqrt(dim)) is used. Population must not be negative.
+	Population int
+	// Inertia is the inertia weight w. If Inertia is 0, a default value of
+	// 0.7298 is used.
+	Inertia float64
+	// Cognitive and Social are the acceleration coefficients c_1 and c_2
+	// towards the best location of the particle and of the swarm, respectively.
+	// If they are 0, a default value of 1.49618 is used. They must not be
+	// negative.
+	Cognitive, Social float64
+	// InitStepSize is the standard deviation of the initial swarm locations and
+	// velocities for variables without finite lower and upper bounds. If
+	// InitStepSize is 0, a default value of 1 is used. InitStepSize must not be
+	// negative.
+	InitStepSize float64
+	// StopSpread sets the threshold for stopping the optimization when the
+	// difference between the largest and smallest function values at the
+	// current particle locations is less than StopSpread. If StopSpread is 0,
+	// a default value of 1e-12 is used. If StopSpread is NaN, the stopping
+	// criterion is not used.
+	StopSpread float64
+	// Src allows a random number generator to be supplied for generating
+	// samples. If Src is nil the generator in golang.org/x/exp/rand is used.
+	Src rand.Source
+
+	dim                        int
+	pop                        int
+	inertia, cognitive, social float64
+	step                       float64
+	rnd                        randFuncs
+
+	x0     []float64
+	init   bool // whether the initial swarm is being evaluated
+	xs     *mat.Dense
+	vs     *mat.Dense
+	pxs    *mat.Dense // best location of each particle
+	pfs    []float64
+	best   int // index of the particle with the best location
+	status Status
+
+	runner batchRunner
+}
+
+func (*ParticleSwarm) Uses(has Available) (uses Available, err error) {
+	return has.function()
+}
+
+// Status returns the status of the method.
+func (ps *ParticleSwarm) Status() (Status, error) {
+	return ps.status, nil
+}
+
+func (ps *ParticleSwarm) Init(dim, tasks int) int {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	checkBounds(ps.Lower, ps.Upper, dim)
+
+	ps.dim = dim
+	ps.pop = ps.Population
+	switch {
+	case ps.pop == 0:
+		ps.pop = 10 + int(2*math.Sqrt(float64(dim)))
+	case ps.pop < 0:
+		panic("particle swarm: negative population size")
+	}
+	ps.inertia = ps.Inertia
+	if ps.inertia == 0 {
+		ps.inertia = 0.7298
+	}
+	ps.cognitive = ps.Cognitive
+	switch {
+	case ps.cognitive == 0:
+		ps.cognitive = 1.49618
+	case ps.cognitive < 0:
+		panic("particle swarm: negative cognitive coefficient")
+	}
+	ps.social = ps.Social
+	switch {
+	case ps.social == 0:
+		ps.social = 1.49618
+	case ps.social < 0:
+		panic("particle swarm: negative social coefficient")
+	}
+	ps.step = ps.InitStepSize
+	switch {
+	case ps.step == 0:
+		ps.step = 1
+	case ps.step < 0:
+		panic("particle swarm: negative initial step size")
+	}
+	ps.rnd = newRandFuncs(ps.Src)
+
+	ps.x0 = resize(ps.x0, dim)
+	ps.init = true
+	ps.xs = mat.NewDense(ps.pop, dim, nil)
+	ps.vs = mat.NewDense(ps.pop, dim, nil)
+	ps.pxs = mat.NewDense(ps.pop, dim, nil)
+	ps.pfs = resize(ps.pfs, ps.pop)
+	ps.best = 0
+	ps.status = NotTerminated
+
+	ps.runner.init(dim, ps.pop)
+	return min(tasks, ps.pop)
+}
+
+func (ps *ParticleSwarm) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	copy(ps.x0, tasks[0].X)
+	ps.runner.run(ps, operations, results, tasks)
+}
+
+func (ps *ParticleSwarm) batchSize() int {
+	return ps.pop
+}
+
+func (ps *ParticleSwarm) sample(i int, x []float64) {
+	v := ps.vs.RawRowView(i)
+	if ps.init {
+		if i == 0 {
+			copy(x, ps.x0)
+			clip(x, ps.Lower, ps.Upper)
+		} else {
+			sampleInitial(x, ps.x0, ps.Lower, ps.Upper, ps.step, ps.rnd)
+		}
+		// The initial velocity is half of the distance to another random
+		// location.
+		sampleInitial(v, ps.x0, ps.Lower, ps.Upper, ps.step, ps.rnd)
+		for j := range v {
+			v[j] = (v[j] - x[j]) / 2
+		}
+		copy(ps.xs.RawRowView(i), x)
+		return
+	}
+
+	xi := ps.xs.RawRowView(i)
+	p := ps.pxs.RawRowView(i)
+	g := ps.pxs.RawRowView(ps.best)
+	for j := range v {
+		r1 := ps.rnd.float64()
+		r2 := ps.rnd.float64()
+		v[j] = ps.inertia*v[j] + ps.cognitive*r1*(p[j]-xi[j]) + ps.social*r2*(g[j]-xi[j])
+		x[j] = xi[j] + v[j]
+		l, u := bounds(ps.Lower, ps.Upper, j)
+		if x[j] < l {
+			x[j] = l
+			v[j] = 0
+		} else if x[j] > u {
+			x[j] = u
+			v[j] = 0
+		}
+	}
+	copy(xi, x)
+}
+
+func (ps *ParticleSwarm) update(xs *mat.Dense, fs []float64) bool {
+	if ps.init {
+		ps.pxs.Copy(xs)
+		copy(ps.pfs, fs)
+		ps.init = false
+	} else {
+		for i, f := range fs {
+			if f < ps.pfs[i] || math.IsNaN(ps.pfs[i]) {
+				ps.pfs[i] = f
+				copy(ps.pxs.RawRowView(i), xs.RawRowView(i))
+			}
+		}
+	}
+	ps.best = 0
+	for i, f := range ps.pfs {
+		if f < ps.pfs[ps.best] || math.IsNaN(ps.pfs[ps.best]) {
+			ps.best = i
+		}
+	}
+
+	stop := ps.StopSpread
+	switch {
+	case math.IsNaN(stop):
+		return false
+	case stop == 0:
+		stop = 1e-12
+	}
+	lo := math.Inf(1)
+	hi := math.Inf(-1)
+	for _, f := range fs {
+		lo = math.Min(lo, f)
+		hi = math.Max(hi, f)
+	}
+	if hi-lo < stop {
+		ps.status = MethodConverge
+		return true
+	}
+	return false
+}
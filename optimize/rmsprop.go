@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+var (
+	_ Method       = (*RMSProp)(nil)
+	_ Statuser     = (*RMSProp)(nil)
+	_ lossAverager = (*RMSProp)(nil)
+)
+
+// RMSProp implements the RMSProp stochastic gradient method, which scales the
+// steps of the variables by a moving average of the squares of their
+// gradients. In every iteration, the gradient g of the objective function is
+// evaluated on a minibatch, and the location is updated by
+//  s = Decay*s + (1-Decay)*g²
+//  v = Momentum*v + rate * g / (√s + Epsilon)
+//  x = x - v
+// Unlike in AdaGrad, the effective learning rate does not decrease
+// indefinitely.
+//
+// See SGD for the handling of minibatches and the reported function values.
+type RMSProp struct {
+	// LearningRate is the learning-rate schedule. If LearningRate is nil,
+	// a constant rate of 0.001 is used.
+	LearningRate Schedule
+	// Decay is the decay rate of the moving average of the squared
+	// gradients in [0, 1). If Decay is 0, it is defaulted to 0.9.
+	Decay float64
+	// Momentum is the momentum coefficient in [0, 1). If Momentum is 0, no
+	// momentum is used.
+	Momentum float64
+	// Epsilon is added to the denominator of the update for numerical
+	// stability. If Epsilon is 0, it is defaulted to 1e-8.
+	Epsilon float64
+	// BatchSize is the number of terms in a minibatch. If BatchSize is 0,
+	// it is defaulted to 32.
+	BatchSize int
+	// LossWindow is the number of iterations of the moving average of the
+	// function values. If LossWindow is 0, it is defaulted to 100.
+	LossWindow int
+	// Src is the source of random numbers for drawing the minibatches. If
+	// Src is nil, the global source of golang.org/x/exp/rand is used.
+	Src rand.Source
+
+	s     stochastic
+	decay float64
+	eps   float64
+	sq, v []float64
+}
+
+// Status returns the status of the method.
+func (r *RMSProp) Status() (Status, error) {
+	return r.s.status, r.s.err
+}
+
+func (r *RMSProp) averageLoss() float64 {
+	return r.s.loss
+}
+
+// Uses returns the functions of the Problem that are used by RMSProp, which
+// are BatchFunc and BatchGrad if available, and Grad otherwise.
+func (r *RMSProp) Uses(has Available) (uses Available, err error) {
+	return r.s.uses(has)
+}
+
+func (r *RMSProp) Init(dim, tasks int) int {
+	r.decay = defaultDecay(r.Decay, 0.9, "rmsprop: decay out of range")
+	if r.Momentum < 0 || 1 <= r.Momentum {
+		panic("rmsprop: momentum out of range")
+	}
+	r.eps = defaultEpsilon(r.Epsilon, "rmsprop: negative epsilon")
+	r.s.init(dim, tasks, r.LearningRate, 0.001, r.BatchSize, r.LossWindow, r.Src)
+	return 1
+}
+
+func (r *RMSProp) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	r.s.run(r, operations, results, tasks)
+}
+
+func (r *RMSProp) init(dim int) {
+	r.sq = resize(r.sq, dim)
+	r.v = resize(r.v, dim)
+	for i := range r.sq {
+		r.sq[i] = 0
+		r.v[i] = 0
+	}
+}
+
+func (r *RMSProp) step(x, grad []float64, t int, rate float64) {
+	for i, g := range grad {
+		r.sq[i] = r.decay*r.sq[i] + (1-r.decay)*g*g
+		r.v[i] = r.Momentum*r.v[i] + rate*g/(math.Sqrt(r.sq[i])+r.eps)
+		x[i] -= r.v[i]
+	}
+}
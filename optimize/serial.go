@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import "math"
+
+// serialRunner implements the Run protocol of Method for methods that
+// evaluate the objective function at one location at a time, which allows
+// the methods to be written as sequential code.
+type serialRunner struct {
+	operations chan<- Task
+	results    <-chan Task
+	task       Task
+
+	stopped bool
+}
+
+func newSerialRunner(operations chan<- Task, results <-chan Task, task Task) *serialRunner {
+	return &serialRunner{
+		operations: operations,
+		results:    results,
+		task:       task,
+	}
+}
+
+// initial returns the function value at the initial location x0 of the
+// task, evaluating it if it is not known.
+func (r *serialRunner) initial() (f float64, ok bool) {
+	if r.task.Op&FuncEvaluation != 0 {
+		return r.task.F, true
+	}
+	return r.eval(r.task.X)
+}
+
+// eval evaluates the objective function at x. ok is false if the
+// optimization has been stopped by the caller, and the method must then
+// return without sending further operations.
+func (r *serialRunner) eval(x []float64) (f float64, ok bool) {
+	if r.stopped {
+		return math.NaN(), false
+	}
+	copy(r.task.X, x)
+	r.task.Op = FuncEvaluation
+	r.operations <- r.task
+	r.task = <-r.results
+	if r.task.Op == PostIteration {
+		r.stopped = true
+		return math.NaN(), false
+	}
+	return r.task.F, true
+}
+
+// major sends a MajorIteration with the location x and the function value f.
+// ok is false if the optimization has been stopped by the caller.
+func (r *serialRunner) major(x []float64, f float64) (ok bool) {
+	if r.stopped {
+		return false
+	}
+	copy(r.task.X, x)
+	r.task.F = f
+	r.task.Op = MajorIteration
+	r.operations <- r.task
+	r.task = <-r.results
+	if r.task.Op == PostIteration {
+		r.stopped = true
+		return false
+	}
+	return true
+}
+
+// finish completes the channel operations, sending a MethodDone if the
+// optimization has not been stopped by the caller, and closes operations.
+func (r *serialRunner) finish() {
+	if !r.stopped {
+		r.task.Op = MethodDone
+		r.operations <- r.task
+		r.task = <-r.results
+		if r.task.Op != PostIteration {
+			panic("optimize: task should have returned post iteration")
+		}
+	}
+	for range r.results {
+	}
+	close(r.operations)
+}
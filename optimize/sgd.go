@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+)
+
+var (
+	_ Method       = (*SGD)(nil)
+	_ Statuser     = (*SGD)(nil)
+	_ lossAverager = (*SGD)(nil)
+)
+
+// SGD implements stochastic gradient descent with optional momentum. In
+// every iteration, the gradient g of the objective function is evaluated on a
+// minibatch, and the location is updated by
+//  v = Momentum*v - rate*g
+//  x = x + v
+// or, with Nesterov momentum, by
+//  x = x + Momentum*v - rate*g
+// where v is the velocity after the update.
+//
+// If the Problem provides BatchFunc and BatchGrad, the minibatches consist of
+// BatchSize terms of the objective function that are drawn without
+// replacement in every epoch. Otherwise the Problem's Func and Grad are
+// evaluated in every iteration, and they may be stochastic themselves.
+//
+// The function value reported at every MajorIteration is the value of the
+// objective function at the location if Func is evaluated, and NaN if only
+// minibatches are evaluated, in which case the Func of the Problem may be nil.
+// The Converger of Minimize is passed the exponential moving average of the
+// evaluated function values over about LossWindow iterations instead, so
+// that it can detect the convergence of the average loss. SGD does not
+// converge by itself, and the optimization terminates when the Converger or
+// the limits in Settings stop it.
+type SGD struct {
+	// LearningRate is the learning-rate schedule. If LearningRate is nil,
+	// a constant rate of 0.01 is used.
+	LearningRate Schedule
+	// Momentum is the momentum coefficient in [0, 1). If Momentum is 0, no
+	// momentum is used.
+	Momentum float64
+	// Nesterov specifies whether Nesterov momentum is used.
+	Nesterov bool
+	// BatchSize is the number of terms in a minibatch. If BatchSize is 0,
+	// it is defaulted to 32.
+	BatchSize int
+	// LossWindow is the number of iterations of the moving average of the
+	// function values. If LossWindow is 0, it is defaulted to 100.
+	LossWindow int
+	// Src is the source of random numbers for drawing the minibatches. If
+	// Src is nil, the global source of golang.org/x/exp/rand is used.
+	Src rand.Source
+
+	s stochastic
+	v []float64
+}
+
+// Status returns the status of the method.
+func (sgd *SGD) Status() (Status, error) {
+	return sgd.s.status, sgd.s.err
+}
+
+func (sgd *SGD) averageLoss() float64 {
+	return sgd.s.loss
+}
+
+// Uses returns the functions of the Problem that are used by SGD, which are
+// BatchFunc and BatchGrad if available, and Grad otherwise.
+func (sgd *SGD) Uses(has Available) (uses Available, err error) {
+	return sgd.s.uses(has)
+}
+
+func (sgd *SGD) Init(dim, tasks int) int {
+	if sgd.Momentum < 0 || 1 <= sgd.Momentum {
+		panic("sgd: momentum out of range")
+	}
+	sgd.s.init(dim, tasks, sgd.LearningRate, 0.01, sgd.BatchSize, sgd.LossWindow, sgd.Src)
+	return 1
+}
+
+func (sgd *SGD) Run(operations chan<- Task, results <-chan Task, tasks []Task) {
+	sgd.s.run(sgd, operations, results, tasks)
+}
+
+func (sgd *SGD) init(dim int) {
+	sgd.v = resize(sgd.v, dim)
+	for i := range sgd.v {
+		sgd.v[i] = 0
+	}
+}
+
+func (sgd *SGD) step(x, grad []float64, t int, rate float64) {
+	if sgd.Momentum == 0 {
+		floats.AddScaled(x, -rate, grad)
+		return
+	}
+	floats.Scale(sgd.Momentum, sgd.v)
+	floats.AddScaled(sgd.v, -rate, grad)
+	if sgd.Nesterov {
+		floats.AddScaled(x, sgd.Momentum, sgd.v)
+		floats.AddScaled(x, -rate, grad)
+		return
+	}
+	floats.Add(x, sgd.v)
+}
@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+)
+
+var (
+	_ Method      = (*SteihaugCG)(nil)
+	_ localMethod = (*SteihaugCG)(nil)
+)
+
+// SteihaugCG implements a trust-region Newton method for unconstrained
+// minimization in which the trust-region subproblem is solved approximately by
+// the truncated conjugate gradient method of Steihaug. SteihaugCG does not
+// need the Hessian matrix, only its products with vectors, which are obtained
+// from the HessVec field of Problem.
+//
+// At each iteration, the conjugate gradient method is applied to the Newton
+// equation
+//  H_k p = -∇f_k
+// starting from p = 0. The iterations are terminated when the residual is
+// sufficiently small, when a direction of non-positive curvature is
+// encountered, or when the iterate leaves the trust region. In the latter two
+// cases the step is continued to the boundary of the trust region. Acceptance
+// of the step and the update of the trust-region radius are the same as in
+// TrustRegion.
+//
+// The method is described in Section 7.1 of
+//  Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer (2006)
+// and in
+//  Steihaug, T.: The conjugate gradient method and trust regions in large scale
+//  optimization. SIAM Journal on Numerical Analysis 20.3 (1983): 626-637.
+type SteihaugCG struct {
+	// InitRadius is the initial trust-region radius. If InitRadius is 0, it is
+	// defaulted to 1. InitRadius must not be negative.
+	InitRadius float64
+	// MaxRadius is the maximum trust-region radius. If MaxRadius is 0, the
+	// radius is not bounded. MaxRadius must not be less than InitRadius.
+	MaxRadius float64
+	// AcceptRatio is the minimum ratio of the actual to the predicted reduction
+	// of the function for a step to be accepted. AcceptRatio must be in
+	// [0, 1/4). If AcceptRatio is 0, it is defaulted to 0.1.
+	AcceptRatio float64
+	// MaxCGIterations is the maximum number of conjugate gradient iterations
+	// used to solve the subproblem. If MaxCGIterations is 0, it is defaulted
+	// to the problem dimension.
+	MaxCGIterations int
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	tr trustRegion
+
+	// Conjugate gradient state.
+	radius  float64
+	tol     float64 // tolerance on the residual norm
+	iter    int
+	maxIter int
+	r       []float64 // residual H p + ∇f
+	d       []float64 // search direction
+	rr      float64   // squared norm of r
+}
+
+func (s *SteihaugCG) Status() (Status, error) {
+	return s.status, s.err
+}
+
+func (*SteihaugCG) Uses(has Available) (uses Available, err error) {
+	return has.hessVec()
+}
+
+func (s *SteihaugCG) Init(dim, tasks int) int {
+	s.status = NotTerminated
+	s.err = nil
+	return 1
+}
+
+func (s *SteihaugCG) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	s.status, s.err = localOptimizer{}.run(s, s.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (s *SteihaugCG) initLocal(loc *Location) (Operation, error) {
+	if s.MaxCGIterations < 0 {
+		panic("optimize: negative MaxCGIterations")
+	}
+	s.tr.init(s, GradEvaluation, s.InitRadius, s.MaxRadius, s.AcceptRatio)
+	return s.tr.initLocal(loc)
+}
+
+func (s *SteihaugCG) iterateLocal(loc *Location) (Operation, error) {
+	return s.tr.iterateLocal(loc)
+}
+
+func (s *SteihaugCG) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, false}
+}
+
+// initStep starts the conjugate gradient iterations from p = 0.
+func (s *SteihaugCG) initStep(loc *Location, radius float64, step []float64) (op Operation, pred float64) {
+	dim := len(loc.X)
+	s.radius = radius
+	s.iter = 0
+	s.maxIter = s.MaxCGIterations
+	if s.maxIter == 0 {
+		s.maxIter = dim
+	}
+	s.r = resize(s.r, dim)
+	s.d = resize(s.d, dim)
+	loc.HessVecDir = resize(loc.HessVecDir, dim)
+
+	for i := range step {
+		step[i] = 0
+	}
+	copy(s.r, loc.Gradient)
+	floats.ScaleTo(s.d, -1, s.r)
+	s.rr = floats.Dot(s.r, s.r)
+	// Use the forcing sequence min(1/2, sqrt(|∇f|)) |∇f| for superlinear
+	// convergence.
+	gnorm := math.Sqrt(s.rr)
+	s.tol = math.Min(0.5, math.Sqrt(gnorm)) * gnorm
+	copy(loc.HessVecDir, s.d)
+	return HessVecEvaluation, 0
+}
+
+// iterateStep performs a conjugate gradient iteration using the product of
+// the Hessian with the search direction in loc.HessVec.
+func (s *SteihaugCG) iterateStep(loc *Location, step []float64) (op Operation, pred float64) {
+	hd := loc.HessVec
+	dhd := floats.Dot(s.d, hd)
+	if dhd <= 0 {
+		// Negative curvature, move to the boundary along d.
+		return NoOperation, s.boundary(loc, step, hd)
+	}
+	alpha := s.rr / dhd
+	// Check whether the new iterate leaves the trust region.
+	var pp float64
+	for i, p := range step {
+		v := p + alpha*s.d[i]
+		pp += v * v
+	}
+	if math.Sqrt(pp) >= s.radius {
+		return NoOperation, s.boundary(loc, step, hd)
+	}
+	floats.AddScaled(step, alpha, s.d)
+	floats.AddScaled(s.r, alpha, hd)
+	rrNew := floats.Dot(s.r, s.r)
+	s.iter++
+	if math.Sqrt(rrNew) <= s.tol || s.iter >= s.maxIter {
+		return NoOperation, s.predicted(loc, step)
+	}
+	beta := rrNew / s.rr
+	s.rr = rrNew
+	for i, r := range s.r {
+		s.d[i] = -r + beta*s.d[i]
+	}
+	copy(loc.HessVecDir, s.d)
+	return HessVecEvaluation, 0
+}
+
+// boundary moves step along the search direction d to the boundary of the
+// trust region and returns the predicted reduction. hd is the product of the
+// Hessian with d.
+func (s *SteihaugCG) boundary(loc *Location, step, hd []float64) float64 {
+	// Find τ ≥ 0 such that |p + τd| = radius.
+	pd := floats.Dot(step, s.d)
+	dd := floats.Dot(s.d, s.d)
+	pp := floats.Dot(step, step)
+	tau := (-pd + math.Sqrt(pd*pd+dd*(s.radius*s.radius-pp))) / dd
+	floats.AddScaled(step, tau, s.d)
+	floats.AddScaled(s.r, tau, hd)
+	return s.predicted(loc, step)
+}
+
+// predicted returns the reduction of f predicted by the quadratic model at
+// step, using the residual r = H p + ∇f.
+func (s *SteihaugCG) predicted(loc *Location, step []float64) float64 {
+	// m(p) - f = ∇fᵀp + 1/2 pᵀHp = 1/2 pᵀ(∇f + r).
+	var m float64
+	for i, p := range step {
+		m += p * (loc.Gradient[i] + s.r[i])
+	}
+	return -m / 2
+}
@@ -0,0 +1,289 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+)
+
+const (
+	defaultBatchSize  = 32
+	defaultLossWindow = 100
+)
+
+// stepper is a stochastic gradient method that updates the location using
+// the gradient of the objective function on a minibatch.
+type stepper interface {
+	// init initializes the state of the method for a problem of dimension
+	// dim.
+	init(dim int)
+
+	// step updates x using the gradient grad and the learning rate in
+	// iteration t, starting from 0.
+	step(x, grad []float64, t int, rate float64)
+}
+
+// stochastic implements the Run protocol of Method for a stepper.
+//
+// In every major iteration, the objective function and its gradient are
+// evaluated on a minibatch of the terms of the objective function if the
+// Problem provides BatchFunc and BatchGrad, and on the entire objective
+// function otherwise. The minibatches are drawn without replacement from a
+// random permutation of the terms that is renewed in every epoch. The
+// MajorIteration reports the location at which the gradient was evaluated
+// with the function value at that location, or NaN if only a minibatch was
+// evaluated. The Converger of Minimize is instead passed the exponential
+// moving average of the evaluated function values, so that it detects
+// convergence of the average loss instead of being misled by the noise of
+// the individual values.
+type stochastic struct {
+	rate      Schedule
+	batchSize int
+	window    int
+
+	batch bool
+	terms int
+
+	status Status
+	err    error
+
+	perm []int
+	pos  int
+	rnd  randFuncs
+	avg  float64
+	bias float64
+	loss float64
+}
+
+// lossAverager is implemented by methods that report noisy function values
+// at major iterations. Minimize passes the average loss instead of the
+// function value of the location to the Converger.
+type lossAverager interface {
+	// averageLoss returns the average of the function values up to the
+	// last MajorIteration.
+	averageLoss() float64
+}
+
+// init initializes the runner with the settings of a method. If rate is nil,
+// a constant learning rate defaultRate is used.
+func (s *stochastic) init(dim, tasks int, rate Schedule, defaultRate float64, batchSize, window int, src rand.Source) {
+	if dim <= 0 {
+		panic(nonpositiveDimension)
+	}
+	if tasks < 0 {
+		panic(negativeTasks)
+	}
+	if batchSize < 0 {
+		panic("optimize: negative batch size")
+	}
+	if window < 0 {
+		panic("optimize: negative loss window")
+	}
+	if batchSize == 0 {
+		batchSize = defaultBatchSize
+	}
+	if window == 0 {
+		window = defaultLossWindow
+	}
+	if rate == nil {
+		rate = ConstantRate(defaultRate)
+	}
+	s.rate = rate
+	s.batchSize = batchSize
+	s.window = window
+	s.rnd = newRandFuncs(src)
+	s.perm = s.perm[:0]
+	s.pos = 0
+	s.avg = 0
+	s.bias = 1
+	s.loss = math.NaN()
+	s.status = NotTerminated
+	s.err = nil
+}
+
+// nextBatch returns the indices of the terms in the next minibatch.
+func (s *stochastic) nextBatch() []int {
+	if len(s.perm) != s.terms {
+		s.perm = make([]int, s.terms)
+		for i := range s.perm {
+			s.perm[i] = i
+		}
+		s.pos = s.terms
+	}
+	if s.pos >= s.terms {
+		// Start a new epoch.
+		for i := len(s.perm) - 1; i > 0; i-- {
+			j := s.rnd.intn(i + 1)
+			s.perm[i], s.perm[j] = s.perm[j], s.perm[i]
+		}
+		s.pos = 0
+	}
+	end := s.pos + s.batchSize
+	if end > s.terms {
+		end = s.terms
+	}
+	batch := s.perm[s.pos:end]
+	s.pos = end
+	return batch
+}
+
+// average updates the exponential moving average of the function values with
+// f, and returns the average corrected for the bias of its zero initial value.
+func (s *stochastic) average(f float64) float64 {
+	beta := 1 - 1/float64(s.window)
+	s.avg = beta*s.avg + (1-beta)*f
+	s.bias *= beta
+	return s.avg / (1 - s.bias)
+}
+
+// uses records whether minibatch evaluations are used for the problem
+// described by has.
+func (s *stochastic) uses(has Available) (uses Available, err error) {
+	uses, err = has.stochastic()
+	s.batch = uses.Batch
+	s.terms = uses.Terms
+	return uses, err
+}
+
+func (s *stochastic) run(m stepper, operations chan<- Task, results <-chan Task, tasks []Task) {
+	task := tasks[0]
+	m.init(len(task.X))
+	for t := 0; ; t++ {
+		task.Op = FuncEvaluation | GradEvaluation
+		task.Batch = nil
+		if s.batch {
+			task.Op |= BatchEvaluation
+			task.Batch = s.nextBatch()
+		}
+		operations <- task
+		task = <-results
+		if task.Op == PostIteration {
+			break
+		}
+		if math.IsNaN(task.F) || math.IsInf(task.F, 0) {
+			s.status, s.err = Failure, ErrNonFiniteFunc
+			task.Op = MethodDone
+			operations <- task
+			task = <-results
+			if task.Op != PostIteration {
+				panic("optimize: task should have returned post iteration")
+			}
+			break
+		}
+
+		s.loss = s.average(task.F)
+		if s.batch {
+			// The minibatch loss is not the value of the
+			// objective function at the location.
+			task.F = math.NaN()
+		}
+		task.Op = MajorIteration
+		operations <- task
+		task = <-results
+		if task.Op == PostIteration {
+			break
+		}
+		m.step(task.X, task.Gradient, t, s.rate.Rate(t))
+	}
+	for range results {
+	}
+	close(operations)
+}
+
+// Schedule is a learning-rate schedule of a stochastic gradient method.
+type Schedule interface {
+	// Rate returns the learning rate in iteration t, starting from 0.
+	Rate(t int) float64
+}
+
+// ConstantRate is a constant learning rate.
+type ConstantRate float64
+
+// Rate returns the learning rate, which is the same in all iterations.
+func (r ConstantRate) Rate(t int) float64 { return float64(r) }
+
+// StepDecay is a learning rate that is multiplied by Factor every Steps
+// iterations,
+//  Initial * Factor^⌊t/Steps⌋.
+type StepDecay struct {
+	Initial float64
+	Factor  float64
+	Steps   int
+}
+
+// Rate returns the learning rate in iteration t.
+func (r StepDecay) Rate(t int) float64 {
+	if r.Steps <= 0 {
+		panic("optimize: non-positive number of steps")
+	}
+	return r.Initial * math.Pow(r.Factor, float64(t/r.Steps))
+}
+
+// ExponentialDecay is a learning rate that decays exponentially,
+//  Initial * Decay^t.
+type ExponentialDecay struct {
+	Initial float64
+	Decay   float64
+}
+
+// Rate returns the learning rate in iteration t.
+func (r ExponentialDecay) Rate(t int) float64 {
+	return r.Initial * math.Pow(r.Decay, float64(t))
+}
+
+// InverseTimeDecay is a learning rate that decays with the inverse of the
+// iteration number,
+//  Initial / (1 + Decay*t).
+// With Decay > 0, it satisfies the Robbins–Monro conditions for the
+// convergence of stochastic gradient descent.
+type InverseTimeDecay struct {
+	Initial float64
+	Decay   float64
+}
+
+// Rate returns the learning rate in iteration t.
+func (r InverseTimeDecay) Rate(t int) float64 {
+	return r.Initial / (1 + r.Decay*float64(t))
+}
+
+// CosineAnnealing is a learning rate that decreases from Initial to Final
+// along a half cosine wave over Period iterations after a linear warm-up from
+// zero over Warmup iterations,
+//  Final + (Initial-Final)/2 * (1 + cos(π (t-Warmup)/Period)).
+// The learning rate is Final after Warmup+Period iterations. If Restart is
+// true, the cosine wave is restarted after every Period iterations as
+// described in
+//  Loshchilov, I., Hutter, F.: SGDR: Stochastic gradient descent with warm
+//  restarts. In: International Conference on Learning Representations (2017)
+type CosineAnnealing struct {
+	Initial float64
+	Final   float64
+	Period  int
+	Warmup  int
+	Restart bool
+}
+
+// Rate returns the learning rate in iteration t.
+func (r CosineAnnealing) Rate(t int) float64 {
+	if r.Period <= 0 {
+		panic("optimize: non-positive period")
+	}
+	if r.Warmup < 0 {
+		panic("optimize: negative warm-up")
+	}
+	if t < r.Warmup {
+		return r.Initial * float64(t+1) / float64(r.Warmup+1)
+	}
+	t -= r.Warmup
+	switch {
+	case r.Restart:
+		t %= r.Period
+	case t >= r.Period:
+		return r.Final
+	}
+	return r.Final + (r.Initial-r.Final)/2*(1+math.Cos(math.Pi*float64(t)/float64(r.Period)))
+}
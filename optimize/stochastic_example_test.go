@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/optimize"
+)
+
+func ExampleAdam() {
+	// Fit a logistic regression model
+	//  P(y = 1 | t) = 1 / (1 + exp(-(w0 + w1*t)))
+	// to noisy observations by minimizing the mean negative
+	// log-likelihood over minibatches of the data.
+	rnd := rand.New(rand.NewSource(1))
+	const n = 1000
+	t := make([]float64, n)
+	y := make([]float64, n)
+	for i := range t {
+		t[i] = 4*rnd.Float64() - 2
+		if rnd.Float64() < 1/(1+math.Exp(-(0.5+2*t[i]))) {
+			y[i] = 1
+		}
+	}
+
+	// prob returns the predicted probability for the ith observation.
+	prob := func(w []float64, i int) float64 {
+		return 1 / (1 + math.Exp(-(w[0] + w[1]*t[i])))
+	}
+	batchFunc := func(w []float64, batch []int) float64 {
+		var f float64
+		for _, i := range batch {
+			p := prob(w, i)
+			f -= y[i]*math.Log(p) + (1-y[i])*math.Log(1-p)
+		}
+		return f / float64(len(batch))
+	}
+	batchGrad := func(grad, w []float64, batch []int) {
+		grad[0], grad[1] = 0, 0
+		for _, i := range batch {
+			r := prob(w, i) - y[i]
+			grad[0] += r / float64(len(batch))
+			grad[1] += r * t[i] / float64(len(batch))
+		}
+	}
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	p := optimize.Problem{
+		Func:      func(w []float64) float64 { return batchFunc(w, all) },
+		Terms:     n,
+		BatchFunc: batchFunc,
+		BatchGrad: batchGrad,
+	}
+
+	method := &optimize.Adam{
+		LearningRate: optimize.ExponentialDecay{Initial: 0.05, Decay: 0.999},
+		BatchSize:    50,
+		Src:          rand.NewSource(1),
+	}
+	settings := &optimize.Settings{
+		Converger: &optimize.FunctionConverge{
+			Relative:   1e-4,
+			Iterations: 500,
+		},
+	}
+	result, err := optimize.Minimize(p, []float64{0, 0}, settings, method)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("status: %v\n", result.Status)
+	fmt.Printf("w: %.2f\n", result.X)
+
+	// Compare with the maximum-likelihood estimate on the full data.
+	p.Grad = func(grad, w []float64) { batchGrad(grad, w, all) }
+	result, err = optimize.Minimize(p, []float64{0, 0}, nil, &optimize.BFGS{})
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("full-batch w: %.2f\n", result.X)
+	// Output:
+	// status: FunctionConvergence
+	// w: [0.34 2.02]
+	// full-batch w: [0.35 2.02]
+}
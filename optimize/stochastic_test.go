@@ -0,0 +1,404 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+// leastSquares is a linear least-squares problem
+//  f(w) = 1/n \sum_i 1/2 (a_iᵀ w - b_i)²
+// with one term for each row of a.
+type leastSquares struct {
+	a *mat.Dense
+	b []float64
+}
+
+func newLeastSquares(n int, w []float64, noise float64, src rand.Source) leastSquares {
+	rnd := rand.New(src)
+	a := mat.NewDense(n, len(w), nil)
+	b := make([]float64, n)
+	for i := 0; i < n; i++ {
+		row := a.RawRowView(i)
+		row[0] = 1
+		for j := 1; j < len(row); j++ {
+			row[j] = rnd.NormFloat64()
+		}
+		b[i] = floats.Dot(row, w) + noise*rnd.NormFloat64()
+	}
+	return leastSquares{a: a, b: b}
+}
+
+func (ls leastSquares) residual(x []float64, i int) float64 {
+	return floats.Dot(ls.a.RawRowView(i), x) - ls.b[i]
+}
+
+func (ls leastSquares) batchFunc(x []float64, batch []int) float64 {
+	var f float64
+	for _, i := range batch {
+		r := ls.residual(x, i)
+		f += r * r / 2
+	}
+	return f / float64(len(batch))
+}
+
+func (ls leastSquares) batchGrad(grad, x []float64, batch []int) {
+	for j := range grad {
+		grad[j] = 0
+	}
+	for _, i := range batch {
+		floats.AddScaled(grad, ls.residual(x, i)/float64(len(batch)), ls.a.RawRowView(i))
+	}
+}
+
+func (ls leastSquares) all() []int {
+	r, _ := ls.a.Dims()
+	all := make([]int, r)
+	for i := range all {
+		all[i] = i
+	}
+	return all
+}
+
+func (ls leastSquares) problem() Problem {
+	all := ls.all()
+	return Problem{
+		Func:      func(x []float64) float64 { return ls.batchFunc(x, all) },
+		Grad:      func(grad, x []float64) { ls.batchGrad(grad, x, all) },
+		Terms:     len(all),
+		BatchFunc: ls.batchFunc,
+		BatchGrad: ls.batchGrad,
+	}
+}
+
+// solution returns the minimizer of the least-squares problem.
+func (ls leastSquares) solution() []float64 {
+	var w mat.VecDense
+	err := w.SolveVec(ls.a, mat.NewVecDense(len(ls.b), ls.b))
+	if err != nil {
+		panic(err)
+	}
+	return w.RawVector().Data
+}
+
+func newStochasticMethods(seed uint64) map[string]Method {
+	src := func() rand.Source { return rand.NewSource(seed) }
+	return map[string]Method{
+		"SGD": &SGD{
+			LearningRate: InverseTimeDecay{Initial: 0.1, Decay: 0.01},
+			Src:          src(),
+		},
+		"SGDMomentum": &SGD{
+			LearningRate: ConstantRate(0.01),
+			Momentum:     0.9,
+			Src:          src(),
+		},
+		"SGDNesterov": &SGD{
+			LearningRate: StepDecay{Initial: 0.01, Factor: 0.5, Steps: 500},
+			Momentum:     0.9,
+			Nesterov:     true,
+			Src:          src(),
+		},
+		"Adam": &Adam{
+			LearningRate: ExponentialDecay{Initial: 0.05, Decay: 0.999},
+			Src:          src(),
+		},
+		"AMSGrad": &Adam{
+			LearningRate: ExponentialDecay{Initial: 0.05, Decay: 0.999},
+			AMSGrad:      true,
+			Src:          src(),
+		},
+		"AdaGrad": &AdaGrad{
+			LearningRate: ConstantRate(0.5),
+			Src:          src(),
+		},
+		"RMSProp": &RMSProp{
+			LearningRate: CosineAnnealing{Initial: 0.01, Final: 1e-4, Period: 2000, Warmup: 50},
+			Src:          src(),
+		},
+	}
+}
+
+func TestStochastic(t *testing.T) {
+	t.Parallel()
+	ls := newLeastSquares(500, []float64{1, -2, 0.5}, 0.1, rand.NewSource(1))
+	want := ls.solution()
+	for name, method := range newStochasticMethods(1) {
+		settings := &Settings{
+			Converger: &FunctionConverge{
+				Relative:   1e-4,
+				Iterations: 200,
+			},
+			MajorIterations: 20000,
+		}
+		// Func is not needed when only minibatches are evaluated.
+		problem := ls.problem()
+		problem.Func = nil
+		result, err := Minimize(problem, make([]float64, len(want)), settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if result.Status != FunctionConvergence {
+			t.Errorf("%s: unexpected status: got %v, want %v", name, result.Status, FunctionConvergence)
+		}
+		if !math.IsNaN(result.F) {
+			t.Errorf("%s: unexpected function value for minibatch evaluations: got %v, want NaN", name, result.F)
+		}
+		if !floats.EqualApprox(result.X, want, 1e-2) {
+			t.Errorf("%s: minimum not found: got %v, want %v", name, result.X, want)
+		}
+		if result.FuncEvaluations != result.MajorIterations && result.FuncEvaluations != result.MajorIterations+1 {
+			t.Errorf("%s: unexpected number of evaluations: got %d with %d iterations", name, result.FuncEvaluations, result.MajorIterations)
+		}
+	}
+}
+
+func TestStochasticMinibatches(t *testing.T) {
+	t.Parallel()
+	// Every term must be evaluated exactly once in every epoch, and the
+	// gradient must be evaluated on the same minibatch as the function.
+	const (
+		terms     = 50
+		batchSize = 8
+		epochs    = 3
+	)
+	ls := newLeastSquares(terms, []float64{1, 2}, 0.1, rand.NewSource(1))
+	for name, method := range newStochasticMethods(1) {
+		var count [terms]int
+		var last []int
+		problem := ls.problem()
+		problem.BatchFunc = func(x []float64, batch []int) float64 {
+			for _, i := range batch {
+				count[i]++
+			}
+			last = append(last[:0], batch...)
+			return ls.batchFunc(x, batch)
+		}
+		problem.BatchGrad = func(grad, x []float64, batch []int) {
+			if !intsEqual(batch, last) {
+				t.Errorf("%s: gradient evaluated on a different minibatch", name)
+			}
+			ls.batchGrad(grad, x, batch)
+		}
+		switch m := method.(type) {
+		case *SGD:
+			m.BatchSize = batchSize
+		case *Adam:
+			m.BatchSize = batchSize
+		case *AdaGrad:
+			m.BatchSize = batchSize
+		case *RMSProp:
+			m.BatchSize = batchSize
+		}
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			MajorIterations: epochs * ((terms + batchSize - 1) / batchSize),
+		}
+		_, err := Minimize(problem, []float64{0, 0}, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		for i, c := range count {
+			if c != epochs {
+				t.Errorf("%s: term %d evaluated %d times in %d epochs", name, i, c, epochs)
+			}
+		}
+	}
+}
+
+func intsEqual(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestStochasticGrad(t *testing.T) {
+	t.Parallel()
+	// Problems without minibatch evaluations are minimized using Grad,
+	// which may be stochastic itself.
+	rnd := rand.New(rand.NewSource(1))
+	problem := Problem{
+		Func: func(x []float64) float64 {
+			return (x[0]-1)*(x[0]-1) + 4*(x[1]+1)*(x[1]+1) + 0.01*rnd.NormFloat64()
+		},
+		Grad: func(grad, x []float64) {
+			grad[0] = 2*(x[0]-1) + 0.1*rnd.NormFloat64()
+			grad[1] = 8*(x[1]+1) + 0.1*rnd.NormFloat64()
+		},
+	}
+	want := []float64{1, -1}
+	for name, method := range newStochasticMethods(1) {
+		recorder := &iterationCounter{}
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			MajorIterations: 3000,
+			Recorder:        recorder,
+		}
+		result, err := Minimize(problem, []float64{0, 0}, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if result.GradEvaluations != 3000 {
+			t.Errorf("%s: unexpected number of gradient evaluations: got %d, want 3000", name, result.GradEvaluations)
+		}
+		if recorder.major == 0 {
+			t.Errorf("%s: no major iterations recorded", name)
+		}
+		if !floats.EqualApprox(result.X, want, 0.05) {
+			t.Errorf("%s: minimum not found: got %v, want %v", name, result.X, want)
+		}
+	}
+}
+
+func TestStochasticFunctionValue(t *testing.T) {
+	t.Parallel()
+	// The reported function value is the value at the reported location
+	// and not the average loss used for the convergence check.
+	f := func(x []float64) float64 {
+		return (x[0]-1)*(x[0]-1) + 4*(x[1]+1)*(x[1]+1)
+	}
+	problem := Problem{
+		Func: f,
+		Grad: func(grad, x []float64) {
+			grad[0] = 2 * (x[0] - 1)
+			grad[1] = 8 * (x[1] + 1)
+		},
+	}
+	for name, method := range newStochasticMethods(1) {
+		settings := &Settings{
+			Converger:       NeverTerminate{},
+			MajorIterations: 50,
+		}
+		result, err := Minimize(problem, []float64{0, 0}, settings, method)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if want := f(result.X); result.F != want {
+			t.Errorf("%s: unexpected function value: got %v, want %v", name, result.F, want)
+		}
+	}
+
+	// Methods that do not use minibatches need Func.
+	problem.Func = nil
+	if !panics(func() { Minimize(problem, []float64{0, 0}, nil, &LBFGS{}) }) {
+		t.Errorf("expected panic for nil Func")
+	}
+	problem.Terms = 10
+	problem.BatchFunc = func(x []float64, batch []int) float64 { return 0 }
+	problem.BatchGrad = func(grad, x []float64, batch []int) {}
+	if !panics(func() { Minimize(problem, []float64{0, 0}, nil, &LBFGS{}) }) {
+		t.Errorf("expected panic for nil Func with minibatches")
+	}
+}
+
+// iterationCounter is a Recorder that counts the major iterations.
+type iterationCounter struct {
+	major int
+}
+
+func (*iterationCounter) Init() error { return nil }
+
+func (r *iterationCounter) Record(loc *Location, op Operation, stats *Stats) error {
+	if op == MajorIteration {
+		r.major++
+	}
+	return nil
+}
+
+func TestStochasticNonFinite(t *testing.T) {
+	t.Parallel()
+	problem := Problem{
+		Func: func(x []float64) float64 { return math.Log(x[0]) },
+		Grad: func(grad, x []float64) { grad[0] = 100 },
+	}
+	result, err := Minimize(problem, []float64{1}, nil, &SGD{LearningRate: ConstantRate(1)})
+	if err != ErrNonFiniteFunc {
+		t.Errorf("unexpected error: got %v, want %v", err, ErrNonFiniteFunc)
+	}
+	if result.Status != Failure {
+		t.Errorf("unexpected status: got %v, want %v", result.Status, Failure)
+	}
+}
+
+func TestSchedules(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name     string
+		schedule Schedule
+		t        []int
+		want     []float64
+	}{
+		{
+			name:     "ConstantRate",
+			schedule: ConstantRate(0.1),
+			t:        []int{0, 1, 1000},
+			want:     []float64{0.1, 0.1, 0.1},
+		},
+		{
+			name:     "StepDecay",
+			schedule: StepDecay{Initial: 1, Factor: 0.5, Steps: 10},
+			t:        []int{0, 9, 10, 25},
+			want:     []float64{1, 1, 0.5, 0.25},
+		},
+		{
+			name:     "ExponentialDecay",
+			schedule: ExponentialDecay{Initial: 2, Decay: 0.5},
+			t:        []int{0, 1, 3},
+			want:     []float64{2, 1, 0.25},
+		},
+		{
+			name:     "InverseTimeDecay",
+			schedule: InverseTimeDecay{Initial: 1, Decay: 0.5},
+			t:        []int{0, 2, 6},
+			want:     []float64{1, 0.5, 0.25},
+		},
+		{
+			name:     "CosineAnnealing",
+			schedule: CosineAnnealing{Initial: 1, Final: 0.1, Period: 10, Warmup: 4},
+			t:        []int{0, 3, 4, 9, 14, 100},
+			want:     []float64{0.2, 0.8, 1, 0.55, 0.1, 0.1},
+		},
+		{
+			name:     "CosineAnnealingRestart",
+			schedule: CosineAnnealing{Initial: 1, Final: 0, Period: 10, Restart: true},
+			t:        []int{0, 5, 10, 15},
+			want:     []float64{1, 0.5, 1, 0.5},
+		},
+	} {
+		for i, it := range test.t {
+			got := test.schedule.Rate(it)
+			if math.Abs(got-test.want[i]) > 1e-14 {
+				t.Errorf("%s: unexpected rate in iteration %d: got %v, want %v", test.name, it, got, test.want[i])
+			}
+		}
+	}
+}
+
+func panics(f func()) (b bool) {
+	defer func() {
+		err := recover()
+		if err != nil {
+			b = true
+		}
+	}()
+	f()
+	return
+}
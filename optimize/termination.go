@@ -25,6 +25,7 @@ const (
 	FunctionEvaluationLimit
 	GradientEvaluationLimit
 	HessianEvaluationLimit
+	HessianVectorEvaluationLimit
 )
 
 func (s Status) String() string {
@@ -104,6 +105,11 @@ var statuses = []struct {
 		early: true,
 		err:   errors.New("optimize: maximum number of Hessian evaluations reached"),
 	},
+	{
+		name:  "HessianVectorEvaluationLimit",
+		early: true,
+		err:   errors.New("optimize: maximum number of Hessian-vector product evaluations reached"),
+	},
 }
 
 // NewStatus returns a unique Status variable to represent a custom status.
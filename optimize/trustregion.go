@@ -0,0 +1,403 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+var (
+	_ Method      = (*TrustRegion)(nil)
+	_ localMethod = (*TrustRegion)(nil)
+)
+
+// TrustRegion implements a trust-region Newton method for Hessian-based
+// unconstrained minimization. At each iteration, it minimizes the quadratic
+// model
+//  m_k(p) = f_k + ∇f_kᵀ p + 1/2 pᵀ H_k p
+// subject to |p| ≤ Δ_k, where H_k is the Hessian matrix of f at x_k and Δ_k is
+// the trust-region radius. The step is accepted if the ratio of the actual
+// to the predicted reduction of f is large enough, and the radius is adjusted
+// according to how well the model predicts the function.
+//
+// Unlike Newton, TrustRegion does not modify the Hessian when it is not
+// positive definite. The subproblem is solved to high accuracy using the
+// characterization of its solution by Moré and Sorensen,
+//  (H_k + λI) p = -∇f_k,  λ ≥ 0,  λ(Δ_k - |p|) = 0,  H_k + λI positive semi-definite,
+// and the so-called hard case is handled explicitly. The solution uses an
+// eigendecomposition of the Hessian at each iteration, so TrustRegion is
+// intended for problems of small to moderate dimension. For large problems,
+// SteihaugCG only requires Hessian-vector products.
+//
+// The method is described in Chapter 4 of
+//  Nocedal, J., Wright, S.: Numerical Optimization (2nd ed). Springer (2006)
+// and
+//  Moré, J. J., Sorensen, D. C.: Computing a trust region step. SIAM Journal
+//  on Scientific and Statistical Computing 4.3 (1983): 553-572.
+type TrustRegion struct {
+	// InitRadius is the initial trust-region radius. If InitRadius is 0, it is
+	// defaulted to 1. InitRadius must not be negative.
+	InitRadius float64
+	// MaxRadius is the maximum trust-region radius. If MaxRadius is 0, the
+	// radius is not bounded. MaxRadius must not be less than InitRadius.
+	MaxRadius float64
+	// AcceptRatio is the minimum ratio of the actual to the predicted reduction
+	// of the function for a step to be accepted. AcceptRatio must be in
+	// [0, 1/4). If AcceptRatio is 0, it is defaulted to 0.1.
+	AcceptRatio float64
+	// GradStopThreshold sets the threshold for stopping if the gradient norm
+	// gets too small. If GradStopThreshold is 0 it is defaulted to 1e-12, and
+	// if it is NaN the setting is not used.
+	GradStopThreshold float64
+
+	status Status
+	err    error
+
+	tr trustRegion
+
+	eigen   mat.EigenSym
+	eigenOK bool
+	vecs    mat.Dense
+	vals    []float64
+	a       []float64 // gradient in the eigenvector basis
+	hessVec []float64
+}
+
+func (t *TrustRegion) Status() (Status, error) {
+	return t.status, t.err
+}
+
+func (*TrustRegion) Uses(has Available) (uses Available, err error) {
+	return has.hessian()
+}
+
+func (t *TrustRegion) Init(dim, tasks int) int {
+	t.status = NotTerminated
+	t.err = nil
+	return 1
+}
+
+func (t *TrustRegion) Run(operation chan<- Task, result <-chan Task, tasks []Task) {
+	t.status, t.err = localOptimizer{}.run(t, t.GradStopThreshold, operation, result, tasks)
+	close(operation)
+}
+
+func (t *TrustRegion) initLocal(loc *Location) (Operation, error) {
+	t.tr.init(t, GradEvaluation|HessEvaluation, t.InitRadius, t.MaxRadius, t.AcceptRatio)
+	return t.tr.initLocal(loc)
+}
+
+func (t *TrustRegion) iterateLocal(loc *Location) (Operation, error) {
+	return t.tr.iterateLocal(loc)
+}
+
+func (t *TrustRegion) needs() struct {
+	Gradient bool
+	Hessian  bool
+} {
+	return struct {
+		Gradient bool
+		Hessian  bool
+	}{true, true}
+}
+
+// initStep computes the solution of the trust-region subproblem. It never
+// needs additional evaluations.
+func (t *TrustRegion) initStep(loc *Location, radius float64, step []float64) (op Operation, pred float64) {
+	dim := len(loc.X)
+	if t.tr.newLocation {
+		// The eigendecomposition only needs to be computed once per location.
+		t.eigenOK = t.eigen.Factorize(loc.Hessian, true)
+		if t.eigenOK {
+			t.vals = t.eigen.Values(resize(t.vals, dim))
+			t.vecs.Reset()
+			t.eigen.VectorsTo(&t.vecs)
+			t.a = resize(t.a, dim)
+			mat.NewVecDense(dim, t.a).MulVec(t.vecs.T(), mat.NewVecDense(dim, loc.Gradient))
+		}
+	}
+	if !t.eigenOK {
+		// Fall back to a steepest descent step to the boundary.
+		floats.ScaleTo(step, -radius/floats.Norm(loc.Gradient, 2), loc.Gradient)
+		return NoOperation, t.predicted(loc, step)
+	}
+
+	// Compute the coefficients of the step in the eigenvector basis and
+	// transform the step back to the original basis.
+	c := make([]float64, dim)
+	_, hard := solveTrustRegionEigen(c, t.vals, t.a, radius)
+	if hard {
+		// In the hard case, move along the eigenvector corresponding to the
+		// smallest eigenvalue to the boundary.
+		norm := floats.Norm(c, 2)
+		if norm < radius {
+			c[0] += math.Sqrt(radius*radius - norm*norm)
+		}
+	}
+	mat.NewVecDense(dim, step).MulVec(&t.vecs, mat.NewVecDense(dim, c))
+	return NoOperation, t.predicted(loc, step)
+}
+
+func (t *TrustRegion) iterateStep(loc *Location, step []float64) (op Operation, pred float64) {
+	panic("optimize: TrustRegion does not need evaluations to compute a step")
+}
+
+// predicted returns the reduction of f predicted by the quadratic model.
+func (t *TrustRegion) predicted(loc *Location, step []float64) float64 {
+	dim := len(step)
+	t.hessVec = resize(t.hessVec, dim)
+	mat.NewVecDense(dim, t.hessVec).MulVec(loc.Hessian, mat.NewVecDense(dim, step))
+	return -floats.Dot(loc.Gradient, step) - 0.5*floats.Dot(step, t.hessVec)
+}
+
+// solveTrustRegionEigen solves the trust-region subproblem
+//  min aᵀc + 1/2 cᵀ diag(vals) c  s.t. |c| ≤ radius
+// where vals are sorted in ascending order, and stores the solution
+//  c_i = -a_i / (vals_i + λ)
+// into c. It returns the Lagrange multiplier λ and whether the subproblem is
+// in the hard case. In the hard case λ = -vals[0], the components of c in the
+// eigenspace of vals[0] are zero, and a multiple of the first coordinate
+// vector must be added to c to reach the boundary.
+func solveTrustRegionEigen(c, vals, a []float64, radius float64) (lambda float64, hard bool) {
+	const (
+		tol     = 1e-10
+		maxIter = 100
+	)
+	// norm returns |c(λ)| and d|c(λ)|/dλ.
+	norm := func(lambda float64) (n, dn float64) {
+		var s, ds float64
+		for i, v := range vals {
+			d := v + lambda
+			if d == 0 {
+				continue
+			}
+			ci := a[i] / d
+			s += ci * ci
+			ds -= 2 * ci * ci / d
+		}
+		n = math.Sqrt(s)
+		return n, ds / (2 * n)
+	}
+	solution := func(lambda float64) {
+		for i, v := range vals {
+			c[i] = 0
+			if d := v + lambda; d != 0 {
+				c[i] = -a[i] / d
+			}
+		}
+	}
+
+	lo := math.Max(0, -vals[0])
+	if vals[0] > 0 {
+		if n, _ := norm(0); n <= radius {
+			// The Newton step lies inside the trust region.
+			solution(0)
+			return 0, false
+		}
+	}
+
+	// Check for the hard case, where the gradient is (nearly) orthogonal to the
+	// eigenspace of the smallest eigenvalue.
+	scale := math.Abs(vals[len(vals)-1]) + math.Abs(vals[0])
+	aNorm := floats.Norm(a, 2)
+	if vals[0] < 0 {
+		hard = true
+		for i, v := range vals {
+			if v-vals[0] > tol*scale {
+				break
+			}
+			if math.Abs(a[i]) > tol*aNorm {
+				hard = false
+				break
+			}
+		}
+	}
+	if hard {
+		// Compute the step at λ = -vals[0] ignoring the components in the
+		// eigenspace of vals[0].
+		for i, v := range vals {
+			c[i] = 0
+			if d := v - vals[0]; d > tol*scale {
+				c[i] = -a[i] / d
+			}
+		}
+		if floats.Norm(c, 2) <= radius {
+			return lo, true
+		}
+	}
+
+	// Find λ such that |c(λ)| = radius by Newton's method on the secular
+	// equation 1/radius - 1/|c(λ)| = 0 safeguarded by bisection. At the upper
+	// bound hi, |c(λ)| ≤ |a|/(vals[0] + hi) ≤ radius.
+	hi := lo + aNorm/radius
+	lambda = hi
+	for i := 0; i < maxIter; i++ {
+		n, dn := norm(lambda)
+		if math.Abs(n-radius) <= tol*radius {
+			break
+		}
+		if n > radius {
+			lo = lambda
+		} else {
+			hi = lambda
+		}
+		next := lambda - (1/radius-1/n)*n*n/dn
+		if !(lo < next && next < hi) {
+			next = (lo + hi) / 2
+		}
+		if next == lambda {
+			break
+		}
+		lambda = next
+	}
+	solution(lambda)
+	return lambda, false
+}
+
+// trustRegionSolver computes an approximate solution of the trust-region
+// subproblem using reverse communication.
+type trustRegionSolver interface {
+	// initStep starts the computation of a step within the given radius from
+	// loc and stores it in step. It returns the evaluation needed at loc.X to
+	// continue the computation, or NoOperation when the step is computed, in
+	// which case pred is the reduction of f predicted by the model.
+	initStep(loc *Location, radius float64, step []float64) (op Operation, pred float64)
+	// iterateStep continues the computation of the step after the evaluation
+	// returned from initStep or iterateStep has been performed.
+	iterateStep(loc *Location, step []float64) (op Operation, pred float64)
+}
+
+// machEps is the machine epsilon.
+const machEps = 1.0 / (1 << 52)
+
+type trustRegionState int
+
+const (
+	trustRegionStep trustRegionState = iota
+	trustRegionTrial
+	trustRegionAccept
+	trustRegionMajor
+)
+
+// trustRegion implements the outer iterations of a trust-region method.
+type trustRegion struct {
+	solver trustRegionSolver
+	eval   Operation // evaluations needed at an accepted location
+
+	radius, maxRadius float64
+	accept            float64
+
+	state       trustRegionState
+	newLocation bool // whether the solver is called for the first time at x
+	x           []float64
+	f           float64
+	step        []float64
+	pred        float64
+}
+
+func (tr *trustRegion) init(solver trustRegionSolver, eval Operation, radius, maxRadius, accept float64) {
+	switch {
+	case radius == 0:
+		radius = 1
+	case radius < 0:
+		panic("optimize: negative trust-region radius")
+	}
+	switch {
+	case maxRadius == 0:
+		maxRadius = math.Inf(1)
+	case maxRadius < radius:
+		panic("optimize: maximum trust-region radius less than initial radius")
+	}
+	switch {
+	case accept == 0:
+		accept = 0.1
+	case accept < 0 || 0.25 <= accept:
+		panic("optimize: trust-region accept ratio out of range")
+	}
+	tr.solver = solver
+	tr.eval = eval
+	tr.radius = radius
+	tr.maxRadius = maxRadius
+	tr.accept = accept
+}
+
+func (tr *trustRegion) initLocal(loc *Location) (Operation, error) {
+	dim := len(loc.X)
+	tr.x = resize(tr.x, dim)
+	tr.step = resize(tr.step, dim)
+	return tr.startStep(loc, true)
+}
+
+// startStep starts the computation of a new step from loc.
+func (tr *trustRegion) startStep(loc *Location, newLocation bool) (Operation, error) {
+	copy(tr.x, loc.X)
+	tr.f = loc.F
+	tr.newLocation = newLocation
+	tr.state = trustRegionStep
+	op, pred := tr.solver.initStep(loc, tr.radius, tr.step)
+	if op != NoOperation {
+		return op, nil
+	}
+	return tr.trial(loc, pred)
+}
+
+// trial sends a trial step for evaluation.
+func (tr *trustRegion) trial(loc *Location, pred float64) (Operation, error) {
+	floats.AddTo(loc.X, tr.x, tr.step)
+	if floats.Equal(loc.X, tr.x) {
+		copy(loc.X, tr.x)
+		return NoOperation, ErrTrustRegionRadius
+	}
+	tr.pred = pred
+	tr.state = trustRegionTrial
+	return FuncEvaluation, nil
+}
+
+func (tr *trustRegion) iterateLocal(loc *Location) (Operation, error) {
+	switch tr.state {
+	default:
+		panic("optimize: unknown trust-region state")
+	case trustRegionStep:
+		op, pred := tr.solver.iterateStep(loc, tr.step)
+		if op != NoOperation {
+			return op, nil
+		}
+		return tr.trial(loc, pred)
+	case trustRegionTrial:
+		// Update the trust-region radius based on the agreement between the
+		// model and the function.
+		// Near a minimum the reductions are dominated by rounding errors,
+		// so add a small multiple of |f| to both of them as suggested in
+		// Section 17.4.2 of Conn, Gould, Toint: Trust-Region Methods (2000).
+		delta := 10 * machEps * math.Max(1, math.Abs(tr.f))
+		rho := (tr.f - loc.F + delta) / (tr.pred + delta)
+		if math.IsNaN(rho) || tr.pred <= 0 {
+			rho = math.Inf(-1)
+		}
+		norm := floats.Norm(tr.step, 2)
+		switch {
+		case rho < 0.25:
+			tr.radius = 0.25 * norm
+		case rho > 0.75 && norm >= 0.99*tr.radius:
+			tr.radius = math.Min(2*tr.radius, tr.maxRadius)
+		}
+		if rho > tr.accept {
+			tr.state = trustRegionAccept
+			return tr.eval, nil
+		}
+		// Reject the step and restore the location.
+		copy(loc.X, tr.x)
+		loc.F = tr.f
+		return tr.startStep(loc, false)
+	case trustRegionAccept:
+		tr.state = trustRegionMajor
+		return MajorIteration, nil
+	case trustRegionMajor:
+		return tr.startStep(loc, true)
+	}
+}
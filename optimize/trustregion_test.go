@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package optimize
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats"
+	"github.com/jingcheng-WU/gonum/mat"
+)
+
+func TestSolveTrustRegionEigen(t *testing.T) {
+	t.Parallel()
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		vals, a []float64
+		radius  float64
+		hard    bool
+	}{
+		// Interior solution.
+		{vals: []float64{1, 2}, a: []float64{0.1, 0.1}, radius: 1},
+		// Boundary solution with positive definite and indefinite matrices.
+		{vals: []float64{1, 2}, a: []float64{3, -4}, radius: 1},
+		{vals: []float64{-2, 1, 3}, a: []float64{1, 1, 1}, radius: 0.5},
+		{vals: []float64{-2, 1, 3}, a: []float64{1e-3, 1, 1}, radius: 2},
+		// Hard case.
+		{vals: []float64{-2, 1, 3}, a: []float64{0, 1, 1}, radius: 2, hard: true},
+		{vals: []float64{-1, -1, 3}, a: []float64{0, 0, 1}, radius: 1, hard: true},
+		// Singular, but not the hard case because the step is too long.
+		{vals: []float64{-1, 1}, a: []float64{0, 10}, radius: 1},
+	} {
+		c := make([]float64, len(test.vals))
+		lambda, hard := solveTrustRegionEigen(c, test.vals, test.a, test.radius)
+		if hard != test.hard {
+			t.Errorf("unexpected hard case for %v: got %t, want %t", test, hard, test.hard)
+		}
+		if hard {
+			norm := floats.Norm(c, 2)
+			c[0] += math.Sqrt(test.radius*test.radius - norm*norm)
+		}
+		if lambda < 0 || lambda+test.vals[0] < -1e-14 {
+			t.Errorf("invalid multiplier for %v: %v", test, lambda)
+		}
+		norm := floats.Norm(c, 2)
+		if norm > test.radius*(1+1e-8) {
+			t.Errorf("solution outside trust region for %v: |c| = %v", test, norm)
+		}
+		if lambda > 0 && math.Abs(norm-test.radius) > 1e-8*test.radius {
+			t.Errorf("complementarity violated for %v: λ = %v, |c| = %v", test, lambda, norm)
+		}
+
+		// Check that the solution is better than random points in the trust
+		// region.
+		model := func(c []float64) float64 {
+			var m float64
+			for i, v := range c {
+				m += test.a[i]*v + 0.5*test.vals[i]*v*v
+			}
+			return m
+		}
+		m := model(c)
+		x := make([]float64, len(c))
+		for k := 0; k < 1000; k++ {
+			for i := range x {
+				x[i] = rnd.NormFloat64()
+			}
+			floats.Scale(test.radius*math.Pow(rnd.Float64(), 1/float64(len(x)))/floats.Norm(x, 2), x)
+			if mx := model(x); mx < m-1e-10 {
+				t.Errorf("better point found for %v: m(%v) = %v < m(%v) = %v", test, x, mx, c, m)
+				break
+			}
+		}
+	}
+}
+
+// saddle is a function with a saddle point at the origin and minima at
+// [0 ±sqrt(2)].
+type saddle struct{}
+
+func (saddle) Func(x []float64) float64 {
+	return x[0]*x[0] - x[1]*x[1] + x[1]*x[1]*x[1]*x[1]/4
+}
+
+func (saddle) Grad(grad, x []float64) {
+	grad[0] = 2 * x[0]
+	grad[1] = -2*x[1] + x[1]*x[1]*x[1]
+}
+
+func (saddle) Hess(dst *mat.SymDense, x []float64) {
+	dst.SetSym(0, 0, 2)
+	dst.SetSym(0, 1, 0)
+	dst.SetSym(1, 1, -2+3*x[1]*x[1])
+}
+
+func (saddle) HessVec(dst, x, v []float64) {
+	dst[0] = 2 * v[0]
+	dst[1] = (-2 + 3*x[1]*x[1]) * v[1]
+}
+
+func TestTrustRegionSaddle(t *testing.T) {
+	t.Parallel()
+	// Starting on the ridge of the saddle, the gradient has no component
+	// along the direction of negative curvature, and the Hessian is
+	// indefinite.
+	problem := Problem{
+		Func:    saddle{}.Func,
+		Grad:    saddle{}.Grad,
+		Hess:    saddle{}.Hess,
+		HessVec: saddle{}.HessVec,
+	}
+	for _, method := range []Method{&TrustRegion{}, &SteihaugCG{}} {
+		x := []float64{1, 1e-3}
+		if _, ok := method.(*TrustRegion); ok {
+			// The exact method escapes from the saddle point by itself.
+			x[1] = 0
+		}
+		result, err := Minimize(problem, x, nil, method)
+		if err != nil {
+			t.Errorf("%T: unexpected error: %v", method, err)
+			continue
+		}
+		if result.Status != GradientThreshold {
+			t.Errorf("%T: unexpected status: got %v, want %v", method, result.Status, GradientThreshold)
+		}
+		if math.Abs(result.X[0]) > 1e-8 || math.Abs(math.Abs(result.X[1])-math.Sqrt2) > 1e-8 {
+			t.Errorf("%T: minimum not found: got %v", method, result.X)
+		}
+		if result.F != -1 && math.Abs(result.F+1) > 1e-14 {
+			t.Errorf("%T: unexpected minimum value: got %v, want -1", method, result.F)
+		}
+		if _, ok := method.(*SteihaugCG); ok {
+			if result.HessVecEvaluations == 0 || result.HessEvaluations != 0 {
+				t.Errorf("unexpected evaluation counts for SteihaugCG: %+v", result.Stats)
+			}
+		}
+	}
+}
+
+func TestSteihaugCGLimits(t *testing.T) {
+	t.Parallel()
+	problem := Problem{
+		Func:    saddle{}.Func,
+		Grad:    saddle{}.Grad,
+		HessVec: saddle{}.HessVec,
+	}
+	result, err := Minimize(problem, []float64{1, 1}, &Settings{HessVecEvaluations: 3}, &SteihaugCG{})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if result.Status != HessianVectorEvaluationLimit {
+		t.Errorf("unexpected status: got %v, want %v", result.Status, HessianVectorEvaluationLimit)
+	}
+
+	problem.HessVec = nil
+	_, err = (&SteihaugCG{}).Uses(availFromProblem(problem))
+	if err != ErrMissingHessVec {
+		t.Errorf("unexpected error for missing HessVec: got %v, want %v", err, ErrMissingHessVec)
+	}
+}
@@ -49,11 +49,20 @@ const (
 	// HessEvaluation specifies that the Hessian
 	// of the objective function should be evaluated.
 	HessEvaluation
+	// HessVecEvaluation specifies that the product of the Hessian
+	// of the objective function with Location.HessVecDir should be
+	// evaluated.
+	HessVecEvaluation
+	// BatchEvaluation specifies that the function and gradient
+	// evaluations of the same operation are of the mean of the terms
+	// of the objective function with the indices in Location.Batch.
+	// It must be combined with FuncEvaluation or GradEvaluation.
+	BatchEvaluation
 	// signalDone is used internally to signal completion.
 	signalDone
 
 	// Mask for the evaluating operations.
-	evalMask = FuncEvaluation | GradEvaluation | HessEvaluation
+	evalMask = FuncEvaluation | GradEvaluation | HessEvaluation | HessVecEvaluation | BatchEvaluation
 )
 
 func (op Operation) isEvaluation() bool {
@@ -62,10 +71,12 @@ func (op Operation) isEvaluation() bool {
 
 func (op Operation) String() string {
 	if op&evalMask != 0 {
-		return fmt.Sprintf("Evaluation(Func: %t, Grad: %t, Hess: %t, Extra: 0b%b)",
+		return fmt.Sprintf("Evaluation(Func: %t, Grad: %t, Hess: %t, HessVec: %t, Batch: %t, Extra: 0b%b)",
 			op&FuncEvaluation != 0,
 			op&GradEvaluation != 0,
 			op&HessEvaluation != 0,
+			op&HessVecEvaluation != 0,
+			op&BatchEvaluation != 0,
 			op&^(evalMask))
 	}
 	s, ok := operationNames[op]
@@ -95,11 +106,12 @@ type Result struct {
 
 // Stats contains the statistics of the run.
 type Stats struct {
-	MajorIterations int           // Total number of major iterations
-	FuncEvaluations int           // Number of evaluations of Func
-	GradEvaluations int           // Number of evaluations of Grad
-	HessEvaluations int           // Number of evaluations of Hess
-	Runtime         time.Duration // Total runtime of the optimization
+	MajorIterations    int           // Total number of major iterations
+	FuncEvaluations    int           // Number of evaluations of Func
+	GradEvaluations    int           // Number of evaluations of Grad
+	HessEvaluations    int           // Number of evaluations of Hess
+	HessVecEvaluations int           // Number of evaluations of HessVec
+	Runtime            time.Duration // Total runtime of the optimization
 }
 
 // complementEval returns an evaluating operation that evaluates fields of loc
@@ -131,6 +143,32 @@ type Problem struct {
 	// will have dimensions matching the length of x. Hess must not modify x.
 	Hess func(hess *mat.SymDense, x []float64)
 
+	// HessVec evaluates the product of the Hessian at x with the vector v and
+	// stores the result in dst. All slices will have the same length.
+	// HessVec must not modify x or v. HessVec is used by methods that do not
+	// need the full Hessian matrix, such as SteihaugCG.
+	HessVec func(dst, x, v []float64)
+
+	// Terms is the number of terms of an objective function that is the
+	// mean of terms
+	//  f(x) = 1/Terms * \sum_i f_i(x),
+	// such as the loss over a set of training data. Terms must be positive
+	// if BatchFunc or BatchGrad is not nil.
+	Terms int
+
+	// BatchFunc evaluates the mean of the terms of the objective function
+	// with the indices in batch at x. BatchFunc is used by stochastic
+	// methods to evaluate the objective function on minibatches, and must
+	// not modify x or batch.
+	BatchFunc func(x []float64, batch []int) float64
+
+	// BatchGrad evaluates the gradient of the mean of the terms of the
+	// objective function with the indices in batch at x and stores the
+	// result in grad which will be the same length as x. BatchGrad is used
+	// by stochastic methods to evaluate the gradient on minibatches, and
+	// must not modify x or batch.
+	BatchGrad func(grad, x []float64, batch []int)
+
 	// Status reports the status of the objective function being optimized and any
 	// error. This can be used to terminate early, for example when the function is
 	// not able to evaluate itself. The user can use one of the pre-provided Status
@@ -140,12 +178,23 @@ type Problem struct {
 
 // Available describes the functions available to call in Problem.
 type Available struct {
-	Grad bool
-	Hess bool
+	Grad    bool
+	Hess    bool
+	HessVec bool
+	// Batch is true if both BatchFunc and BatchGrad are available, and
+	// Terms is then the number of terms of the objective function.
+	Batch bool
+	Terms int
 }
 
 func availFromProblem(prob Problem) Available {
-	return Available{Grad: prob.Grad != nil, Hess: prob.Hess != nil}
+	return Available{
+		Grad:    prob.Grad != nil,
+		Hess:    prob.Hess != nil,
+		HessVec: prob.HessVec != nil,
+		Batch:   prob.BatchFunc != nil && prob.BatchGrad != nil,
+		Terms:   prob.Terms,
+	}
 }
 
 // function tests if the Problem described by the receiver is suitable for an
@@ -181,6 +230,31 @@ func (has Available) hessian() (uses Available, err error) {
 	return Available{Grad: true, Hess: true}, nil
 }
 
+// hessVec tests if the Problem described by the receiver is suitable for an
+// unconstrained Method based on Hessian-vector products, and returns the
+// result.
+func (has Available) hessVec() (uses Available, err error) {
+	// TODO(btracey): This needs to be modified when optimize supports
+	// constrained optimization.
+	if !has.Grad {
+		return Available{}, ErrMissingGrad
+	}
+	if !has.HessVec {
+		return Available{}, ErrMissingHessVec
+	}
+	return Available{Grad: true, HessVec: true}, nil
+}
+
+// stochastic tests if the Problem described by the receiver is suitable for
+// an unconstrained stochastic gradient-based Method, and returns the result.
+// Minibatch evaluations are used if they are available.
+func (has Available) stochastic() (uses Available, err error) {
+	if has.Batch {
+		return Available{Batch: true, Terms: has.Terms}, nil
+	}
+	return has.gradient()
+}
+
 // Settings represents settings of the optimization run. It contains initial
 // settings, convergence information, and Recorder information. Convergence
 // settings are only checked at MajorIterations, while Evaluation thresholds
@@ -250,10 +324,25 @@ type Settings struct {
 	// The default value is 0.
 	HessEvaluations int
 
+	// HessVecEvaluations is the maximum allowed number of Hessian-vector
+	// product evaluations. HessianVectorEvaluationLimit status is returned if
+	// the total number of calls to HessVec equals or exceeds this number.
+	// If it equals zero, this setting has no effect.
+	// The default value is 0.
+	HessVecEvaluations int
+
 	Recorder Recorder
 
 	// Concurrent represents how many concurrent evaluations are possible.
 	Concurrent int
+
+	// Resume, if not nil, resumes the optimization run saved in the
+	// Checkpoint instead of starting a new run. The Method must implement
+	// Checkpointer and be configured as in the saved run, the initial
+	// location passed to Minimize must have the length of the location in
+	// the Checkpoint, and InitValues must be nil. The limits in Settings
+	// apply to the total statistics of the original and the resumed run.
+	Resume *Checkpoint
 }
 
 // resize takes x and returns a slice of length dim. It returns a resliced x
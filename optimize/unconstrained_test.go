@@ -1176,6 +1176,28 @@ func TestNewton(t *testing.T) {
 	testLocal(t, newtonTests, &Newton{})
 }
 
+func TestTrustRegion(t *testing.T) {
+	t.Parallel()
+	testLocal(t, newtonTests, &TrustRegion{})
+}
+
+func TestSteihaugCG(t *testing.T) {
+	t.Parallel()
+	// Replace the Hessian in the Newton tests with Hessian-vector products.
+	var tests []unconstrainedTest
+	for _, test := range newtonTests {
+		hess := test.p.Hess
+		test.p.Hess = nil
+		test.p.HessVec = func(dst, x, v []float64) {
+			h := mat.NewSymDense(len(x), nil)
+			hess(h, x)
+			mat.NewVecDense(len(dst), dst).MulVec(h, mat.NewVecDense(len(v), v))
+		}
+		tests = append(tests, test)
+	}
+	testLocal(t, tests, &SteihaugCG{})
+}
+
 func testLocal(t *testing.T, tests []unconstrainedTest, method Method) {
 	for cas, test := range tests {
 		if test.long && testing.Short() {
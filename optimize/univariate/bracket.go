@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import "math"
+
+// golden is the golden ratio.
+var golden = (1 + math.Sqrt(5)) / 2
+
+// defaultMaxExpansions is the default number of expansions of brackets.
+const defaultMaxExpansions = 50
+
+// BracketRoot expands the interval [a, b] until the values of f at its ends
+// have opposite signs, and returns the resulting interval with lo < hi. In
+// every expansion, the end with the smaller function magnitude is moved away
+// from the other end by the golden ratio times the width of the interval.
+// a and b must be distinct.
+//
+// If maxIter is zero, it is defaulted to 50. If no sign change is found
+// within maxIter expansions, BracketRoot returns the last interval with
+// ErrNotBracketed.
+func BracketRoot(f func(float64) float64, a, b float64, maxIter int) (lo, hi float64, err error) {
+	if a == b {
+		panic("univariate: empty interval")
+	}
+	if maxIter < 0 {
+		panic("univariate: negative maxIter")
+	}
+	if maxIter == 0 {
+		maxIter = defaultMaxExpansions
+	}
+	if b < a {
+		a, b = b, a
+	}
+	fa, fb := f(a), f(b)
+	for i := 0; ; i++ {
+		if math.IsNaN(fa) || math.IsNaN(fb) {
+			return a, b, ErrNaN
+		}
+		if fa == 0 || fb == 0 || (fa < 0) != (fb < 0) {
+			return a, b, nil
+		}
+		if i == maxIter || math.IsInf(a, 0) || math.IsInf(b, 0) {
+			return a, b, ErrNotBracketed
+		}
+		if math.Abs(fa) < math.Abs(fb) {
+			a += golden * (a - b)
+			fa = f(a)
+		} else {
+			b += golden * (b - a)
+			fb = f(b)
+		}
+	}
+}
+
+// BracketMinimum searches downhill from the interval [a, b] for three
+// locations lo < mid < hi such that f(mid) ≤ f(lo) and f(mid) ≤ f(hi), so
+// that a minimum of a continuous f lies in [lo, hi]. In every step the
+// interval is extended in the downhill direction by the golden ratio times
+// its width. a and b must be distinct.
+//
+// If maxIter is zero, it is defaulted to 50. If no such locations are found
+// within maxIter steps, BracketMinimum returns the last locations with
+// ErrIterationLimit.
+func BracketMinimum(f func(float64) float64, a, b float64, maxIter int) (lo, mid, hi float64, err error) {
+	if a == b {
+		panic("univariate: empty interval")
+	}
+	if maxIter < 0 {
+		panic("univariate: negative maxIter")
+	}
+	if maxIter == 0 {
+		maxIter = defaultMaxExpansions
+	}
+	fa, fb := f(a), f(b)
+	if fb > fa {
+		// Go downhill from a to b.
+		a, b = b, a
+		fa, fb = fb, fa
+	}
+	c := b + golden*(b-a)
+	fc := f(c)
+	for i := 0; ; i++ {
+		if math.IsNaN(fa) || math.IsNaN(fb) || math.IsNaN(fc) {
+			err = ErrNaN
+			break
+		}
+		if fb <= fc {
+			break
+		}
+		if i == maxIter || math.IsInf(c, 0) {
+			err = ErrIterationLimit
+			break
+		}
+		a, b = b, c
+		fa, fb = fb, fc
+		c = b + golden*(b-a)
+		fc = f(c)
+	}
+	if c < a {
+		a, c = c, a
+	}
+	return a, b, c, err
+}
@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package univariate provides methods for finding roots and minima of
+// functions of a single variable.
+//
+// The bracketing root finders Bisection, Brent, Ridders and ITP require an
+// interval [a, b] over which the function changes sign, and are guaranteed to
+// converge. BracketRoot can be used to find such an interval. Newton and
+// Halley use the first and second derivatives of the function, which can be
+// computed by automatic differentiation using DualFunc and HyperdualFunc.
+//
+// Minimize finds a local minimum of a function within an interval using
+// Brent's combination of golden-section search and parabolic interpolation.
+// BracketMinimum can be used to find an interval containing a minimum.
+//
+// A typical use of the root finders is the inversion of a cumulative
+// distribution function that has no closed-form quantile function.
+package univariate // import "github.com/jingcheng-WU/gonum/optimize/univariate"
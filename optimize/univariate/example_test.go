@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate_test
+
+import (
+	"fmt"
+	"log"
+	"math"
+
+	"github.com/jingcheng-WU/gonum/optimize/univariate"
+	"github.com/jingcheng-WU/gonum/stat/distuv"
+)
+
+func ExampleBrent_quantile() {
+	// The quantile function of a mixture of two normal distributions has
+	// no closed form, so it is computed by inverting the CDF.
+	a := distuv.Normal{Mu: -1, Sigma: 1}
+	b := distuv.Normal{Mu: 2, Sigma: 0.5}
+	cdf := func(x float64) float64 { return 0.3*a.CDF(x) + 0.7*b.CDF(x) }
+
+	const p = 0.25
+	f := func(x float64) float64 { return cdf(x) - p }
+	lo, hi, err := univariate.BracketRoot(f, -1, 1, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	res, err := univariate.Brent(f, lo, hi, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("quantile = %.6f, CDF(quantile) = %.6f\n", res.X, cdf(res.X))
+
+	// Output:
+	// quantile = -0.032802, CDF(quantile) = 0.250000
+}
+
+func ExampleMinimize() {
+	res, err := univariate.Minimize(func(x float64) float64 { return x*math.Log(x) - x/2 }, 0.1, 5, nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("minimum at x = %.6f (exp(-1/2) = %.6f)\n", res.X, math.Exp(-0.5))
+
+	// Output:
+	// minimum at x = 0.606531 (exp(-1/2) = 0.606531)
+}
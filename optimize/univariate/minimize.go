@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import "math"
+
+// Minimize finds a local minimum of f in the interval [a, b] using Brent's
+// method, which combines golden-section search with successive parabolic
+// interpolation. If f is unimodal in [a, b], the minimum is global in the
+// interval. The method converges superlinearly for smooth functions and
+// never needs many more function evaluations than golden-section search.
+//
+// The default relative tolerance of Minimize is the square root of the
+// machine epsilon, since the location of a minimum of a smooth function can
+// only be determined to about that accuracy. FuncTol is not used.
+//
+// If the iteration limit is reached, the best location found is returned
+// with ErrIterationLimit.
+//
+// The method is described in
+//  Brent, R. P.: Algorithms for Minimization without Derivatives, Chapter 5.
+//  Prentice-Hall (1973)
+func Minimize(f func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+	t := newTolerances(settings, math.Sqrt(machEps))
+	if b < a {
+		a, b = b, a
+	}
+
+	// c is the squared inverse of the golden ratio.
+	c := (3 - math.Sqrt(5)) / 2
+
+	// x is the best location found, w is the second best, and v is the
+	// previous value of w.
+	x := a + c*(b-a)
+	fx := f(x)
+	res := Result{FuncEvaluations: 1}
+	if math.IsNaN(fx) {
+		res.X, res.F, res.Tol = x, fx, b-a
+		return res, ErrNaN
+	}
+	v, w := x, x
+	fv, fw := fx, fx
+	var d, e float64
+	for {
+		m := a + (b-a)/2
+		tol := t.tol(x) / 2
+		tol2 := 2 * tol
+		if math.Abs(x-m) <= tol2-(b-a)/2 {
+			res.X, res.F, res.Tol = x, fx, math.Max(x-a, b-x)
+			return res, nil
+		}
+		if res.Iterations == t.maxIter {
+			res.X, res.F, res.Tol = x, fx, math.Max(x-a, b-x)
+			return res, ErrIterationLimit
+		}
+
+		var p, q, r float64
+		if math.Abs(e) > tol {
+			// Fit a parabola.
+			r = (x - w) * (fx - fv)
+			q = (x - v) * (fx - fw)
+			p = (x-v)*q - (x-w)*r
+			q = 2 * (q - r)
+			if q > 0 {
+				p = -p
+			} else {
+				q = -q
+			}
+			r = e
+			e = d
+		}
+		if math.Abs(p) < math.Abs(q*r/2) && q*(a-x) < p && p < q*(b-x) {
+			// Parabolic interpolation step.
+			d = p / q
+			// f must not be evaluated too close to a or b.
+			if u := x + d; u-a < tol2 || b-u < tol2 {
+				d = math.Copysign(tol, m-x)
+			}
+		} else {
+			// Golden-section step.
+			if x < m {
+				e = b - x
+			} else {
+				e = a - x
+			}
+			d = c * e
+		}
+
+		// f must not be evaluated too close to x.
+		u := x + d
+		if math.Abs(d) < tol {
+			u = x + math.Copysign(tol, d)
+		}
+		fu := f(u)
+		res.Iterations++
+		res.FuncEvaluations++
+		if math.IsNaN(fu) {
+			res.X, res.F, res.Tol = x, fx, math.Max(x-a, b-x)
+			return res, ErrNaN
+		}
+
+		if fu <= fx {
+			if u < x {
+				b = x
+			} else {
+				a = x
+			}
+			v, fv = w, fw
+			w, fw = x, fx
+			x, fx = u, fu
+		} else {
+			if u < x {
+				a = u
+			} else {
+				b = u
+			}
+			if fu <= fw || w == x {
+				v, fv = w, fw
+				w, fw = u, fu
+			} else if fu <= fv || v == x || v == w {
+				v, fv = u, fu
+			}
+		}
+	}
+}
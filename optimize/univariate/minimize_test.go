@@ -0,0 +1,111 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMinimize(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		xmin float64
+		tol  float64
+	}{
+		{
+			name: "Quadratic",
+			f:    func(x float64) float64 { return (x - 1) * (x - 1) },
+			a:    -3, b: 5,
+			xmin: 1,
+			tol:  1e-8,
+		},
+		{
+			name: "Cos",
+			f:    math.Cos,
+			a:    0, b: 5,
+			xmin: math.Pi,
+			tol:  1e-7,
+		},
+		{
+			name: "Quartic",
+			f:    func(x float64) float64 { return math.Pow(x-2, 4) },
+			a:    0, b: 3,
+			xmin: 2,
+			tol:  1e-3,
+		},
+		{
+			name: "Abs",
+			f:    func(x float64) float64 { return math.Abs(x - 0.3) },
+			a:    1, b: -1,
+			xmin: 0.3,
+			tol:  1e-8,
+		},
+		{
+			name: "Boundary",
+			f:    func(x float64) float64 { return x },
+			a:    2, b: 4,
+			xmin: 2,
+			tol:  1e-7,
+		},
+	} {
+		var evals int
+		f := func(x float64) float64 {
+			evals++
+			return test.f(x)
+		}
+		res, err := Minimize(f, test.a, test.b, nil)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if math.Abs(res.X-test.xmin) > test.tol {
+			t.Errorf("%s: unexpected minimum: got %v, want %v", test.name, res.X, test.xmin)
+		}
+		if res.F != test.f(res.X) {
+			t.Errorf("%s: function value mismatch: got %v, want %v", test.name, res.F, test.f(res.X))
+		}
+		if res.FuncEvaluations != evals || res.Iterations != evals-1 || evals > 100 {
+			t.Errorf("%s: unexpected counts: %d iterations, %d evaluations, %d calls", test.name, res.Iterations, res.FuncEvaluations, evals)
+		}
+		if res.Tol > 2*(defaultAbsTol+math.Sqrt(machEps)*math.Abs(res.X)) {
+			t.Errorf("%s: tolerance too large: %v", test.name, res.Tol)
+		}
+	}
+
+	res, err := Minimize(math.Cos, 0, 5, &Settings{MaxIterations: 3})
+	if err != ErrIterationLimit || res.Iterations != 3 {
+		t.Errorf("unexpected result with iteration limit: got %v, %v", res, err)
+	}
+}
+
+func TestBracketMinimum(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		f    func(float64) float64
+		a, b float64
+		err  error
+	}{
+		{f: func(x float64) float64 { return (x - 100) * (x - 100) }, a: 0, b: 1},
+		{f: func(x float64) float64 { return (x + 100) * (x + 100) }, a: 0, b: 1},
+		{f: math.Cos, a: 3, b: 3.5},
+		{f: func(x float64) float64 { return x }, a: 0, b: 1, err: ErrIterationLimit},
+	} {
+		lo, mid, hi, err := BracketMinimum(test.f, test.a, test.b, 0)
+		if err != test.err {
+			t.Errorf("unexpected error for [%v, %v]: got %v, want %v", test.a, test.b, err, test.err)
+			continue
+		}
+		if !(lo < mid && mid < hi) {
+			t.Errorf("invalid locations %v, %v, %v", lo, mid, hi)
+		}
+		if err == nil && (test.f(mid) > test.f(lo) || test.f(mid) > test.f(hi)) {
+			t.Errorf("minimum not bracketed by %v, %v, %v", lo, mid, hi)
+		}
+	}
+}
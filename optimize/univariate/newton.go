@@ -0,0 +1,106 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/num/dual"
+	"github.com/jingcheng-WU/gonum/num/hyperdual"
+)
+
+// Newton finds a root of a function using Newton's method starting from x0.
+// fdf returns the value of the function and its first derivative at x.
+// Newton converges quadratically close to a simple root, but is not
+// guaranteed to converge from a poor starting location. The iterations
+// terminate when the magnitude of a step is within the tolerance on the
+// location, or when the function value is within FuncTol.
+//
+// If the derivative vanishes, Newton returns the current location with
+// ErrZeroDerivative. If the iteration limit is reached, the current location
+// is returned with ErrIterationLimit.
+func Newton(fdf func(x float64) (f, df float64), x0 float64, settings *Settings) (Result, error) {
+	t := newTolerances(settings, defaultRelTol)
+	return iterate(t, x0, func(x float64) (f, step float64, err error) {
+		f, df := fdf(x)
+		if math.IsNaN(f) || math.IsNaN(df) {
+			return f, 0, ErrNaN
+		}
+		if df == 0 {
+			return f, 0, ErrZeroDerivative
+		}
+		return f, f / df, nil
+	})
+}
+
+// Halley finds a root of a function using Halley's method starting from x0.
+// fdf returns the value of the function and its first and second derivatives
+// at x. Halley converges cubically close to a simple root, but is not
+// guaranteed to converge from a poor starting location. The termination
+// criteria are the same as for Newton.
+//
+// If the Halley step is undefined, Halley returns the current location with
+// ErrZeroDerivative. If the iteration limit is reached, the current location
+// is returned with ErrIterationLimit.
+func Halley(fdf func(x float64) (f, df, d2f float64), x0 float64, settings *Settings) (Result, error) {
+	t := newTolerances(settings, defaultRelTol)
+	return iterate(t, x0, func(x float64) (f, step float64, err error) {
+		f, df, d2f := fdf(x)
+		if math.IsNaN(f) || math.IsNaN(df) || math.IsNaN(d2f) {
+			return f, 0, ErrNaN
+		}
+		denom := 2*df*df - f*d2f
+		if denom == 0 {
+			return f, 0, ErrZeroDerivative
+		}
+		return f, 2 * f * df / denom, nil
+	})
+}
+
+// iterate performs the iterations x_{k+1} = x_k - step(x_k) starting from x0.
+func iterate(t tolerances, x0 float64, next func(x float64) (f, step float64, err error)) (Result, error) {
+	res := Result{X: x0, Tol: math.Inf(1)}
+	for {
+		f, step, err := next(res.X)
+		res.F = f
+		res.FuncEvaluations++
+		if t.isRoot(f) {
+			if f == 0 {
+				res.Tol = 0
+			}
+			return res, nil
+		}
+		if err != nil {
+			return res, err
+		}
+		if res.Tol <= t.tol(res.X) {
+			return res, nil
+		}
+		if res.Iterations == t.maxIter {
+			return res, ErrIterationLimit
+		}
+		res.X -= step
+		res.Tol = math.Abs(step)
+		res.Iterations++
+	}
+}
+
+// DualFunc returns a function that evaluates f and its derivative at x using
+// dual numbers, for use with Newton.
+func DualFunc(f func(dual.Number) dual.Number) func(x float64) (f, df float64) {
+	return func(x float64) (float64, float64) {
+		d := f(dual.Number{Real: x, Emag: 1})
+		return d.Real, d.Emag
+	}
+}
+
+// HyperdualFunc returns a function that evaluates f and its first and second
+// derivatives at x using hyperdual numbers, for use with Halley.
+func HyperdualFunc(f func(hyperdual.Number) hyperdual.Number) func(x float64) (f, df, d2f float64) {
+	return func(x float64) (float64, float64, float64) {
+		d := f(hyperdual.Number{Real: x, E1mag: 1, E2mag: 1})
+		return d.Real, d.E1mag, d.E1E2mag
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jingcheng-WU/gonum/num/dual"
+	"github.com/jingcheng-WU/gonum/num/hyperdual"
+)
+
+func TestNewtonHalley(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		name string
+		f    func(float64) float64
+		df   func(float64) float64
+		d2f  func(float64) float64
+		fd   func(dual.Number) dual.Number
+		fh   func(hyperdual.Number) hyperdual.Number
+		x0   float64
+		root float64
+	}{
+		{
+			name: "Cubic",
+			f:    func(x float64) float64 { return x*x*x - 2*x - 5 },
+			df:   func(x float64) float64 { return 3*x*x - 2 },
+			d2f:  func(x float64) float64 { return 6 * x },
+			fd: func(x dual.Number) dual.Number {
+				return dual.Sub(dual.Mul(x, dual.Mul(x, x)), dual.Number{Real: 2*x.Real + 5, Emag: 2 * x.Emag})
+			},
+			fh: func(x hyperdual.Number) hyperdual.Number {
+				return hyperdual.Sub(hyperdual.Mul(x, hyperdual.Mul(x, x)), hyperdual.Add(hyperdual.Scale(2, x), hyperdual.Number{Real: 5}))
+			},
+			x0:   2,
+			root: 2.0945514815423265,
+		},
+		{
+			name: "Cos",
+			f:    func(x float64) float64 { return math.Cos(x) - x },
+			df:   func(x float64) float64 { return -math.Sin(x) - 1 },
+			d2f:  func(x float64) float64 { return -math.Cos(x) },
+			fd:   func(x dual.Number) dual.Number { return dual.Sub(dual.Cos(x), x) },
+			fh:   func(x hyperdual.Number) hyperdual.Number { return hyperdual.Sub(hyperdual.Cos(x), x) },
+			x0:   1,
+			root: 0.7390851332151607,
+		},
+		{
+			name: "Exp",
+			f:    func(x float64) float64 { return math.Exp(x) - 10 },
+			df:   math.Exp,
+			d2f:  math.Exp,
+			fd:   func(x dual.Number) dual.Number { return dual.Sub(dual.Exp(x), dual.Number{Real: 10}) },
+			fh: func(x hyperdual.Number) hyperdual.Number {
+				return hyperdual.Sub(hyperdual.Exp(x), hyperdual.Number{Real: 10})
+			},
+			x0:   0,
+			root: math.Log(10),
+		},
+	} {
+		tol := 1e-14 * math.Max(1, math.Abs(test.root))
+		check := func(method string, res Result, err error) {
+			t.Helper()
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", method, test.name, err)
+				return
+			}
+			if math.Abs(res.X-test.root) > tol {
+				t.Errorf("%s %s: unexpected root: got %v, want %v", method, test.name, res.X, test.root)
+			}
+			if res.F != test.f(res.X) && math.Abs(res.F-test.f(res.X)) > 1e-15 {
+				t.Errorf("%s %s: function value mismatch: got %v, want %v", method, test.name, res.F, test.f(res.X))
+			}
+			if res.Iterations > 20 || res.FuncEvaluations != res.Iterations+1 {
+				t.Errorf("%s %s: unexpected counts: %d iterations, %d evaluations", method, test.name, res.Iterations, res.FuncEvaluations)
+			}
+		}
+
+		res, err := Newton(func(x float64) (float64, float64) { return test.f(x), test.df(x) }, test.x0, nil)
+		check("Newton", res, err)
+		newton := res.Iterations
+		res, err = Newton(DualFunc(test.fd), test.x0, nil)
+		check("NewtonDual", res, err)
+
+		res, err = Halley(func(x float64) (float64, float64, float64) { return test.f(x), test.df(x), test.d2f(x) }, test.x0, nil)
+		check("Halley", res, err)
+		if res.Iterations > newton {
+			t.Errorf("%s: Halley slower than Newton: %d > %d iterations", test.name, res.Iterations, newton)
+		}
+		res, err = Halley(HyperdualFunc(test.fh), test.x0, nil)
+		check("HalleyHyperdual", res, err)
+	}
+}
+
+func TestNewtonErrors(t *testing.T) {
+	t.Parallel()
+	// The derivative of x^2 + 1 vanishes at 0.
+	res, err := Newton(func(x float64) (float64, float64) { return x*x + 1, 2 * x }, 0, nil)
+	if err != ErrZeroDerivative || res.X != 0 {
+		t.Errorf("unexpected result for zero derivative: got %v, %v", res, err)
+	}
+	// Newton's method cycles between 0 and 1 for x^3 - 2x + 2.
+	fdf := func(x float64) (float64, float64) { return x*x*x - 2*x + 2, 3*x*x - 2 }
+	res, err = Newton(fdf, 0, &Settings{MaxIterations: 10})
+	if err != ErrIterationLimit || res.Iterations != 10 {
+		t.Errorf("unexpected result for cycle: got %v, %v", res, err)
+	}
+	res, err = Newton(func(x float64) (float64, float64) { return math.Log(x), 1 / x }, 3, nil)
+	if err != ErrNaN {
+		t.Errorf("unexpected error for NaN: got %v, want %v", err, ErrNaN)
+	}
+	res, err = Newton(func(x float64) (float64, float64) { return x - 2, 1 }, 2, nil)
+	if err != nil || res.X != 2 || res.Tol != 0 || res.Iterations != 0 {
+		t.Errorf("unexpected result for initial root: got %v, %v", res, err)
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import "math"
+
+// bracket is an interval [a, b] with a < b over which the function changes
+// sign.
+type bracket struct {
+	a, b   float64
+	fa, fb float64
+}
+
+// newBracket evaluates f at the ends of the interval [a, b] and checks that
+// they bracket a root. If one of the ends is accepted as a root, it is
+// returned in res and done is true.
+func newBracket(f func(float64) float64, a, b float64, t tolerances) (br bracket, res Result, done bool, err error) {
+	if b < a {
+		a, b = b, a
+	}
+	br = bracket{a: a, b: b, fa: f(a), fb: f(b)}
+	res.FuncEvaluations = 2
+	if math.IsNaN(br.fa) || math.IsNaN(br.fb) {
+		return br, res, true, ErrNaN
+	}
+	switch {
+	case t.isRoot(br.fa):
+		res.X, res.F = a, br.fa
+		return br, res, true, nil
+	case t.isRoot(br.fb):
+		res.X, res.F = b, br.fb
+		return br, res, true, nil
+	case (br.fa < 0) == (br.fb < 0):
+		return br, res, true, ErrNotBracketed
+	}
+	return br, res, false, nil
+}
+
+// update replaces the end of the bracket that has the same sign as fx by x.
+// x must be inside the bracket.
+func (br *bracket) update(x, fx float64) {
+	if (fx < 0) == (br.fa < 0) {
+		br.a, br.fa = x, fx
+	} else {
+		br.b, br.fb = x, fx
+	}
+}
+
+// best stores the end of the bracket with the smaller function magnitude and
+// the width of the bracket in res.
+func (br *bracket) best(res *Result) {
+	if math.Abs(br.fa) <= math.Abs(br.fb) {
+		res.X, res.F = br.a, br.fa
+	} else {
+		res.X, res.F = br.b, br.fb
+	}
+	res.Tol = br.b - br.a
+}
+
+// converged returns whether the bracket is narrow enough, or cannot be
+// narrowed further because its ends are adjacent floating point numbers.
+func (br *bracket) converged(t tolerances) bool {
+	m := br.a + (br.b-br.a)/2
+	if m <= br.a || br.b <= m {
+		return true
+	}
+	x := br.a
+	if math.Abs(br.fb) < math.Abs(br.fa) {
+		x = br.b
+	}
+	return br.b-br.a <= t.tol(x)
+}
+
+// Bisection finds a root of f in the interval [a, b] by repeatedly halving
+// the interval. The function values at a and b must have opposite signs.
+// Bisection converges linearly, gaining one bit of accuracy per iteration,
+// but is robust to functions that are badly behaved in the interval.
+//
+// If f(a) and f(b) have the same sign, Bisection returns ErrNotBracketed.
+// If the iteration limit is reached, the best location found is returned
+// with ErrIterationLimit.
+func Bisection(f func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+	t := newTolerances(settings, defaultRelTol)
+	br, res, done, err := newBracket(f, a, b, t)
+	if done {
+		return res, err
+	}
+	for {
+		if br.converged(t) {
+			br.best(&res)
+			return res, nil
+		}
+		if res.Iterations == t.maxIter {
+			br.best(&res)
+			return res, ErrIterationLimit
+		}
+		m := br.a + (br.b-br.a)/2
+		fm := f(m)
+		res.Iterations++
+		res.FuncEvaluations++
+		if math.IsNaN(fm) {
+			br.best(&res)
+			return res, ErrNaN
+		}
+		if t.isRoot(fm) {
+			res.X, res.F, res.Tol = m, fm, 0
+			return res, nil
+		}
+		br.update(m, fm)
+	}
+}
+
+// Brent finds a root of f in the interval [a, b] using the Brent–Dekker
+// method, which combines inverse quadratic interpolation and the secant
+// method with bisection. The function values at a and b must have opposite
+// signs. The method converges superlinearly for smooth functions and never
+// needs many more function evaluations than Bisection.
+//
+// If f(a) and f(b) have the same sign, Brent returns ErrNotBracketed. If the
+// iteration limit is reached, the best location found is returned with
+// ErrIterationLimit.
+//
+// The method is described in
+//  Brent, R. P.: Algorithms for Minimization without Derivatives, Chapter 4.
+//  Prentice-Hall (1973)
+func Brent(f func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+	t := newTolerances(settings, defaultRelTol)
+	br, res, done, err := newBracket(f, a, b, t)
+	if done {
+		return res, err
+	}
+
+	// b is the best estimate of the root, a is the previous estimate, and
+	// the root lies between b and c.
+	a, b = br.a, br.b
+	fa, fb := br.fa, br.fb
+	c, fc := b, fb
+	var d, e float64
+	for {
+		if (fb < 0) == (fc < 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+
+		tol := t.tol(b) / 2
+		m := (c - b) / 2
+		if math.Abs(m) <= tol || b+m == b || t.isRoot(fb) {
+			res.X, res.F, res.Tol = b, fb, math.Abs(c-b)
+			if t.isRoot(fb) {
+				res.Tol = 0
+			}
+			return res, nil
+		}
+		if res.Iterations == t.maxIter {
+			res.X, res.F, res.Tol = b, fb, math.Abs(c-b)
+			return res, ErrIterationLimit
+		}
+
+		if math.Abs(e) >= tol && math.Abs(fa) > math.Abs(fb) {
+			// Attempt interpolation.
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				// Secant method.
+				p = 2 * m * s
+				q = 1 - s
+			} else {
+				// Inverse quadratic interpolation.
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*m*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			} else {
+				p = -p
+			}
+			if 2*p < math.Min(3*m*q-math.Abs(tol*q), math.Abs(e*q)) {
+				e = d
+				d = p / q
+			} else {
+				// Interpolation failed, use bisection.
+				d = m
+				e = d
+			}
+		} else {
+			// The bounds are decreasing too slowly, use bisection.
+			d = m
+			e = d
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol {
+			b += d
+		} else {
+			b += math.Copysign(tol, m)
+		}
+		fb = f(b)
+		res.Iterations++
+		res.FuncEvaluations++
+		if math.IsNaN(fb) {
+			res.X, res.F, res.Tol = a, fa, math.Abs(c-a)
+			return res, ErrNaN
+		}
+	}
+}
+
+// Ridders finds a root of f in the interval [a, b] using Ridders' method,
+// which applies regula falsi to the function f(x) exp(λx), where λ is chosen
+// so that the function becomes linear through the ends and the midpoint of
+// the bracket. The function values at a and b must have opposite signs.
+// Every iteration uses two function evaluations and the method converges
+// quadratically for smooth functions.
+//
+// If f(a) and f(b) have the same sign, Ridders returns ErrNotBracketed. If
+// the iteration limit is reached, the best location found is returned with
+// ErrIterationLimit.
+//
+// The method is described in
+//  Ridders, C.: A new algorithm for computing a single root of a real
+//  continuous function. IEEE Transactions on Circuits and Systems 26.11
+//  (1979): 979-980.
+func Ridders(f func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+	t := newTolerances(settings, defaultRelTol)
+	br, res, done, err := newBracket(f, a, b, t)
+	if done {
+		return res, err
+	}
+	for {
+		if br.converged(t) {
+			br.best(&res)
+			return res, nil
+		}
+		if res.Iterations == t.maxIter {
+			br.best(&res)
+			return res, ErrIterationLimit
+		}
+		res.Iterations++
+
+		m := br.a + (br.b-br.a)/2
+		fm := f(m)
+		res.FuncEvaluations++
+		if math.IsNaN(fm) {
+			br.best(&res)
+			return res, ErrNaN
+		}
+		if t.isRoot(fm) {
+			res.X, res.F, res.Tol = m, fm, 0
+			return res, nil
+		}
+
+		// fa and fb have opposite signs, so the square root is real and
+		// not smaller than |fm|.
+		s := math.Sqrt(fm*fm - br.fa*br.fb)
+		x := m + (m-br.a)*math.Copysign(1, br.fa-br.fb)*fm/s
+		x = math.Max(br.a, math.Min(x, br.b))
+		fx := f(x)
+		res.FuncEvaluations++
+		if math.IsNaN(fx) {
+			br.best(&res)
+			return res, ErrNaN
+		}
+		if t.isRoot(fx) {
+			res.X, res.F, res.Tol = x, fx, 0
+			return res, nil
+		}
+
+		// Choose the smallest bracket from a, m, x and b.
+		br.update(m, fm)
+		if br.a < x && x < br.b {
+			br.update(x, fx)
+		}
+	}
+}
+
+// ITP finds a root of f in the interval [a, b] using the
+// Interpolate–Truncate–Project method. The function values at a and b must
+// have opposite signs. ITP uses regula falsi steps that are truncated and
+// projected so that the number of iterations never exceeds that of Bisection
+// by more than one, while it converges superlinearly for smooth functions.
+// The hyperparameters of the method are κ₁ = 0.2/(b-a), κ₂ = 2 and n₀ = 1.
+//
+// Since ITP needs a fixed absolute tolerance, the tolerance is computed from
+// the settings at the location in [a, b] with the smallest magnitude.
+//
+// If f(a) and f(b) have the same sign, ITP returns ErrNotBracketed. If the
+// iteration limit is reached, the best location found is returned with
+// ErrIterationLimit.
+//
+// The method is described in
+//  Oliveira, I. F. D., Takahashi, R. H. C.: An enhancement of the bisection
+//  method average performance preserving minmax optimality. ACM Transactions
+//  on Mathematical Software 47.1 (2020): 1-24.
+func ITP(f func(float64) float64, a, b float64, settings *Settings) (Result, error) {
+	t := newTolerances(settings, defaultRelTol)
+	br, res, done, err := newBracket(f, a, b, t)
+	if done {
+		return res, err
+	}
+
+	var minAbs float64
+	if 0 < br.a || br.b < 0 {
+		minAbs = math.Min(math.Abs(br.a), math.Abs(br.b))
+	}
+	eps := t.tol(minAbs) / 2
+	const (
+		k2 = 2
+		n0 = 1
+	)
+	k1 := 0.2 / (br.b - br.a)
+	nmax := int(math.Ceil(math.Log2((br.b-br.a)/(2*eps)))) + n0
+
+	for {
+		if br.b-br.a <= 2*eps || br.converged(t) {
+			br.best(&res)
+			return res, nil
+		}
+		if res.Iterations == t.maxIter {
+			br.best(&res)
+			return res, ErrIterationLimit
+		}
+
+		width := br.b - br.a
+		half := br.a + width/2
+		r := math.Ldexp(eps, nmax-res.Iterations) - width/2
+		delta := k1 * math.Pow(width, k2)
+
+		// Interpolate.
+		xf := (br.fb*br.a - br.fa*br.b) / (br.fb - br.fa)
+		// Truncate.
+		sigma := math.Copysign(1, half-xf)
+		xt := half
+		if delta <= math.Abs(half-xf) {
+			xt = xf + sigma*delta
+		}
+		// Project.
+		x := xt
+		if math.Abs(xt-half) > r {
+			x = half - sigma*r
+		}
+		if x <= br.a || br.b <= x {
+			x = half
+		}
+
+		fx := f(x)
+		res.Iterations++
+		res.FuncEvaluations++
+		if math.IsNaN(fx) {
+			br.best(&res)
+			return res, ErrNaN
+		}
+		if t.isRoot(fx) {
+			res.X, res.F, res.Tol = x, fx, 0
+			return res, nil
+		}
+		br.update(x, fx)
+	}
+}
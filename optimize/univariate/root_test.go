@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"math"
+	"testing"
+)
+
+var rootFinders = []struct {
+	name string
+	find func(f func(float64) float64, a, b float64, settings *Settings) (Result, error)
+}{
+	{name: "Bisection", find: Bisection},
+	{name: "Brent", find: Brent},
+	{name: "Ridders", find: Ridders},
+	{name: "ITP", find: ITP},
+}
+
+var rootTests = []struct {
+	name string
+	f    func(float64) float64
+	a, b float64
+	root float64
+}{
+	{
+		name: "Linear",
+		f:    func(x float64) float64 { return 2*x - 1 },
+		a:    -3, b: 7,
+		root: 0.5,
+	},
+	{
+		name: "Cubic",
+		f:    func(x float64) float64 { return x*x*x - 2*x - 5 },
+		a:    2, b: 3,
+		root: 2.0945514815423265,
+	},
+	{
+		name: "Cos",
+		f:    func(x float64) float64 { return math.Cos(x) - x },
+		a:    0, b: 1,
+		root: 0.7390851332151607,
+	},
+	{
+		name: "Exp",
+		f:    func(x float64) float64 { return math.Exp(x) - 1e-3 },
+		a:    -20, b: 1,
+		root: math.Log(1e-3),
+	},
+	{
+		name: "Flat",
+		f:    func(x float64) float64 { return math.Pow(x-1, 5) },
+		a:    0, b: 3,
+		root: 1,
+	},
+	{
+		name: "Step",
+		f: func(x float64) float64 {
+			if x < math.Pi {
+				return -1
+			}
+			return 1
+		},
+		a: 0, b: 10,
+		root: math.Pi,
+	},
+	{
+		name: "Reversed",
+		f:    func(x float64) float64 { return x*x - 2 },
+		a:    10, b: 0,
+		root: math.Sqrt2,
+	},
+	{
+		name: "Large",
+		f:    func(x float64) float64 { return x - 1e10 },
+		a:    0, b: 1e12,
+		root: 1e10,
+	},
+}
+
+func TestRootFinders(t *testing.T) {
+	t.Parallel()
+	for _, method := range rootFinders {
+		for _, test := range rootTests {
+			var evals int
+			f := func(x float64) float64 {
+				evals++
+				return test.f(x)
+			}
+			res, err := method.find(f, test.a, test.b, nil)
+			if err != nil {
+				t.Errorf("%s %s: unexpected error: %v", method.name, test.name, err)
+				continue
+			}
+			tol := defaultAbsTol + 4*machEps*math.Abs(test.root)
+			if math.Abs(res.X-test.root) > tol {
+				t.Errorf("%s %s: unexpected root: got %v, want %v", method.name, test.name, res.X, test.root)
+			}
+			if res.Tol > tol || math.Abs(res.X-test.root) > res.Tol {
+				t.Errorf("%s %s: unexpected tolerance: got %v, error %v", method.name, test.name, res.Tol, math.Abs(res.X-test.root))
+			}
+			if res.F != test.f(res.X) {
+				t.Errorf("%s %s: function value mismatch: got %v, want %v", method.name, test.name, res.F, test.f(res.X))
+			}
+			if res.FuncEvaluations != evals {
+				t.Errorf("%s %s: unexpected number of evaluations: got %d, want %d", method.name, test.name, res.FuncEvaluations, evals)
+			}
+			if res.Iterations > 200 {
+				t.Errorf("%s %s: too many iterations: %d", method.name, test.name, res.Iterations)
+			}
+		}
+	}
+}
+
+func TestRootFindersFaster(t *testing.T) {
+	t.Parallel()
+	// The superlinear methods must need fewer evaluations than Bisection for
+	// a smooth function.
+	f := func(x float64) float64 { return math.Cos(x) - x }
+	bisect, _ := Bisection(f, 0, 1, nil)
+	for _, method := range rootFinders[1:] {
+		res, _ := method.find(f, 0, 1, nil)
+		if res.FuncEvaluations >= bisect.FuncEvaluations/2 {
+			t.Errorf("%s: too many evaluations: got %d, bisection needs %d", method.name, res.FuncEvaluations, bisect.FuncEvaluations)
+		}
+	}
+}
+
+func TestRootFindersSettings(t *testing.T) {
+	t.Parallel()
+	f := func(x float64) float64 { return math.Cos(x) - x }
+	const root = 0.7390851332151607
+	for _, method := range rootFinders {
+		_, err := method.find(f, 1, 2, nil)
+		if err != ErrNotBracketed {
+			t.Errorf("%s: unexpected error for unbracketed root: got %v, want %v", method.name, err, ErrNotBracketed)
+		}
+
+		res, err := method.find(f, root, 2, nil)
+		if err != nil || res.X != root || res.Tol != 0 {
+			t.Errorf("%s: root at end of interval not found: got %v, %v", method.name, res, err)
+		}
+
+		res, err = method.find(f, 0, 1, &Settings{AbsTol: 1e-3, RelTol: 1e-3})
+		if err != nil {
+			t.Errorf("%s: unexpected error with loose tolerance: %v", method.name, err)
+		}
+		if math.Abs(res.X-root) > 2e-3 || res.Tol > 2e-3 {
+			t.Errorf("%s: unexpected result with loose tolerance: got %v", method.name, res)
+		}
+
+		res, err = method.find(f, 0, 1, &Settings{FuncTol: 1e-2})
+		if err != nil || math.Abs(res.F) > 1e-2 {
+			t.Errorf("%s: unexpected result with function tolerance: got %v, %v", method.name, res, err)
+		}
+
+		res, err = method.find(f, 0, 1, &Settings{MaxIterations: 2})
+		if err != ErrIterationLimit || res.Iterations != 2 {
+			t.Errorf("%s: unexpected result with iteration limit: got %v, %v", method.name, res, err)
+		}
+
+		nan := func(x float64) float64 {
+			if x > 0.5 && x < 0.6 {
+				return math.NaN()
+			}
+			return x - 0.55
+		}
+		_, err = method.find(nan, 0, 1, nil)
+		if err != ErrNaN {
+			t.Errorf("%s: unexpected error for NaN: got %v, want %v", method.name, err, ErrNaN)
+		}
+	}
+}
+
+func TestBracketRoot(t *testing.T) {
+	t.Parallel()
+	for _, test := range []struct {
+		f    func(float64) float64
+		a, b float64
+		err  error
+	}{
+		{f: func(x float64) float64 { return x - 100 }, a: 0, b: 1},
+		{f: func(x float64) float64 { return x + 100 }, a: 1, b: 0},
+		{f: math.Log, a: 2, b: 3},
+		{f: func(x float64) float64 { return x*x + 1 }, a: 0, b: 1, err: ErrNotBracketed},
+	} {
+		lo, hi, err := BracketRoot(test.f, test.a, test.b, 0)
+		if err != test.err {
+			t.Errorf("unexpected error for [%v, %v]: got %v, want %v", test.a, test.b, err, test.err)
+			continue
+		}
+		if !(lo < hi) {
+			t.Errorf("invalid interval [%v, %v]", lo, hi)
+		}
+		if err == nil && (test.f(lo) < 0) == (test.f(hi) < 0) {
+			t.Errorf("root not bracketed by [%v, %v]", lo, hi)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package univariate
+
+import (
+	"errors"
+	"math"
+)
+
+const (
+	machEps = 1.0 / (1 << 52)
+
+	defaultAbsTol        = 2e-12
+	defaultRelTol        = 4 * machEps
+	defaultMaxIterations = 1000
+)
+
+var (
+	// ErrNotBracketed is returned when the function values at the ends of
+	// the interval given to a bracketing root finder do not have opposite
+	// signs.
+	ErrNotBracketed = errors.New("univariate: root not bracketed")
+	// ErrIterationLimit is returned when the maximum number of iterations
+	// was reached before convergence.
+	ErrIterationLimit = errors.New("univariate: iteration limit reached")
+	// ErrZeroDerivative is returned by Newton and Halley when the
+	// derivative of the function vanishes.
+	ErrZeroDerivative = errors.New("univariate: zero derivative")
+	// ErrNaN is returned when the function returns NaN.
+	ErrNaN = errors.New("univariate: NaN function value")
+)
+
+// Settings holds the convergence settings of the root finders and
+// minimizers. A nil *Settings is equivalent to the zero value, for which the
+// defaults are used.
+type Settings struct {
+	// AbsTol and RelTol specify the tolerance on the location. The methods
+	// terminate when the location is known to within
+	//  AbsTol + RelTol*|x|.
+	// If AbsTol is zero, it is defaulted to 2e-12. If RelTol is zero, it is
+	// defaulted to 4 times the machine epsilon for the root finders and to
+	// the square root of the machine epsilon for Minimize. Relative
+	// tolerances below 2 times the machine epsilon are not attainable and
+	// are increased to that value. AbsTol and RelTol must not be negative.
+	AbsTol, RelTol float64
+
+	// FuncTol is the tolerance on the function value for the root finders.
+	// The root finders terminate when |f(x)| ≤ FuncTol. FuncTol must not
+	// be negative. The default of zero only terminates at an exact root.
+	FuncTol float64
+
+	// MaxIterations is the maximum number of iterations. If MaxIterations
+	// is zero, it is defaulted to 1000. MaxIterations must not be
+	// negative.
+	MaxIterations int
+}
+
+// Result holds the result of a root finding or minimization.
+type Result struct {
+	// X is the location of the root or the minimum.
+	X float64
+	// F is the function value at X.
+	F float64
+
+	// Iterations is the number of iterations performed.
+	Iterations int
+	// FuncEvaluations is the number of function evaluations. For Newton
+	// and Halley it counts the evaluations of the function together with
+	// its derivatives.
+	FuncEvaluations int
+
+	// Tol is the tolerance achieved on X. For the bracketing root finders
+	// it is the width of the final bracket, which has X as one of its ends,
+	// and for Minimize it is the distance from X to the farther end of the
+	// final interval. For Newton and Halley it is the magnitude of the last
+	// step.
+	Tol float64
+}
+
+// tolerances holds the validated settings.
+type tolerances struct {
+	abs, rel, fn float64
+	maxIter      int
+}
+
+func newTolerances(s *Settings, rel float64) tolerances {
+	t := tolerances{
+		abs:     defaultAbsTol,
+		rel:     rel,
+		maxIter: defaultMaxIterations,
+	}
+	if s == nil {
+		return t
+	}
+	if s.AbsTol < 0 || s.RelTol < 0 || s.FuncTol < 0 {
+		panic("univariate: negative tolerance")
+	}
+	if s.MaxIterations < 0 {
+		panic("univariate: negative MaxIterations")
+	}
+	if s.AbsTol != 0 {
+		t.abs = s.AbsTol
+	}
+	if s.RelTol != 0 {
+		t.rel = s.RelTol
+	}
+	if s.MaxIterations != 0 {
+		t.maxIter = s.MaxIterations
+	}
+	t.fn = s.FuncTol
+	return t
+}
+
+// tol returns the tolerance on the location at x.
+func (t tolerances) tol(x float64) float64 {
+	return t.abs + math.Max(t.rel, 2*machEps)*math.Abs(x)
+}
+
+// isRoot returns whether fx is small enough for x to be accepted as a root.
+func (t tolerances) isRoot(fx float64) bool {
+	return math.Abs(fx) <= t.fn
+}
@@ -71,6 +71,25 @@ func (b Binomial) Prob(x float64) float64 {
 	return math.Exp(b.LogProb(x))
 }
 
+// Quantile returns the inverse of the cumulative distribution function, the
+// smallest k such that CDF(k) ≥ p. Since the binomial distribution has no
+// closed-form quantile function, Quantile finds the root of a continuous
+// extension of the CDF.
+func (b Binomial) Quantile(p float64) float64 {
+	if p < 0 || p > 1 {
+		panic(badPercentile)
+	}
+	if b.CDF(0) >= p {
+		return 0
+	}
+	if b.CDF(b.N-1) < p {
+		return b.N
+	}
+	return discreteQuantile(p, b.CDF, func(x float64) float64 {
+		return mathext.RegIncBeta(b.N-x, x+1, 1-b.P)
+	}, 0, b.N-1)
+}
+
 // Rand returns a random sample drawn from the distribution.
 func (b Binomial) Rand() float64 {
 	// NUMERICAL RECIPES IN C: THE ART OF SCIENTIFIC COMPUTING (ISBN 0-521-43108-5)
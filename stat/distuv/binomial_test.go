@@ -5,6 +5,7 @@
 package distuv
 
 import (
+	"math"
 	"sort"
 	"testing"
 
@@ -146,6 +147,10 @@ func testBinomial(t *testing.T, b Binomial, i int) {
 	checkVarAndStd(t, i, x, b, tol)
 	checkExKurtosis(t, i, x, b, 7e-2)
 	checkSkewness(t, i, x, b, tol)
+	checkQuantileDiscrete(t, i, b, math.Max(0, math.Floor(b.Mean()-10*b.StdDev())), math.Min(b.N, math.Ceil(b.Mean()+10*b.StdDev())))
+	if b.Quantile(0) != 0 || b.Quantile(1) != b.N {
+		t.Errorf("Quantile mismatch case %v: got %v and %v at 0 and 1", i, b.Quantile(0), b.Quantile(1))
+	}
 
 	if b.NumParameters() != 2 {
 		t.Errorf("Wrong number of parameters")
@@ -252,6 +252,33 @@ func checkProbDiscrete(t *testing.T, i int, xs []float64, p probLogprober, tol f
 	}
 }
 
+type discreteCumulanter interface {
+	quantiler
+	CDF(x float64) float64
+}
+
+// checkQuantileDiscrete confirms that Quantile returns the smallest integer k
+// such that CDF(k) ≥ p for discrete distributions with support on the
+// integers. Only the integers in [min, max] at which the CDF is not too close
+// to 0 or 1 are checked.
+func checkQuantileDiscrete(t *testing.T, i int, c discreteCumulanter, min, max float64) {
+	const tail = 1e-10
+	prev := c.CDF(min - 1)
+	for k := min; k <= max; k++ {
+		cdf := c.CDF(k)
+		if cdf < tail || 1-tail < cdf {
+			prev = cdf
+			continue
+		}
+		for _, p := range []float64{prev + (cdf-prev)/2, cdf} {
+			if got := c.Quantile(p); got != k {
+				t.Errorf("Quantile mismatch case %v at %v: want %v, got %v", i, p, k, got)
+			}
+		}
+		prev = cdf
+	}
+}
+
 // testRandLogProb tests that LogProb and Rand give consistent results. This
 // can be used when the distribution does not implement CDF.
 func testRandLogProbContinuous(t *testing.T, i int, min float64, x []float64, f LogProber, tol float64, bins int) {
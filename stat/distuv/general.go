@@ -4,6 +4,12 @@
 
 package distuv
 
+import (
+	"math"
+
+	"github.com/jingcheng-WU/gonum/optimize/univariate"
+)
+
 // Parameter represents a parameter of a probability distribution
 type Parameter struct {
 	Name  string
@@ -22,3 +28,22 @@ const (
 	eulerMascheroni = 0.5772156649015328606065120900824024310421 // https://oeis.org/A001620
 	apery           = 1.2020569031595942853997381615114499907649 // https://oeis.org/A002117
 )
+
+// discreteQuantile returns the smallest integer k in (lo, hi] for which
+// cdf(k) ≥ p, where cdf(lo) < p ≤ cdf(hi). cont must be an increasing
+// continuous function on [lo, hi] that is equal to cdf at the integers.
+func discreteQuantile(p float64, cdf, cont func(float64) float64, lo, hi float64) float64 {
+	// Only the integer part of the root is needed, and inaccuracies are
+	// corrected below, so the error of Brent is not checked.
+	res, _ := univariate.Brent(func(x float64) float64 {
+		return cont(x) - p
+	}, lo, hi, &univariate.Settings{AbsTol: 0.25})
+	k := math.Max(lo+1, math.Min(math.Ceil(res.X), hi))
+	for k > lo+1 && cdf(k-1) >= p {
+		k--
+	}
+	for k < hi && cdf(k) < p {
+		k++
+	}
+	return k
+}
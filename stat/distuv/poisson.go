@@ -64,6 +64,29 @@ func (p Poisson) Prob(x float64) float64 {
 	return math.Exp(p.LogProb(x))
 }
 
+// Quantile returns the inverse of the cumulative distribution function, the
+// smallest k such that CDF(k) ≥ p. Since the Poisson distribution has no
+// closed-form quantile function, Quantile finds the root of a continuous
+// extension of the CDF.
+func (p Poisson) Quantile(prob float64) float64 {
+	if prob < 0 || prob > 1 {
+		panic(badPercentile)
+	}
+	if prob == 1 {
+		return math.Inf(1)
+	}
+	if p.CDF(0) >= prob {
+		return 0
+	}
+	hi := math.Ceil(p.Lambda) + 1
+	for p.CDF(hi) < prob {
+		hi *= 2
+	}
+	return discreteQuantile(prob, p.CDF, func(x float64) float64 {
+		return mathext.GammaIncRegComp(x+1, p.Lambda)
+	}, 0, hi)
+}
+
 // Rand returns a random sample drawn from the distribution.
 func (p Poisson) Rand() float64 {
 	// NUMERICAL RECIPES IN C: THE ART OF SCIENTIFIC COMPUTING (ISBN 0-521-43108-5)
@@ -132,6 +132,10 @@ func testPoisson(t *testing.T, p Poisson, i int) {
 	checkVarAndStd(t, i, x, p, tol)
 	checkExKurtosis(t, i, x, p, 7e-2)
 	checkSkewness(t, i, x, p, tol)
+	checkQuantileDiscrete(t, i, p, math.Max(0, math.Floor(p.Mean()-10*p.StdDev())), math.Ceil(p.Mean()+10*p.StdDev()))
+	if p.Quantile(0) != 0 || !math.IsInf(p.Quantile(1), 1) {
+		t.Errorf("Quantile mismatch case %v: got %v and %v at 0 and 1", i, p.Quantile(0), p.Quantile(1))
+	}
 
 	if p.NumParameters() != 1 {
 		t.Errorf("Mismatch in NumParameters: got %v, want 1", p.NumParameters())
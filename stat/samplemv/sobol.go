@@ -0,0 +1,238 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package samplemv
+
+import (
+	"fmt"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/stat/distmv"
+)
+
+// Sobol is a type for sampling using the Sobol' sequence from the given
+// distribution. The specific method for scrambling (or lack thereof) is
+// specified by the SobolKind. If src is not nil, it will be used to generate
+// the randomness needed to scramble the sequence (if necessary), otherwise
+// the rand package will be used. Sobol panics if the SobolKind is unrecognized,
+// if q is nil, if the number of columns of the batch exceeds the number of
+// available direction numbers or if the number of rows exceeds 2^32.
+//
+// The sequence is generated in Gray code order using the direction numbers of
+//  S. Joe and F. Y. Kuo, Constructing Sobol sequences with better two-dimensional
+//  projections, SIAM J. Sci. Comput. 30, 2635-2654 (2008).
+// The unscrambled sequence starts at the origin. Sobol' points have their best
+// uniformity properties when the number of samples is a power of two.
+//
+// Sobol sequence random number generation is a quasi-Monte Carlo procedure
+// where the samples are generated to be evenly spaced out across the distribution.
+// Note that this means the sample locations are correlated with one another.
+// The distmv.NewUnitUniform function can be used for easy sampling from the unit hypercube.
+type Sobol struct {
+	Kind SobolKind
+	Q    distmv.Quantiler
+	Src  rand.Source
+}
+
+// Sample generates rows(batch) samples using the Sobol generation procedure.
+func (s Sobol) Sample(batch *mat.Dense) {
+	sobol(batch, s.Kind, s.Q, s.Src)
+}
+
+// SobolKind specifies the type of algorithm used to generate Sobol samples.
+type SobolKind int
+
+const (
+	// SobolUnscrambled generates the deterministic Sobol' sequence.
+	SobolUnscrambled SobolKind = iota + 1
+
+	// SobolOwen generates Sobol' samples with nested uniform (Owen)
+	// scrambling as described in
+	//  Scrambling Sobol' and Niederreiter-Xing points
+	//  Art Owen
+	//  Journal of Complexity 14, 466-489 (1998).
+	// Each scrambled point is uniformly distributed over the unit cube and
+	// the scrambled points retain the net structure of the sequence.
+	// The random permutations are generated lazily by hashing the leading
+	// digits of each coordinate with a random seed for each dimension.
+	SobolOwen
+)
+
+// SobolMaxDim is the maximum dimension of samples generated by Sobol.
+const SobolMaxDim = 40
+
+// sobolBits is the number of bits of precision in the generated sequence.
+const sobolBits = 32
+
+func sobol(batch *mat.Dense, kind SobolKind, q distmv.Quantiler, src rand.Source) {
+	n, d := batch.Dims()
+	if d > SobolMaxDim {
+		panic(fmt.Sprintf("sobol: dimension must be at most %d", SobolMaxDim))
+	}
+	if uint64(n) > 1<<sobolBits {
+		panic("sobol: too many samples")
+	}
+	uint64n := rand.Uint64
+	float64n := rand.Float64
+	if src != nil {
+		rnd := rand.New(src)
+		uint64n = rnd.Uint64
+		float64n = rnd.Float64
+	}
+
+	var scramble bool
+	switch kind {
+	default:
+		panic("sobol: unknown SobolKind")
+	case SobolUnscrambled:
+	case SobolOwen:
+		scramble = true
+	}
+
+	v := make([]uint32, sobolBits)
+	x := make([]uint32, n)
+	for j := 0; j < d; j++ {
+		sobolDirectionNumbers(v, j)
+
+		// Generate the j-th coordinate of every point in Gray code order.
+		for i := 1; i < n; i++ {
+			x[i] = x[i-1] ^ v[trailingOnes(uint32(i-1))]
+		}
+
+		var seed uint64
+		if scramble {
+			seed = uint64n()
+		}
+		for i, u := range x {
+			if !scramble {
+				batch.Set(i, j, float64(u)/(1<<sobolBits))
+				continue
+			}
+			u = owenScramble(u, seed)
+			// Fill the digits below the generated precision uniformly at random.
+			batch.Set(i, j, (float64(u)+float64n())/(1<<sobolBits))
+		}
+	}
+
+	p := make([]float64, d)
+	for i := 0; i < n; i++ {
+		copy(p, batch.RawRowView(i))
+		q.Quantile(batch.RawRowView(i), p)
+	}
+}
+
+// sobolDirectionNumbers fills v with the direction numbers for dimension j
+// scaled to occupy the leading bits of a uint32.
+func sobolDirectionNumbers(v []uint32, j int) {
+	if j == 0 {
+		for k := range v {
+			v[k] = 1 << uint(sobolBits-1-k)
+		}
+		return
+	}
+	dir := sobolDirections[j-1]
+	s := len(dir.m)
+	for k := 0; k < len(v); k++ {
+		if k < s {
+			v[k] = dir.m[k] << uint(sobolBits-1-k)
+			continue
+		}
+		v[k] = v[k-s] ^ (v[k-s] >> uint(s))
+		for l := 1; l < s; l++ {
+			if (dir.a>>uint(s-1-l))&1 == 1 {
+				v[k] ^= v[k-l]
+			}
+		}
+	}
+}
+
+// trailingOnes returns the number of trailing one bits in i.
+func trailingOnes(i uint32) int {
+	var c int
+	for i&1 == 1 {
+		i >>= 1
+		c++
+	}
+	return c
+}
+
+// owenScramble applies a nested uniform scramble to the bits of u. The
+// decision to flip each bit is made by hashing the more significant bits
+// of u together with the seed, so that points sharing leading digits are
+// permuted identically.
+func owenScramble(u uint32, seed uint64) uint32 {
+	var flips uint32
+	for k := 0; k < sobolBits; k++ {
+		var prefix uint64
+		if k != 0 {
+			prefix = uint64(u >> uint(sobolBits-k))
+		}
+		h := splitMix64(seed ^ splitMix64(uint64(k)<<sobolBits|prefix))
+		flips |= uint32(h>>63) << uint(sobolBits-1-k)
+	}
+	return u ^ flips
+}
+
+// splitMix64 is the finalizer of the SplitMix64 generator.
+func splitMix64(z uint64) uint64 {
+	z += 0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// sobolDirection holds the primitive polynomial and initial direction
+// numbers for a dimension of the Sobol' sequence. The degree of the
+// polynomial is len(m), and a holds its interior coefficients with the
+// coefficient of the highest power in the most significant bit.
+type sobolDirection struct {
+	a uint32
+	m []uint32
+}
+
+// sobolDirections are the direction numbers for dimensions 2 and above
+// from the new-joe-kuo-6.21201 file of Joe and Kuo.
+var sobolDirections = []sobolDirection{
+	{0, []uint32{1}},
+	{1, []uint32{1, 3}},
+	{1, []uint32{1, 3, 1}},
+	{2, []uint32{1, 1, 1}},
+	{1, []uint32{1, 1, 3, 3}},
+	{4, []uint32{1, 3, 5, 13}},
+	{2, []uint32{1, 1, 5, 5, 17}},
+	{4, []uint32{1, 1, 5, 5, 5}},
+	{7, []uint32{1, 1, 7, 11, 19}},
+	{11, []uint32{1, 1, 5, 1, 1}},
+	{13, []uint32{1, 1, 1, 3, 11}},
+	{14, []uint32{1, 3, 5, 5, 31}},
+	{1, []uint32{1, 3, 3, 9, 7, 49}},
+	{13, []uint32{1, 1, 1, 15, 21, 21}},
+	{16, []uint32{1, 3, 1, 13, 27, 49}},
+	{19, []uint32{1, 1, 1, 15, 7, 5}},
+	{22, []uint32{1, 3, 1, 15, 13, 25}},
+	{25, []uint32{1, 1, 5, 5, 19, 61}},
+	{1, []uint32{1, 3, 7, 11, 23, 15, 103}},
+	{4, []uint32{1, 3, 7, 13, 13, 15, 69}},
+	{7, []uint32{1, 1, 3, 13, 7, 35, 63}},
+	{8, []uint32{1, 3, 5, 9, 1, 25, 53}},
+	{14, []uint32{1, 3, 1, 13, 9, 35, 107}},
+	{19, []uint32{1, 3, 1, 5, 27, 61, 31}},
+	{21, []uint32{1, 1, 5, 11, 19, 41, 61}},
+	{28, []uint32{1, 3, 5, 3, 3, 13, 69}},
+	{31, []uint32{1, 1, 7, 13, 1, 19, 1}},
+	{32, []uint32{1, 3, 7, 5, 13, 19, 59}},
+	{37, []uint32{1, 1, 3, 9, 25, 29, 41}},
+	{41, []uint32{1, 3, 5, 13, 23, 1, 55}},
+	{42, []uint32{1, 3, 7, 3, 13, 59, 17}},
+	{50, []uint32{1, 3, 1, 3, 5, 53, 69}},
+	{55, []uint32{1, 1, 5, 5, 23, 33, 13}},
+	{56, []uint32{1, 1, 7, 7, 1, 61, 123}},
+	{59, []uint32{1, 1, 7, 9, 13, 61, 49}},
+	{62, []uint32{1, 3, 3, 5, 3, 55, 33}},
+	{14, []uint32{1, 3, 1, 15, 31, 13, 49, 245}},
+	{21, []uint32{1, 3, 5, 15, 31, 59, 63, 97}},
+	{22, []uint32{1, 3, 1, 11, 11, 11, 77, 249}},
+}
@@ -0,0 +1,176 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package samplemv
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"github.com/jingcheng-WU/gonum/floats/scalar"
+	"github.com/jingcheng-WU/gonum/mat"
+	"github.com/jingcheng-WU/gonum/stat/distmv"
+)
+
+func TestSobolUnscrambled(t *testing.T) {
+	t.Parallel()
+	want := mat.NewDense(8, 3, []float64{
+		0, 0, 0,
+		0.5, 0.5, 0.5,
+		0.75, 0.25, 0.25,
+		0.25, 0.75, 0.75,
+		0.375, 0.375, 0.625,
+		0.875, 0.875, 0.125,
+		0.625, 0.125, 0.875,
+		0.125, 0.625, 0.375,
+	})
+	got := mat.NewDense(8, 3, nil)
+	Sobol{Kind: SobolUnscrambled, Q: distmv.NewUnitUniform(3, nil)}.Sample(got)
+	if !mat.Equal(got, want) {
+		t.Errorf("unexpected Sobol points:\ngot:\n%v\nwant:\n%v", mat.Formatted(got), mat.Formatted(want))
+	}
+}
+
+func TestSobolDirections(t *testing.T) {
+	t.Parallel()
+	if len(sobolDirections)+1 != SobolMaxDim {
+		t.Fatalf("mismatched number of directions: got %d, want %d", len(sobolDirections)+1, SobolMaxDim)
+	}
+	seen := make(map[[2]uint32]bool)
+	for j, dir := range sobolDirections {
+		s := len(dir.m)
+		if dir.a >= 1<<uint(s-1) {
+			t.Errorf("dimension %d: polynomial coefficients out of range", j+2)
+		}
+		for k, m := range dir.m {
+			if m%2 != 1 || m >= 1<<uint(k+1) {
+				t.Errorf("dimension %d: invalid initial direction number m_%d = %d", j+2, k+1, m)
+			}
+		}
+		key := [2]uint32{uint32(s), dir.a}
+		if seen[key] {
+			t.Errorf("dimension %d: repeated polynomial", j+2)
+		}
+		seen[key] = true
+		if !isPrimitive(s, dir.a) {
+			t.Errorf("dimension %d: polynomial is not primitive", j+2)
+		}
+	}
+}
+
+// isPrimitive returns whether the polynomial over GF(2) of degree s with
+// interior coefficients a is primitive, that is whether x has order 2^s-1
+// modulo the polynomial.
+func isPrimitive(s int, a uint32) bool {
+	poly := uint64(1)<<uint(s) | uint64(a)<<1 | 1
+	order := uint64(1)<<uint(s) - 1
+	x := uint64(1)
+	for i := uint64(1); i <= order; i++ {
+		x <<= 1
+		if x>>uint(s)&1 == 1 {
+			x ^= poly
+		}
+		if x == 1 {
+			return i == order
+		}
+	}
+	return false
+}
+
+func TestSobolStratification(t *testing.T) {
+	t.Parallel()
+	for _, kind := range []SobolKind{SobolUnscrambled, SobolOwen} {
+		for _, m := range []int{4, 7, 10} {
+			n := 1 << uint(m)
+			const d = SobolMaxDim
+			batch := mat.NewDense(n, d, nil)
+			src := rand.NewSource(uint64(m))
+			Sobol{Kind: kind, Q: distmv.NewUnitUniform(d, nil), Src: src}.Sample(batch)
+
+			// Every one-dimensional projection of the first 2^m points
+			// has exactly one point in each interval of width 2^-m.
+			for j := 0; j < d; j++ {
+				count := make([]int, n)
+				for i := 0; i < n; i++ {
+					v := batch.At(i, j)
+					if v < 0 || 1 <= v {
+						t.Fatalf("kind %d, m=%d: point out of unit interval: %v", kind, m, v)
+					}
+					count[int(v*float64(n))]++
+				}
+				for b, c := range count {
+					if c != 1 {
+						t.Errorf("kind %d, m=%d, dim %d: interval %d has %d points", kind, m, j, b, c)
+						break
+					}
+				}
+			}
+
+			// The first two dimensions form a (0,m,2)-net, so every
+			// elementary interval of volume 2^-m holds exactly one point.
+			for q := 0; q <= m; q++ {
+				nx := 1 << uint(q)
+				ny := 1 << uint(m-q)
+				count := make(map[[2]int]int)
+				for i := 0; i < n; i++ {
+					count[[2]int{int(batch.At(i, 0) * float64(nx)), int(batch.At(i, 1) * float64(ny))}]++
+				}
+				if len(count) != n {
+					t.Errorf("kind %d, m=%d: not a (0,m,2)-net for %d×%d intervals", kind, m, nx, ny)
+				}
+			}
+		}
+	}
+}
+
+func TestSobolOwenMean(t *testing.T) {
+	t.Parallel()
+	// Each scrambled point is uniformly distributed so the average over
+	// independent replicates of the mean of x_0 x_1 x_2 is unbiased.
+	const (
+		reps = 200
+		n    = 64
+		d    = 3
+	)
+	src := rand.NewSource(1)
+	batch := mat.NewDense(n, d, nil)
+	var mean float64
+	for r := 0; r < reps; r++ {
+		Sobol{Kind: SobolOwen, Q: distmv.NewUnitUniform(d, nil), Src: src}.Sample(batch)
+		for i := 0; i < n; i++ {
+			row := batch.RawRowView(i)
+			mean += row[0] * row[1] * row[2]
+		}
+	}
+	mean /= reps * n
+	if !scalar.EqualWithinAbs(mean, 0.125, 1e-3) {
+		t.Errorf("unexpected mean: got %v, want 0.125", mean)
+	}
+}
+
+func TestSobolPanics(t *testing.T) {
+	t.Parallel()
+	q := distmv.NewUnitUniform(SobolMaxDim+1, nil)
+	for _, test := range []struct {
+		name string
+		fn   func()
+	}{
+		{"unknown kind", func() { Sobol{Q: q}.Sample(mat.NewDense(2, 2, nil)) }},
+		{"too many dimensions", func() { Sobol{Kind: SobolOwen, Q: q}.Sample(mat.NewDense(2, SobolMaxDim+1, nil)) }},
+	} {
+		if !panics(test.fn) {
+			t.Errorf("expected panic for %s", test.name)
+		}
+	}
+}
+
+func panics(fn func()) (panicked bool) {
+	defer func() {
+		r := recover()
+		panicked = r != nil
+	}()
+	fn()
+	return
+}